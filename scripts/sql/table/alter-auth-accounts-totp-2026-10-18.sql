@@ -0,0 +1,16 @@
+-- totp_secret holds the AES-GCM sealed base32 secret, never the plain one.
+-- It is set on enrollment and only used for sign-in once totp_enabled is
+-- set by a confirmed code.
+ALTER TABLE accounts
+    ADD COLUMN totp_secret TEXT,
+    ADD COLUMN totp_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN totp_confirmed_at TIMESTAMP WITH TIME ZONE;
+
+-- recovery codes are single use and only stored as sha256 hashes.
+CREATE TABLE totp_recovery_codes (
+    code_hash CHAR(64) NOT NULL,
+    account_id UUID NOT NULL REFERENCES accounts(id),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    used_at TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (account_id, code_hash)
+);
@@ -0,0 +1,2 @@
+-- serves the farm list pages, ordered by (created_at, id) per farmer
+CREATE INDEX idx_farms_farmer_created_id_active ON farms (farmer_id, created_at, id) WHERE deleted_at IS NULL;
@@ -0,0 +1,28 @@
+-- filters of the farm list, every list is scoped to one farmer
+CREATE INDEX IF NOT EXISTS idx_farms_status ON farms (farm_status);
+
+CREATE INDEX IF NOT EXISTS idx_farms_created_at ON farms (created_at);
+
+CREATE INDEX IF NOT EXISTS idx_farms_type ON farms (farm_type);
+
+CREATE INDEX IF NOT EXISTS idx_farms_farmer_status ON farms (farmer_id, farm_status);
+
+-- sort columns of the farm list, the id breaks ties for the page tokens
+CREATE INDEX IF NOT EXISTS idx_farms_farmer_name_id_active ON farms (farmer_id, farm_name, id) WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_farms_farmer_size_id_active ON farms (farmer_id, farm_size, id) WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_farms_farmer_updated_id_active ON farms (farmer_id, updated_at, id) WHERE deleted_at IS NULL;
+
+-- address filters of the farm list
+CREATE INDEX IF NOT EXISTS idx_addresses_province ON addresses (province);
+
+CREATE INDEX IF NOT EXISTS idx_addresses_city ON addresses (city);
+
+CREATE INDEX IF NOT EXISTS idx_addresses_postal_code ON addresses (postal_code);
+
+CREATE INDEX IF NOT EXISTS idx_addresses_province_city ON addresses (province, city);
+
+CREATE INDEX IF NOT EXISTS idx_addresses_sub_district ON addresses (sub_district);
+
+CREATE INDEX IF NOT EXISTS idx_addresses_created_at ON addresses (created_at);
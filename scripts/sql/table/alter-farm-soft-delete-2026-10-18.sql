@@ -0,0 +1,5 @@
+ALTER TABLE addresses ADD COLUMN deleted_at TIMESTAMP WITH TIME ZONE;
+
+ALTER TABLE farms ADD COLUMN deleted_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX idx_farms_farmer_id_active ON farms (farmer_id) WHERE deleted_at IS NULL;
@@ -0,0 +1,12 @@
+-- verification tokens are only stored as sha256 hashes. account_id has no
+-- foreign key because accounts are inserted asynchronously through kafka.
+CREATE TABLE email_verifications (
+    token_hash CHAR(64) PRIMARY KEY NOT NULL,
+    account_id UUID NOT NULL,
+    email VARCHAR(225) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    used_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_email_verifications_account_id ON email_verifications (account_id);
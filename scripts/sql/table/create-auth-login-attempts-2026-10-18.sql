@@ -0,0 +1,15 @@
+-- audit trail of sign-in attempts. account_id is null when the email is not
+-- registered, so there is no foreign key to accounts.
+CREATE TABLE login_attempts (
+    id UUID PRIMARY KEY NOT NULL,
+    account_id UUID,
+    email VARCHAR(225) NOT NULL,
+    ip_address VARCHAR(64) NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    success BOOLEAN NOT NULL DEFAULT false,
+    reason VARCHAR(64) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_login_attempts_email_created_at ON login_attempts (email, created_at DESC);
+CREATE INDEX idx_login_attempts_account_id_created_at ON login_attempts (account_id, created_at DESC);
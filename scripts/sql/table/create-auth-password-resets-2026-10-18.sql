@@ -0,0 +1,10 @@
+-- reset tokens are only stored as sha256 hashes, like email_verifications.
+CREATE TABLE password_resets (
+    token_hash CHAR(64) PRIMARY KEY NOT NULL,
+    account_id UUID NOT NULL REFERENCES accounts(id),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    used_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_password_resets_account_id ON password_resets (account_id);
@@ -0,0 +1,21 @@
+-- one row per signup saga, keyed by the id shared by accounts and users.
+-- the account and user rows land asynchronously through kafka, the saga
+-- consumer stamps each confirmation and completes the registration once both
+-- rows exist. an email can only have one pending registration at a time.
+-- compensated_at is set once the rows of a failed registration are removed.
+CREATE TABLE registrations (
+    user_id UUID PRIMARY KEY NOT NULL,
+    email VARCHAR(225) NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'complete', 'failed')),
+    account_confirmed_at TIMESTAMP WITH TIME ZONE,
+    user_confirmed_at TIMESTAMP WITH TIME ZONE,
+    failure_reason TEXT,
+    compensated_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX idx_registrations_pending_email ON registrations (email) WHERE status = 'pending';
+CREATE INDEX idx_registrations_pending_created_at ON registrations (created_at) WHERE status = 'pending';
+CREATE INDEX idx_registrations_uncompensated ON registrations (updated_at) WHERE status = 'failed' AND compensated_at IS NULL;
@@ -0,0 +1,23 @@
+CREATE TABLE sessions (
+    id UUID PRIMARY KEY NOT NULL,
+    account_id UUID NOT NULL REFERENCES accounts (id),
+    user_agent TEXT NOT NULL DEFAULT '',
+    ip_address VARCHAR(64) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    last_used_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    revoked_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_sessions_account_id_active ON sessions (account_id) WHERE revoked_at IS NULL;
+
+-- refresh tokens are only stored as sha256 hashes. A token with used_at set
+-- has already been rotated, presenting it again revokes the whole session.
+CREATE TABLE refresh_tokens (
+    token_hash CHAR(64) PRIMARY KEY NOT NULL,
+    session_id UUID NOT NULL REFERENCES sessions (id),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    used_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_refresh_tokens_session_id ON refresh_tokens (session_id);
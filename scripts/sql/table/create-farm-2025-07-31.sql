@@ -42,11 +42,4 @@ CREATE TABLE farms_p7 PARTITION OF farms
 
 CREATE INDEX idx_farms_farmer_id ON farms (farmer_id);
 
--- CREATE INDEX idx_farms_status ON farms (farm_status);
---
--- CREATE INDEX idx_farms_created_at ON farms (created_at);
---
--- CREATE INDEX idx_farms_type ON farms (farm_type);
---
--- CREATE INDEX idx_farms_farmer_status ON farms (farmer_id, farm_status);
-
+-- the farm list indexes are created by alter-farm-list-indexes-2026-10-18.sql
@@ -35,19 +35,4 @@ CREATE TABLE addresses_p6 PARTITION OF addresses
 CREATE TABLE addresses_p7 PARTITION OF addresses
     FOR VALUES WITH (modulus 8, remainder 7);
 
--- CREATE INDEX idx_addresses_province ON addresses (province);
---
--- -- Index untuk city (sering diquery)
--- CREATE INDEX idx_addresses_city ON addresses (city);
---
--- -- Index untuk postal_code
--- CREATE INDEX idx_addresses_postal_code ON addresses (postal_code);
---
--- -- Composite index untuk geographic queries
--- CREATE INDEX idx_addresses_province_city ON addresses (province, city);
---
--- -- Index untuk sub_district
--- CREATE INDEX idx_addresses_sub_district ON addresses (sub_district);
---
--- -- Index untuk created_at (untuk sorting/filtering)
--- CREATE INDEX idx_addresses_created_at ON addresses (created_at);
+-- the address lookup indexes are created by alter-farm-list-indexes-2026-10-18.sql
@@ -0,0 +1,13 @@
+-- filled from the user-login topic. id is the event id, so a redelivered
+-- event is only recorded once. user_id has no foreign key because the
+-- partitioned users table is written asynchronously as well.
+CREATE TABLE login_audit (
+    id UUID PRIMARY KEY NOT NULL,
+    user_id UUID NOT NULL,
+    ip_address VARCHAR(64) NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    outcome VARCHAR(64) NOT NULL,
+    logged_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX idx_login_audit_user_id_logged_at ON login_audit (user_id, logged_at DESC);
@@ -2,12 +2,10 @@ package main
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"log"
 	"os"
-	"os/signal"
-	"sync"
-	"syscall"
+	"time"
 
 	"github.com/cockroachdb/pebble"
 	"github.com/cockroachdb/pebble/vfs"
@@ -18,13 +16,40 @@ import (
 	"github.com/sony-nurdianto/farm/shared_lib/Go/kafkaev/avr"
 	"github.com/sony-nurdianto/farm/shared_lib/Go/kafkaev/kev"
 	"github.com/sony-nurdianto/farm/shared_lib/Go/kafkaev/schrgs"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/observability"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 func main() {
 	godotenv.Load()
-	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	ctx, stop := runtime.SignalContext()
 	defer stop()
 
+	serviceObsName := "farm-event"
+
+	connColl, err := grpc.NewClient(
+		os.Getenv("OTELCOLLECTORADDR"),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	obs := observability.NewObservability(
+		serviceObsName,
+		connColl,
+	)
+
+	tp, mp, lp, err := obs.Init(ctx)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	tracer := tp.Tracer(serviceObsName)
+	meter := mp.Meter(serviceObsName)
+
 	opts := &pebble.Options{
 		FS: vfs.Default,
 	}
@@ -46,32 +71,60 @@ func main() {
 		log.Fatalln(err)
 	}
 
-	defer farmRepo.CloseRepo()
-
 	farmService := services.NewFarmService(farmRepo)
 
-	go func(c context.Context, fs services.FarmService) {
-		if err := fs.SyncFarmCache(c, "farm-db.public.farms_all_partitions"); err != nil {
-			log.Fatalln(err)
-		}
-	}(ctx, farmService)
-
-	go func(c context.Context, fs services.FarmService) {
-		if err := fs.SyncFarmAddressCache(c, "farm-db.public.addresses_all_partitions"); err != nil {
-			log.Fatalln(err)
-		}
-	}(ctx, farmService)
-
-	var once sync.Once
+	// the consumers depend on the repo, so they stop and commit their last
+	// offsets before the repo closes them
+	runner := runtime.NewRunner()
+	runner.Register(
+		runtime.Hook{
+			Name: "observability",
+			Stop: func(ctx context.Context) error {
+				return errors.Join(tp.Shutdown(ctx), mp.Shutdown(ctx), lp.Shutdown(ctx))
+			},
+		},
+		runtime.Hook{
+			Name:      "state-db",
+			DependsOn: []string{"observability"},
+			Stop: func(context.Context) error {
+				return stateDB.Close()
+			},
+		},
+		runtime.Hook{
+			Name:      "farm-repo",
+			DependsOn: []string{"state-db"},
+			Stop: func(context.Context) error {
+				farmRepo.CloseRepo()
+				return nil
+			},
+		},
+		runtime.Hook{
+			Name:      "farm-search-index",
+			DependsOn: []string{"farm-repo"},
+			Start:     farmRepo.EnsureFarmSearchIndex,
+		},
+		runtime.Hook{
+			Name:      "farm-consumer",
+			DependsOn: []string{"farm-search-index"},
+			Run: func(ctx context.Context) error {
+				return farmService.SyncFarmCache(ctx, "farm-db.public.farms_all_partitions", tracer, meter)
+			},
+		},
+		runtime.Hook{
+			Name:      "farm-address-consumer",
+			DependsOn: []string{"farm-search-index"},
+			Run: func(ctx context.Context) error {
+				return farmService.SyncFarmAddressCache(ctx, "farm-db.public.addresses_all_partitions", tracer, meter)
+			},
+		},
+		runtime.Hook{
+			Name:      "farm-cache-refresh",
+			DependsOn: []string{"farm-repo"},
+			Run: func(ctx context.Context) error {
+				return farmService.RefreshFarmCache(ctx, time.Hour*6)
+			},
+		},
+	)
 
-	for {
-		select {
-		case <-ctx.Done():
-			fmt.Println("Event Server Stoping, Gracefully Stop ...")
-			fmt.Println("Application Quit.")
-			return
-		default:
-			once.Do(func() { fmt.Println("event service daemon run") })
-		}
-	}
+	runner.Main(ctx)
 }
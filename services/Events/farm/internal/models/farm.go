@@ -12,16 +12,20 @@ type Farm struct {
 	CreatedAt   string  `avro:"created_at" redis:"created_at" json:"created_at"`
 	UpdatedAt   string  `avro:"updated_at" redis:"updated_at" json:"updated_at"`
 	AddressID   string  `avro:"address_id" redis:"address_id" json:"address_id"` // bukan addresses_id
+	DeletedAt   *string `avro:"deleted_at" redis:"-" json:"deleted_at"`
 }
 
+// FarmAddress is written into the hash of its farm, the fields the farm
+// has too are prefixed so they do not overwrite the farm's.
 type FarmAddress struct {
-	ID          string `avro:"id" redis:"id" json:"id"`
-	Street      string `avro:"street" redis:"street" json:"street"`
-	Village     string `avro:"village" redis:"village" json:"village"`
-	SubDistrict string `avro:"sub_district" redis:"sub_district" json:"sub_district"`
-	City        string `avro:"city" redis:"city" json:"city"`
-	Province    string `avro:"province" redis:"province" json:"province"`
-	PostalCode  string `avro:"postal_code" redis:"postal_code" json:"postal_code"`
-	CreatedAt   string `avro:"created_at" redis:"created_at" json:"created_at"`
-	UpdatedAt   string `avro:"updated_at" redis:"updated_at" json:"updated_at"`
+	ID          string  `avro:"id" redis:"address_id" json:"id"`
+	Street      string  `avro:"street" redis:"street" json:"street"`
+	Village     string  `avro:"village" redis:"village" json:"village"`
+	SubDistrict string  `avro:"sub_district" redis:"sub_district" json:"sub_district"`
+	City        string  `avro:"city" redis:"city" json:"city"`
+	Province    string  `avro:"province" redis:"province" json:"province"`
+	PostalCode  string  `avro:"postal_code" redis:"postal_code" json:"postal_code"`
+	CreatedAt   string  `avro:"created_at" redis:"address_created_at" json:"created_at"`
+	UpdatedAt   string  `avro:"updated_at" redis:"address_updated_at" json:"updated_at"`
+	DeletedAt   *string `avro:"deleted_at" redis:"-" json:"deleted_at"`
 }
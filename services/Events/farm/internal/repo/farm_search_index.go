@@ -0,0 +1,48 @@
+package repo
+
+import (
+	"context"
+	"strings"
+)
+
+// FarmSearchIndex is the RediSearch index over the farm:{id}:{farmerID}
+// hashes, the farm service searches it by this name.
+const FarmSearchIndex = "idx:farms"
+
+// farmSearchSchema indexes the fields the farm and address consumers write
+// into a farm hash. Tags are matched whole and counted for the facets, text
+// fields are tokenized for the typo tolerant search.
+var farmSearchSchema = []any{
+	"farm_name", "TEXT", "WEIGHT", "5.0",
+	"description", "TEXT",
+	"street", "TEXT",
+	"village", "TEXT",
+	"sub_district", "TEXT",
+	"farmer_id", "TAG",
+	"farm_type", "TAG",
+	"farm_status", "TAG",
+	"province", "TAG",
+	"city", "TAG",
+	"postal_code", "TAG",
+	"farm_size", "NUMERIC", "SORTABLE",
+}
+
+// EnsureFarmSearchIndex creates the farm search index when it does not
+// exist yet. RediSearch indexes the hashes that are already cached in the
+// background and every hash written after.
+func (fr farmRepo) EnsureFarmSearchIndex(ctx context.Context) error {
+	args := []any{
+		"FT.CREATE", FarmSearchIndex,
+		"ON", "HASH",
+		"PREFIX", "1", "farm:",
+		"SCHEMA",
+	}
+	args = append(args, farmSearchSchema...)
+
+	err := fr.farmCache.Do(ctx, args...).Err()
+	if err != nil && !strings.Contains(err.Error(), "Index already exists") {
+		return err
+	}
+
+	return nil
+}
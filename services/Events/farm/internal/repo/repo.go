@@ -16,6 +16,8 @@ import (
 	"github.com/sony-nurdianto/farm/shared_lib/Go/kafkaev/avr"
 	"github.com/sony-nurdianto/farm/shared_lib/Go/kafkaev/kev"
 	"github.com/sony-nurdianto/farm/shared_lib/Go/kafkaev/schrgs"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/observability/otel/trace"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type FarmRepo interface {
@@ -26,7 +28,10 @@ type FarmRepo interface {
 	DeserializerFarmAddress(topic string, payload []byte) (f models.FarmAddress, _ error)
 	UpsertFarmCache(ctx context.Context, farm models.Farm, ops string) error
 	UpsertFarmAddressCache(ctx context.Context, addr models.FarmAddress) error
-	DeleteFarmCache(ctx context.Context, farmID string, farmerID string) error
+	DeleteFarmAddressCache(ctx context.Context, addressID string) error
+	DeleteFarmCache(ctx context.Context, farmID string, farmerID string, addressID string) error
+	RefreshFarmCache(ctx context.Context) (int, error)
+	EnsureFarmSearchIndex(ctx context.Context) error
 }
 
 const (
@@ -285,6 +290,7 @@ func (fr farmRepo) CloseRepo() {
 	fr.srcClient.Close()
 	fr.avrDeserializer.Close()
 	fr.farmConsumer.Close()
+	fr.farmAddrConsumer.Close()
 	fr.farmCache.Close()
 }
 
@@ -314,81 +320,205 @@ func (fr farmRepo) DeserializerFarmAddress(topic string, payload []byte) (f mode
 	return f, nil
 }
 
+// farmCacheTTL bounds the life of a farm hash whose delete never reached
+// the consumer. RefreshFarmCache extends the hashes of the farms that are
+// still alive, so the search index keeps them.
+const farmCacheTTL = time.Hour * 24
+
+func farmKey(farmID string, farmerID string) string {
+	return fmt.Sprintf("farm:%s:%s", farmID, farmerID)
+}
+
+// setFarmHash writes values into the hash of the farm and resets its TTL.
+func (fr farmRepo) setFarmHash(ctx context.Context, key string, values any) error {
+	pipe := fr.farmCache.TxPipeline()
+	pipe.HSet(ctx, key, values)
+	pipe.Expire(ctx, key, farmCacheTTL)
+
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
 func (fr farmRepo) UpsertFarmCache(
 	ctx context.Context,
 	farm models.Farm,
 	ops string,
-) error {
-	key := fmt.Sprintf("farm:%s:%s", farm.ID, farm.FarmerID)
-
-	pipe := fr.farmCache.TxPipeline()
+) (err error) {
+	ctx, span := spans.StartClient(ctx, "UpsertFarmCache", trace.CacheAttrs("HSET")...)
+	defer func() { spans.End(span, err) }()
 
-	hset := pipe.HSet(ctx, key, farm)
-	if hset.Err() != nil {
-		return hset.Err()
+	if err := fr.setFarmHash(ctx, farmKey(farm.ID, farm.FarmerID), farm); err != nil {
+		return err
 	}
 
-	expire := pipe.Expire(ctx, key, time.Hour*24)
-	if expire.Err() != nil {
-		return expire.Err()
-	}
+	stateValue := fmt.Sprintf("%s:%s", farm.ID, farm.FarmerID)
+	return fr.setFarmState(ctx, farm.AddressID, stateValue)
+}
 
-	if _, err := pipe.Exec(ctx); err != nil {
-		return err
+func (fr farmRepo) setFarmState(ctx context.Context, addressID string, value string) (err error) {
+	_, span := spans.StartClient(ctx, "UpsertFarmCache.state", stateAttrs("SET")...)
+	defer func() { spans.End(span, err) }()
+
+	return fr.stateDB.Set([]byte(addressID), []byte(value), pebble.Sync)
+}
+
+// farmState reads the farm the state pairs with the address.
+func (fr farmRepo) farmState(addressID string) (farmID string, farmerID string, err error) {
+	ids, closer, err := fr.stateDB.Get([]byte(addressID))
+	if err != nil {
+		return "", "", err
 	}
 
-	stateValue := fmt.Sprintf("%s:%s", farm.ID, farm.FarmerID)
-	if err := fr.stateDB.Set([]byte(farm.AddressID), []byte(stateValue), pebble.Sync); err != nil {
-		return err
+	value := string(ids)
+	closer.Close()
+
+	farmID, farmerID, ok := strings.Cut(value, ":")
+	if !ok {
+		return "", "", fmt.Errorf("farm of address %s: malformed state %q", addressID, value)
 	}
 
-	return nil
+	return farmID, farmerID, nil
 }
 
-func (fr farmRepo) UpsertFarmAddressCache(ctx context.Context, addr models.FarmAddress) error {
-	var farmID string
-	var farmerID string
-
-	for range 5 {
-		ids, closer, err := fr.stateDB.Get([]byte(addr.ID))
-		if err == nil {
-			data := strings.Split(string(ids), ":")
-			farmID = data[0]
-			farmerID = data[1]
-			closer.Close()
-			break
-		}
+// farmOfAddress waits for the farm of the address to reach the state, the
+// address can be read before the farm that points at it.
+func (fr farmRepo) farmOfAddress(ctx context.Context, addressID string) (farmID string, farmerID string, err error) {
+	_, span := spans.StartClient(ctx, "UpsertFarmAddressCache.state", stateAttrs("GET")...)
+	defer func() { spans.End(span, err) }()
 
+	for attempt := range 5 {
+		farmID, farmerID, err := fr.farmState(addressID)
 		if errors.Is(err, pebble.ErrNotFound) {
 			time.Sleep(time.Millisecond * 100)
 			continue
 		}
 
-		return err
+		if err != nil {
+			return "", "", err
+		}
 
+		span.SetAttributes(attribute.Int("state.attempts", attempt+1))
+		return farmID, farmerID, nil
 	}
 
-	key := fmt.Sprintf("farm:%s:%s", farmID, farmerID)
-	hset := fr.farmCache.HSet(ctx, key, addr)
-	if hset.Err() != nil {
-		return hset.Err()
+	// without its farm the address would be written to a farm:: hash that
+	// the search index picks up
+	return "", "", fmt.Errorf("farm of address %s not found in state", addressID)
+}
+
+// UpsertFarmAddressCache writes the address into the hash of its farm. The
+// address keeps its farm in the state until the farm is deleted, every later
+// update of the address needs it again.
+func (fr farmRepo) UpsertFarmAddressCache(ctx context.Context, addr models.FarmAddress) (err error) {
+	ctx, span := spans.StartClient(ctx, "UpsertFarmAddressCache", trace.CacheAttrs("HSET")...)
+	defer func() { spans.End(span, err) }()
+
+	farmID, farmerID, err := fr.farmOfAddress(ctx, addr.ID)
+	if err != nil {
+		return err
 	}
 
-	if err := fr.stateDB.Delete([]byte(addr.ID), pebble.Sync); err != nil {
-		log.Println(err)
+	return fr.setFarmHash(ctx, farmKey(farmID, farmerID), addr)
+}
+
+// farmAddressFields are the fields FarmAddress writes into the hash of its
+// farm, address_id aside which the farm writes too.
+var farmAddressFields = []string{
+	"street",
+	"village",
+	"sub_district",
+	"city",
+	"province",
+	"postal_code",
+	"address_created_at",
+	"address_updated_at",
+}
+
+// DeleteFarmAddressCache removes the address from the hash of its farm. An
+// address without a farm in the state went with the hash of its farm.
+func (fr farmRepo) DeleteFarmAddressCache(ctx context.Context, addressID string) (err error) {
+	ctx, span := spans.StartClient(ctx, "DeleteFarmAddressCache", trace.CacheAttrs("HDEL")...)
+	defer func() { spans.End(span, err) }()
+
+	farmID, farmerID, err := fr.farmState(addressID)
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil
+	}
+
+	if err != nil {
 		return err
 	}
 
-	return nil
+	pipe := fr.farmCache.TxPipeline()
+	pipe.HDel(ctx, farmKey(farmID, farmerID), farmAddressFields...)
+
+	_, err = pipe.Exec(ctx)
+	return err
 }
 
-func (fr farmRepo) DeleteFarmCache(ctx context.Context, farmID string, farmerID string) error {
-	keyFarm := fmt.Sprintf("farm:%s:%s", farmID, farmerID)
+func (fr farmRepo) deleteFarmState(ctx context.Context, addressID string) (err error) {
+	_, span := spans.StartClient(ctx, "DeleteFarmCache.state", stateAttrs("DELETE")...)
+	defer func() { spans.End(span, err) }()
+
+	return fr.stateDB.Delete([]byte(addressID), pebble.Sync)
+}
+
+// DeleteFarmCache removes the hash of the farm and the farm of its address
+// from the state.
+func (fr farmRepo) DeleteFarmCache(ctx context.Context, farmID string, farmerID string, addressID string) (err error) {
+	ctx, span := spans.StartClient(ctx, "DeleteFarmCache", trace.CacheAttrs("DEL")...)
+	defer func() { spans.End(span, err) }()
+
+	del := fr.farmCache.Del(ctx, farmKey(farmID, farmerID))
+	if del.Err() != nil {
+		return del.Err()
+	}
+
+	if addressID == "" {
+		return nil
+	}
+
+	return fr.deleteFarmState(ctx, addressID)
+}
 
-	hset := fr.farmCache.Del(ctx, keyFarm)
-	if hset.Err() != nil {
-		return hset.Err()
+// RefreshFarmCache resets the TTL of the hash of every farm in the state,
+// those are the farms that are not deleted and so have to stay searchable.
+// It returns the number of hashes it refreshed.
+func (fr farmRepo) RefreshFarmCache(ctx context.Context) (refreshed int, err error) {
+	ctx, span := spans.StartClient(ctx, "RefreshFarmCache", trace.CacheAttrs("EXPIRE")...)
+	defer func() {
+		span.SetAttributes(attribute.Int("farm.cache.refreshed", refreshed))
+		spans.End(span, err)
+	}()
+
+	iter, err := fr.stateDB.NewIterWithContext(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	pipe := fr.farmCache.TxPipeline()
+	for iter.First(); iter.Valid(); iter.Next() {
+		farmID, farmerID, ok := strings.Cut(string(iter.Value()), ":")
+		if !ok {
+			continue
+		}
+
+		pipe.Expire(ctx, farmKey(farmID, farmerID), farmCacheTTL)
+		refreshed++
+	}
+
+	if err := iter.Error(); err != nil {
+		return 0, err
+	}
+
+	if refreshed == 0 {
+		return 0, nil
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
 	}
 
-	return nil
+	return refreshed, nil
 }
@@ -0,0 +1,19 @@
+package repo
+
+import (
+	"github.com/sony-nurdianto/farm/shared_lib/Go/observability/otel/trace"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// spans of the cache and state calls of the repo, neither client is
+// instrumented so every call gets a client span.
+var spans = trace.NewRepoSpans("farm-event")
+
+// stateAttrs describes a call to the pebble state that pairs an address
+// with its farm until the farm is deleted.
+func stateAttrs(operation string) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("db.system", "pebble"),
+		attribute.String("db.operation", operation),
+	}
+}
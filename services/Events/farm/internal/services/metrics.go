@@ -0,0 +1,74 @@
+package services
+
+import (
+	"go.opentelemetry.io/otel/metric"
+)
+
+// consumerMetrics are the instruments of a consumer loop, named like the
+// ones of the farmer events so both land on the same dashboards.
+type consumerMetrics struct {
+	msgProcessed           metric.Int64Counter
+	cacheOperations        metric.Int64Counter
+	msgCommitted           metric.Int64Counter
+	prcsDuration           metric.Float64Histogram
+	deseDuration           metric.Float64Histogram
+	cacheOperationDuration metric.Float64Histogram
+	errorCounter           metric.Int64Counter
+	activeConsumers        metric.Int64UpDownCounter
+}
+
+func newConsumerMetrics(meter metric.Meter) consumerMetrics {
+	msgProcessed, _ := meter.Int64Counter(
+		"kafka_messages_processed_total",
+		metric.WithDescription("Total number of Kafka messages processed"),
+	)
+
+	cacheOperations, _ := meter.Int64Counter(
+		"cache_operations_total",
+		metric.WithDescription("Total number of cache operations"),
+	)
+
+	msgCommitted, _ := meter.Int64Counter(
+		"kafka_messages_committed_total",
+		metric.WithDescription("Total number of kafka messages committed"),
+	)
+
+	prcsDuration, _ := meter.Float64Histogram(
+		"message_processing_duration_seconds",
+		metric.WithDescription("Time taken to process each message"),
+		metric.WithUnit("s"),
+	)
+
+	deseDuration, _ := meter.Float64Histogram(
+		"deserialization_duration_seconds",
+		metric.WithDescription("Time taken to deserialize messages"),
+		metric.WithUnit("s"),
+	)
+
+	cacheOperationDuration, _ := meter.Float64Histogram(
+		"cache_operation_duration_seconds",
+		metric.WithDescription("Time taken for cache operations (upsert/delete)"),
+		metric.WithUnit("s"),
+	)
+
+	errorCounter, _ := meter.Int64Counter(
+		"sync_errors_total",
+		metric.WithDescription("Total number of errors during sync"),
+	)
+
+	activeConsumers, _ := meter.Int64UpDownCounter(
+		"active_kafka_consumers",
+		metric.WithDescription("Number of active Kafka consumers"),
+	)
+
+	return consumerMetrics{
+		msgProcessed:           msgProcessed,
+		cacheOperations:        cacheOperations,
+		msgCommitted:           msgCommitted,
+		prcsDuration:           prcsDuration,
+		deseDuration:           deseDuration,
+		cacheOperationDuration: cacheOperationDuration,
+		errorCounter:           errorCounter,
+		activeConsumers:        activeConsumers,
+	}
+}
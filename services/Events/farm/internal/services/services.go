@@ -3,80 +3,318 @@ package services
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
 	"github.com/sony-nurdianto/farm/services/Events/farm/internal/repo"
 	"github.com/sony-nurdianto/farm/shared_lib/Go/kafkaev/kev"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/observability/otel/logs"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type FarmService interface {
-	SyncFarmCache(ctx context.Context, topic string) error
-	SyncFarmAddressCache(ctx context.Context, topic string) error
+	SyncFarmCache(ctx context.Context, topic string, tracer trace.Tracer, meter metric.Meter) error
+	SyncFarmAddressCache(ctx context.Context, topic string, tracer trace.Tracer, meter metric.Meter) error
+	RefreshFarmCache(ctx context.Context, interval time.Duration) error
 }
 
 type farmService struct {
-	repo repo.FarmRepo
+	repo   repo.FarmRepo
+	logger *logs.Logger
 }
 
 func NewFarmService(repo repo.FarmRepo) farmService {
 	return farmService{
-		repo,
+		repo:   repo,
+		logger: logs.NewLogger(),
 	}
 }
 
+// consumerLoop is the state shared by the messages of one SyncFarmCache or
+// SyncFarmAddressCache loop.
+type consumerLoop struct {
+	farmService
+	topic    string
+	consumer kev.KevConsumer
+	tracer   trace.Tracer
+	metrics  consumerMetrics
+}
+
+func (fs farmService) subscribe(
+	ctx context.Context,
+	name string,
+	topic string,
+	consumer kev.KevConsumer,
+	tracer trace.Tracer,
+	meter metric.Meter,
+) (context.Context, trace.Span, consumerLoop, error) {
+	loopCtx, span := tracer.Start(ctx, name,
+		trace.WithAttributes(
+			attribute.String("kafka.topic", topic),
+			attribute.String("operation", name),
+		),
+	)
+
+	if err := consumer.SubscribeTopics([]string{topic}, kev.RebalanceCbCooperativeSticky); err != nil {
+		span.SetStatus(codes.Error, "Subscribe failed")
+		span.RecordError(err)
+		span.End()
+		return ctx, nil, consumerLoop{}, err
+	}
+
+	span.SetAttributes(attribute.String("consumer.status", "subscribed"))
+
+	return loopCtx, span, consumerLoop{
+		farmService: fs,
+		topic:       topic,
+		consumer:    consumer,
+		tracer:      tracer,
+		metrics:     newConsumerMetrics(meter),
+	}, nil
+}
+
+// read reads the next message and starts its process_kafka_message span,
+// which continues the trace of the record when it carries one. A nil
+// message without an error means there was no message to read.
+func (cl consumerLoop) read(ctx context.Context, loopSpan trace.Span) (context.Context, trace.Span, *kafka.Message, error) {
+	msg, err := cl.consumer.ReadMessage(100 * time.Millisecond)
+	if err != nil {
+		if _, ok := err.(kev.KevError); ok {
+			return ctx, nil, nil, nil
+		}
+
+		loopSpan.SetStatus(codes.Error, "Failed to read message")
+		loopSpan.RecordError(err)
+		cl.metrics.errorCounter.Add(ctx, 1, metric.WithAttributes(
+			attribute.String("error.type", "kafka_read_failed"),
+			attribute.String("topic", cl.topic),
+		))
+		cl.logger.Error(ctx, "Failed to read Kafka message", err)
+		return ctx, nil, nil, err
+	}
+
+	msgCtx, msgSpan := kev.StartConsumerSpan(ctx, cl.tracer, "process_kafka_message", msg)
+	msgSpan.SetAttributes(
+		attribute.String("kafka.partition", fmt.Sprintf("%d", msg.TopicPartition.Partition)),
+		attribute.String("kafka.offset", fmt.Sprintf("%d", msg.TopicPartition.Offset)),
+		attribute.Int("message.size", len(msg.Value)),
+	)
+
+	cl.metrics.msgProcessed.Add(msgCtx, 1, metric.WithAttributes(
+		attribute.String("topic", cl.topic),
+		attribute.String("partition", fmt.Sprintf("%d", msg.TopicPartition.Partition)),
+	))
+
+	return msgCtx, msgSpan, msg, nil
+}
+
+// deserialize times the deserialization of the message into v.
+func deserialize[T any](
+	ctx context.Context,
+	cl consumerLoop,
+	span trace.Span,
+	payload []byte,
+	fn func(topic string, payload []byte) (T, error),
+) (v T, err error) {
+	start := time.Now()
+	v, err = fn(cl.topic, payload)
+	duration := time.Since(start)
+
+	cl.metrics.deseDuration.Record(ctx, duration.Seconds(),
+		metric.WithAttributes(
+			attribute.String("topic", cl.topic),
+		),
+	)
+
+	if err != nil {
+		cl.fail(ctx, span, "deserialization_failed", "Deserialization error", err)
+		return v, err
+	}
+
+	span.SetAttributes(attribute.Float64("deserialization.duration_seconds", duration.Seconds()))
+	return v, nil
+}
+
+// cache runs a cache operation, upsert or delete, within its own span.
+func (cl consumerLoop) cache(
+	ctx context.Context,
+	operation string,
+	attrs []attribute.KeyValue,
+	fn func(ctx context.Context) error,
+) (time.Duration, error) {
+	start := time.Now()
+	cacheCtx, cacheSpan := cl.tracer.Start(ctx, "cache_"+operation,
+		trace.WithAttributes(append(attrs, attribute.String("cache.operation", operation))...),
+	)
+	defer cacheSpan.End()
+
+	err := fn(cacheCtx)
+	duration := time.Since(start)
+
+	if err != nil {
+		cacheSpan.SetStatus(codes.Error, "Cache "+operation+" failed")
+		cacheSpan.RecordError(err)
+		cl.metrics.errorCounter.Add(cacheCtx, 1, metric.WithAttributes(
+			attribute.String("error.type", "cache_"+operation+"_failed"),
+			attribute.String("topic", cl.topic),
+		))
+		cl.logger.Error(cacheCtx, "Cache "+operation+" error", err)
+		return duration, err
+	}
+
+	cacheSpan.SetStatus(codes.Ok, "Cache "+operation+" successful")
+	cl.metrics.cacheOperations.Add(cacheCtx, 1, metric.WithAttributes(
+		attribute.String("cache.operation", operation),
+		attribute.String("status", "success"),
+	))
+	cl.metrics.cacheOperationDuration.Record(cacheCtx, duration.Seconds(),
+		metric.WithAttributes(
+			attribute.String("cache.operation", operation),
+		),
+	)
+
+	return duration, nil
+}
+
+// commit commits the message and ends its span.
+func (cl consumerLoop) commit(
+	ctx context.Context,
+	span trace.Span,
+	msg *kafka.Message,
+	op string,
+	start time.Time,
+	cacheDuration time.Duration,
+) {
+	commitStart := time.Now()
+	if _, err := cl.consumer.CommitMessage(msg); err != nil {
+		cl.fail(ctx, span, "kafka_commit_failed", "Kafka commit error", err)
+		return
+	}
+
+	commitDuration := time.Since(commitStart)
+	cl.metrics.msgCommitted.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("topic", cl.topic),
+		attribute.String("partition", fmt.Sprintf("%d", msg.TopicPartition.Partition)),
+	))
+
+	totalDuration := time.Since(start)
+	cl.metrics.prcsDuration.Record(ctx, totalDuration.Seconds(),
+		metric.WithAttributes(
+			attribute.String("topic", cl.topic),
+			attribute.String("operation", op),
+			attribute.String("status", "success"),
+		),
+	)
+
+	span.SetAttributes(
+		attribute.Float64("processing.total_duration_seconds", totalDuration.Seconds()),
+		attribute.Float64("processing.cache_duration_seconds", cacheDuration.Seconds()),
+		attribute.Float64("processing.commit_duration_seconds", commitDuration.Seconds()),
+	)
+	span.SetStatus(codes.Ok, "Message processed successfully")
+	span.End()
+}
+
+// fail records err on the span of the message, counts it and ends the span.
+func (cl consumerLoop) fail(ctx context.Context, span trace.Span, errorType string, msg string, err error) {
+	span.SetStatus(codes.Error, msg)
+	span.RecordError(err)
+	cl.metrics.errorCounter.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("error.type", errorType),
+		attribute.String("topic", cl.topic),
+	))
+	cl.logger.Error(ctx, msg, err)
+	span.End()
+}
+
+func cdcOperation(msg *kafka.Message) string {
+	for _, h := range msg.Headers {
+		key := strings.TrimPrefix(h.Key, "__")
+		if key == "op" {
+			return string(h.Value)
+		}
+	}
+
+	return ""
+}
+
 func (fs farmService) SyncFarmAddressCache(
 	ctx context.Context,
 	topic string,
+	tracer trace.Tracer,
+	meter metric.Meter,
 ) error {
-	consumer := fs.repo.FarmAddrConsumer()
-	err := consumer.SubscribeTopics([]string{topic}, kev.RebalanceCbCooperativeSticky)
+	loopCtx, span, cl, err := fs.subscribe(ctx, "sync_farm_address_cache", topic, fs.repo.FarmAddrConsumer(), tracer, meter)
 	if err != nil {
 		return err
 	}
+	defer span.End()
+
+	topicAttr := metric.WithAttributes(attribute.String("topic", topic))
+	cl.metrics.activeConsumers.Add(loopCtx, 1, topicAttr)
+	defer cl.metrics.activeConsumers.Add(loopCtx, -1, topicAttr)
 
 	for {
 		select {
 		case <-ctx.Done():
+			span.SetStatus(codes.Ok, "Sync stopped gracefully")
 			return ctx.Err()
 		default:
-			msg, err := consumer.ReadMessage(100 * time.Millisecond)
+			startTime := time.Now()
+			msgCtx, msgSpan, msg, err := cl.read(loopCtx, span)
 			if err != nil {
-				if _, ok := err.(kev.KevError); ok {
-					continue
-				}
 				return err
 			}
+			if msg == nil {
+				continue
+			}
 
-			farmAddr, err := fs.repo.DeserializerFarmAddress(topic, msg.Value)
+			farmAddr, err := deserialize(msgCtx, cl, msgSpan, msg.Value, fs.repo.DeserializerFarmAddress)
 			if err != nil {
 				continue
 			}
 
-			var op string
-			for _, h := range msg.Headers {
-				key := strings.TrimPrefix(h.Key, "__")
-				if key == "op" {
-					op = string(h.Value)
-					break
-				}
+			op := cdcOperation(msg)
+			msgSpan.SetAttributes(
+				attribute.String("farm_address.id", farmAddr.ID),
+				attribute.String("cdc.operation", op),
+			)
+
+			addrAttrs := []attribute.KeyValue{attribute.String("farm_address.id", farmAddr.ID)}
+			deleteAddr := func(ctx context.Context) error {
+				return fs.repo.DeleteFarmAddressCache(ctx, farmAddr.ID)
 			}
 
+			var cacheDuration time.Duration
 			switch op {
 			case "c", "u", "r":
-				if err := fs.repo.UpsertFarmAddressCache(ctx, farmAddr); err != nil {
-					continue
+				if farmAddr.DeletedAt != nil {
+					cacheDuration, err = cl.cache(msgCtx, "delete", addrAttrs, deleteAddr)
+					break
 				}
+
+				cacheDuration, err = cl.cache(msgCtx, "upsert", addrAttrs,
+					func(ctx context.Context) error {
+						return fs.repo.UpsertFarmAddressCache(ctx, farmAddr)
+					},
+				)
 			case "d":
-				continue
+				cacheDuration, err = cl.cache(msgCtx, "delete", addrAttrs, deleteAddr)
+			default:
+				msgSpan.SetAttributes(attribute.String("warning", "unknown_operation"))
+				fs.logger.Info(msgCtx, fmt.Sprintf("Unknown CDC operation: %s", op))
 			}
 
-			if _, err := consumer.CommitMessage(msg); err != nil {
-				fmt.Printf("Error committing message: %v\n", err)
+			if err != nil {
+				msgSpan.End()
 				continue
 			}
 
+			cl.commit(msgCtx, msgSpan, msg, op, startTime, cacheDuration)
 		}
 	}
 }
@@ -84,58 +322,102 @@ func (fs farmService) SyncFarmAddressCache(
 func (fs farmService) SyncFarmCache(
 	ctx context.Context,
 	topic string,
+	tracer trace.Tracer,
+	meter metric.Meter,
 ) error {
-	consumer := fs.repo.FarmConsumer()
-	if err := consumer.SubscribeTopics([]string{topic}, kev.RebalanceCbCooperativeSticky); err != nil {
+	loopCtx, span, cl, err := fs.subscribe(ctx, "sync_farm_cache", topic, fs.repo.FarmConsumer(), tracer, meter)
+	if err != nil {
 		return err
 	}
+	defer span.End()
+
+	topicAttr := metric.WithAttributes(attribute.String("topic", topic))
+	cl.metrics.activeConsumers.Add(loopCtx, 1, topicAttr)
+	defer cl.metrics.activeConsumers.Add(loopCtx, -1, topicAttr)
 
 	for {
 		select {
 		case <-ctx.Done():
+			span.SetStatus(codes.Ok, "Sync stopped gracefully")
 			return ctx.Err()
 		default:
-			msg, err := consumer.ReadMessage(100 * time.Millisecond)
+			startTime := time.Now()
+			msgCtx, msgSpan, msg, err := cl.read(loopCtx, span)
 			if err != nil {
-				if _, ok := err.(kev.KevError); ok {
-					continue
-				}
-
 				return err
 			}
+			if msg == nil {
+				continue
+			}
 
-			farm, err := fs.repo.DeserializerFarm(topic, msg.Value)
+			farm, err := deserialize(msgCtx, cl, msgSpan, msg.Value, fs.repo.DeserializerFarm)
 			if err != nil {
-				log.Println(err)
 				continue
 			}
 
-			var op string
-			for _, h := range msg.Headers {
-				key := strings.TrimPrefix(h.Key, "__")
-				if key == "op" {
-					op = string(h.Value)
-					break
-				}
+			op := cdcOperation(msg)
+			msgSpan.SetAttributes(
+				attribute.String("farm.id", farm.ID),
+				attribute.String("cdc.operation", op),
+			)
+
+			farmAttrs := []attribute.KeyValue{
+				attribute.String("farm.id", farm.ID),
+				attribute.String("farmer.id", farm.FarmerID),
+			}
+			deleteFarm := func(ctx context.Context) error {
+				return fs.repo.DeleteFarmCache(ctx, farm.ID, farm.FarmerID, farm.AddressID)
 			}
 
+			var cacheDuration time.Duration
 			switch op {
 			case "c", "u", "r":
-				if err := fs.repo.UpsertFarmCache(ctx, farm, op); err != nil {
-					log.Println(err)
-					continue
+				if farm.DeletedAt != nil {
+					cacheDuration, err = cl.cache(msgCtx, "delete", farmAttrs, deleteFarm)
+					break
 				}
+
+				cacheDuration, err = cl.cache(msgCtx, "upsert", farmAttrs,
+					func(ctx context.Context) error {
+						return fs.repo.UpsertFarmCache(ctx, farm, op)
+					},
+				)
 			case "d":
-				if err := fs.repo.DeleteFarmCache(ctx, farm.ID, farm.FarmerID); err != nil {
-					log.Println(err)
-					continue
-				}
+				cacheDuration, err = cl.cache(msgCtx, "delete", farmAttrs, deleteFarm)
+			default:
+				msgSpan.SetAttributes(attribute.String("warning", "unknown_operation"))
+				fs.logger.Info(msgCtx, fmt.Sprintf("Unknown CDC operation: %s", op))
 			}
 
-			if _, err := consumer.CommitMessage(msg); err != nil {
-				fmt.Printf("Error committing message: %v\n", err)
+			if err != nil {
+				msgSpan.End()
 				continue
 			}
+
+			cl.commit(msgCtx, msgSpan, msg, op, startTime, cacheDuration)
+		}
+	}
+}
+
+// RefreshFarmCache keeps the hashes of the live farms from expiring, it
+// refreshes them on start and then every interval until ctx is done. A
+// failed refresh is retried on the next tick, the TTL outlasts a few.
+func (fs farmService) RefreshFarmCache(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		refreshed, err := fs.repo.RefreshFarmCache(ctx)
+		if err != nil {
+			fs.logger.Error(ctx, "Failed to refresh the farm cache", err)
+		} else {
+			fs.logger.Info(ctx, fmt.Sprintf("Refreshed %d farm hashes", refreshed))
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
 		}
 	}
 }
@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/sony-nurdianto/farm/shared_lib/Go/kafkaev/avr (interfaces: AvrSerdeInstance,AvrDeserializer)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	schemaregistry "github.com/confluentinc/confluent-kafka-go/v2/schemaregistry"
+	gomock "github.com/golang/mock/gomock"
+	avr "github.com/sony-nurdianto/farm/shared_lib/Go/kafkaev/avr"
+)
+
+// MockAvrSerdeInstance is a mock of AvrSerdeInstance interface.
+type MockAvrSerdeInstance struct {
+	ctrl     *gomock.Controller
+	recorder *MockAvrSerdeInstanceMockRecorder
+}
+
+// MockAvrSerdeInstanceMockRecorder is the mock recorder for MockAvrSerdeInstance.
+type MockAvrSerdeInstanceMockRecorder struct {
+	mock *MockAvrSerdeInstance
+}
+
+// NewMockAvrSerdeInstance creates a new mock instance.
+func NewMockAvrSerdeInstance(ctrl *gomock.Controller) *MockAvrSerdeInstance {
+	mock := &MockAvrSerdeInstance{ctrl: ctrl}
+	mock.recorder = &MockAvrSerdeInstanceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAvrSerdeInstance) EXPECT() *MockAvrSerdeInstanceMockRecorder {
+	return m.recorder
+}
+
+// NewGenericDeserializer mocks base method.
+func (m *MockAvrSerdeInstance) NewGenericDeserializer(arg0 schemaregistry.Client, arg1 int, arg2 *avr.DeserializerConfig) (avr.AvrDeserializer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGenericDeserializer", arg0, arg1, arg2)
+	ret0, _ := ret[0].(avr.AvrDeserializer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewGenericDeserializer indicates an expected call of NewGenericDeserializer.
+func (mr *MockAvrSerdeInstanceMockRecorder) NewGenericDeserializer(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGenericDeserializer", reflect.TypeOf((*MockAvrSerdeInstance)(nil).NewGenericDeserializer), arg0, arg1, arg2)
+}
+
+// NewGenericSerializer mocks base method.
+func (m *MockAvrSerdeInstance) NewGenericSerializer(arg0 schemaregistry.Client, arg1 int, arg2 *avr.SerializerConfig) (avr.AvrSerializer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGenericSerializer", arg0, arg1, arg2)
+	ret0, _ := ret[0].(avr.AvrSerializer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewGenericSerializer indicates an expected call of NewGenericSerializer.
+func (mr *MockAvrSerdeInstanceMockRecorder) NewGenericSerializer(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGenericSerializer", reflect.TypeOf((*MockAvrSerdeInstance)(nil).NewGenericSerializer), arg0, arg1, arg2)
+}
+
+// MockAvrDeserializer is a mock of AvrDeserializer interface.
+type MockAvrDeserializer struct {
+	ctrl     *gomock.Controller
+	recorder *MockAvrDeserializerMockRecorder
+}
+
+// MockAvrDeserializerMockRecorder is the mock recorder for MockAvrDeserializer.
+type MockAvrDeserializerMockRecorder struct {
+	mock *MockAvrDeserializer
+}
+
+// NewMockAvrDeserializer creates a new mock instance.
+func NewMockAvrDeserializer(ctrl *gomock.Controller) *MockAvrDeserializer {
+	mock := &MockAvrDeserializer{ctrl: ctrl}
+	mock.recorder = &MockAvrDeserializerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAvrDeserializer) EXPECT() *MockAvrDeserializerMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockAvrDeserializer) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockAvrDeserializerMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockAvrDeserializer)(nil).Close))
+}
+
+// DeserializeInto mocks base method.
+func (m *MockAvrDeserializer) DeserializeInto(arg0 string, arg1 []byte, arg2 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeserializeInto", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeserializeInto indicates an expected call of DeserializeInto.
+func (mr *MockAvrDeserializerMockRecorder) DeserializeInto(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeserializeInto", reflect.TypeOf((*MockAvrDeserializer)(nil).DeserializeInto), arg0, arg1, arg2)
+}
@@ -0,0 +1,612 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/sony-nurdianto/farm/shared_lib/Go/kafkaev/kev (interfaces: Kafka,KevConsumer)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	kafka "github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	gomock "github.com/golang/mock/gomock"
+	kev "github.com/sony-nurdianto/farm/shared_lib/Go/kafkaev/kev"
+)
+
+// MockKafka is a mock of Kafka interface.
+type MockKafka struct {
+	ctrl     *gomock.Controller
+	recorder *MockKafkaMockRecorder
+}
+
+// MockKafkaMockRecorder is the mock recorder for MockKafka.
+type MockKafkaMockRecorder struct {
+	mock *MockKafka
+}
+
+// NewMockKafka creates a new mock instance.
+func NewMockKafka(ctrl *gomock.Controller) *MockKafka {
+	mock := &MockKafka{ctrl: ctrl}
+	mock.recorder = &MockKafkaMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKafka) EXPECT() *MockKafkaMockRecorder {
+	return m.recorder
+}
+
+// NewConsumer mocks base method.
+func (m *MockKafka) NewConsumer(arg0 *kafka.ConfigMap) (kev.KevConsumer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewConsumer", arg0)
+	ret0, _ := ret[0].(kev.KevConsumer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewConsumer indicates an expected call of NewConsumer.
+func (mr *MockKafkaMockRecorder) NewConsumer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewConsumer", reflect.TypeOf((*MockKafka)(nil).NewConsumer), arg0)
+}
+
+// NewProducer mocks base method.
+func (m *MockKafka) NewProducer(arg0 *kafka.ConfigMap) (kev.KevProducer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewProducer", arg0)
+	ret0, _ := ret[0].(kev.KevProducer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewProducer indicates an expected call of NewProducer.
+func (mr *MockKafkaMockRecorder) NewProducer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewProducer", reflect.TypeOf((*MockKafka)(nil).NewProducer), arg0)
+}
+
+// MockKevConsumer is a mock of KevConsumer interface.
+type MockKevConsumer struct {
+	ctrl     *gomock.Controller
+	recorder *MockKevConsumerMockRecorder
+}
+
+// MockKevConsumerMockRecorder is the mock recorder for MockKevConsumer.
+type MockKevConsumerMockRecorder struct {
+	mock *MockKevConsumer
+}
+
+// NewMockKevConsumer creates a new mock instance.
+func NewMockKevConsumer(ctrl *gomock.Controller) *MockKevConsumer {
+	mock := &MockKevConsumer{ctrl: ctrl}
+	mock.recorder = &MockKevConsumerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKevConsumer) EXPECT() *MockKevConsumerMockRecorder {
+	return m.recorder
+}
+
+// Assign mocks base method.
+func (m *MockKevConsumer) Assign(arg0 []kafka.TopicPartition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assign", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Assign indicates an expected call of Assign.
+func (mr *MockKevConsumerMockRecorder) Assign(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockKevConsumer)(nil).Assign), arg0)
+}
+
+// Assignment mocks base method.
+func (m *MockKevConsumer) Assignment() ([]kafka.TopicPartition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assignment")
+	ret0, _ := ret[0].([]kafka.TopicPartition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Assignment indicates an expected call of Assignment.
+func (mr *MockKevConsumerMockRecorder) Assignment() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assignment", reflect.TypeOf((*MockKevConsumer)(nil).Assignment))
+}
+
+// AssignmentLost mocks base method.
+func (m *MockKevConsumer) AssignmentLost() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignmentLost")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// AssignmentLost indicates an expected call of AssignmentLost.
+func (mr *MockKevConsumerMockRecorder) AssignmentLost() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignmentLost", reflect.TypeOf((*MockKevConsumer)(nil).AssignmentLost))
+}
+
+// Close mocks base method.
+func (m *MockKevConsumer) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockKevConsumerMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockKevConsumer)(nil).Close))
+}
+
+// Commit mocks base method.
+func (m *MockKevConsumer) Commit() ([]kafka.TopicPartition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Commit")
+	ret0, _ := ret[0].([]kafka.TopicPartition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Commit indicates an expected call of Commit.
+func (mr *MockKevConsumerMockRecorder) Commit() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockKevConsumer)(nil).Commit))
+}
+
+// CommitMessage mocks base method.
+func (m *MockKevConsumer) CommitMessage(arg0 *kafka.Message) ([]kafka.TopicPartition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitMessage", arg0)
+	ret0, _ := ret[0].([]kafka.TopicPartition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitMessage indicates an expected call of CommitMessage.
+func (mr *MockKevConsumerMockRecorder) CommitMessage(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitMessage", reflect.TypeOf((*MockKevConsumer)(nil).CommitMessage), arg0)
+}
+
+// CommitOffsets mocks base method.
+func (m *MockKevConsumer) CommitOffsets(arg0 []kafka.TopicPartition) ([]kafka.TopicPartition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitOffsets", arg0)
+	ret0, _ := ret[0].([]kafka.TopicPartition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitOffsets indicates an expected call of CommitOffsets.
+func (mr *MockKevConsumerMockRecorder) CommitOffsets(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitOffsets", reflect.TypeOf((*MockKevConsumer)(nil).CommitOffsets), arg0)
+}
+
+// Committed mocks base method.
+func (m *MockKevConsumer) Committed(arg0 []kafka.TopicPartition, arg1 int) ([]kafka.TopicPartition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Committed", arg0, arg1)
+	ret0, _ := ret[0].([]kafka.TopicPartition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Committed indicates an expected call of Committed.
+func (mr *MockKevConsumerMockRecorder) Committed(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Committed", reflect.TypeOf((*MockKevConsumer)(nil).Committed), arg0, arg1)
+}
+
+// GetConsumerGroupMetadata mocks base method.
+func (m *MockKevConsumer) GetConsumerGroupMetadata() (*kafka.ConsumerGroupMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConsumerGroupMetadata")
+	ret0, _ := ret[0].(*kafka.ConsumerGroupMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConsumerGroupMetadata indicates an expected call of GetConsumerGroupMetadata.
+func (mr *MockKevConsumerMockRecorder) GetConsumerGroupMetadata() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsumerGroupMetadata", reflect.TypeOf((*MockKevConsumer)(nil).GetConsumerGroupMetadata))
+}
+
+// GetMetadata mocks base method.
+func (m *MockKevConsumer) GetMetadata(arg0 *string, arg1 bool, arg2 int) (*kafka.Metadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMetadata", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*kafka.Metadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMetadata indicates an expected call of GetMetadata.
+func (mr *MockKevConsumerMockRecorder) GetMetadata(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetadata", reflect.TypeOf((*MockKevConsumer)(nil).GetMetadata), arg0, arg1, arg2)
+}
+
+// GetRebalanceProtocol mocks base method.
+func (m *MockKevConsumer) GetRebalanceProtocol() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRebalanceProtocol")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetRebalanceProtocol indicates an expected call of GetRebalanceProtocol.
+func (mr *MockKevConsumerMockRecorder) GetRebalanceProtocol() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRebalanceProtocol", reflect.TypeOf((*MockKevConsumer)(nil).GetRebalanceProtocol))
+}
+
+// GetWatermarkOffsets mocks base method.
+func (m *MockKevConsumer) GetWatermarkOffsets(arg0 string, arg1 int32) (int64, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWatermarkOffsets", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetWatermarkOffsets indicates an expected call of GetWatermarkOffsets.
+func (mr *MockKevConsumerMockRecorder) GetWatermarkOffsets(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWatermarkOffsets", reflect.TypeOf((*MockKevConsumer)(nil).GetWatermarkOffsets), arg0, arg1)
+}
+
+// IncrementalAssign mocks base method.
+func (m *MockKevConsumer) IncrementalAssign(arg0 []kafka.TopicPartition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementalAssign", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrementalAssign indicates an expected call of IncrementalAssign.
+func (mr *MockKevConsumerMockRecorder) IncrementalAssign(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementalAssign", reflect.TypeOf((*MockKevConsumer)(nil).IncrementalAssign), arg0)
+}
+
+// IncrementalUnassign mocks base method.
+func (m *MockKevConsumer) IncrementalUnassign(arg0 []kafka.TopicPartition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementalUnassign", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrementalUnassign indicates an expected call of IncrementalUnassign.
+func (mr *MockKevConsumerMockRecorder) IncrementalUnassign(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementalUnassign", reflect.TypeOf((*MockKevConsumer)(nil).IncrementalUnassign), arg0)
+}
+
+// IsClosed mocks base method.
+func (m *MockKevConsumer) IsClosed() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsClosed")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsClosed indicates an expected call of IsClosed.
+func (mr *MockKevConsumerMockRecorder) IsClosed() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsClosed", reflect.TypeOf((*MockKevConsumer)(nil).IsClosed))
+}
+
+// Logs mocks base method.
+func (m *MockKevConsumer) Logs() chan kafka.LogEvent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logs")
+	ret0, _ := ret[0].(chan kafka.LogEvent)
+	return ret0
+}
+
+// Logs indicates an expected call of Logs.
+func (mr *MockKevConsumerMockRecorder) Logs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logs", reflect.TypeOf((*MockKevConsumer)(nil).Logs))
+}
+
+// OffsetsForTimes mocks base method.
+func (m *MockKevConsumer) OffsetsForTimes(arg0 []kafka.TopicPartition, arg1 int) ([]kafka.TopicPartition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OffsetsForTimes", arg0, arg1)
+	ret0, _ := ret[0].([]kafka.TopicPartition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OffsetsForTimes indicates an expected call of OffsetsForTimes.
+func (mr *MockKevConsumerMockRecorder) OffsetsForTimes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OffsetsForTimes", reflect.TypeOf((*MockKevConsumer)(nil).OffsetsForTimes), arg0, arg1)
+}
+
+// Pause mocks base method.
+func (m *MockKevConsumer) Pause(arg0 []kafka.TopicPartition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pause", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Pause indicates an expected call of Pause.
+func (mr *MockKevConsumerMockRecorder) Pause(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pause", reflect.TypeOf((*MockKevConsumer)(nil).Pause), arg0)
+}
+
+// Poll mocks base method.
+func (m *MockKevConsumer) Poll(arg0 int) kafka.Event {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Poll", arg0)
+	ret0, _ := ret[0].(kafka.Event)
+	return ret0
+}
+
+// Poll indicates an expected call of Poll.
+func (mr *MockKevConsumerMockRecorder) Poll(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Poll", reflect.TypeOf((*MockKevConsumer)(nil).Poll), arg0)
+}
+
+// Position mocks base method.
+func (m *MockKevConsumer) Position(arg0 []kafka.TopicPartition) ([]kafka.TopicPartition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Position", arg0)
+	ret0, _ := ret[0].([]kafka.TopicPartition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Position indicates an expected call of Position.
+func (mr *MockKevConsumerMockRecorder) Position(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Position", reflect.TypeOf((*MockKevConsumer)(nil).Position), arg0)
+}
+
+// QueryWatermarkOffsets mocks base method.
+func (m *MockKevConsumer) QueryWatermarkOffsets(arg0 string, arg1 int32, arg2 int) (int64, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryWatermarkOffsets", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// QueryWatermarkOffsets indicates an expected call of QueryWatermarkOffsets.
+func (mr *MockKevConsumerMockRecorder) QueryWatermarkOffsets(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryWatermarkOffsets", reflect.TypeOf((*MockKevConsumer)(nil).QueryWatermarkOffsets), arg0, arg1, arg2)
+}
+
+// ReadMessage mocks base method.
+func (m *MockKevConsumer) ReadMessage(arg0 time.Duration) (*kafka.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadMessage", arg0)
+	ret0, _ := ret[0].(*kafka.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadMessage indicates an expected call of ReadMessage.
+func (mr *MockKevConsumerMockRecorder) ReadMessage(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadMessage", reflect.TypeOf((*MockKevConsumer)(nil).ReadMessage), arg0)
+}
+
+// Resume mocks base method.
+func (m *MockKevConsumer) Resume(arg0 []kafka.TopicPartition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resume", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Resume indicates an expected call of Resume.
+func (mr *MockKevConsumerMockRecorder) Resume(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockKevConsumer)(nil).Resume), arg0)
+}
+
+// Seek mocks base method.
+func (m *MockKevConsumer) Seek(arg0 kafka.TopicPartition, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Seek", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Seek indicates an expected call of Seek.
+func (mr *MockKevConsumerMockRecorder) Seek(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seek", reflect.TypeOf((*MockKevConsumer)(nil).Seek), arg0, arg1)
+}
+
+// SeekPartitions mocks base method.
+func (m *MockKevConsumer) SeekPartitions(arg0 []kafka.TopicPartition) ([]kafka.TopicPartition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeekPartitions", arg0)
+	ret0, _ := ret[0].([]kafka.TopicPartition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeekPartitions indicates an expected call of SeekPartitions.
+func (mr *MockKevConsumerMockRecorder) SeekPartitions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeekPartitions", reflect.TypeOf((*MockKevConsumer)(nil).SeekPartitions), arg0)
+}
+
+// SetOAuthBearerToken mocks base method.
+func (m *MockKevConsumer) SetOAuthBearerToken(arg0 kafka.OAuthBearerToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOAuthBearerToken", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetOAuthBearerToken indicates an expected call of SetOAuthBearerToken.
+func (mr *MockKevConsumerMockRecorder) SetOAuthBearerToken(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOAuthBearerToken", reflect.TypeOf((*MockKevConsumer)(nil).SetOAuthBearerToken), arg0)
+}
+
+// SetOAuthBearerTokenFailure mocks base method.
+func (m *MockKevConsumer) SetOAuthBearerTokenFailure(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOAuthBearerTokenFailure", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetOAuthBearerTokenFailure indicates an expected call of SetOAuthBearerTokenFailure.
+func (mr *MockKevConsumerMockRecorder) SetOAuthBearerTokenFailure(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOAuthBearerTokenFailure", reflect.TypeOf((*MockKevConsumer)(nil).SetOAuthBearerTokenFailure), arg0)
+}
+
+// SetSaslCredentials mocks base method.
+func (m *MockKevConsumer) SetSaslCredentials(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSaslCredentials", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSaslCredentials indicates an expected call of SetSaslCredentials.
+func (mr *MockKevConsumerMockRecorder) SetSaslCredentials(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSaslCredentials", reflect.TypeOf((*MockKevConsumer)(nil).SetSaslCredentials), arg0, arg1)
+}
+
+// StoreMessage mocks base method.
+func (m *MockKevConsumer) StoreMessage(arg0 *kafka.Message) ([]kafka.TopicPartition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreMessage", arg0)
+	ret0, _ := ret[0].([]kafka.TopicPartition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StoreMessage indicates an expected call of StoreMessage.
+func (mr *MockKevConsumerMockRecorder) StoreMessage(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreMessage", reflect.TypeOf((*MockKevConsumer)(nil).StoreMessage), arg0)
+}
+
+// StoreOffsets mocks base method.
+func (m *MockKevConsumer) StoreOffsets(arg0 []kafka.TopicPartition) ([]kafka.TopicPartition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreOffsets", arg0)
+	ret0, _ := ret[0].([]kafka.TopicPartition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StoreOffsets indicates an expected call of StoreOffsets.
+func (mr *MockKevConsumerMockRecorder) StoreOffsets(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreOffsets", reflect.TypeOf((*MockKevConsumer)(nil).StoreOffsets), arg0)
+}
+
+// String mocks base method.
+func (m *MockKevConsumer) String() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "String")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// String indicates an expected call of String.
+func (mr *MockKevConsumerMockRecorder) String() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "String", reflect.TypeOf((*MockKevConsumer)(nil).String))
+}
+
+// Subscribe mocks base method.
+func (m *MockKevConsumer) Subscribe(arg0 string, arg1 kafka.RebalanceCb) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockKevConsumerMockRecorder) Subscribe(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockKevConsumer)(nil).Subscribe), arg0, arg1)
+}
+
+// SubscribeTopics mocks base method.
+func (m *MockKevConsumer) SubscribeTopics(arg0 []string, arg1 kafka.RebalanceCb) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeTopics", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubscribeTopics indicates an expected call of SubscribeTopics.
+func (mr *MockKevConsumerMockRecorder) SubscribeTopics(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeTopics", reflect.TypeOf((*MockKevConsumer)(nil).SubscribeTopics), arg0, arg1)
+}
+
+// Subscription mocks base method.
+func (m *MockKevConsumer) Subscription() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscription")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscription indicates an expected call of Subscription.
+func (mr *MockKevConsumerMockRecorder) Subscription() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscription", reflect.TypeOf((*MockKevConsumer)(nil).Subscription))
+}
+
+// Unassign mocks base method.
+func (m *MockKevConsumer) Unassign() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unassign")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unassign indicates an expected call of Unassign.
+func (mr *MockKevConsumerMockRecorder) Unassign() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unassign", reflect.TypeOf((*MockKevConsumer)(nil).Unassign))
+}
+
+// Unsubscribe mocks base method.
+func (m *MockKevConsumer) Unsubscribe() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unsubscribe")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unsubscribe indicates an expected call of Unsubscribe.
+func (mr *MockKevConsumerMockRecorder) Unsubscribe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unsubscribe", reflect.TypeOf((*MockKevConsumer)(nil).Unsubscribe))
+}
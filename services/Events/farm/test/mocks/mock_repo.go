@@ -0,0 +1,192 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repo/repo.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/sony-nurdianto/farm/services/Events/farm/internal/models"
+	kev "github.com/sony-nurdianto/farm/shared_lib/Go/kafkaev/kev"
+)
+
+// MockFarmRepo is a mock of FarmRepo interface.
+type MockFarmRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockFarmRepoMockRecorder
+}
+
+// MockFarmRepoMockRecorder is the mock recorder for MockFarmRepo.
+type MockFarmRepoMockRecorder struct {
+	mock *MockFarmRepo
+}
+
+// NewMockFarmRepo creates a new mock instance.
+func NewMockFarmRepo(ctrl *gomock.Controller) *MockFarmRepo {
+	mock := &MockFarmRepo{ctrl: ctrl}
+	mock.recorder = &MockFarmRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFarmRepo) EXPECT() *MockFarmRepoMockRecorder {
+	return m.recorder
+}
+
+// CloseRepo mocks base method.
+func (m *MockFarmRepo) CloseRepo() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CloseRepo")
+}
+
+// CloseRepo indicates an expected call of CloseRepo.
+func (mr *MockFarmRepoMockRecorder) CloseRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseRepo", reflect.TypeOf((*MockFarmRepo)(nil).CloseRepo))
+}
+
+// DeleteFarmAddressCache mocks base method.
+func (m *MockFarmRepo) DeleteFarmAddressCache(ctx context.Context, addressID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFarmAddressCache", ctx, addressID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFarmAddressCache indicates an expected call of DeleteFarmAddressCache.
+func (mr *MockFarmRepoMockRecorder) DeleteFarmAddressCache(ctx, addressID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFarmAddressCache", reflect.TypeOf((*MockFarmRepo)(nil).DeleteFarmAddressCache), ctx, addressID)
+}
+
+// DeleteFarmCache mocks base method.
+func (m *MockFarmRepo) DeleteFarmCache(ctx context.Context, farmID, farmerID, addressID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFarmCache", ctx, farmID, farmerID, addressID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFarmCache indicates an expected call of DeleteFarmCache.
+func (mr *MockFarmRepoMockRecorder) DeleteFarmCache(ctx, farmID, farmerID, addressID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFarmCache", reflect.TypeOf((*MockFarmRepo)(nil).DeleteFarmCache), ctx, farmID, farmerID, addressID)
+}
+
+// DeserializerFarm mocks base method.
+func (m *MockFarmRepo) DeserializerFarm(topic string, payload []byte) (models.Farm, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeserializerFarm", topic, payload)
+	ret0, _ := ret[0].(models.Farm)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeserializerFarm indicates an expected call of DeserializerFarm.
+func (mr *MockFarmRepoMockRecorder) DeserializerFarm(topic, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeserializerFarm", reflect.TypeOf((*MockFarmRepo)(nil).DeserializerFarm), topic, payload)
+}
+
+// DeserializerFarmAddress mocks base method.
+func (m *MockFarmRepo) DeserializerFarmAddress(topic string, payload []byte) (models.FarmAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeserializerFarmAddress", topic, payload)
+	ret0, _ := ret[0].(models.FarmAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeserializerFarmAddress indicates an expected call of DeserializerFarmAddress.
+func (mr *MockFarmRepoMockRecorder) DeserializerFarmAddress(topic, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeserializerFarmAddress", reflect.TypeOf((*MockFarmRepo)(nil).DeserializerFarmAddress), topic, payload)
+}
+
+// EnsureFarmSearchIndex mocks base method.
+func (m *MockFarmRepo) EnsureFarmSearchIndex(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureFarmSearchIndex", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnsureFarmSearchIndex indicates an expected call of EnsureFarmSearchIndex.
+func (mr *MockFarmRepoMockRecorder) EnsureFarmSearchIndex(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureFarmSearchIndex", reflect.TypeOf((*MockFarmRepo)(nil).EnsureFarmSearchIndex), ctx)
+}
+
+// FarmAddrConsumer mocks base method.
+func (m *MockFarmRepo) FarmAddrConsumer() kev.KevConsumer {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FarmAddrConsumer")
+	ret0, _ := ret[0].(kev.KevConsumer)
+	return ret0
+}
+
+// FarmAddrConsumer indicates an expected call of FarmAddrConsumer.
+func (mr *MockFarmRepoMockRecorder) FarmAddrConsumer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FarmAddrConsumer", reflect.TypeOf((*MockFarmRepo)(nil).FarmAddrConsumer))
+}
+
+// FarmConsumer mocks base method.
+func (m *MockFarmRepo) FarmConsumer() kev.KevConsumer {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FarmConsumer")
+	ret0, _ := ret[0].(kev.KevConsumer)
+	return ret0
+}
+
+// FarmConsumer indicates an expected call of FarmConsumer.
+func (mr *MockFarmRepoMockRecorder) FarmConsumer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FarmConsumer", reflect.TypeOf((*MockFarmRepo)(nil).FarmConsumer))
+}
+
+// RefreshFarmCache mocks base method.
+func (m *MockFarmRepo) RefreshFarmCache(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshFarmCache", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshFarmCache indicates an expected call of RefreshFarmCache.
+func (mr *MockFarmRepoMockRecorder) RefreshFarmCache(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshFarmCache", reflect.TypeOf((*MockFarmRepo)(nil).RefreshFarmCache), ctx)
+}
+
+// UpsertFarmAddressCache mocks base method.
+func (m *MockFarmRepo) UpsertFarmAddressCache(ctx context.Context, addr models.FarmAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertFarmAddressCache", ctx, addr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertFarmAddressCache indicates an expected call of UpsertFarmAddressCache.
+func (mr *MockFarmRepoMockRecorder) UpsertFarmAddressCache(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertFarmAddressCache", reflect.TypeOf((*MockFarmRepo)(nil).UpsertFarmAddressCache), ctx, addr)
+}
+
+// UpsertFarmCache mocks base method.
+func (m *MockFarmRepo) UpsertFarmCache(ctx context.Context, farm models.Farm, ops string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertFarmCache", ctx, farm, ops)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertFarmCache indicates an expected call of UpsertFarmCache.
+func (mr *MockFarmRepoMockRecorder) UpsertFarmCache(ctx, farm, ops interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertFarmCache", reflect.TypeOf((*MockFarmRepo)(nil).UpsertFarmCache), ctx, farm, ops)
+}
@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/sony-nurdianto/farm/shared_lib/Go/kafkaev/schrgs (interfaces: SchemaRegisteryInstance)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	schemaregistry "github.com/confluentinc/confluent-kafka-go/v2/schemaregistry"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSchemaRegisteryInstance is a mock of SchemaRegisteryInstance interface.
+type MockSchemaRegisteryInstance struct {
+	ctrl     *gomock.Controller
+	recorder *MockSchemaRegisteryInstanceMockRecorder
+}
+
+// MockSchemaRegisteryInstanceMockRecorder is the mock recorder for MockSchemaRegisteryInstance.
+type MockSchemaRegisteryInstanceMockRecorder struct {
+	mock *MockSchemaRegisteryInstance
+}
+
+// NewMockSchemaRegisteryInstance creates a new mock instance.
+func NewMockSchemaRegisteryInstance(ctrl *gomock.Controller) *MockSchemaRegisteryInstance {
+	mock := &MockSchemaRegisteryInstance{ctrl: ctrl}
+	mock.recorder = &MockSchemaRegisteryInstanceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSchemaRegisteryInstance) EXPECT() *MockSchemaRegisteryInstanceMockRecorder {
+	return m.recorder
+}
+
+// NewClient mocks base method.
+func (m *MockSchemaRegisteryInstance) NewClient(arg0 *schemaregistry.Config) (schemaregistry.Client, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewClient", arg0)
+	ret0, _ := ret[0].(schemaregistry.Client)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewClient indicates an expected call of NewClient.
+func (mr *MockSchemaRegisteryInstanceMockRecorder) NewClient(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewClient", reflect.TypeOf((*MockSchemaRegisteryInstance)(nil).NewClient), arg0)
+}
+
+// NewConfig mocks base method.
+func (m *MockSchemaRegisteryInstance) NewConfig(arg0 string) *schemaregistry.Config {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewConfig", arg0)
+	ret0, _ := ret[0].(*schemaregistry.Config)
+	return ret0
+}
+
+// NewConfig indicates an expected call of NewConfig.
+func (mr *MockSchemaRegisteryInstanceMockRecorder) NewConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewConfig", reflect.TypeOf((*MockSchemaRegisteryInstance)(nil).NewConfig), arg0)
+}
@@ -0,0 +1,80 @@
+package unit_test
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// fakeRedis answers the commands of a go-redis client without a server, it
+// records the arguments of every command and replies with success.
+type fakeRedis struct {
+	mu   sync.Mutex
+	cmds [][]any
+}
+
+func newFakeClient(f *fakeRedis) *goredis.Client {
+	client := goredis.NewClient(&goredis.Options{Addr: "fake:6379"})
+	client.AddHook(f)
+	return client
+}
+
+func (f *fakeRedis) DialHook(next goredis.DialHook) goredis.DialHook {
+	return next
+}
+
+func (f *fakeRedis) ProcessHook(goredis.ProcessHook) goredis.ProcessHook {
+	return func(_ context.Context, cmd goredis.Cmder) error {
+		f.answer(cmd)
+		return cmd.Err()
+	}
+}
+
+func (f *fakeRedis) ProcessPipelineHook(goredis.ProcessPipelineHook) goredis.ProcessPipelineHook {
+	return func(_ context.Context, cmds []goredis.Cmder) error {
+		for _, cmd := range cmds {
+			if name := cmd.Name(); name == "multi" || name == "exec" {
+				continue
+			}
+			f.answer(cmd)
+		}
+		return nil
+	}
+}
+
+func (f *fakeRedis) answer(cmd goredis.Cmder) {
+	f.mu.Lock()
+	f.cmds = append(f.cmds, cmd.Args())
+	f.mu.Unlock()
+
+	switch c := cmd.(type) {
+	case *goredis.StatusCmd:
+		c.SetVal("OK")
+	case *goredis.BoolCmd:
+		c.SetVal(true)
+	case *goredis.IntCmd:
+		c.SetVal(1)
+	}
+}
+
+// commands returns the recorded commands named name.
+func (f *fakeRedis) commands(name string) [][]any {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var cmds [][]any
+	for _, args := range f.cmds {
+		if n, _ := args[0].(string); strings.EqualFold(n, name) {
+			cmds = append(cmds, args)
+		}
+	}
+	return cmds
+}
+
+func (f *fakeRedis) reset() {
+	f.mu.Lock()
+	f.cmds = nil
+	f.mu.Unlock()
+}
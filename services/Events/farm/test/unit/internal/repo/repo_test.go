@@ -0,0 +1,159 @@
+package unit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/vfs"
+	"github.com/golang/mock/gomock"
+	"github.com/sony-nurdianto/farm/services/Events/farm/internal/models"
+	"github.com/sony-nurdianto/farm/services/Events/farm/internal/repo"
+	"github.com/sony-nurdianto/farm/services/Events/farm/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// dayInSeconds is the TTL of a farm hash as EXPIRE receives it.
+const dayInSeconds = int64(24 * 60 * 60)
+
+func setupRepo(t *testing.T) (repo.FarmRepo, *fakeRedis, *pebble.DB) {
+	ctrl := gomock.NewController(t)
+
+	mockSchrgs := mocks.NewMockSchemaRegisteryInstance(ctrl)
+	mockSchrgs.EXPECT().NewConfig(gomock.Any()).Return(nil)
+	mockSchrgs.EXPECT().NewClient(gomock.Any()).Return(nil, nil)
+
+	mockAvr := mocks.NewMockAvrSerdeInstance(ctrl)
+	mockAvr.EXPECT().
+		NewGenericDeserializer(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(mocks.NewMockAvrDeserializer(ctrl), nil)
+
+	mockKafka := mocks.NewMockKafka(ctrl)
+	mockKafka.EXPECT().NewConsumer(gomock.Any()).Return(mocks.NewMockKevConsumer(ctrl), nil).Times(2)
+
+	cache := &fakeRedis{}
+	mockRedis := mocks.NewMockRedisInstance(ctrl)
+	mockRedis.EXPECT().NewFailoverClient(gomock.Any()).Return(newFakeClient(cache))
+
+	stateDB, err := pebble.Open("state", &pebble.Options{FS: vfs.NewMem()})
+	require.NoError(t, err)
+	t.Cleanup(func() { stateDB.Close() })
+
+	fr, err := repo.NewFarmRepo(context.Background(), mockSchrgs, mockAvr, mockKafka, mockRedis, stateDB)
+	require.NoError(t, err)
+
+	cache.reset()
+	return fr, cache, stateDB
+}
+
+func TestFarmAddressCache(t *testing.T) {
+	ctx := context.Background()
+	farm := models.Farm{ID: "farm-1", FarmerID: "farmer-1", AddressID: "address-1"}
+	addr := models.FarmAddress{ID: "address-1", Street: "Jl. Sawah"}
+
+	t.Run("Every Update Of The Address Finds Its Farm", func(t *testing.T) {
+		fr, cache, _ := setupRepo(t)
+
+		require.NoError(t, fr.UpsertFarmCache(ctx, farm, "c"))
+		require.NoError(t, fr.UpsertFarmAddressCache(ctx, addr))
+
+		addr.Street = "Jl. Ladang"
+		require.NoError(t, fr.UpsertFarmAddressCache(ctx, addr))
+
+		hsets := cache.commands("hset")
+		require.Len(t, hsets, 3)
+		for _, args := range hsets {
+			assert.Equal(t, "farm:farm-1:farmer-1", args[1])
+		}
+		assert.Contains(t, hsets[2], "Jl. Ladang")
+	})
+
+	t.Run("Every Write Resets The TTL", func(t *testing.T) {
+		fr, cache, _ := setupRepo(t)
+
+		require.NoError(t, fr.UpsertFarmCache(ctx, farm, "c"))
+		require.NoError(t, fr.UpsertFarmAddressCache(ctx, addr))
+
+		assert.Equal(t, [][]any{
+			{"expire", "farm:farm-1:farmer-1", dayInSeconds},
+			{"expire", "farm:farm-1:farmer-1", dayInSeconds},
+		}, cache.commands("expire"))
+	})
+
+	t.Run("Deleted Farm Releases Its Address", func(t *testing.T) {
+		fr, cache, _ := setupRepo(t)
+
+		require.NoError(t, fr.UpsertFarmCache(ctx, farm, "c"))
+		require.NoError(t, fr.DeleteFarmCache(ctx, farm.ID, farm.FarmerID, farm.AddressID))
+		assert.Equal(t, [][]any{{"del", "farm:farm-1:farmer-1"}}, cache.commands("del"))
+
+		err := fr.UpsertFarmAddressCache(ctx, addr)
+		assert.ErrorContains(t, err, "not found in state")
+		assert.Len(t, cache.commands("hset"), 1)
+	})
+
+	t.Run("Deleted Address Leaves Its Farm", func(t *testing.T) {
+		fr, cache, _ := setupRepo(t)
+
+		require.NoError(t, fr.UpsertFarmCache(ctx, farm, "c"))
+		require.NoError(t, fr.UpsertFarmAddressCache(ctx, addr))
+		require.NoError(t, fr.DeleteFarmAddressCache(ctx, addr.ID))
+
+		assert.Equal(t, [][]any{{
+			"hdel", "farm:farm-1:farmer-1",
+			"street", "village", "sub_district", "city", "province", "postal_code",
+			"address_created_at", "address_updated_at",
+		}}, cache.commands("hdel"))
+		assert.Empty(t, cache.commands("del"))
+
+		// the farm still finds its address for a later update
+		require.NoError(t, fr.UpsertFarmAddressCache(ctx, addr))
+	})
+
+	t.Run("Deleted Address Of A Deleted Farm", func(t *testing.T) {
+		fr, cache, _ := setupRepo(t)
+
+		require.NoError(t, fr.UpsertFarmCache(ctx, farm, "c"))
+		require.NoError(t, fr.DeleteFarmCache(ctx, farm.ID, farm.FarmerID, farm.AddressID))
+
+		require.NoError(t, fr.DeleteFarmAddressCache(ctx, addr.ID))
+		assert.Empty(t, cache.commands("hdel"))
+	})
+
+	t.Run("Malformed State", func(t *testing.T) {
+		fr, cache, stateDB := setupRepo(t)
+		require.NoError(t, stateDB.Set([]byte("address-1"), []byte("farm-1"), pebble.Sync))
+
+		err := fr.UpsertFarmAddressCache(ctx, addr)
+		assert.ErrorContains(t, err, "malformed state")
+		assert.Empty(t, cache.commands("hset"))
+	})
+}
+
+func TestRefreshFarmCache(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Refreshes The Farms That Are Not Deleted", func(t *testing.T) {
+		fr, cache, _ := setupRepo(t)
+
+		require.NoError(t, fr.UpsertFarmCache(ctx, models.Farm{ID: "farm-1", FarmerID: "farmer-1", AddressID: "address-1"}, "c"))
+		require.NoError(t, fr.UpsertFarmCache(ctx, models.Farm{ID: "farm-2", FarmerID: "farmer-1", AddressID: "address-2"}, "c"))
+		require.NoError(t, fr.DeleteFarmCache(ctx, "farm-1", "farmer-1", "address-1"))
+		cache.reset()
+
+		refreshed, err := fr.RefreshFarmCache(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, refreshed)
+		assert.Equal(t, [][]any{{"expire", "farm:farm-2:farmer-1", dayInSeconds}}, cache.commands("expire"))
+	})
+
+	t.Run("Nothing To Refresh", func(t *testing.T) {
+		fr, cache, _ := setupRepo(t)
+
+		refreshed, err := fr.RefreshFarmCache(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, refreshed)
+		assert.Empty(t, cache.commands("expire"))
+	})
+}
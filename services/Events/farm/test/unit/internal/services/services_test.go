@@ -0,0 +1,298 @@
+package unit_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/golang/mock/gomock"
+	"github.com/sony-nurdianto/farm/services/Events/farm/internal/models"
+	"github.com/sony-nurdianto/farm/services/Events/farm/internal/services"
+	"github.com/sony-nurdianto/farm/services/Events/farm/test/mocks"
+	"github.com/stretchr/testify/assert"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+)
+
+const (
+	farmTopic    = "farms"
+	addressTopic = "farm_addresses"
+)
+
+// loopDeps are the dependencies of a consumer loop under test. The loop
+// reads the messages given to feed and then its context is canceled.
+type loopDeps struct {
+	repo     *mocks.MockFarmRepo
+	consumer *mocks.MockKevConsumer
+	service  services.FarmService
+	ctx      context.Context
+	cancel   context.CancelFunc
+}
+
+func newLoopDeps(t *testing.T) *loopDeps {
+	ctrl := gomock.NewController(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	fr := mocks.NewMockFarmRepo(ctrl)
+	consumer := mocks.NewMockKevConsumer(ctrl)
+	fr.EXPECT().FarmConsumer().Return(consumer).AnyTimes()
+	fr.EXPECT().FarmAddrConsumer().Return(consumer).AnyTimes()
+
+	return &loopDeps{
+		repo:     fr,
+		consumer: consumer,
+		service:  services.NewFarmService(fr),
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+}
+
+// feed subscribes the loop to topic and reads msgs in order, the read after
+// the last message cancels the context and answers no message.
+func (d *loopDeps) feed(topic string, msgs ...*kafka.Message) {
+	d.consumer.EXPECT().SubscribeTopics([]string{topic}, gomock.Any()).Return(nil)
+
+	calls := make([]*gomock.Call, 0, len(msgs)+1)
+	for _, msg := range msgs {
+		calls = append(calls, d.consumer.EXPECT().ReadMessage(gomock.Any()).Return(msg, nil))
+	}
+
+	calls = append(calls, d.consumer.EXPECT().
+		ReadMessage(gomock.Any()).
+		DoAndReturn(func(time.Duration) (*kafka.Message, error) {
+			d.cancel()
+			return nil, kafka.NewError(kafka.ErrTimedOut, "timed out", false)
+		}),
+	)
+
+	gomock.InOrder(calls...)
+}
+
+func (d *loopDeps) syncFarm() error {
+	return d.service.SyncFarmCache(d.ctx, farmTopic, tracenoop.NewTracerProvider().Tracer("test"), metricnoop.NewMeterProvider().Meter("test"))
+}
+
+func (d *loopDeps) syncFarmAddress() error {
+	return d.service.SyncFarmAddressCache(d.ctx, addressTopic, tracenoop.NewTracerProvider().Tracer("test"), metricnoop.NewMeterProvider().Meter("test"))
+}
+
+// cdcMessage is a change record of the debezium connector with operation op.
+func cdcMessage(topic string, op string) *kafka.Message {
+	return &kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: 0, Offset: 7},
+		Value:          []byte("payload"),
+		Headers:        []kafka.Header{{Key: "__op", Value: []byte(op)}},
+	}
+}
+
+func TestSyncFarmCache(t *testing.T) {
+	farm := models.Farm{ID: "farm-1", FarmerID: "farmer-1", AddressID: "address-1"}
+	deletedAt := "2025-01-01T00:00:00Z"
+
+	t.Run("Upsert Commits The Message", func(t *testing.T) {
+		d := newLoopDeps(t)
+		msg := cdcMessage(farmTopic, "c")
+		d.feed(farmTopic, msg)
+
+		d.repo.EXPECT().DeserializerFarm(farmTopic, msg.Value).Return(farm, nil)
+		d.repo.EXPECT().UpsertFarmCache(gomock.Any(), farm, "c").Return(nil)
+		d.consumer.EXPECT().CommitMessage(msg).Return(nil, nil)
+
+		assert.ErrorIs(t, d.syncFarm(), context.Canceled)
+	})
+
+	t.Run("Soft Deleted Farm Is Removed", func(t *testing.T) {
+		d := newLoopDeps(t)
+		msg := cdcMessage(farmTopic, "u")
+		d.feed(farmTopic, msg)
+
+		deleted := farm
+		deleted.DeletedAt = &deletedAt
+		d.repo.EXPECT().DeserializerFarm(farmTopic, msg.Value).Return(deleted, nil)
+		d.repo.EXPECT().DeleteFarmCache(gomock.Any(), "farm-1", "farmer-1", "address-1").Return(nil)
+		d.consumer.EXPECT().CommitMessage(msg).Return(nil, nil)
+
+		assert.ErrorIs(t, d.syncFarm(), context.Canceled)
+	})
+
+	t.Run("Delete Operation Removes The Farm", func(t *testing.T) {
+		d := newLoopDeps(t)
+		msg := cdcMessage(farmTopic, "d")
+		d.feed(farmTopic, msg)
+
+		d.repo.EXPECT().DeserializerFarm(farmTopic, msg.Value).Return(farm, nil)
+		d.repo.EXPECT().DeleteFarmCache(gomock.Any(), "farm-1", "farmer-1", "address-1").Return(nil)
+		d.consumer.EXPECT().CommitMessage(msg).Return(nil, nil)
+
+		assert.ErrorIs(t, d.syncFarm(), context.Canceled)
+	})
+
+	t.Run("Cache Error Leaves The Message For A Retry", func(t *testing.T) {
+		d := newLoopDeps(t)
+		msg := cdcMessage(farmTopic, "c")
+		// the uncommitted message is read again
+		d.feed(farmTopic, msg, msg)
+
+		d.repo.EXPECT().DeserializerFarm(farmTopic, msg.Value).Return(farm, nil).Times(2)
+		gomock.InOrder(
+			d.repo.EXPECT().UpsertFarmCache(gomock.Any(), farm, "c").Return(errors.New("redis down")),
+			d.repo.EXPECT().UpsertFarmCache(gomock.Any(), farm, "c").Return(nil),
+		)
+		d.consumer.EXPECT().CommitMessage(msg).Return(nil, nil).Times(1)
+
+		assert.ErrorIs(t, d.syncFarm(), context.Canceled)
+	})
+
+	t.Run("Deserialization Error Skips The Commit", func(t *testing.T) {
+		d := newLoopDeps(t)
+		msg := cdcMessage(farmTopic, "c")
+		d.feed(farmTopic, msg)
+
+		d.repo.EXPECT().DeserializerFarm(farmTopic, msg.Value).Return(models.Farm{}, errors.New("bad payload"))
+
+		assert.ErrorIs(t, d.syncFarm(), context.Canceled)
+	})
+
+	t.Run("Commit Error Keeps Consuming", func(t *testing.T) {
+		d := newLoopDeps(t)
+		first := cdcMessage(farmTopic, "c")
+		second := cdcMessage(farmTopic, "u")
+		d.feed(farmTopic, first, second)
+
+		d.repo.EXPECT().DeserializerFarm(farmTopic, gomock.Any()).Return(farm, nil).Times(2)
+		d.repo.EXPECT().UpsertFarmCache(gomock.Any(), farm, "c").Return(nil)
+		d.repo.EXPECT().UpsertFarmCache(gomock.Any(), farm, "u").Return(nil)
+		gomock.InOrder(
+			d.consumer.EXPECT().CommitMessage(first).Return(nil, errors.New("rebalance in progress")),
+			d.consumer.EXPECT().CommitMessage(second).Return(nil, nil),
+		)
+
+		assert.ErrorIs(t, d.syncFarm(), context.Canceled)
+	})
+
+	t.Run("Unknown Operation Is Committed", func(t *testing.T) {
+		d := newLoopDeps(t)
+		msg := cdcMessage(farmTopic, "t")
+		d.feed(farmTopic, msg)
+
+		d.repo.EXPECT().DeserializerFarm(farmTopic, msg.Value).Return(farm, nil)
+		d.consumer.EXPECT().CommitMessage(msg).Return(nil, nil)
+
+		assert.ErrorIs(t, d.syncFarm(), context.Canceled)
+	})
+
+	t.Run("Read Error Stops The Loop", func(t *testing.T) {
+		d := newLoopDeps(t)
+		d.consumer.EXPECT().SubscribeTopics([]string{farmTopic}, gomock.Any()).Return(nil)
+		d.consumer.EXPECT().ReadMessage(gomock.Any()).Return(nil, errors.New("consumer closed"))
+
+		assert.EqualError(t, d.syncFarm(), "consumer closed")
+	})
+
+	t.Run("Subscribe Error", func(t *testing.T) {
+		d := newLoopDeps(t)
+		d.consumer.EXPECT().SubscribeTopics([]string{farmTopic}, gomock.Any()).Return(errors.New("unknown topic"))
+
+		assert.EqualError(t, d.syncFarm(), "unknown topic")
+	})
+
+	t.Run("Stops When The Context Is Done", func(t *testing.T) {
+		d := newLoopDeps(t)
+		d.consumer.EXPECT().SubscribeTopics([]string{farmTopic}, gomock.Any()).Return(nil)
+		d.cancel()
+
+		assert.ErrorIs(t, d.syncFarm(), context.Canceled)
+	})
+}
+
+func TestSyncFarmAddressCache(t *testing.T) {
+	addr := models.FarmAddress{ID: "address-1", Street: "Jl. Sawah"}
+	deletedAt := "2025-01-01T00:00:00Z"
+
+	t.Run("Upsert Commits The Message", func(t *testing.T) {
+		d := newLoopDeps(t)
+		msg := cdcMessage(addressTopic, "u")
+		d.feed(addressTopic, msg)
+
+		d.repo.EXPECT().DeserializerFarmAddress(addressTopic, msg.Value).Return(addr, nil)
+		d.repo.EXPECT().UpsertFarmAddressCache(gomock.Any(), addr).Return(nil)
+		d.consumer.EXPECT().CommitMessage(msg).Return(nil, nil)
+
+		assert.ErrorIs(t, d.syncFarmAddress(), context.Canceled)
+	})
+
+	t.Run("Soft Deleted Address Is Removed", func(t *testing.T) {
+		d := newLoopDeps(t)
+		msg := cdcMessage(addressTopic, "u")
+		d.feed(addressTopic, msg)
+
+		deleted := addr
+		deleted.DeletedAt = &deletedAt
+		d.repo.EXPECT().DeserializerFarmAddress(addressTopic, msg.Value).Return(deleted, nil)
+		d.repo.EXPECT().DeleteFarmAddressCache(gomock.Any(), "address-1").Return(nil)
+		d.consumer.EXPECT().CommitMessage(msg).Return(nil, nil)
+
+		assert.ErrorIs(t, d.syncFarmAddress(), context.Canceled)
+	})
+
+	t.Run("Delete Operation Removes The Address", func(t *testing.T) {
+		d := newLoopDeps(t)
+		msg := cdcMessage(addressTopic, "d")
+		d.feed(addressTopic, msg)
+
+		d.repo.EXPECT().DeserializerFarmAddress(addressTopic, msg.Value).Return(addr, nil)
+		d.repo.EXPECT().DeleteFarmAddressCache(gomock.Any(), "address-1").Return(nil)
+		d.consumer.EXPECT().CommitMessage(msg).Return(nil, nil)
+
+		assert.ErrorIs(t, d.syncFarmAddress(), context.Canceled)
+	})
+
+	t.Run("Cache Error Leaves The Message For A Retry", func(t *testing.T) {
+		d := newLoopDeps(t)
+		msg := cdcMessage(addressTopic, "c")
+		// the farm of the address is not in state yet, the message is read
+		// again once it is
+		d.feed(addressTopic, msg, msg)
+
+		d.repo.EXPECT().DeserializerFarmAddress(addressTopic, msg.Value).Return(addr, nil).Times(2)
+		gomock.InOrder(
+			d.repo.EXPECT().UpsertFarmAddressCache(gomock.Any(), addr).Return(errors.New("farm of address address-1 not found in state")),
+			d.repo.EXPECT().UpsertFarmAddressCache(gomock.Any(), addr).Return(nil),
+		)
+		d.consumer.EXPECT().CommitMessage(msg).Return(nil, nil).Times(1)
+
+		assert.ErrorIs(t, d.syncFarmAddress(), context.Canceled)
+	})
+
+	t.Run("Delete Error Skips The Commit", func(t *testing.T) {
+		d := newLoopDeps(t)
+		msg := cdcMessage(addressTopic, "d")
+		d.feed(addressTopic, msg)
+
+		d.repo.EXPECT().DeserializerFarmAddress(addressTopic, msg.Value).Return(addr, nil)
+		d.repo.EXPECT().DeleteFarmAddressCache(gomock.Any(), "address-1").Return(errors.New("redis down"))
+
+		assert.ErrorIs(t, d.syncFarmAddress(), context.Canceled)
+	})
+
+	t.Run("Deserialization Error Skips The Commit", func(t *testing.T) {
+		d := newLoopDeps(t)
+		msg := cdcMessage(addressTopic, "c")
+		d.feed(addressTopic, msg)
+
+		d.repo.EXPECT().DeserializerFarmAddress(addressTopic, msg.Value).Return(models.FarmAddress{}, errors.New("bad payload"))
+
+		assert.ErrorIs(t, d.syncFarmAddress(), context.Canceled)
+	})
+
+	t.Run("Stops When The Context Is Done", func(t *testing.T) {
+		d := newLoopDeps(t)
+		d.consumer.EXPECT().SubscribeTopics([]string{addressTopic}, gomock.Any()).Return(nil)
+		d.cancel()
+
+		assert.ErrorIs(t, d.syncFarmAddress(), context.Canceled)
+	})
+}
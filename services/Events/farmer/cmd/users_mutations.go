@@ -2,12 +2,10 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
-	"os/signal"
-	"sync"
-	"syscall"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -20,6 +18,7 @@ import (
 	"github.com/sony-nurdianto/farm/shared_lib/Go/kafkaev/kev"
 	"github.com/sony-nurdianto/farm/shared_lib/Go/kafkaev/schrgs"
 	"github.com/sony-nurdianto/farm/shared_lib/Go/observability/otel/logs"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/runtime"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/metric"
@@ -102,7 +101,7 @@ func main() {
 	startTime := time.Now()
 
 	godotenv.Load()
-	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	ctx, stop := runtime.SignalContext()
 	defer stop()
 
 	serviceObsName := "auth-farmer-event"
@@ -125,10 +124,6 @@ func main() {
 		log.Fatalln(err)
 	}
 
-	defer tp.Shutdown(ctx) // <- TraceProvider
-	defer mp.Shutdown(ctx) // <- MeterProvider
-	defer lp.Shutdown(ctx) // <-LogProvider
-
 	tracer := tp.Tracer(serviceObsName)
 	meter := mp.Meter(serviceObsName)
 	logger := logs.NewLogger()
@@ -143,7 +138,11 @@ func main() {
 			attribute.String("service.name", serviceObsName),
 		),
 	)
-	defer mainSpan.End()
+
+	shutdownObs := func(ctx context.Context) error {
+		mainSpan.End()
+		return errors.Join(tp.Shutdown(ctx), mp.Shutdown(ctx), lp.Shutdown(ctx))
+	}
 
 	rdsCtx, rdSpan := tracer.Start(ctx, "initialize_redis_connection")
 	rdb, err := redisConnection(rdsCtx, tracer, observeMeter.RedisConnections, observeMeter.ErrCounter)
@@ -160,7 +159,9 @@ func main() {
 		rdSpan.SetStatus(codes.Error, "Redis initialization failed")
 		rdSpan.RecordError(err)
 		logger.Error(rdsCtx, err.Error(), err)
-		return
+		rdSpan.End()
+		shutdownObs(context.Background())
+		os.Exit(runtime.ExitFailure)
 	}
 	rdSpan.SetStatus(codes.Ok, "Redis initialized successfully")
 	rdSpan.End()
@@ -173,11 +174,29 @@ func main() {
 		kev.PARTITION_ASSIGNMENT_STRATEGY: "cooperative-sticky",
 	}
 
+	cfgLogin := map[kev.ConfigKeyKafka]string{
+		kev.BOOTSTRAP_SERVERS:             os.Getenv("KAKFKABROKER"),
+		kev.GROUP_ID:                      "farmer-login-event",
+		kev.AUTO_OFFSET_RESET:             "earliest",
+		kev.ENABLE_AUTO_COMMIT:            "false",
+		kev.PARTITION_ASSIGNMENT_STRATEGY: "cooperative-sticky",
+	}
+
+	cfgSaga := map[kev.ConfigKeyKafka]string{
+		kev.BOOTSTRAP_SERVERS:             os.Getenv("KAKFKABROKER"),
+		kev.GROUP_ID:                      "registration-saga",
+		kev.AUTO_OFFSET_RESET:             "earliest",
+		kev.ENABLE_AUTO_COMMIT:            "false",
+		kev.PARTITION_ASSIGNMENT_STRATEGY: "cooperative-sticky",
+	}
+
 	farmerSvcRepo, err := repo.NewFarmerRepo(
 		schrgs.NewRegistery(),
 		avr.NewAvrSerdeInstance(),
 		kev.NewKafka(),
 		cfgFarmer,
+		cfgLogin,
+		cfgSaga,
 		pkg.NewPostgresInstance(),
 		rdb,
 	)
@@ -193,26 +212,105 @@ func main() {
 		log.Fatalln(err)
 	}
 
-	defer farmerSvcRepo.CloseRepo()
-
-	go func() {
-		farmerSvc := services.NewFarmerService(farmerSvcRepo)
-		if err := farmerSvc.SyncUserCache(
-			ctx, "farmer-db.public.users_all_partitions",
-			tracer,
-			meter,
-		); err != nil {
-			observeMeter.ErrCounter.Add(
-				ctx, 1,
-				metric.WithAttributes(
-					attribute.String("service", serviceObsName),
-					attribute.String("description", "error when runninc SyncUserCache service"),
-					attribute.String("error_at", time.Now().Format(time.RFC3339)),
-				),
-			)
-			log.Fatalln(err)
+	farmerSvc := services.NewFarmerService(farmerSvcRepo)
+
+	// consumer runs a loop of farmerSvc, a failure is counted before it
+	// fails the daemon
+	consumer := func(name string, run func(ctx context.Context) error) runtime.Hook {
+		return runtime.Hook{
+			Name:      name,
+			DependsOn: []string{"farmer-repo"},
+			Run: func(ctx context.Context) error {
+				err := run(ctx)
+				if err != nil && !errors.Is(err, context.Canceled) {
+					observeMeter.ErrCounter.Add(
+						ctx, 1,
+						metric.WithAttributes(
+							attribute.String("service", serviceObsName),
+							attribute.String("description", "error when running "+name+" service"),
+							attribute.String("error_at", time.Now().Format(time.RFC3339)),
+						),
+					)
+				}
+				return err
+			},
 		}
-	}()
+	}
+
+	runner := runtime.NewRunner()
+	runner.Register(
+		runtime.Hook{
+			Name: "observability",
+			Stop: shutdownObs,
+		},
+		runtime.Hook{
+			Name:      "farmer-repo",
+			DependsOn: []string{"observability"},
+			Stop: func(ctx context.Context) error {
+				farmerSvcRepo.CloseRepo()
+				observeMeter.RedisConnections.Add(ctx, -1)
+				return nil
+			},
+		},
+		consumer("SyncUserCache", func(ctx context.Context) error {
+			return farmerSvc.SyncUserCache(
+				ctx, "farmer-db.public.users_all_partitions",
+				tracer,
+				meter,
+			)
+		}),
+		consumer("RecordUserLogins", func(ctx context.Context) error {
+			return farmerSvc.RecordUserLogins(
+				ctx, "user-login",
+				tracer,
+				meter,
+			)
+		}),
+		consumer("ConfirmRegistrations", func(ctx context.Context) error {
+			return farmerSvc.ConfirmRegistrations(
+				ctx,
+				"auth-db.public.accounts_all_partitions",
+				"farmer-db.public.users_all_partitions",
+				tracer,
+				meter,
+			)
+		}),
+		// a signup whose rows have not both landed after ten minutes is failed
+		// and its partial rows are removed
+		consumer("CompensateRegistrations", func(ctx context.Context) error {
+			return farmerSvc.CompensateRegistrations(
+				ctx,
+				10*time.Minute,
+				30*time.Second,
+				tracer,
+				meter,
+			)
+		}),
+		runtime.Hook{
+			Name:      "uptime",
+			DependsOn: []string{"observability"},
+			Run: func(ctx context.Context) error {
+				ticker := time.NewTicker(30 * time.Second)
+				defer ticker.Stop()
+
+				uptimeStart := time.Now()
+				for {
+					select {
+					case <-ctx.Done():
+						return nil
+					case <-ticker.C:
+						observeMeter.DaemonUpTime.Add(
+							ctx,
+							time.Since(uptimeStart).Seconds(),
+							metric.WithAttributes(
+								attribute.String("service", serviceObsName),
+							),
+						)
+					}
+				}
+			},
+		},
+	)
 
 	observeMeter.StartupDuration.Record(
 		ctx,
@@ -222,31 +320,5 @@ func main() {
 		),
 	)
 
-	ticker := time.NewTicker(30 * time.Second)
-	defer ticker.Stop()
-
-	uptimeStart := time.Now()
-
-	var once sync.Once
-
-	for {
-		select {
-		case <-ctx.Done():
-			fmt.Println("Event Server Stoping, Gracefully Stop ...")
-			observeMeter.RedisConnections.Add(ctx, -1)
-			fmt.Println("Application Quit.")
-			return
-		case <-ticker.C:
-			observeMeter.DaemonUpTime.Add(
-				ctx,
-				time.Since(uptimeStart).Seconds(),
-				metric.WithAttributes(
-					attribute.String("service", serviceObsName),
-				),
-			)
-
-		default:
-			once.Do(func() { fmt.Println("event service daemon run") })
-		}
-	}
+	runner.Main(ctx)
 }
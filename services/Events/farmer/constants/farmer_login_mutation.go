@@ -0,0 +1,25 @@
+package constants
+
+const (
+	// FarmerRecordLogin appends to login_audit and, for successful sign-ins,
+	// moves users.last_login forward. Nothing is written for an event id that
+	// is already recorded.
+	FarmerRecordLogin string = `
+		WITH audit AS (
+			INSERT INTO login_audit
+				(id, user_id, ip_address, user_agent, outcome, logged_at)
+			VALUES
+				($1, $2, $3, $4, $5, $6)
+			ON CONFLICT (id) DO NOTHING
+			RETURNING id
+		)
+		UPDATE users
+		SET
+			last_login = $6
+		WHERE id = $2
+			AND $5 = 'success'
+			AND (last_login IS NULL OR last_login < $6)
+			AND EXISTS (SELECT 1 FROM audit)
+		RETURNING id
+	`
+)
@@ -0,0 +1,92 @@
+package constants
+
+const (
+	// AuthConfirmRegistrationAccount stamps the account side of a signup and
+	// completes it when the user row is already confirmed. A row landing for a
+	// failed registration reopens its compensation.
+	AuthConfirmRegistrationAccount string = `
+		UPDATE registrations
+		SET
+			account_confirmed_at = COALESCE(account_confirmed_at, now()),
+			status = CASE
+				WHEN status = 'pending' AND user_confirmed_at IS NOT NULL THEN 'complete'
+				ELSE status
+			END,
+			compensated_at = CASE WHEN status = 'failed' THEN NULL ELSE compensated_at END,
+			updated_at = now()
+		WHERE user_id = $1
+		RETURNING status
+	`
+
+	// AuthConfirmRegistrationUser is AuthConfirmRegistrationAccount for the
+	// farmer users row.
+	AuthConfirmRegistrationUser string = `
+		UPDATE registrations
+		SET
+			user_confirmed_at = COALESCE(user_confirmed_at, now()),
+			status = CASE
+				WHEN status = 'pending' AND account_confirmed_at IS NOT NULL THEN 'complete'
+				ELSE status
+			END,
+			compensated_at = CASE WHEN status = 'failed' THEN NULL ELSE compensated_at END,
+			updated_at = now()
+		WHERE user_id = $1
+		RETURNING status
+	`
+
+	AuthExpireRegistrations string = `
+		UPDATE registrations
+		SET
+			status = 'failed',
+			failure_reason = $1,
+			updated_at = now()
+		WHERE status = 'pending'
+			AND created_at < $2
+		RETURNING user_id
+	`
+
+	AuthUncompensatedRegistrations string = `
+		SELECT user_id, updated_at FROM registrations
+		WHERE status = 'failed'
+			AND compensated_at IS NULL
+		ORDER BY updated_at
+		LIMIT $1
+	`
+
+	// AuthMarkRegistrationCompensated only matches when the registration was
+	// not touched since it was read, a confirmation that raced the cleanup
+	// leaves it for the next sweep.
+	AuthMarkRegistrationCompensated string = `
+		UPDATE registrations
+		SET
+			compensated_at = now()
+		WHERE user_id = $1
+			AND updated_at = $2
+			AND status = 'failed'
+		RETURNING user_id
+	`
+
+	// AuthDeleteRegistrationAccount removes an account and the rows a pending
+	// signup can leave behind. Sessions cannot exist because sign-in ignores
+	// accounts of an incomplete registration.
+	AuthDeleteRegistrationAccount string = `
+		WITH verifications AS (
+			DELETE FROM email_verifications
+			WHERE account_id = $1
+		), resets AS (
+			DELETE FROM password_resets
+			WHERE account_id = $1
+		), account AS (
+			DELETE FROM accounts
+			WHERE id = $1
+			RETURNING id
+		)
+		SELECT count(*) FROM account
+	`
+
+	FarmerDeleteUser string = `
+		DELETE FROM users
+		WHERE id = $1
+		RETURNING id
+	`
+)
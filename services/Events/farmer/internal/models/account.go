@@ -0,0 +1,6 @@
+package models
+
+type Account struct {
+	ID    string `avro:"id" json:"id"`
+	Email string `avro:"email" json:"email"`
+}
@@ -0,0 +1,10 @@
+package models
+
+type UserLogin struct {
+	ID        string `avro:"id" json:"id"`
+	UserID    string `avro:"user_id" json:"user_id"`
+	IpAddress string `avro:"ip_address" json:"ip_address"`
+	UserAgent string `avro:"user_agent" json:"user_agent"`
+	Outcome   string `avro:"outcome" json:"outcome"`
+	LoggedAt  string `avro:"logged_at" json:"logged_at"`
+}
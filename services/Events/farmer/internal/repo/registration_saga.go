@@ -0,0 +1,128 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/sony-nurdianto/farm/services/Events/farmer/internal/models"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/database/postgres/pkg"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/kafkaev/kev"
+)
+
+type UncompensatedRegistration struct {
+	UserID    string
+	UpdatedAt time.Time
+}
+
+func (ar Repo) SagaConsumer() kev.KevConsumer {
+	return ar.sagaConsumer
+}
+
+func (ar Repo) DeserializerAccount(topic string, payload []byte) (a models.Account, _ error) {
+	if err := ar.avroDeserializer.DeserializeInto(topic, payload, &a); err != nil {
+		return a, err
+	}
+
+	return a, nil
+}
+
+// confirmRegistration returns the registration status after the confirmation,
+// or an empty status for rows that were not created through the saga.
+func confirmRegistration(ctx context.Context, stmt pkg.Stmt, id string) (string, error) {
+	var status string
+	row := stmt.QueryRowContext(ctx, id)
+	if err := row.Scan(&status); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	return status, nil
+}
+
+func (ar Repo) ConfirmRegistrationAccount(ctx context.Context, id string) (string, error) {
+	return confirmRegistration(ctx, ar.authDB.registrationStmts.confirmAccountStmt, id)
+}
+
+func (ar Repo) ConfirmRegistrationUser(ctx context.Context, id string) (string, error) {
+	return confirmRegistration(ctx, ar.authDB.registrationStmts.confirmUserStmt, id)
+}
+
+// ExpireRegistrations fails every registration still pending since before
+// createdBefore and returns how many were failed.
+func (ar Repo) ExpireRegistrations(ctx context.Context, reason string, createdBefore time.Time) (int, error) {
+	rows, err := ar.authDB.registrationStmts.expireStmt.QueryContext(ctx, reason, createdBefore)
+	if err != nil {
+		return 0, err
+	}
+
+	defer rows.Close()
+
+	expired := 0
+	for rows.Next() {
+		expired++
+	}
+
+	if err := rows.Err(); err != nil {
+		return expired, err
+	}
+
+	return expired, nil
+}
+
+func (ar Repo) UncompensatedRegistrations(ctx context.Context, limit int) ([]UncompensatedRegistration, error) {
+	rows, err := ar.authDB.registrationStmts.uncompensatedStmt.QueryContext(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var regs []UncompensatedRegistration
+	for rows.Next() {
+		var reg UncompensatedRegistration
+		if err := rows.Scan(&reg.UserID, &reg.UpdatedAt); err != nil {
+			return nil, err
+		}
+		regs = append(regs, reg)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return regs, nil
+}
+
+// CompensateRegistration deletes whatever rows of a failed registration have
+// landed in the farmer and auth databases, then marks it compensated. It
+// reports false when a confirmation raced the cleanup, the registration is
+// then picked up again by the next sweep.
+func (ar Repo) CompensateRegistration(ctx context.Context, reg UncompensatedRegistration) (bool, error) {
+	var id string
+	row := ar.farmerDB.deleteUserStmt.QueryRowContext(ctx, reg.UserID)
+	if err := row.Scan(&id); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return false, err
+	}
+
+	var deleted int
+	row = ar.authDB.registrationStmts.deleteAccountStmt.QueryRowContext(ctx, reg.UserID)
+	if err := row.Scan(&deleted); err != nil {
+		return false, err
+	}
+
+	row = ar.authDB.registrationStmts.markCompensatedStmt.QueryRowContext(ctx, reg.UserID, reg.UpdatedAt)
+	if err := row.Scan(&id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
@@ -2,7 +2,10 @@ package repo
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"os"
+	"time"
 
 	"github.com/confluentinc/confluent-kafka-go/v2/schemaregistry/serde"
 	"github.com/redis/go-redis/v9"
@@ -14,17 +17,55 @@ import (
 	"github.com/sony-nurdianto/farm/shared_lib/Go/kafkaev/schrgs"
 )
 
+type FarmerRepo interface {
+	CloseRepo()
+	Consumer() kev.KevConsumer
+	LoginConsumer() kev.KevConsumer
+	SagaConsumer() kev.KevConsumer
+	DeserializerFarmer(topic string, payload []byte) (f models.Farmer, _ error)
+	DeserializerUserLogin(topic string, payload []byte) (l models.UserLogin, _ error)
+	DeserializerAccount(topic string, payload []byte) (a models.Account, _ error)
+	SyncAccountsEmail(ctx context.Context, id, email string) error
+	UpsertFarmerCache(ctx context.Context, key string, farmer models.Farmer) error
+	DeleteFarmerCache(ctx context.Context, key string) error
+	RecordUserLogin(ctx context.Context, login models.UserLogin) error
+	ConfirmRegistrationAccount(ctx context.Context, id string) (string, error)
+	ConfirmRegistrationUser(ctx context.Context, id string) (string, error)
+	ExpireRegistrations(ctx context.Context, reason string, createdBefore time.Time) (int, error)
+	UncompensatedRegistrations(ctx context.Context, limit int) ([]UncompensatedRegistration, error)
+	CompensateRegistration(ctx context.Context, reg UncompensatedRegistration) (bool, error)
+}
+
 type Repo struct {
 	schemaRegisteryClient schrgs.SchemaRegisteryClient
 	avroDeserializer      avr.AvrDeserializer
 	authConsumer          kev.KevConsumer
+	loginConsumer         kev.KevConsumer
+	sagaConsumer          kev.KevConsumer
 	authDB                authDB
+	farmerDB              farmerDB
 	rdb                   *redis.Client
 }
 
-type authDB struct {
+type farmerDB struct {
 	db              pkg.PostgresDatabase
-	updateEmailStmt pkg.Stmt
+	recordLoginStmt pkg.Stmt
+	deleteUserStmt  pkg.Stmt
+}
+
+type authDB struct {
+	db                pkg.PostgresDatabase
+	updateEmailStmt   pkg.Stmt
+	registrationStmts registrationStmts
+}
+
+type registrationStmts struct {
+	confirmAccountStmt  pkg.Stmt
+	confirmUserStmt     pkg.Stmt
+	expireStmt          pkg.Stmt
+	uncompensatedStmt   pkg.Stmt
+	markCompensatedStmt pkg.Stmt
+	deleteAccountStmt   pkg.Stmt
 }
 
 func NewFarmerRepo(
@@ -32,6 +73,8 @@ func NewFarmerRepo(
 	avri avr.AvrSerdeInstance,
 	kv kev.Kafka,
 	kevcfg map[kev.ConfigKeyKafka]string,
+	loginKevCfg map[kev.ConfigKeyKafka]string,
+	sagaKevCfg map[kev.ConfigKeyKafka]string,
 	pgi pkg.PostgresInstance,
 	rdb *redis.Client,
 ) (ap Repo, _ error) {
@@ -59,7 +102,21 @@ func NewFarmerRepo(
 
 	ap.authConsumer = consumer
 
-	pgDB, err := pkg.OpenPostgres(os.Getenv("AUTH_DATABASE_ADDR"), pgi)
+	loginConsumer, err := pool.Consumer(loginKevCfg)
+	if err != nil {
+		return ap, err
+	}
+
+	ap.loginConsumer = loginConsumer
+
+	sagaConsumer, err := pool.Consumer(sagaKevCfg)
+	if err != nil {
+		return ap, err
+	}
+
+	ap.sagaConsumer = sagaConsumer
+
+	pgDB, err := pkg.OpenPostgres(os.Getenv("AUTH_DATABASE_ADDR"), pgi, pkg.WithTelemetry("auth"))
 	if err != nil {
 		return ap, err
 	}
@@ -74,8 +131,50 @@ func NewFarmerRepo(
 		updateEmailStmt: ues,
 	}
 
+	rs := &athDB.registrationStmts
+	regStmts := []struct {
+		query string
+		stmt  *pkg.Stmt
+	}{
+		{constants.AuthConfirmRegistrationAccount, &rs.confirmAccountStmt},
+		{constants.AuthConfirmRegistrationUser, &rs.confirmUserStmt},
+		{constants.AuthExpireRegistrations, &rs.expireStmt},
+		{constants.AuthUncompensatedRegistrations, &rs.uncompensatedStmt},
+		{constants.AuthMarkRegistrationCompensated, &rs.markCompensatedStmt},
+		{constants.AuthDeleteRegistrationAccount, &rs.deleteAccountStmt},
+	}
+
+	for _, st := range regStmts {
+		prepared, err := pgDB.Prepare(st.query)
+		if err != nil {
+			return ap, err
+		}
+		*st.stmt = prepared
+	}
+
 	ap.authDB = athDB
 
+	farmerPgDB, err := pkg.OpenPostgres(os.Getenv("FARMER_DATABASE_ADDR"), pgi, pkg.WithTelemetry("farmer"))
+	if err != nil {
+		return ap, err
+	}
+
+	rls, err := farmerPgDB.Prepare(constants.FarmerRecordLogin)
+	if err != nil {
+		return ap, err
+	}
+
+	fdu, err := farmerPgDB.Prepare(constants.FarmerDeleteUser)
+	if err != nil {
+		return ap, err
+	}
+
+	ap.farmerDB = farmerDB{
+		db:              farmerPgDB,
+		recordLoginStmt: rls,
+		deleteUserStmt:  fdu,
+	}
+
 	ap.rdb = rdb
 
 	return ap, nil
@@ -118,9 +217,44 @@ func (ar Repo) Consumer() kev.KevConsumer {
 	return ar.authConsumer
 }
 
+func (ar Repo) LoginConsumer() kev.KevConsumer {
+	return ar.loginConsumer
+}
+
+func (ar Repo) DeserializerUserLogin(topic string, payload []byte) (l models.UserLogin, _ error) {
+	if err := ar.avroDeserializer.DeserializeInto(topic, payload, &l); err != nil {
+		return l, err
+	}
+
+	return l, nil
+}
+
+func (ar Repo) RecordUserLogin(ctx context.Context, login models.UserLogin) error {
+	var id string
+	row := ar.farmerDB.recordLoginStmt.QueryRowContext(
+		ctx,
+		login.ID,
+		login.UserID,
+		login.IpAddress,
+		login.UserAgent,
+		login.Outcome,
+		login.LoggedAt,
+	)
+
+	// no row comes back for failed sign-ins and redelivered events
+	if err := row.Scan(&id); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	return nil
+}
+
 func (ar Repo) CloseRepo() {
 	ar.schemaRegisteryClient.Client().Close()
 	ar.avroDeserializer.Close()
 	ar.authConsumer.Close()
+	ar.loginConsumer.Close()
+	ar.sagaConsumer.Close()
+	ar.farmerDB.db.Close()
 	ar.rdb.Close()
 }
@@ -16,10 +16,10 @@ import (
 )
 
 type farmerService struct {
-	repo repo.Repo
+	repo repo.FarmerRepo
 }
 
-func NewFarmerService(rp repo.Repo) farmerService {
+func NewFarmerService(rp repo.FarmerRepo) farmerService {
 	return farmerService{
 		repo: rp,
 	}
@@ -32,49 +32,7 @@ func (fs farmerService) SyncUserCache(
 	meter metric.Meter,
 ) error {
 	logger := logs.NewLogger()
-
-	msgProcessed, _ := meter.Int64Counter(
-		"kafka_messages_processed_total",
-		metric.WithDescription("Total number of Kafka messages processed"),
-	)
-
-	cacheOperations, _ := meter.Int64Counter(
-		"cache_operations_total",
-		metric.WithDescription("Total number of cache operations"),
-	)
-
-	msgCommitted, _ := meter.Int64Counter(
-		"kafka_messages_committed_total",
-		metric.WithDescription("Total number of kafka messages committed"),
-	)
-
-	prcsDuration, _ := meter.Float64Histogram(
-		"message_processing_duration_seconds",
-		metric.WithDescription("Time taken to process each message"),
-		metric.WithUnit("s"),
-	)
-
-	deseDuration, _ := meter.Float64Histogram(
-		"deserialization_duration_seconds",
-		metric.WithDescription("Time taken to deserialize messages"),
-		metric.WithUnit("s"),
-	)
-
-	cacheOperationDuration, _ := meter.Float64Histogram(
-		"cache_operation_duration_seconds",
-		metric.WithDescription("Time taken for cache operations (upsert/delete)"),
-		metric.WithUnit("s"),
-	)
-
-	errorCounter, _ := meter.Int64Counter(
-		"sync_errors_total",
-		metric.WithDescription("Total number of errors during sync"),
-	)
-
-	activeConsumers, _ := meter.Int64UpDownCounter(
-		"active_kafka_consumers",
-		metric.WithDescription("Number of active Kafka consumers"),
-	)
+	metrics := newConsumerMetrics(meter)
 
 	fmCtx, span := tracer.Start(ctx, "sync_user_cache",
 		trace.WithAttributes(
@@ -87,10 +45,10 @@ func (fs farmerService) SyncUserCache(
 	consumer := fs.repo.Consumer()
 	consumer.SubscribeTopics([]string{topic}, kev.RebalanceCbCooperativeSticky)
 
-	activeConsumers.Add(fmCtx, 1, metric.WithAttributes(
+	metrics.activeConsumers.Add(fmCtx, 1, metric.WithAttributes(
 		attribute.String("topic", topic),
 	))
-	defer activeConsumers.Add(fmCtx, -1, metric.WithAttributes(
+	defer metrics.activeConsumers.Add(fmCtx, -1, metric.WithAttributes(
 		attribute.String("topic", topic),
 	))
 
@@ -102,35 +60,33 @@ func (fs farmerService) SyncUserCache(
 			span.SetStatus(codes.Ok, "Sync stopped gracefully")
 			return ctx.Err()
 		default:
-			msgCtx, msgSpan := tracer.Start(fmCtx, "process_kafka_message")
 			startTime := time.Now()
 
 			msg, err := consumer.ReadMessage(100 * time.Millisecond)
 			if err != nil {
 				if _, ok := err.(kev.KevError); ok {
-					msgSpan.SetStatus(codes.Ok, "No message available")
-					msgSpan.End()
 					continue
 				}
 
-				msgSpan.SetStatus(codes.Error, "Failed to read message")
-				msgSpan.RecordError(err)
-				errorCounter.Add(msgCtx, 1, metric.WithAttributes(
+				span.SetStatus(codes.Error, "Failed to read message")
+				span.RecordError(err)
+				metrics.errorCounter.Add(fmCtx, 1, metric.WithAttributes(
 					attribute.String("error.type", "kafka_read_failed"),
 					attribute.String("topic", topic),
 				))
-				msgSpan.End()
-				logger.Error(msgCtx, "Failed to read Kafka message", err)
+				logger.Error(fmCtx, "Failed to read Kafka message", err)
 				return err
 			}
 
+			// the span continues the trace of the record when it carries one
+			msgCtx, msgSpan := kev.StartConsumerSpan(fmCtx, tracer, "process_kafka_message", msg)
 			msgSpan.SetAttributes(
 				attribute.String("kafka.partition", fmt.Sprintf("%d", msg.TopicPartition.Partition)),
 				attribute.String("kafka.offset", fmt.Sprintf("%d", msg.TopicPartition.Offset)),
 				attribute.Int("message.size", len(msg.Value)),
 			)
 
-			msgProcessed.Add(msgCtx, 1, metric.WithAttributes(
+			metrics.msgProcessed.Add(msgCtx, 1, metric.WithAttributes(
 				attribute.String("topic", topic),
 				attribute.String("partition", fmt.Sprintf("%d", msg.TopicPartition.Partition)),
 			))
@@ -139,7 +95,7 @@ func (fs farmerService) SyncUserCache(
 			farmer, err := fs.repo.DeserializerFarmer(topic, msg.Value)
 			deserDuration := time.Since(deserStart)
 
-			deseDuration.Record(msgCtx, deserDuration.Seconds(),
+			metrics.deseDuration.Record(msgCtx, deserDuration.Seconds(),
 				metric.WithAttributes(
 					attribute.String("topic", topic),
 				),
@@ -148,7 +104,7 @@ func (fs farmerService) SyncUserCache(
 			if err != nil {
 				msgSpan.SetStatus(codes.Error, "Deserialization failed")
 				msgSpan.RecordError(err)
-				errorCounter.Add(msgCtx, 1, metric.WithAttributes(
+				metrics.errorCounter.Add(msgCtx, 1, metric.WithAttributes(
 					attribute.String("error.type", "deserialization_failed"),
 					attribute.String("topic", topic),
 				))
@@ -197,7 +153,7 @@ func (fs farmerService) SyncUserCache(
 				if err != nil {
 					cacheSpan.SetStatus(codes.Error, "Cache upsert failed")
 					cacheSpan.RecordError(err)
-					errorCounter.Add(cacheCtx, 1, metric.WithAttributes(
+					metrics.errorCounter.Add(cacheCtx, 1, metric.WithAttributes(
 						attribute.String("error.type", "cache_upsert_failed"),
 						attribute.String("cache.key", cacheKey),
 					))
@@ -208,12 +164,12 @@ func (fs farmerService) SyncUserCache(
 				}
 
 				cacheSpan.SetStatus(codes.Ok, "Cache upsert successful")
-				cacheOperations.Add(cacheCtx, 1, metric.WithAttributes(
+				metrics.cacheOperations.Add(cacheCtx, 1, metric.WithAttributes(
 					attribute.String("cache.operation", "upsert"),
 					attribute.String("status", "success"),
 				))
 
-				cacheOperationDuration.Record(cacheCtx, cacheDuration.Seconds(),
+				metrics.cacheOperationDuration.Record(cacheCtx, cacheDuration.Seconds(),
 					metric.WithAttributes(
 						attribute.String("cache.operation", "upsert"),
 					),
@@ -236,7 +192,7 @@ func (fs farmerService) SyncUserCache(
 				if err != nil {
 					cacheSpan.SetStatus(codes.Error, "Cache delete failed")
 					cacheSpan.RecordError(err)
-					errorCounter.Add(cacheCtx, 1, metric.WithAttributes(
+					metrics.errorCounter.Add(cacheCtx, 1, metric.WithAttributes(
 						attribute.String("error.type", "cache_delete_failed"),
 						attribute.String("cache.key", cacheKey),
 					))
@@ -247,12 +203,12 @@ func (fs farmerService) SyncUserCache(
 				}
 
 				cacheSpan.SetStatus(codes.Ok, "Cache delete successful")
-				cacheOperations.Add(cacheCtx, 1, metric.WithAttributes(
+				metrics.cacheOperations.Add(cacheCtx, 1, metric.WithAttributes(
 					attribute.String("cache.operation", "delete"),
 					attribute.String("status", "success"),
 				))
 
-				cacheOperationDuration.Record(cacheCtx, cacheDuration.Seconds(),
+				metrics.cacheOperationDuration.Record(cacheCtx, cacheDuration.Seconds(),
 					metric.WithAttributes(
 						attribute.String("cache.operation", "delete"),
 					),
@@ -270,7 +226,7 @@ func (fs farmerService) SyncUserCache(
 			if _, err := consumer.CommitMessage(msg); err != nil {
 				msgSpan.SetStatus(codes.Error, "Message commit failed")
 				msgSpan.RecordError(err)
-				errorCounter.Add(msgCtx, 1, metric.WithAttributes(
+				metrics.errorCounter.Add(msgCtx, 1, metric.WithAttributes(
 					attribute.String("error.type", "kafka_commit_failed"),
 					attribute.String("topic", topic),
 				))
@@ -280,13 +236,13 @@ func (fs farmerService) SyncUserCache(
 			}
 
 			commitDuration := time.Since(commitStart)
-			msgCommitted.Add(msgCtx, 1, metric.WithAttributes(
+			metrics.msgCommitted.Add(msgCtx, 1, metric.WithAttributes(
 				attribute.String("topic", topic),
 				attribute.String("partition", fmt.Sprintf("%d", msg.TopicPartition.Partition)),
 			))
 
 			totalDuration := time.Since(startTime)
-			prcsDuration.Record(msgCtx, totalDuration.Seconds(),
+			metrics.prcsDuration.Record(msgCtx, totalDuration.Seconds(),
 				metric.WithAttributes(
 					attribute.String("topic", topic),
 					attribute.String("operation", op),
@@ -0,0 +1,276 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sony-nurdianto/farm/shared_lib/Go/kafkaev/kev"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/observability/otel/logs"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	registrationExpiredReason string = "registration timed out"
+	compensationBatchSize     int    = 100
+)
+
+// ConfirmRegistrations consumes the accounts and users CDC topics and stamps
+// the matching side of each registration saga. A registration completes once
+// both rows are confirmed. A confirmation that fails is retried until it is
+// applied, so the commit of a later message never skips it. A row that can't
+// be deserialized is skipped.
+func (fs farmerService) ConfirmRegistrations(
+	ctx context.Context,
+	accountTopic string,
+	userTopic string,
+	tracer trace.Tracer,
+	meter metric.Meter,
+) error {
+	logger := logs.NewLogger()
+	metrics := newConsumerMetrics(meter)
+
+	registrations, _ := meter.Int64Counter(
+		"registration_confirmations_total",
+		metric.WithDescription("Total number of registration rows confirmed"),
+	)
+
+	topics := []string{accountTopic, userTopic}
+	rgCtx, span := tracer.Start(ctx, "confirm_registrations",
+		trace.WithAttributes(
+			attribute.StringSlice("kafka.topics", topics),
+			attribute.String("operation", "confirm_registrations"),
+		),
+	)
+	defer span.End()
+
+	consumer := fs.repo.SagaConsumer()
+	consumer.SubscribeTopics(topics, kev.RebalanceCbCooperativeSticky)
+
+	metrics.activeConsumers.Add(rgCtx, 1, metric.WithAttributes(
+		attribute.String("topic", strings.Join(topics, ",")),
+	))
+	defer metrics.activeConsumers.Add(rgCtx, -1, metric.WithAttributes(
+		attribute.String("topic", strings.Join(topics, ",")),
+	))
+
+	span.SetAttributes(attribute.String("consumer.status", "subscribed"))
+
+	for {
+		select {
+		case <-ctx.Done():
+			span.SetStatus(codes.Ok, "Registration confirmation stopped gracefully")
+			return ctx.Err()
+		default:
+			msg, err := consumer.ReadMessage(100 * time.Millisecond)
+			if err != nil {
+				if _, ok := err.(kev.KevError); ok {
+					continue
+				}
+
+				span.RecordError(err)
+				metrics.errorCounter.Add(rgCtx, 1, metric.WithAttributes(
+					attribute.String("error.type", "kafka_read_failed"),
+				))
+				logger.Error(rgCtx, "Failed to read Kafka message", err)
+				return err
+			}
+
+			topic := *msg.TopicPartition.Topic
+			msgCtx, msgSpan := kev.StartConsumerSpan(rgCtx, tracer, "process_registration_row", msg,
+				trace.WithAttributes(
+					attribute.String("kafka.topic", topic),
+					attribute.String("kafka.partition", fmt.Sprintf("%d", msg.TopicPartition.Partition)),
+					attribute.String("kafka.offset", fmt.Sprintf("%d", msg.TopicPartition.Offset)),
+				),
+			)
+
+			metrics.msgProcessed.Add(msgCtx, 1, metric.WithAttributes(
+				attribute.String("topic", topic),
+				attribute.String("partition", fmt.Sprintf("%d", msg.TopicPartition.Partition)),
+			))
+
+			var op string
+			for _, h := range msg.Headers {
+				if strings.TrimPrefix(h.Key, "__") == "op" {
+					op = string(h.Value)
+					break
+				}
+			}
+
+			msgSpan.SetAttributes(attribute.String("cdc.operation", op))
+
+			var status string
+			if op == "c" || op == "r" {
+				var id string
+				var confirm func(context.Context, string) (string, error)
+
+				switch topic {
+				case accountTopic:
+					account, derr := fs.repo.DeserializerAccount(topic, msg.Value)
+					id, err, confirm = account.ID, derr, fs.repo.ConfirmRegistrationAccount
+				default:
+					farmer, derr := fs.repo.DeserializerFarmer(topic, msg.Value)
+					id, err, confirm = farmer.ID, derr, fs.repo.ConfirmRegistrationUser
+				}
+
+				if err != nil {
+					msgSpan.SetStatus(codes.Error, "Deserialization failed")
+					msgSpan.RecordError(err)
+					metrics.errorCounter.Add(msgCtx, 1, metric.WithAttributes(
+						attribute.String("error.type", "deserialization_failed"),
+						attribute.String("topic", topic),
+					))
+					logger.Error(msgCtx, "Deserialization error", err)
+					msgSpan.End()
+					continue
+				}
+
+				msgSpan.SetAttributes(attribute.String("farmer.id", id))
+
+				err = applyWithRetry(msgCtx,
+					func() (err error) {
+						status, err = confirm(msgCtx, id)
+						return err
+					},
+					func(attempt int, err error) {
+						msgSpan.RecordError(err, trace.WithAttributes(attribute.Int("retry.attempt", attempt)))
+						metrics.errorCounter.Add(msgCtx, 1, metric.WithAttributes(
+							attribute.String("error.type", "confirm_registration_failed"),
+							attribute.String("topic", topic),
+						))
+						logger.Error(msgCtx, "Confirm registration error", err)
+					},
+				)
+				if err != nil {
+					msgSpan.SetStatus(codes.Error, "Registration confirmation interrupted")
+					msgSpan.End()
+					span.SetStatus(codes.Ok, "Registration confirmation stopped gracefully")
+					return err
+				}
+			}
+
+			if status != "" {
+				msgSpan.SetAttributes(attribute.String("registration.status", status))
+				registrations.Add(msgCtx, 1, metric.WithAttributes(
+					attribute.String("topic", topic),
+					attribute.String("status", status),
+				))
+			}
+
+			if _, err := consumer.CommitMessage(msg); err != nil {
+				msgSpan.SetStatus(codes.Error, "Message commit failed")
+				msgSpan.RecordError(err)
+				metrics.errorCounter.Add(msgCtx, 1, metric.WithAttributes(
+					attribute.String("error.type", "kafka_commit_failed"),
+					attribute.String("topic", topic),
+				))
+				logger.Error(msgCtx, "Kafka commit error", err)
+				msgSpan.End()
+				continue
+			}
+
+			metrics.msgCommitted.Add(msgCtx, 1, metric.WithAttributes(
+				attribute.String("topic", topic),
+				attribute.String("partition", fmt.Sprintf("%d", msg.TopicPartition.Partition)),
+			))
+
+			msgSpan.SetStatus(codes.Ok, "Message processed successfully")
+			msgSpan.End()
+		}
+	}
+}
+
+// CompensateRegistrations fails registrations that stayed pending longer than
+// timeout and removes the rows of failed registrations that did land, every
+// interval.
+func (fs farmerService) CompensateRegistrations(
+	ctx context.Context,
+	timeout time.Duration,
+	interval time.Duration,
+	tracer trace.Tracer,
+	meter metric.Meter,
+) error {
+	logger := logs.NewLogger()
+
+	expiredCounter, _ := meter.Int64Counter(
+		"registrations_expired_total",
+		metric.WithDescription("Total number of pending registrations failed by timeout"),
+	)
+
+	compensatedCounter, _ := meter.Int64Counter(
+		"registrations_compensated_total",
+		metric.WithDescription("Total number of failed registrations cleaned up"),
+	)
+
+	errorCounter, _ := meter.Int64Counter(
+		"sync_errors_total",
+		metric.WithDescription("Total number of errors during sync"),
+	)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			swCtx, span := tracer.Start(ctx, "compensate_registrations",
+				trace.WithAttributes(
+					attribute.String("operation", "compensate_registrations"),
+				),
+			)
+
+			expired, err := fs.repo.ExpireRegistrations(swCtx, registrationExpiredReason, time.Now().Add(-timeout))
+			if err != nil {
+				span.RecordError(err)
+				errorCounter.Add(swCtx, 1, metric.WithAttributes(
+					attribute.String("error.type", "expire_registrations_failed"),
+				))
+				logger.Error(swCtx, "Expire registrations error", err)
+			}
+
+			expiredCounter.Add(swCtx, int64(expired))
+			span.SetAttributes(attribute.Int("registrations.expired", expired))
+
+			regs, err := fs.repo.UncompensatedRegistrations(swCtx, compensationBatchSize)
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, "Listing failed registrations failed")
+				errorCounter.Add(swCtx, 1, metric.WithAttributes(
+					attribute.String("error.type", "list_failed_registrations_failed"),
+				))
+				logger.Error(swCtx, "List failed registrations error", err)
+				span.End()
+				continue
+			}
+
+			compensated := 0
+			for _, reg := range regs {
+				ok, err := fs.repo.CompensateRegistration(swCtx, reg)
+				if err != nil {
+					span.RecordError(err)
+					errorCounter.Add(swCtx, 1, metric.WithAttributes(
+						attribute.String("error.type", "compensate_registration_failed"),
+					))
+					logger.Error(swCtx, fmt.Sprintf("Compensate registration %s error", reg.UserID), err)
+					continue
+				}
+
+				if ok {
+					compensated++
+				}
+			}
+
+			compensatedCounter.Add(swCtx, int64(compensated))
+			span.SetAttributes(attribute.Int("registrations.compensated", compensated))
+			span.SetStatus(codes.Ok, "Registrations swept")
+			span.End()
+		}
+	}
+}
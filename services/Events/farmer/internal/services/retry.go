@@ -0,0 +1,36 @@
+package services
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	applyRetryBase = 100 * time.Millisecond
+	applyRetryMax  = 10 * time.Second
+)
+
+// applyWithRetry runs apply until it succeeds, waiting twice as long after
+// every failure up to applyRetryMax, and reports each failure to onError.
+// The consumer does not read past the message meanwhile, so a later commit
+// can't skip it. It answers ctx.Err() when ctx is done first, the message
+// stays uncommitted and the next consumer of its partition reads it again.
+func applyWithRetry(ctx context.Context, apply func() error, onError func(attempt int, err error)) error {
+	wait := applyRetryBase
+	for attempt := 1; ; attempt++ {
+		err := apply()
+		if err == nil {
+			return nil
+		}
+
+		onError(attempt, err)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+
+		wait = min(wait*2, applyRetryMax)
+	}
+}
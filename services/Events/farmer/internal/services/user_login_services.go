@@ -0,0 +1,151 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sony-nurdianto/farm/shared_lib/Go/kafkaev/kev"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/observability/otel/logs"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RecordUserLogins consumes user-login events from the auth service and
+// writes them to login_audit, moving users.last_login on successful
+// sign-ins. A login that fails to be recorded is retried until it is, so the
+// commit of a later message never skips it. A message that can't be
+// deserialized is skipped.
+func (fs farmerService) RecordUserLogins(
+	ctx context.Context,
+	topic string,
+	tracer trace.Tracer,
+	meter metric.Meter,
+) error {
+	logger := logs.NewLogger()
+	metrics := newConsumerMetrics(meter)
+
+	lgCtx, span := tracer.Start(ctx, "record_user_logins",
+		trace.WithAttributes(
+			attribute.String("kafka.topic", topic),
+			attribute.String("operation", "record_user_logins"),
+		),
+	)
+	defer span.End()
+
+	consumer := fs.repo.LoginConsumer()
+	consumer.SubscribeTopics([]string{topic}, kev.RebalanceCbCooperativeSticky)
+
+	metrics.activeConsumers.Add(lgCtx, 1, metric.WithAttributes(
+		attribute.String("topic", topic),
+	))
+	defer metrics.activeConsumers.Add(lgCtx, -1, metric.WithAttributes(
+		attribute.String("topic", topic),
+	))
+
+	span.SetAttributes(attribute.String("consumer.status", "subscribed"))
+
+	for {
+		select {
+		case <-ctx.Done():
+			span.SetStatus(codes.Ok, "Login recording stopped gracefully")
+			return ctx.Err()
+		default:
+			msg, err := consumer.ReadMessage(100 * time.Millisecond)
+			if err != nil {
+				if _, ok := err.(kev.KevError); ok {
+					continue
+				}
+
+				span.RecordError(err)
+				metrics.errorCounter.Add(lgCtx, 1, metric.WithAttributes(
+					attribute.String("error.type", "kafka_read_failed"),
+					attribute.String("topic", topic),
+				))
+				logger.Error(lgCtx, "Failed to read Kafka message", err)
+				return err
+			}
+
+			startTime := time.Now()
+			msgCtx, msgSpan := kev.StartConsumerSpan(lgCtx, tracer, "process_user_login", msg,
+				trace.WithAttributes(
+					attribute.String("kafka.partition", fmt.Sprintf("%d", msg.TopicPartition.Partition)),
+					attribute.String("kafka.offset", fmt.Sprintf("%d", msg.TopicPartition.Offset)),
+				),
+			)
+
+			metrics.msgProcessed.Add(msgCtx, 1, metric.WithAttributes(
+				attribute.String("topic", topic),
+				attribute.String("partition", fmt.Sprintf("%d", msg.TopicPartition.Partition)),
+			))
+
+			login, err := fs.repo.DeserializerUserLogin(topic, msg.Value)
+			if err != nil {
+				msgSpan.SetStatus(codes.Error, "Deserialization failed")
+				msgSpan.RecordError(err)
+				metrics.errorCounter.Add(msgCtx, 1, metric.WithAttributes(
+					attribute.String("error.type", "deserialization_failed"),
+					attribute.String("topic", topic),
+				))
+				logger.Error(msgCtx, "Deserialization error", err)
+				msgSpan.End()
+				continue
+			}
+
+			msgSpan.SetAttributes(
+				attribute.String("farmer.id", login.UserID),
+				attribute.String("login.outcome", login.Outcome),
+			)
+
+			err = applyWithRetry(msgCtx,
+				func() error {
+					return fs.repo.RecordUserLogin(msgCtx, login)
+				},
+				func(attempt int, err error) {
+					msgSpan.RecordError(err, trace.WithAttributes(attribute.Int("retry.attempt", attempt)))
+					metrics.errorCounter.Add(msgCtx, 1, metric.WithAttributes(
+						attribute.String("error.type", "record_login_failed"),
+						attribute.String("topic", topic),
+					))
+					logger.Error(msgCtx, "Record login error", err)
+				},
+			)
+			if err != nil {
+				msgSpan.SetStatus(codes.Error, "Recording login interrupted")
+				msgSpan.End()
+				span.SetStatus(codes.Ok, "Login recording stopped gracefully")
+				return err
+			}
+
+			if _, err := consumer.CommitMessage(msg); err != nil {
+				msgSpan.SetStatus(codes.Error, "Message commit failed")
+				msgSpan.RecordError(err)
+				metrics.errorCounter.Add(msgCtx, 1, metric.WithAttributes(
+					attribute.String("error.type", "kafka_commit_failed"),
+					attribute.String("topic", topic),
+				))
+				logger.Error(msgCtx, "Kafka commit error", err)
+				msgSpan.End()
+				continue
+			}
+
+			metrics.msgCommitted.Add(msgCtx, 1, metric.WithAttributes(
+				attribute.String("topic", topic),
+				attribute.String("partition", fmt.Sprintf("%d", msg.TopicPartition.Partition)),
+			))
+
+			metrics.prcsDuration.Record(msgCtx, time.Since(startTime).Seconds(),
+				metric.WithAttributes(
+					attribute.String("topic", topic),
+					attribute.String("operation", "record_login"),
+					attribute.String("status", "success"),
+				),
+			)
+
+			msgSpan.SetStatus(codes.Ok, "Message processed successfully")
+			msgSpan.End()
+		}
+	}
+}
@@ -0,0 +1,269 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repo/repo.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/sony-nurdianto/farm/services/Events/farmer/internal/models"
+	repo "github.com/sony-nurdianto/farm/services/Events/farmer/internal/repo"
+	kev "github.com/sony-nurdianto/farm/shared_lib/Go/kafkaev/kev"
+)
+
+// MockFarmerRepo is a mock of FarmerRepo interface.
+type MockFarmerRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockFarmerRepoMockRecorder
+}
+
+// MockFarmerRepoMockRecorder is the mock recorder for MockFarmerRepo.
+type MockFarmerRepoMockRecorder struct {
+	mock *MockFarmerRepo
+}
+
+// NewMockFarmerRepo creates a new mock instance.
+func NewMockFarmerRepo(ctrl *gomock.Controller) *MockFarmerRepo {
+	mock := &MockFarmerRepo{ctrl: ctrl}
+	mock.recorder = &MockFarmerRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFarmerRepo) EXPECT() *MockFarmerRepoMockRecorder {
+	return m.recorder
+}
+
+// CloseRepo mocks base method.
+func (m *MockFarmerRepo) CloseRepo() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CloseRepo")
+}
+
+// CloseRepo indicates an expected call of CloseRepo.
+func (mr *MockFarmerRepoMockRecorder) CloseRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseRepo", reflect.TypeOf((*MockFarmerRepo)(nil).CloseRepo))
+}
+
+// CompensateRegistration mocks base method.
+func (m *MockFarmerRepo) CompensateRegistration(ctx context.Context, reg repo.UncompensatedRegistration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompensateRegistration", ctx, reg)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompensateRegistration indicates an expected call of CompensateRegistration.
+func (mr *MockFarmerRepoMockRecorder) CompensateRegistration(ctx, reg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompensateRegistration", reflect.TypeOf((*MockFarmerRepo)(nil).CompensateRegistration), ctx, reg)
+}
+
+// ConfirmRegistrationAccount mocks base method.
+func (m *MockFarmerRepo) ConfirmRegistrationAccount(ctx context.Context, id string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmRegistrationAccount", ctx, id)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmRegistrationAccount indicates an expected call of ConfirmRegistrationAccount.
+func (mr *MockFarmerRepoMockRecorder) ConfirmRegistrationAccount(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmRegistrationAccount", reflect.TypeOf((*MockFarmerRepo)(nil).ConfirmRegistrationAccount), ctx, id)
+}
+
+// ConfirmRegistrationUser mocks base method.
+func (m *MockFarmerRepo) ConfirmRegistrationUser(ctx context.Context, id string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmRegistrationUser", ctx, id)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmRegistrationUser indicates an expected call of ConfirmRegistrationUser.
+func (mr *MockFarmerRepoMockRecorder) ConfirmRegistrationUser(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmRegistrationUser", reflect.TypeOf((*MockFarmerRepo)(nil).ConfirmRegistrationUser), ctx, id)
+}
+
+// Consumer mocks base method.
+func (m *MockFarmerRepo) Consumer() kev.KevConsumer {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Consumer")
+	ret0, _ := ret[0].(kev.KevConsumer)
+	return ret0
+}
+
+// Consumer indicates an expected call of Consumer.
+func (mr *MockFarmerRepoMockRecorder) Consumer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Consumer", reflect.TypeOf((*MockFarmerRepo)(nil).Consumer))
+}
+
+// DeleteFarmerCache mocks base method.
+func (m *MockFarmerRepo) DeleteFarmerCache(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFarmerCache", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFarmerCache indicates an expected call of DeleteFarmerCache.
+func (mr *MockFarmerRepoMockRecorder) DeleteFarmerCache(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFarmerCache", reflect.TypeOf((*MockFarmerRepo)(nil).DeleteFarmerCache), ctx, key)
+}
+
+// DeserializerAccount mocks base method.
+func (m *MockFarmerRepo) DeserializerAccount(topic string, payload []byte) (models.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeserializerAccount", topic, payload)
+	ret0, _ := ret[0].(models.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeserializerAccount indicates an expected call of DeserializerAccount.
+func (mr *MockFarmerRepoMockRecorder) DeserializerAccount(topic, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeserializerAccount", reflect.TypeOf((*MockFarmerRepo)(nil).DeserializerAccount), topic, payload)
+}
+
+// DeserializerFarmer mocks base method.
+func (m *MockFarmerRepo) DeserializerFarmer(topic string, payload []byte) (models.Farmer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeserializerFarmer", topic, payload)
+	ret0, _ := ret[0].(models.Farmer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeserializerFarmer indicates an expected call of DeserializerFarmer.
+func (mr *MockFarmerRepoMockRecorder) DeserializerFarmer(topic, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeserializerFarmer", reflect.TypeOf((*MockFarmerRepo)(nil).DeserializerFarmer), topic, payload)
+}
+
+// DeserializerUserLogin mocks base method.
+func (m *MockFarmerRepo) DeserializerUserLogin(topic string, payload []byte) (models.UserLogin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeserializerUserLogin", topic, payload)
+	ret0, _ := ret[0].(models.UserLogin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeserializerUserLogin indicates an expected call of DeserializerUserLogin.
+func (mr *MockFarmerRepoMockRecorder) DeserializerUserLogin(topic, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeserializerUserLogin", reflect.TypeOf((*MockFarmerRepo)(nil).DeserializerUserLogin), topic, payload)
+}
+
+// ExpireRegistrations mocks base method.
+func (m *MockFarmerRepo) ExpireRegistrations(ctx context.Context, reason string, createdBefore time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireRegistrations", ctx, reason, createdBefore)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireRegistrations indicates an expected call of ExpireRegistrations.
+func (mr *MockFarmerRepoMockRecorder) ExpireRegistrations(ctx, reason, createdBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireRegistrations", reflect.TypeOf((*MockFarmerRepo)(nil).ExpireRegistrations), ctx, reason, createdBefore)
+}
+
+// LoginConsumer mocks base method.
+func (m *MockFarmerRepo) LoginConsumer() kev.KevConsumer {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoginConsumer")
+	ret0, _ := ret[0].(kev.KevConsumer)
+	return ret0
+}
+
+// LoginConsumer indicates an expected call of LoginConsumer.
+func (mr *MockFarmerRepoMockRecorder) LoginConsumer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginConsumer", reflect.TypeOf((*MockFarmerRepo)(nil).LoginConsumer))
+}
+
+// RecordUserLogin mocks base method.
+func (m *MockFarmerRepo) RecordUserLogin(ctx context.Context, login models.UserLogin) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordUserLogin", ctx, login)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordUserLogin indicates an expected call of RecordUserLogin.
+func (mr *MockFarmerRepoMockRecorder) RecordUserLogin(ctx, login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordUserLogin", reflect.TypeOf((*MockFarmerRepo)(nil).RecordUserLogin), ctx, login)
+}
+
+// SagaConsumer mocks base method.
+func (m *MockFarmerRepo) SagaConsumer() kev.KevConsumer {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SagaConsumer")
+	ret0, _ := ret[0].(kev.KevConsumer)
+	return ret0
+}
+
+// SagaConsumer indicates an expected call of SagaConsumer.
+func (mr *MockFarmerRepoMockRecorder) SagaConsumer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SagaConsumer", reflect.TypeOf((*MockFarmerRepo)(nil).SagaConsumer))
+}
+
+// SyncAccountsEmail mocks base method.
+func (m *MockFarmerRepo) SyncAccountsEmail(ctx context.Context, id, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncAccountsEmail", ctx, id, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SyncAccountsEmail indicates an expected call of SyncAccountsEmail.
+func (mr *MockFarmerRepoMockRecorder) SyncAccountsEmail(ctx, id, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncAccountsEmail", reflect.TypeOf((*MockFarmerRepo)(nil).SyncAccountsEmail), ctx, id, email)
+}
+
+// UncompensatedRegistrations mocks base method.
+func (m *MockFarmerRepo) UncompensatedRegistrations(ctx context.Context, limit int) ([]repo.UncompensatedRegistration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UncompensatedRegistrations", ctx, limit)
+	ret0, _ := ret[0].([]repo.UncompensatedRegistration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UncompensatedRegistrations indicates an expected call of UncompensatedRegistrations.
+func (mr *MockFarmerRepoMockRecorder) UncompensatedRegistrations(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UncompensatedRegistrations", reflect.TypeOf((*MockFarmerRepo)(nil).UncompensatedRegistrations), ctx, limit)
+}
+
+// UpsertFarmerCache mocks base method.
+func (m *MockFarmerRepo) UpsertFarmerCache(ctx context.Context, key string, farmer models.Farmer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertFarmerCache", ctx, key, farmer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertFarmerCache indicates an expected call of UpsertFarmerCache.
+func (mr *MockFarmerRepoMockRecorder) UpsertFarmerCache(ctx, key, farmer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertFarmerCache", reflect.TypeOf((*MockFarmerRepo)(nil).UpsertFarmerCache), ctx, key, farmer)
+}
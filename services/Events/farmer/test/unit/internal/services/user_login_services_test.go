@@ -0,0 +1,176 @@
+package unit_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/golang/mock/gomock"
+	"github.com/sony-nurdianto/farm/services/Events/farmer/internal/models"
+	"github.com/sony-nurdianto/farm/services/Events/farmer/internal/services"
+	"github.com/sony-nurdianto/farm/services/Events/farmer/test/mocks"
+	"github.com/stretchr/testify/assert"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+)
+
+const loginTopic = "user_logins"
+
+// loginDeps are the dependencies of a login recording loop under test. The
+// loop reads the messages given to feed and then its context is canceled.
+type loginDeps struct {
+	repo     *mocks.MockFarmerRepo
+	consumer *mocks.MockKevConsumer
+	ctx      context.Context
+	cancel   context.CancelFunc
+}
+
+func newLoginDeps(t *testing.T) *loginDeps {
+	ctrl := gomock.NewController(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	rp := mocks.NewMockFarmerRepo(ctrl)
+	consumer := mocks.NewMockKevConsumer(ctrl)
+	rp.EXPECT().LoginConsumer().Return(consumer).AnyTimes()
+	consumer.EXPECT().SubscribeTopics([]string{loginTopic}, gomock.Any()).Return(nil)
+
+	return &loginDeps{
+		repo:     rp,
+		consumer: consumer,
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+}
+
+// feed reads msgs in order, the read after the last message cancels the
+// context and answers no message.
+func (d *loginDeps) feed(msgs ...*kafka.Message) {
+	calls := make([]*gomock.Call, 0, len(msgs)+1)
+	for _, msg := range msgs {
+		calls = append(calls, d.consumer.EXPECT().ReadMessage(gomock.Any()).Return(msg, nil))
+	}
+
+	calls = append(calls, d.consumer.EXPECT().
+		ReadMessage(gomock.Any()).
+		DoAndReturn(func(time.Duration) (*kafka.Message, error) {
+			d.cancel()
+			return nil, kafka.NewError(kafka.ErrTimedOut, "timed out", false)
+		}),
+	)
+
+	gomock.InOrder(calls...)
+}
+
+func (d *loginDeps) record() error {
+	return services.NewFarmerService(d.repo).RecordUserLogins(
+		d.ctx,
+		loginTopic,
+		tracenoop.NewTracerProvider().Tracer("test"),
+		metricnoop.NewMeterProvider().Meter("test"),
+	)
+}
+
+func loginMessage(offset kafka.Offset, payload string) *kafka.Message {
+	topic := loginTopic
+	return &kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &topic, Partition: 0, Offset: offset},
+		Value:          []byte(payload),
+	}
+}
+
+func TestRecordUserLogins(t *testing.T) {
+	first := models.UserLogin{ID: "login-1", UserID: "farmer-1", Outcome: "success"}
+	second := models.UserLogin{ID: "login-2", UserID: "farmer-1", Outcome: "failure"}
+	errDB := errors.New("connection refused")
+
+	t.Run("Recorded Login Is Committed", func(t *testing.T) {
+		d := newLoginDeps(t)
+		msg := loginMessage(1, "login-1")
+		d.feed(msg)
+
+		d.repo.EXPECT().DeserializerUserLogin(loginTopic, msg.Value).Return(first, nil)
+		gomock.InOrder(
+			d.repo.EXPECT().RecordUserLogin(gomock.Any(), first).Return(nil),
+			d.consumer.EXPECT().CommitMessage(msg).Return(nil, nil),
+		)
+
+		assert.ErrorIs(t, d.record(), context.Canceled)
+	})
+
+	t.Run("Failed Record Is Retried Before The Next Message", func(t *testing.T) {
+		d := newLoginDeps(t)
+		msg1 := loginMessage(1, "login-1")
+		msg2 := loginMessage(2, "login-2")
+		d.feed(msg1, msg2)
+
+		d.repo.EXPECT().DeserializerUserLogin(loginTopic, msg1.Value).Return(first, nil)
+		d.repo.EXPECT().DeserializerUserLogin(loginTopic, msg2.Value).Return(second, nil)
+		gomock.InOrder(
+			d.repo.EXPECT().RecordUserLogin(gomock.Any(), first).Return(errDB).Times(2),
+			d.repo.EXPECT().RecordUserLogin(gomock.Any(), first).Return(nil),
+			d.consumer.EXPECT().CommitMessage(msg1).Return(nil, nil),
+			d.repo.EXPECT().RecordUserLogin(gomock.Any(), second).Return(nil),
+			d.consumer.EXPECT().CommitMessage(msg2).Return(nil, nil),
+		)
+
+		assert.ErrorIs(t, d.record(), context.Canceled)
+	})
+
+	t.Run("Shutdown During A Retry Leaves The Message Uncommitted", func(t *testing.T) {
+		d := newLoginDeps(t)
+		msg := loginMessage(1, "login-1")
+		d.consumer.EXPECT().ReadMessage(gomock.Any()).Return(msg, nil)
+
+		d.repo.EXPECT().DeserializerUserLogin(loginTopic, msg.Value).Return(first, nil)
+		d.repo.EXPECT().
+			RecordUserLogin(gomock.Any(), first).
+			DoAndReturn(func(context.Context, models.UserLogin) error {
+				d.cancel()
+				return errDB
+			})
+
+		assert.ErrorIs(t, d.record(), context.Canceled)
+	})
+
+	t.Run("Deserialization Error Skips The Message", func(t *testing.T) {
+		d := newLoginDeps(t)
+		msg1 := loginMessage(1, "malformed")
+		msg2 := loginMessage(2, "login-2")
+		d.feed(msg1, msg2)
+
+		d.repo.EXPECT().DeserializerUserLogin(loginTopic, msg1.Value).Return(models.UserLogin{}, errors.New("bad payload"))
+		d.repo.EXPECT().DeserializerUserLogin(loginTopic, msg2.Value).Return(second, nil)
+		d.repo.EXPECT().RecordUserLogin(gomock.Any(), second).Return(nil)
+		d.consumer.EXPECT().CommitMessage(msg2).Return(nil, nil)
+
+		assert.ErrorIs(t, d.record(), context.Canceled)
+	})
+
+	t.Run("Commit Error Keeps Consuming", func(t *testing.T) {
+		d := newLoginDeps(t)
+		msg1 := loginMessage(1, "login-1")
+		msg2 := loginMessage(2, "login-2")
+		d.feed(msg1, msg2)
+
+		d.repo.EXPECT().DeserializerUserLogin(loginTopic, msg1.Value).Return(first, nil)
+		d.repo.EXPECT().DeserializerUserLogin(loginTopic, msg2.Value).Return(second, nil)
+		d.repo.EXPECT().RecordUserLogin(gomock.Any(), first).Return(nil)
+		d.repo.EXPECT().RecordUserLogin(gomock.Any(), second).Return(nil)
+		gomock.InOrder(
+			d.consumer.EXPECT().CommitMessage(msg1).Return(nil, errors.New("rebalance in progress")),
+			d.consumer.EXPECT().CommitMessage(msg2).Return(nil, nil),
+		)
+
+		assert.ErrorIs(t, d.record(), context.Canceled)
+	})
+
+	t.Run("Read Error Stops The Loop", func(t *testing.T) {
+		d := newLoginDeps(t)
+		d.consumer.EXPECT().ReadMessage(gomock.Any()).Return(nil, errors.New("consumer closed"))
+
+		assert.EqualError(t, d.record(), "consumer closed")
+	})
+}
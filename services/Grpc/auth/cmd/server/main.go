@@ -3,27 +3,28 @@ package main
 import (
 	"context"
 	"crypto/rand"
-	"fmt"
+	"errors"
 	"log"
-	"net"
 	"os"
-	"os/signal"
-	"sync"
-	"syscall"
 
 	"github.com/joho/godotenv"
 	"github.com/sony-nurdianto/farm/auth/internal/encryption/codec"
 	"github.com/sony-nurdianto/farm/auth/internal/encryption/passencrypt"
 	"github.com/sony-nurdianto/farm/auth/internal/encryption/token"
+	"github.com/sony-nurdianto/farm/auth/internal/encryption/totp"
 	"github.com/sony-nurdianto/farm/auth/internal/interceptor"
+	"github.com/sony-nurdianto/farm/auth/internal/mailer"
 	"github.com/sony-nurdianto/farm/auth/internal/pbgen"
 	"github.com/sony-nurdianto/farm/auth/internal/repository"
 	"github.com/sony-nurdianto/farm/auth/internal/service"
 	"github.com/sony-nurdianto/farm/auth/internal/usecase"
 	"github.com/sony-nurdianto/farm/shared_lib/Go/database/postgres/pkg"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/database/redis"
 	"github.com/sony-nurdianto/farm/shared_lib/Go/kafkaev/avr"
 	"github.com/sony-nurdianto/farm/shared_lib/Go/kafkaev/kev"
 	"github.com/sony-nurdianto/farm/shared_lib/Go/kafkaev/schrgs"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/runtime"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/runtime/health"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/otel"
 	"google.golang.org/grpc"
@@ -35,7 +36,7 @@ import (
 func main() {
 	godotenv.Load()
 
-	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	ctx, stop := runtime.SignalContext()
 	defer stop()
 
 	serviceObsName := "auth-service"
@@ -58,22 +59,42 @@ func main() {
 		log.Fatalln(err)
 	}
 
-	defer tp.Shutdown(ctx)
-	defer mp.Shutdown(ctx)
-	defer lp.Shutdown(ctx)
-
 	repo, err := repository.NewAuthRepo(
 		ctx,
 		schrgs.NewRegistery(),
 		pkg.NewPostgresInstance(),
 		avr.NewAvrSerdeInstance(),
 		kev.NewKafka(),
+		redis.NewRedisInstance(),
 	)
 	if err != nil {
 		log.Fatalln(err)
 	}
 
-	defer repo.CloseRepo()
+	keyRing, err := token.LoadKeyRing()
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	mail, err := mailer.NewMailerFromEnv()
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	totpCipher, err := totp.LoadSecretCipher()
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	verifyURL := os.Getenv("EMAIL_VERIFY_URL")
+	if verifyURL == "" {
+		verifyURL = "http://localhost:3000/auth/verify"
+	}
+
+	resetURL := os.Getenv("PASSWORD_RESET_URL")
+	if resetURL == "" {
+		resetURL = "http://localhost:3000/auth/password/reset"
+	}
 
 	uc := usecase.NewServiceUsecase(
 		&repo,
@@ -83,7 +104,12 @@ func main() {
 		),
 		token.NewTokhan(
 			token.NewPassetoToken(),
+			keyRing,
 		),
+		mail,
+		totpCipher,
+		verifyURL,
+		resetURL,
 	)
 
 	gs := grpc.NewServer(
@@ -102,32 +128,32 @@ func main() {
 	svc := service.NewAuthServiceServer(uc)
 	pbgen.RegisterAuthServiceServer(gs, svc)
 
-	lis, err := net.Listen("tcp", ":50051")
-	if err != nil {
-		log.Fatalln(err)
-	}
+	checker := health.NewChecker([]health.Probe{
+		{Name: "postgres", Check: repo.PingDatabase},
+		{Name: "redis", Check: repo.PingCache},
+		{Name: "kafka", Check: repo.PingKafka},
+	})
+	checker.Register(gs)
+
+	runner := runtime.NewRunner()
+	runner.Register(
+		runtime.Hook{
+			Name: "observability",
+			Stop: func(ctx context.Context) error {
+				return errors.Join(tp.Shutdown(ctx), mp.Shutdown(ctx), lp.Shutdown(ctx))
+			},
+		},
+		runtime.Hook{
+			Name:      "auth-repo",
+			DependsOn: []string{"observability"},
+			Stop: func(context.Context) error {
+				repo.CloseRepo()
+				return nil
+			},
+		},
+		runtime.ServerHook("grpc-server", ":50051", gs, "auth-repo"),
+		checker.Hook("grpc-server"),
+	)
 
-	defer lis.Close()
-
-	go func(listener net.Listener) {
-		if err := gs.Serve(listener); err != nil {
-			log.Fatalln(err)
-		}
-	}(lis)
-
-	var once sync.Once
-
-	for {
-		select {
-		case <-ctx.Done():
-			fmt.Println("Server Stoping, Gracefully Stop ...")
-			gs.GracefulStop()
-			fmt.Println("Application Quit.")
-			return
-		default:
-			once.Do(func() {
-				fmt.Println("Server Running At 0.0.0.0:50051")
-			})
-		}
-	}
+	runner.Main(ctx)
 }
@@ -3,4 +3,7 @@ package constants
 const (
 	INSERT_ACCOUNT_TOPIC string = "insert-account"
 	INSERT_USER_TOPIC    string = "insert-user"
+	VERIFY_ACCOUNT_TOPIC string = "verify-account"
+	VERIFY_USER_TOPIC    string = "verify-user"
+	USER_LOGIN_TOPIC     string = "user-login"
 )
@@ -0,0 +1,11 @@
+package constants
+
+const (
+	QUERY_CREATE_LOGIN_ATTEMPT string = `
+		insert into %s
+			(id, account_id, email, ip_address, user_agent, success, reason)
+		values
+			($1,$2,$3,$4,$5,$6,$7)
+		returning id
+	`
+)
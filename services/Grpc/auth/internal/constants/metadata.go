@@ -0,0 +1,5 @@
+package constants
+
+// CLIENT_IP_METADATA carries the end user's IP from the gateway, the peer
+// address of the gRPC call is the gateway itself.
+const CLIENT_IP_METADATA string = "x-client-ip"
@@ -0,0 +1,39 @@
+package constants
+
+// %[1]s password_resets, %[2]s accounts, %[3]s sessions
+const (
+	QUERY_UPDATE_PASSWORD_HASH string = `
+		update %[2]s
+		set password_hash = $1, updated_at = now()
+		where id = $2
+		returning id
+	`
+
+	QUERY_CREATE_PASSWORD_RESET string = `
+		insert into %[1]s
+			(token_hash, account_id, expires_at)
+		values
+			($1,$2,$3)
+		returning token_hash
+	`
+
+	QUERY_GET_PASSWORD_RESET string = `
+		select account_id, expires_at, used_at from %[1]s
+		where token_hash = $1
+		for update
+	`
+
+	QUERY_USE_PASSWORD_RESET string = `
+		update %[1]s
+		set used_at = $1
+		where token_hash = $2 and used_at is null
+		returning token_hash
+	`
+
+	QUERY_REVOKE_ACCOUNT_SESSIONS string = `
+		update %[3]s
+		set revoked_at = $1
+		where account_id = $2 and id::text <> $3 and revoked_at is null
+		returning id
+	`
+)
@@ -0,0 +1,25 @@
+package constants
+
+const (
+	// nothing is returned when the email already has a pending registration
+	QUERY_CREATE_REGISTRATION string = `
+		insert into %s
+			(user_id, email)
+		values
+			($1,$2)
+		on conflict (email) where status = 'pending' do nothing
+		returning user_id
+	`
+
+	QUERY_GET_REGISTRATION string = `
+		select user_id, status, coalesce(failure_reason, ''), created_at, updated_at from %s
+		where user_id = $1
+	`
+
+	QUERY_FAIL_REGISTRATION string = `
+		update %s
+		set status = 'failed', failure_reason = $1, updated_at = now()
+		where user_id = $2 and status = 'pending'
+		returning user_id
+	`
+)
@@ -0,0 +1,62 @@
+package constants
+
+// Session queries are formatted with SESSION_TABLE, REFRESH_TOKEN_TABLE then
+// ACCOUNT_TABLE.
+const (
+	QUERY_CREATE_SESSION string = `
+		insert into %[1]s
+			(id, account_id, user_agent, ip_address, expires_at)
+		values
+			($1,$2,$3,$4,$5)
+		returning id, account_id, user_agent, ip_address, created_at, last_used_at, expires_at
+	`
+
+	QUERY_CREATE_REFRESH_TOKEN string = `
+		insert into %[2]s
+			(token_hash, session_id)
+		values
+			($1,$2)
+		returning token_hash
+	`
+
+	QUERY_GET_REFRESH_TOKEN_SESSION string = `
+		select
+			r.used_at,
+			s.id, s.account_id, s.user_agent, s.ip_address,
+			s.created_at, s.last_used_at, s.expires_at, s.revoked_at,
+			coalesce(a.verified, false)
+		from %[2]s r
+		join %[1]s s on s.id = r.session_id
+		left join %[3]s a on a.id = s.account_id
+		where r.token_hash = $1
+		for update of r, s
+	`
+
+	QUERY_USE_REFRESH_TOKEN string = `
+		update %[2]s
+		set used_at = $1
+		where token_hash = $2 and used_at is null
+		returning token_hash
+	`
+
+	QUERY_TOUCH_SESSION string = `
+		update %[1]s
+		set last_used_at = $1, expires_at = $2
+		where id = $3 and revoked_at is null
+		returning last_used_at, expires_at
+	`
+
+	QUERY_REVOKE_SESSION string = `
+		update %[1]s
+		set revoked_at = $1
+		where id = $2 and account_id = $3 and revoked_at is null
+		returning id, account_id, user_agent, ip_address, created_at, last_used_at, expires_at, revoked_at
+	`
+
+	QUERY_LIST_SESSIONS string = `
+		select id, account_id, user_agent, ip_address, created_at, last_used_at, expires_at, revoked_at
+		from %[1]s
+		where account_id = $1 and revoked_at is null and expires_at > $2
+		order by last_used_at desc
+	`
+)
@@ -1,5 +1,12 @@
 package constants
 
 const (
-	ACCOUNT_TABLE string = "accounts"
+	ACCOUNT_TABLE            string = "accounts"
+	SESSION_TABLE            string = "sessions"
+	REFRESH_TOKEN_TABLE      string = "refresh_tokens"
+	EMAIL_VERIFICATION_TABLE string = "email_verifications"
+	PASSWORD_RESET_TABLE     string = "password_resets"
+	LOGIN_ATTEMPT_TABLE      string = "login_attempts"
+	RECOVERY_CODE_TABLE      string = "totp_recovery_codes"
+	REGISTRATION_TABLE       string = "registrations"
 )
@@ -0,0 +1,52 @@
+package constants
+
+// %[1]s accounts, %[2]s totp_recovery_codes
+const (
+	// pending enrollments are replaced, an enabled one has to be disabled first
+	QUERY_SET_TOTP_SECRET string = `
+		update %[1]s
+		set totp_secret = $1, totp_enabled = false, totp_confirmed_at = null, updated_at = now()
+		where id = $2 and totp_enabled = false
+		returning id
+	`
+
+	QUERY_GET_TOTP string = `
+		select totp_secret, totp_enabled from %[1]s
+		where id = $1
+	`
+
+	QUERY_ENABLE_TOTP string = `
+		update %[1]s
+		set totp_enabled = true, totp_confirmed_at = $1, updated_at = now()
+		where id = $2 and totp_secret is not null
+		returning id
+	`
+
+	QUERY_DISABLE_TOTP string = `
+		update %[1]s
+		set totp_secret = null, totp_enabled = false, totp_confirmed_at = null, updated_at = now()
+		where id = $1
+		returning id
+	`
+
+	QUERY_DELETE_RECOVERY_CODES string = `
+		delete from %[2]s
+		where account_id = $1
+		returning code_hash
+	`
+
+	QUERY_CREATE_RECOVERY_CODE string = `
+		insert into %[2]s
+			(code_hash, account_id)
+		values
+			($1,$2)
+		returning code_hash
+	`
+
+	QUERY_USE_RECOVERY_CODE string = `
+		update %[2]s
+		set used_at = $1
+		where account_id = $2 and code_hash = $3 and used_at is null
+		returning code_hash
+	`
+)
@@ -9,8 +9,19 @@ const (
 		returning id, email, password_hash, created_at, updated_at 
 	`
 
+	// accounts of a signup that is still pending or has failed are not
+	// visible until the registration saga completes them
 	QUERY_GET_USER_BY_EMAIL string = `
-		select * from %s
-		where email = $1
+		select a.id, a.email, a.password_hash, a.created_at, a.updated_at, a.verified, a.totp_enabled from %s a
+		where a.email = $1
+			and not exists (
+				select 1 from registrations r
+				where r.user_id = a.id and r.status <> 'complete'
+			)
+	`
+
+	QUERY_GET_USER_BY_ID string = `
+		select id, email, password_hash, created_at, updated_at, verified, totp_enabled from %s
+		where id = $1
 	`
 )
@@ -0,0 +1,24 @@
+package constants
+
+const (
+	QUERY_CREATE_EMAIL_VERIFICATION string = `
+		insert into %s
+			(token_hash, account_id, email, expires_at)
+		values
+			($1,$2,$3,$4)
+		returning token_hash
+	`
+
+	QUERY_GET_EMAIL_VERIFICATION string = `
+		select account_id, email, expires_at, used_at from %s
+		where token_hash = $1
+		for update
+	`
+
+	QUERY_USE_EMAIL_VERIFICATION string = `
+		update %s
+		set used_at = $1
+		where token_hash = $2 and used_at is null
+		returning token_hash
+	`
+)
@@ -1,54 +1,105 @@
 package passencrypt
 
 import (
+	"crypto/subtle"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/sony-nurdianto/farm/auth/internal/encryption/codec"
 	"golang.org/x/crypto/argon2"
 )
 
 //go:generate mockgen -source=password_hash.go -destination=../../../test/mocks/mock_passencrypt.go -package=mocks
+
+// legacy hashes are base64(hash)+base64(salt) with these fixed parameters
 const (
 	time    = 1
 	memory  = 64 * 1024
 	threads = 4
 	keyLen  = 32
+
+	legacyHashLen = 44
+	phcPrefix     = "$argon2id$"
+)
+
+var (
+	ErrorGenSaltReadRand = errors.New("failed to read rand")
+	ErrorInvalidHash     = errors.New("password hash has invalid format")
+	ErrorIncompatible    = errors.New("password hash has incompatible argon2 version")
 )
 
-var ErrorGenSaltReadRand = errors.New("failed to read rand")
+type Params struct {
+	Time    uint32
+	Memory  uint32
+	Threads uint8
+	KeyLen  uint32
+	SaltLen uint32
+}
+
+var DefaultParams = Params{
+	Time:    time,
+	Memory:  memory,
+	Threads: threads,
+	KeyLen:  keyLen,
+	SaltLen: 32,
+}
 
 type PassEncrypt interface {
 	HashPassword(password string) (passwordHash string, _ error)
 	VerifyPassword(password, passwordHash string) (verify bool, _ error)
+	// NeedsRehash reports whether passwordHash was produced by the legacy
+	// format or with parameters other than the current ones.
+	NeedsRehash(passwordHash string) bool
 }
 
 type passEncrypt struct {
 	randRead       io.Reader
 	base64Encoding codec.Base64Encoder
+	params         Params
 }
 
 func NewPassEncrypt(randRead io.Reader, base64Encoding codec.Base64Encoder) passEncrypt {
-	return passEncrypt{randRead, base64Encoding}
+	return passEncrypt{randRead, base64Encoding, DefaultParams}
+}
+
+func (pe passEncrypt) WithParams(params Params) passEncrypt {
+	pe.params = params
+	return pe
 }
 
+// HashPassword returns the hash in PHC string format:
+// $argon2id$v=19$m=<memory>,t=<time>,p=<threads>$<salt>$<hash>
 func (pe passEncrypt) HashPassword(
 	password string,
 ) (passwordHash string, _ error) {
-	salt := make([]byte, 32)
+	salt := make([]byte, pe.params.SaltLen)
 
 	_, err := pe.randRead.Read(salt)
 	if err != nil {
 		return passwordHash, err
 	}
 
-	hash := argon2.IDKey([]byte(password), salt, time, memory, threads, keyLen)
-
-	saltStr := pe.base64Encoding.EncodeToString(salt)
-	hashStr := pe.base64Encoding.EncodeToString(hash)
-
-	passwordHash = fmt.Sprintf("%s%s", hashStr, saltStr)
+	hash := argon2.IDKey(
+		[]byte(password),
+		salt,
+		pe.params.Time,
+		pe.params.Memory,
+		pe.params.Threads,
+		pe.params.KeyLen,
+	)
+
+	passwordHash = fmt.Sprintf(
+		"$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version,
+		pe.params.Memory,
+		pe.params.Time,
+		pe.params.Threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(hash),
+	)
 
 	return passwordHash, nil
 }
@@ -56,18 +107,90 @@ func (pe passEncrypt) HashPassword(
 func (pe passEncrypt) VerifyPassword(
 	password, passwordHash string,
 ) (verify bool, _ error) {
-	salt, err := pe.base64Encoding.DecodeString(passwordHash[44:])
+	if !strings.HasPrefix(passwordHash, phcPrefix) {
+		return pe.verifyLegacy(password, passwordHash)
+	}
+
+	params, salt, expectedHash, err := decodePHC(passwordHash)
 	if err != nil {
 		return verify, err
 	}
 
-	expectedHash, err := pe.base64Encoding.DecodeString(passwordHash[:44])
+	newHash := argon2.IDKey([]byte(password), salt, params.Time, params.Memory, params.Threads, params.KeyLen)
+	verify = subtle.ConstantTimeCompare(newHash, expectedHash) == 1
+
+	return verify, nil
+}
+
+func (pe passEncrypt) NeedsRehash(passwordHash string) bool {
+	if !strings.HasPrefix(passwordHash, phcPrefix) {
+		return true
+	}
+
+	params, salt, _, err := decodePHC(passwordHash)
+	if err != nil {
+		return true
+	}
+
+	params.SaltLen = uint32(len(salt))
+	return params != pe.params
+}
+
+func (pe passEncrypt) verifyLegacy(
+	password, passwordHash string,
+) (verify bool, _ error) {
+	if len(passwordHash) <= legacyHashLen {
+		return verify, ErrorInvalidHash
+	}
+
+	salt, err := pe.base64Encoding.DecodeString(passwordHash[legacyHashLen:])
+	if err != nil {
+		return verify, err
+	}
+
+	expectedHash, err := pe.base64Encoding.DecodeString(passwordHash[:legacyHashLen])
 	if err != nil {
 		return verify, err
 	}
 
 	newHash := argon2.IDKey([]byte(password), salt, time, memory, threads, keyLen)
-	verify = string(newHash) == string(expectedHash)
+	verify = subtle.ConstantTimeCompare(newHash, expectedHash) == 1
 
 	return verify, nil
 }
+
+func decodePHC(passwordHash string) (params Params, salt, hash []byte, _ error) {
+	// "", "argon2id", "v=19", "m=..,t=..,p=..", salt, hash
+	parts := strings.Split(passwordHash, "$")
+	if len(parts) != 6 {
+		return params, nil, nil, ErrorInvalidHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return params, nil, nil, ErrorInvalidHash
+	}
+
+	if version != argon2.Version {
+		return params, nil, nil, ErrorIncompatible
+	}
+
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Time, &params.Threads); err != nil {
+		return params, nil, nil, ErrorInvalidHash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return params, nil, nil, ErrorInvalidHash
+	}
+
+	// an empty hash has KeyLen 0, every password would derive the same
+	// empty key and match it
+	hash, err = base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(hash) == 0 {
+		return params, nil, nil, ErrorInvalidHash
+	}
+
+	params.KeyLen = uint32(len(hash))
+	return params, salt, hash, nil
+}
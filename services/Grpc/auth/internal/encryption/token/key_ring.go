@@ -0,0 +1,145 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+const KeyVersion = "v2.public"
+
+var (
+	ErrActiveKeyNotSet  error = errors.New("active signing key is not set")
+	ErrKeyIDNotFound    error = errors.New("token key id is not found")
+	ErrMalformedKeyPair error = errors.New("malformed key pair expected kid:base64")
+)
+
+type VerificationKey struct {
+	ID        string
+	PublicKey ed25519.PublicKey
+	Active    bool
+}
+
+type KeyRing struct {
+	activeID string
+	signing  map[string]ed25519.PrivateKey
+	verify   map[string]ed25519.PublicKey
+}
+
+// NewKeyRing signs with the activeID key and accepts tokens signed by any
+// signing key or any verify-only key, so old keys stay valid while rotating.
+func NewKeyRing(
+	activeID string,
+	signing map[string]ed25519.PrivateKey,
+	verify map[string]ed25519.PublicKey,
+) (KeyRing, error) {
+	kr := KeyRing{
+		activeID: activeID,
+		signing:  make(map[string]ed25519.PrivateKey, len(signing)),
+		verify:   make(map[string]ed25519.PublicKey, len(signing)+len(verify)),
+	}
+
+	for kid, pub := range verify {
+		kr.verify[kid] = pub
+	}
+
+	for kid, priv := range signing {
+		kr.signing[kid] = priv
+		kr.verify[kid] = priv.Public().(ed25519.PublicKey)
+	}
+
+	if _, ok := kr.signing[activeID]; !ok {
+		return kr, ErrActiveKeyNotSet
+	}
+
+	return kr, nil
+}
+
+func parseKeyPairs(env string, size int) (map[string][]byte, error) {
+	out := make(map[string][]byte)
+
+	for pair := range strings.SplitSeq(os.Getenv(env), ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		kid, encoded, ok := strings.Cut(pair, ":")
+		if !ok || kid == "" {
+			return nil, fmt.Errorf("%s: %w", env, ErrMalformedKeyPair)
+		}
+
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("%s: kid %s: %w", env, kid, err)
+		}
+
+		if len(key) != size {
+			return nil, fmt.Errorf("%s: kid %s: expected %d bytes key but got %d", env, kid, size, len(key))
+		}
+
+		out[kid] = key
+	}
+
+	return out, nil
+}
+
+// LoadKeyRing reads TOK_ACTIVE_KID, TOK_SIGNING_KEYS (kid:base64 ed25519 seed)
+// and TOK_VERIFY_KEYS (kid:base64 ed25519 public key, for retired keys).
+func LoadKeyRing() (KeyRing, error) {
+	seeds, err := parseKeyPairs("TOK_SIGNING_KEYS", ed25519.SeedSize)
+	if err != nil {
+		return KeyRing{}, err
+	}
+
+	pubs, err := parseKeyPairs("TOK_VERIFY_KEYS", ed25519.PublicKeySize)
+	if err != nil {
+		return KeyRing{}, err
+	}
+
+	signing := make(map[string]ed25519.PrivateKey, len(seeds))
+	for kid, seed := range seeds {
+		signing[kid] = ed25519.NewKeyFromSeed(seed)
+	}
+
+	verify := make(map[string]ed25519.PublicKey, len(pubs))
+	for kid, pub := range pubs {
+		verify[kid] = ed25519.PublicKey(pub)
+	}
+
+	return NewKeyRing(os.Getenv("TOK_ACTIVE_KID"), signing, verify)
+}
+
+func (kr KeyRing) ActiveKey() (string, ed25519.PrivateKey) {
+	return kr.activeID, kr.signing[kr.activeID]
+}
+
+func (kr KeyRing) PublicKey(kid string) (ed25519.PublicKey, error) {
+	pub, ok := kr.verify[kid]
+	if !ok {
+		return nil, ErrKeyIDNotFound
+	}
+
+	return pub, nil
+}
+
+func (kr KeyRing) VerificationKeys() []VerificationKey {
+	keys := make([]VerificationKey, 0, len(kr.verify))
+	for kid, pub := range kr.verify {
+		keys = append(keys, VerificationKey{
+			ID:        kid,
+			PublicKey: pub,
+			Active:    kid == kr.activeID,
+		})
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].ID < keys[j].ID
+	})
+
+	return keys
+}
@@ -0,0 +1,37 @@
+package token
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+)
+
+const (
+	// AccessTokenTTL is kept short because the gateway verifies access tokens
+	// locally, a revoked session is only rejected there once its token expires.
+	AccessTokenTTL       = 15 * time.Minute
+	RefreshTokenTTL      = 30 * 24 * time.Hour
+	EmailVerificationTTL = 24 * time.Hour
+	PasswordResetTTL     = time.Hour
+	LoginChallengeTTL    = 5 * time.Minute
+)
+
+// GenerateOpaqueToken returns a random single-use token, used for refresh,
+// email verification, password reset and login challenge tokens, and the
+// hash that is stored in place of it.
+func GenerateOpaqueToken() (token string, hash string, _ error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+
+	token = base64.RawURLEncoding.EncodeToString(buf)
+	return token, HashOpaqueToken(token), nil
+}
+
+func HashOpaqueToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -1,13 +1,15 @@
 package token
 
 import (
+	"crypto/ed25519"
+
 	"github.com/o1egl/paseto"
 )
 
 //go:generate mockgen -source=passeto_def.go -destination=../../../test/mocks/mock_passeto_def.go -package=mocks
 type PassetoToken interface {
-	Encrypt(secretKey []byte, payload any, footer any) (string, error)
-	Decrypt(token string, key []byte, payload any, footer any) error
+	Sign(privateKey ed25519.PrivateKey, payload any, footer any) (string, error)
+	Verify(token string, publicKey ed25519.PublicKey, payload any, footer any) error
 }
 
 type passetoToken struct {
@@ -18,10 +20,10 @@ func NewPassetoToken() PassetoToken {
 	return passetoToken{passetoV2: paseto.NewV2()}
 }
 
-func (pt passetoToken) Encrypt(secretKey []byte, payload any, footer any) (string, error) {
-	return pt.passetoV2.Encrypt(secretKey, payload, footer)
+func (pt passetoToken) Sign(privateKey ed25519.PrivateKey, payload any, footer any) (string, error) {
+	return pt.passetoV2.Sign(privateKey, payload, footer)
 }
 
-func (pt passetoToken) Decrypt(token string, key []byte, payload any, footer any) error {
-	return pt.passetoV2.Decrypt(token, key, payload, footer)
+func (pt passetoToken) Verify(token string, publicKey ed25519.PublicKey, payload any, footer any) error {
+	return pt.passetoV2.Verify(token, publicKey, payload, footer)
 }
@@ -2,50 +2,62 @@ package token
 
 import (
 	"errors"
-	"os"
+	"strconv"
 	"time"
 
-	"github.com/aead/chacha20poly1305"
 	"github.com/o1egl/paseto"
 )
 
 var (
-	ErrSecretNotSet  error = errors.New("SECRET is not set")
 	ErrDecryptFailed error = errors.New("invalid token failed to decrypt")
 	ErrTokenExperied error = errors.New("invalid token token is experied")
 )
 
 //go:generate mockgen -source=tokhan.go -destination=../../../test/mocks/mock_tokhan.go -package=mocks
 type Tokhan interface {
-	CreateWebToken(subject string) (string, error)
+	CreateWebToken(claims AccessClaims) (string, error)
 	VerifyWebToken(token string) (paseto.JSONToken, error)
+	VerificationKeys() []VerificationKey
 }
 
-type tokhan struct {
-	handler PassetoToken
+// VerifiedClaim is set to "true" once the account email is verified, so the
+// gateway can restrict routes without asking the auth service.
+const VerifiedClaim = "verified"
+
+type AccessClaims struct {
+	Subject   string
+	SessionID string
+	Verified  bool
 }
 
-func NewTokhan(handler PassetoToken) Tokhan {
-	return tokhan{handler}
+type tokenFooter struct {
+	KeyID string `json:"kid"`
 }
 
-func secret() []byte {
-	secretKey := make([]byte, chacha20poly1305.KeySize)
-	secret := os.Getenv("TOKSECRET")
+type tokhan struct {
+	handler PassetoToken
+	keys    KeyRing
+}
 
-	copy(secretKey, secret)
-	return secretKey
+func NewTokhan(handler PassetoToken, keys KeyRing) Tokhan {
+	return tokhan{handler, keys}
 }
 
-func (tg tokhan) CreateWebToken(subject string) (string, error) {
+// CreateWebToken issues an access token bound to the session through the jti
+// claim, so revoking the session also rejects the token.
+func (tg tokhan) CreateWebToken(claims AccessClaims) (string, error) {
 	jsonToken := paseto.JSONToken{
 		IssuedAt:   time.Now(),
-		Expiration: time.Now().Add(1 * time.Hour),
-		Subject:    subject,
+		Expiration: time.Now().Add(AccessTokenTTL),
+		Subject:    claims.Subject,
 		Issuer:     "auth",
+		Jti:        claims.SessionID,
 	}
+	jsonToken.Set(VerifiedClaim, strconv.FormatBool(claims.Verified))
 
-	token, err := tg.handler.Encrypt(secret(), jsonToken, nil)
+	kid, key := tg.keys.ActiveKey()
+
+	token, err := tg.handler.Sign(key, jsonToken, tokenFooter{KeyID: kid})
 	if err != nil {
 		return "", err
 	}
@@ -55,8 +67,18 @@ func (tg tokhan) CreateWebToken(subject string) (string, error) {
 
 func (tg tokhan) VerifyWebToken(token string) (paseto.JSONToken, error) {
 	var newJsonToken paseto.JSONToken
+	var footer tokenFooter
+
+	if err := paseto.ParseFooter(token, &footer); err != nil {
+		return newJsonToken, ErrDecryptFailed
+	}
+
+	pub, err := tg.keys.PublicKey(footer.KeyID)
+	if err != nil {
+		return newJsonToken, ErrDecryptFailed
+	}
 
-	if err := tg.handler.Decrypt(token, secret(), &newJsonToken, nil); err != nil {
+	if err := tg.handler.Verify(token, pub, &newJsonToken, nil); err != nil {
 		return newJsonToken, ErrDecryptFailed
 	}
 
@@ -66,3 +88,7 @@ func (tg tokhan) VerifyWebToken(token string) (paseto.JSONToken, error) {
 
 	return newJsonToken, nil
 }
+
+func (tg tokhan) VerificationKeys() []VerificationKey {
+	return tg.keys.VerificationKeys()
+}
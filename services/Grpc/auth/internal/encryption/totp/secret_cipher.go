@@ -0,0 +1,96 @@
+package totp
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+)
+
+//go:generate mockgen -source=secret_cipher.go -destination=../../../test/mocks/mock_secret_cipher.go -package=mocks
+
+const encryptionKeyEnv = "TOTP_ENCRYPTION_KEY"
+
+var (
+	ErrEncryptionKeyNotSet = errors.New("totp encryption key is not set")
+	ErrEncryptionKeySize   = errors.New("totp encryption key must be 32 bytes")
+	ErrMalformedCiphertext = errors.New("totp secret ciphertext is malformed")
+)
+
+// SecretCipher seals TOTP secrets before they are stored in the accounts
+// table.
+type SecretCipher interface {
+	Seal(plaintext string) (string, error)
+	Open(ciphertext string) (string, error)
+}
+
+type secretCipher struct {
+	aead cipher.AEAD
+}
+
+// NewSecretCipher uses AES-256-GCM with the given 32 byte key.
+func NewSecretCipher(key []byte) (SecretCipher, error) {
+	if len(key) != 32 {
+		return nil, ErrEncryptionKeySize
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return secretCipher{aead}, nil
+}
+
+// LoadSecretCipher reads the base64 key from TOTP_ENCRYPTION_KEY.
+func LoadSecretCipher() (SecretCipher, error) {
+	encoded := os.Getenv(encryptionKeyEnv)
+	if encoded == "" {
+		return nil, ErrEncryptionKeyNotSet
+	}
+
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", encryptionKeyEnv, err)
+	}
+
+	return NewSecretCipher(key)
+}
+
+// Seal returns base64(nonce || ciphertext).
+func (sc secretCipher) Seal(plaintext string) (string, error) {
+	nonce := make([]byte, sc.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	sealed := sc.aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func (sc secretCipher) Open(ciphertext string) (string, error) {
+	sealed, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return "", ErrMalformedCiphertext
+	}
+
+	if len(sealed) < sc.aead.NonceSize() {
+		return "", ErrMalformedCiphertext
+	}
+
+	nonce, data := sealed[:sc.aead.NonceSize()], sealed[sc.aead.NonceSize():]
+	plaintext, err := sc.aead.Open(nil, nonce, data, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return string(plaintext), nil
+}
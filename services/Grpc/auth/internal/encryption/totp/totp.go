@@ -0,0 +1,133 @@
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// RFC 6238 defaults, which is what authenticator apps assume when the
+// otpauth URI does not say otherwise.
+const (
+	Digits    = 6
+	Period    = 30 * time.Second
+	secretLen = 20
+
+	// digitsModulo is 10^Digits
+	digitsModulo = 1_000_000
+
+	// Skew is the number of periods accepted before and after the current
+	// one, to allow for clock drift on the phone.
+	Skew = 1
+
+	RecoveryCodeCount = 10
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret returns a random base32 secret as shown to the user.
+func GenerateSecret() (string, error) {
+	buf := make([]byte, secretLen)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return encoding.EncodeToString(buf), nil
+}
+
+// KeyURI builds the otpauth URI that authenticator apps read from a QR code.
+func KeyURI(issuer, account, secret string) string {
+	label := url.PathEscape(issuer + ":" + account)
+
+	q := url.Values{}
+	q.Set("secret", secret)
+	q.Set("issuer", issuer)
+	q.Set("algorithm", "SHA1")
+	q.Set("digits", fmt.Sprint(Digits))
+	q.Set("period", fmt.Sprint(int(Period.Seconds())))
+
+	return "otpauth://totp/" + label + "?" + q.Encode()
+}
+
+// Step is the time step t falls in, used to reject a code that was already
+// used in the same step.
+func Step(t time.Time) int64 {
+	return t.Unix() / int64(Period.Seconds())
+}
+
+func Code(secret string, step int64) (string, error) {
+	key, err := encoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return "", err
+	}
+
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", Digits, value%digitsModulo), nil
+}
+
+// Validate checks code against the steps around t and returns the step it
+// matched.
+func Validate(secret, code string, t time.Time) (step int64, ok bool, _ error) {
+	if len(code) != Digits {
+		return 0, false, nil
+	}
+
+	current := Step(t)
+	for i := -Skew; i <= Skew; i++ {
+		expected, err := Code(secret, current+int64(i))
+		if err != nil {
+			return 0, false, err
+		}
+
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return current + int64(i), true, nil
+		}
+	}
+
+	return 0, false, nil
+}
+
+// GenerateRecoveryCodes returns n codes formatted as xxxxx-xxxxx.
+func GenerateRecoveryCodes(n int) ([]string, error) {
+	codes := make([]string, 0, n)
+	buf := make([]byte, 7)
+
+	for range n {
+		if _, err := rand.Read(buf); err != nil {
+			return nil, err
+		}
+
+		raw := strings.ToLower(encoding.EncodeToString(buf))[:10]
+		codes = append(codes, raw[:5]+"-"+raw[5:])
+	}
+
+	return codes, nil
+}
+
+// NormalizeRecoveryCode makes the code the user typed comparable with the
+// generated one, whatever the case and separators.
+func NormalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	code = strings.NewReplacer("-", "", " ", "").Replace(code)
+	if len(code) != 10 {
+		return code
+	}
+
+	return code[:5] + "-" + code[5:]
+}
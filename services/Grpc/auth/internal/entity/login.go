@@ -0,0 +1,20 @@
+package entity
+
+import "time"
+
+type LoginAttempt struct {
+	Id        string
+	AccountId string
+	Email     string
+	IpAddress string
+	UserAgent string
+	Success   bool
+	Reason    string
+	CreatedAt time.Time
+}
+
+// LoginWindow is the state of a sliding window of failed sign-ins.
+type LoginWindow struct {
+	Count  int64
+	Oldest time.Time
+}
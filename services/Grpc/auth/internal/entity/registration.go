@@ -0,0 +1,17 @@
+package entity
+
+import "time"
+
+const (
+	RegistrationPending  string = "pending"
+	RegistrationComplete string = "complete"
+	RegistrationFailed   string = "failed"
+)
+
+type Registration struct {
+	UserId        string
+	Status        string
+	FailureReason string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
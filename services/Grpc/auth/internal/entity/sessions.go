@@ -0,0 +1,16 @@
+package entity
+
+import "time"
+
+type Session struct {
+	Id         string
+	AccountId  string
+	UserAgent  string
+	IpAddress  string
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+	ExpiresAt  time.Time
+	RevokedAt  *time.Time
+
+	AccountVerified bool
+}
@@ -3,9 +3,17 @@ package entity
 import "time"
 
 type Users struct {
-	Id        string
-	Email     string
-	Password  string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	Id          string
+	Email       string
+	Password    string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Verified    bool
+	TOTPEnabled bool
+}
+
+type TOTP struct {
+	// Secret is the sealed secret as stored, empty when not enrolled
+	Secret  string
+	Enabled bool
 }
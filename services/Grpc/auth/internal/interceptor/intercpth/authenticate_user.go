@@ -39,20 +39,22 @@ func InterceptAuthenticateUser(ctx context.Context, sp trace.Span, lg *logs.Logg
 	}
 
 	if len(dataRequest.GetEmail()) == 0 {
-		return recorder.Record(
+		return recorder.RecordWithDetails(
 			ctx,
 			code,
 			fullMethodName,
 			"[AuthService] Invalid request type for AuthenticateUser - Email is empty - does not requirements - Email must not be empty",
+			recorderr.FieldViolation("email", "must not be empty"),
 		)
 	}
 
 	if len(dataRequest.GetPassword()) == 0 {
-		return recorder.Record(
+		return recorder.RecordWithDetails(
 			ctx,
 			code,
 			fullMethodName,
 			"[AuthService] Invalid request type for AuthenticateUser - Password is empty - does not requirements - Password must not be empty",
+			recorderr.FieldViolation("password", "must not be empty"),
 		)
 	}
 
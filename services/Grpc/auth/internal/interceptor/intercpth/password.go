@@ -0,0 +1,212 @@
+package intercpth
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/sony-nurdianto/farm/auth/internal/pbgen"
+	"github.com/sony-nurdianto/farm/auth/internal/recorderr"
+	"github.com/sony-nurdianto/farm/auth/internal/validator"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/observability/otel/logs"
+	"go.opentelemetry.io/otel/attribute"
+	otelCodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+)
+
+const passwordComplexityMsg = "does not meet complexity requirements - Password must be at least 8 characters, include 1 uppercase letter, 1 number, and 1 special character"
+
+func InterceptRequestPasswordReset(ctx context.Context, sp trace.Span, lg *logs.Logger, req any) error {
+	fullMethodName := pbgen.AuthService_RequestPasswordReset_FullMethodName
+	code := codes.InvalidArgument
+	recorder := recorderr.NewErrorRecorder(sp, lg)
+	if req == nil {
+		return recorder.Record(
+			ctx,
+			code,
+			fullMethodName,
+			"[AuthService] Nil request payload for RequestPasswordReset - Expected Request is not nil",
+		)
+	}
+
+	dataRequest, ok := req.(*pbgen.RequestPasswordResetRequest)
+	if !ok {
+		return recorder.Record(
+			ctx,
+			code,
+			fullMethodName,
+			fmt.Sprintf("[AuthService] Invalid request type for RequestPasswordReset - got: %T - Expected Request have type RequestPasswordResetRequest Proto", req),
+		)
+	}
+
+	if !validator.ValidateEmail(dataRequest.GetEmail()) {
+		return recorder.RecordWithDetails(
+			ctx,
+			code,
+			fullMethodName,
+			fmt.Sprintf("[AuthService] Invalid request type for RequestPasswordReset - Email Invalid - %s", dataRequest.GetEmail()),
+			recorderr.FieldViolation("email", "must be a valid email address"),
+		)
+	}
+
+	lg.Info(
+		ctx,
+		"[AuthService] RequestPasswordReset request",
+		slog.String("full_method", fullMethodName),
+		slog.Time("timestamp", time.Now()),
+		slog.String("function", "InterceptRequestPasswordReset"),
+	)
+
+	sp.AddEvent("validation_completed",
+		trace.WithAttributes(
+			attribute.String("validation_status", "success"),
+		),
+	)
+	sp.SetStatus(otelCodes.Ok, "Request validation successful")
+
+	return nil
+}
+
+func InterceptResetPassword(ctx context.Context, sp trace.Span, lg *logs.Logger, req any) error {
+	fullMethodName := pbgen.AuthService_ResetPassword_FullMethodName
+	code := codes.InvalidArgument
+	recorder := recorderr.NewErrorRecorder(sp, lg)
+	if req == nil {
+		return recorder.Record(
+			ctx,
+			code,
+			fullMethodName,
+			"[AuthService] Nil request payload for ResetPassword - Expected Request is not nil",
+		)
+	}
+
+	dataRequest, ok := req.(*pbgen.ResetPasswordRequest)
+	if !ok {
+		return recorder.Record(
+			ctx,
+			code,
+			fullMethodName,
+			fmt.Sprintf("[AuthService] Invalid request type for ResetPassword - got: %T - Expected Request have type ResetPasswordRequest Proto", req),
+		)
+	}
+
+	if len(dataRequest.GetToken()) == 0 {
+		return recorder.RecordWithDetails(
+			ctx,
+			code,
+			fullMethodName,
+			"[AuthService] Invalid request type for ResetPassword - Token is empty - does not meet requirements",
+			recorderr.FieldViolation("token", "must not be empty"),
+		)
+	}
+
+	if !validator.ValidatePassword(dataRequest.GetNewPassword()) {
+		return recorder.RecordWithDetails(
+			ctx,
+			code,
+			fullMethodName,
+			"[AuthService] Invalid request type for ResetPassword - New Password Invalid - "+passwordComplexityMsg,
+			recorderr.FieldViolation("new_password", "must be at least 8 characters, include 1 uppercase letter, 1 number, and 1 special character"),
+		)
+	}
+
+	lg.Info(
+		ctx,
+		"[AuthService] ResetPassword request",
+		slog.String("full_method", fullMethodName),
+		slog.Time("timestamp", time.Now()),
+		slog.String("function", "InterceptResetPassword"),
+	)
+
+	sp.AddEvent("validation_completed",
+		trace.WithAttributes(
+			attribute.String("validation_status", "success"),
+		),
+	)
+	sp.SetStatus(otelCodes.Ok, "Request validation successful")
+
+	return nil
+}
+
+func InterceptChangePassword(ctx context.Context, sp trace.Span, lg *logs.Logger, req any) error {
+	fullMethodName := pbgen.AuthService_ChangePassword_FullMethodName
+	code := codes.InvalidArgument
+	recorder := recorderr.NewErrorRecorder(sp, lg)
+	if req == nil {
+		return recorder.Record(
+			ctx,
+			code,
+			fullMethodName,
+			"[AuthService] Nil request payload for ChangePassword - Expected Request is not nil",
+		)
+	}
+
+	dataRequest, ok := req.(*pbgen.ChangePasswordRequest)
+	if !ok {
+		return recorder.Record(
+			ctx,
+			code,
+			fullMethodName,
+			fmt.Sprintf("[AuthService] Invalid request type for ChangePassword - got: %T - Expected Request have type ChangePasswordRequest Proto", req),
+		)
+	}
+
+	if len(dataRequest.GetToken()) == 0 {
+		return recorder.RecordWithDetails(
+			ctx,
+			code,
+			fullMethodName,
+			"[AuthService] Invalid request type for ChangePassword - Token is empty - does not meet requirements",
+			recorderr.FieldViolation("token", "must not be empty"),
+		)
+	}
+
+	if len(dataRequest.GetCurrentPassword()) == 0 {
+		return recorder.RecordWithDetails(
+			ctx,
+			code,
+			fullMethodName,
+			"[AuthService] Invalid request type for ChangePassword - Current Password is empty - does not meet requirements",
+			recorderr.FieldViolation("current_password", "must not be empty"),
+		)
+	}
+
+	if !validator.ValidatePassword(dataRequest.GetNewPassword()) {
+		return recorder.RecordWithDetails(
+			ctx,
+			code,
+			fullMethodName,
+			"[AuthService] Invalid request type for ChangePassword - New Password Invalid - "+passwordComplexityMsg,
+			recorderr.FieldViolation("new_password", "must be at least 8 characters, include 1 uppercase letter, 1 number, and 1 special character"),
+		)
+	}
+
+	if dataRequest.GetNewPassword() == dataRequest.GetCurrentPassword() {
+		return recorder.RecordWithDetails(
+			ctx,
+			code,
+			fullMethodName,
+			"[AuthService] Invalid request type for ChangePassword - New Password must differ from Current Password",
+			recorderr.FieldViolation("new_password", "must differ from current_password"),
+		)
+	}
+
+	lg.Info(
+		ctx,
+		"[AuthService] ChangePassword request",
+		slog.String("full_method", fullMethodName),
+		slog.Time("timestamp", time.Now()),
+		slog.String("function", "InterceptChangePassword"),
+	)
+
+	sp.AddEvent("validation_completed",
+		trace.WithAttributes(
+			attribute.String("validation_status", "success"),
+		),
+	)
+	sp.SetStatus(otelCodes.Ok, "Request validation successful")
+
+	return nil
+}
@@ -42,29 +42,32 @@ func InterceptRegisterUser(ctx context.Context, sp trace.Span, lg *logs.Logger,
 	}
 
 	if !validator.ValidateEmail(dataRequest.Email) {
-		return recorder.Record(
+		return recorder.RecordWithDetails(
 			ctx,
 			code,
 			fullMethodName,
 			fmt.Sprintf("[AuthService] Invalid request type for Register - Email Invalid - %s", dataRequest.Email),
+			recorderr.FieldViolation("email", "must be a valid email address"),
 		)
 	}
 
 	if !validator.ValidatePhone(dataRequest.PhoneNumber) {
-		return recorder.Record(
+		return recorder.RecordWithDetails(
 			ctx,
 			code,
 			fullMethodName,
 			fmt.Sprintf("[AuthService] Invalid request type for Register - Phone Number Invalid - %s", dataRequest.PhoneNumber),
+			recorderr.FieldViolation("phone_number", "must be 10 to 15 digits, optionally prefixed with +"),
 		)
 	}
 
 	if !validator.ValidatePassword(dataRequest.Password) {
-		return recorder.Record(
+		return recorder.RecordWithDetails(
 			ctx,
 			code,
 			fullMethodName,
 			"[AuthService] Invalid request type for Register - Password Invalid - does not meet complexity requirements - Password must be at least 8 characters, include 1 uppercase letter, 1 number, and 1 special character",
+			recorderr.FieldViolation("password", "must be at least 8 characters, include 1 uppercase letter, 1 number, and 1 special character"),
 		)
 	}
 
@@ -0,0 +1,68 @@
+package intercpth
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sony-nurdianto/farm/auth/internal/pbgen"
+	"github.com/sony-nurdianto/farm/auth/internal/recorderr"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/observability/otel/logs"
+	"go.opentelemetry.io/otel/attribute"
+	otelCodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+)
+
+func InterceptGetRegistrationStatus(ctx context.Context, sp trace.Span, lg *logs.Logger, req any) error {
+	fullMethodName := pbgen.AuthService_GetRegistrationStatus_FullMethodName
+	code := codes.InvalidArgument
+	recorder := recorderr.NewErrorRecorder(sp, lg)
+	if req == nil {
+		return recorder.Record(
+			ctx,
+			code,
+			fullMethodName,
+			"[AuthService] Nil request payload for GetRegistrationStatus - Expected Request is not nil",
+		)
+	}
+
+	dataRequest, ok := req.(*pbgen.GetRegistrationStatusRequest)
+	if !ok {
+		return recorder.Record(
+			ctx,
+			code,
+			fullMethodName,
+			fmt.Sprintf("[AuthService] Invalid request type for GetRegistrationStatus - got: %T - Expected Request have type GetRegistrationStatusRequest Proto", req),
+		)
+	}
+
+	if _, err := uuid.Parse(dataRequest.GetId()); err != nil {
+		return recorder.RecordWithDetails(
+			ctx,
+			code,
+			fullMethodName,
+			"[AuthService] Invalid request type for GetRegistrationStatus - Id is not a valid uuid - does not meet requirements",
+			recorderr.FieldViolation("id", "must be a UUID"),
+		)
+	}
+
+	lg.Info(
+		ctx,
+		"[AuthService] GetRegistrationStatus request",
+		slog.String("full_method", fullMethodName),
+		slog.Time("timestamp", time.Now()),
+		slog.String("function", "InterceptGetRegistrationStatus"),
+	)
+
+	sp.AddEvent("validation_completed",
+		trace.WithAttributes(
+			attribute.String("validation_status", "success"),
+		),
+	)
+	sp.SetStatus(otelCodes.Ok, "Request validation successful")
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package intercpth
+
+import (
+	"context"
+	"fmt"
+
+	"buf.build/go/protovalidate"
+	"github.com/sony-nurdianto/farm/auth/internal/recorderr"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/grpcstatus"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/observability/otel/logs"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+// InterceptRules enforces the buf.validate rules declared in auth.proto. It
+// runs after the method checks, which keep their more specific messages.
+func InterceptRules(ctx context.Context, sp trace.Span, lg *logs.Logger, fullMethodName string, req any) error {
+	dataRequest, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	err := protovalidate.Validate(dataRequest)
+	if err == nil {
+		return nil
+	}
+
+	recorder := recorderr.NewErrorRecorder(sp, lg)
+	badRequest, ok := recorderr.RuleViolations(err)
+	if !ok {
+		return recorder.RecordInternal(
+			ctx,
+			fullMethodName,
+			fmt.Errorf("[AuthService] Request rules could not be evaluated - %w", err),
+		)
+	}
+
+	return recorder.RecordWithDetails(
+		ctx,
+		codes.InvalidArgument,
+		fullMethodName,
+		fmt.Sprintf("[AuthService] Invalid request - %s", grpcstatus.Summary(badRequest)),
+		badRequest,
+	)
+}
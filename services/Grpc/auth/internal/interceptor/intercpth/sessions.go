@@ -0,0 +1,128 @@
+package intercpth
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/sony-nurdianto/farm/auth/internal/pbgen"
+	"github.com/sony-nurdianto/farm/auth/internal/recorderr"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/observability/otel/logs"
+	"go.opentelemetry.io/otel/attribute"
+	otelCodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+)
+
+func InterceptListSessions(ctx context.Context, sp trace.Span, lg *logs.Logger, req any) error {
+	fullMethodName := pbgen.AuthService_ListSessions_FullMethodName
+	code := codes.InvalidArgument
+	recorder := recorderr.NewErrorRecorder(sp, lg)
+	if req == nil {
+		return recorder.Record(
+			ctx,
+			code,
+			fullMethodName,
+			"[AuthService] Nil request payload for ListSessions - Expected Request is not nil",
+		)
+	}
+
+	dataRequest, ok := req.(*pbgen.ListSessionsRequest)
+	if !ok {
+		return recorder.Record(
+			ctx,
+			code,
+			fullMethodName,
+			fmt.Sprintf("[AuthService] Invalid request type for ListSessions - got: %T - Expected Request have type ListSessionsRequest Proto", req),
+		)
+	}
+
+	if len(dataRequest.GetAccountId()) == 0 {
+		return recorder.RecordWithDetails(
+			ctx,
+			code,
+			fullMethodName,
+			"[AuthService] Invalid request type for ListSessions - AccountId is empty - does not meet requirements",
+			recorderr.FieldViolation("account_id", "must not be empty"),
+		)
+	}
+
+	lg.Info(
+		ctx,
+		"[AuthService] ListSessions request",
+		slog.String("full_method", fullMethodName),
+		slog.Time("timestamp", time.Now()),
+		slog.String("function", "InterceptListSessions"),
+	)
+
+	sp.AddEvent("validation_completed",
+		trace.WithAttributes(
+			attribute.String("validation_status", "success"),
+		),
+	)
+	sp.SetStatus(otelCodes.Ok, "Request validation successful")
+
+	return nil
+}
+
+func InterceptRevokeSession(ctx context.Context, sp trace.Span, lg *logs.Logger, req any) error {
+	fullMethodName := pbgen.AuthService_RevokeSession_FullMethodName
+	code := codes.InvalidArgument
+	recorder := recorderr.NewErrorRecorder(sp, lg)
+	if req == nil {
+		return recorder.Record(
+			ctx,
+			code,
+			fullMethodName,
+			"[AuthService] Nil request payload for RevokeSession - Expected Request is not nil",
+		)
+	}
+
+	dataRequest, ok := req.(*pbgen.RevokeSessionRequest)
+	if !ok {
+		return recorder.Record(
+			ctx,
+			code,
+			fullMethodName,
+			fmt.Sprintf("[AuthService] Invalid request type for RevokeSession - got: %T - Expected Request have type RevokeSessionRequest Proto", req),
+		)
+	}
+
+	if len(dataRequest.GetAccountId()) == 0 {
+		return recorder.RecordWithDetails(
+			ctx,
+			code,
+			fullMethodName,
+			"[AuthService] Invalid request type for RevokeSession - AccountId is empty - does not meet requirements",
+			recorderr.FieldViolation("account_id", "must not be empty"),
+		)
+	}
+
+	if len(dataRequest.GetSessionId()) == 0 {
+		return recorder.RecordWithDetails(
+			ctx,
+			code,
+			fullMethodName,
+			"[AuthService] Invalid request type for RevokeSession - SessionId is empty - does not meet requirements",
+			recorderr.FieldViolation("session_id", "must not be empty"),
+		)
+	}
+
+	lg.Info(
+		ctx,
+		"[AuthService] RevokeSession request",
+		slog.String("full_method", fullMethodName),
+		slog.Time("timestamp", time.Now()),
+		slog.String("function", "InterceptRevokeSession"),
+	)
+
+	sp.AddEvent("validation_completed",
+		trace.WithAttributes(
+			attribute.String("validation_status", "success"),
+		),
+	)
+	sp.SetStatus(otelCodes.Ok, "Request validation successful")
+
+	return nil
+}
@@ -39,11 +39,12 @@ func InterceptTokenValidate(ctx context.Context, sp trace.Span, lg *logs.Logger,
 	}
 
 	if len(dataRequest.Token) == 0 {
-		return recorder.Record(
+		return recorder.RecordWithDetails(
 			ctx,
 			code,
 			fullMethodName,
 			"[AuthService] Invalid request type for TokenValidate - Token is empty - does not meet requirements",
+			recorderr.FieldViolation("token", "must not be empty"),
 		)
 	}
 
@@ -0,0 +1,265 @@
+package intercpth
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/sony-nurdianto/farm/auth/internal/encryption/totp"
+	"github.com/sony-nurdianto/farm/auth/internal/pbgen"
+	"github.com/sony-nurdianto/farm/auth/internal/recorderr"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/observability/otel/logs"
+	"go.opentelemetry.io/otel/attribute"
+	otelCodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+)
+
+func isTOTPCode(code string) bool {
+	if len(code) != totp.Digits {
+		return false
+	}
+
+	for _, c := range code {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func InterceptEnrollTOTP(ctx context.Context, sp trace.Span, lg *logs.Logger, req any) error {
+	fullMethodName := pbgen.AuthService_EnrollTOTP_FullMethodName
+	code := codes.InvalidArgument
+	recorder := recorderr.NewErrorRecorder(sp, lg)
+	if req == nil {
+		return recorder.Record(
+			ctx,
+			code,
+			fullMethodName,
+			"[AuthService] Nil request payload for EnrollTOTP - Expected Request is not nil",
+		)
+	}
+
+	dataRequest, ok := req.(*pbgen.EnrollTOTPRequest)
+	if !ok {
+		return recorder.Record(
+			ctx,
+			code,
+			fullMethodName,
+			fmt.Sprintf("[AuthService] Invalid request type for EnrollTOTP - got: %T - Expected Request have type EnrollTOTPRequest Proto", req),
+		)
+	}
+
+	if len(dataRequest.GetToken()) == 0 {
+		return recorder.RecordWithDetails(
+			ctx,
+			code,
+			fullMethodName,
+			"[AuthService] Invalid request type for EnrollTOTP - Token is empty - does not meet requirements",
+			recorderr.FieldViolation("token", "must not be empty"),
+		)
+	}
+
+	lg.Info(
+		ctx,
+		"[AuthService] EnrollTOTP request",
+		slog.String("full_method", fullMethodName),
+		slog.Time("timestamp", time.Now()),
+		slog.String("function", "InterceptEnrollTOTP"),
+	)
+
+	sp.AddEvent("validation_completed",
+		trace.WithAttributes(
+			attribute.String("validation_status", "success"),
+		),
+	)
+	sp.SetStatus(otelCodes.Ok, "Request validation successful")
+
+	return nil
+}
+
+func InterceptConfirmTOTP(ctx context.Context, sp trace.Span, lg *logs.Logger, req any) error {
+	fullMethodName := pbgen.AuthService_ConfirmTOTP_FullMethodName
+	code := codes.InvalidArgument
+	recorder := recorderr.NewErrorRecorder(sp, lg)
+	if req == nil {
+		return recorder.Record(
+			ctx,
+			code,
+			fullMethodName,
+			"[AuthService] Nil request payload for ConfirmTOTP - Expected Request is not nil",
+		)
+	}
+
+	dataRequest, ok := req.(*pbgen.ConfirmTOTPRequest)
+	if !ok {
+		return recorder.Record(
+			ctx,
+			code,
+			fullMethodName,
+			fmt.Sprintf("[AuthService] Invalid request type for ConfirmTOTP - got: %T - Expected Request have type ConfirmTOTPRequest Proto", req),
+		)
+	}
+
+	if len(dataRequest.GetToken()) == 0 {
+		return recorder.RecordWithDetails(
+			ctx,
+			code,
+			fullMethodName,
+			"[AuthService] Invalid request type for ConfirmTOTP - Token is empty - does not meet requirements",
+			recorderr.FieldViolation("token", "must not be empty"),
+		)
+	}
+
+	if !isTOTPCode(dataRequest.GetCode()) {
+		return recorder.RecordWithDetails(
+			ctx,
+			code,
+			fullMethodName,
+			"[AuthService] Invalid request type for ConfirmTOTP - Code Invalid - Code must be the 6 digits from the authenticator app",
+			recorderr.FieldViolation("code", "must be a 6 digit code"),
+		)
+	}
+
+	lg.Info(
+		ctx,
+		"[AuthService] ConfirmTOTP request",
+		slog.String("full_method", fullMethodName),
+		slog.Time("timestamp", time.Now()),
+		slog.String("function", "InterceptConfirmTOTP"),
+	)
+
+	sp.AddEvent("validation_completed",
+		trace.WithAttributes(
+			attribute.String("validation_status", "success"),
+		),
+	)
+	sp.SetStatus(otelCodes.Ok, "Request validation successful")
+
+	return nil
+}
+
+func InterceptDisableTOTP(ctx context.Context, sp trace.Span, lg *logs.Logger, req any) error {
+	fullMethodName := pbgen.AuthService_DisableTOTP_FullMethodName
+	code := codes.InvalidArgument
+	recorder := recorderr.NewErrorRecorder(sp, lg)
+	if req == nil {
+		return recorder.Record(
+			ctx,
+			code,
+			fullMethodName,
+			"[AuthService] Nil request payload for DisableTOTP - Expected Request is not nil",
+		)
+	}
+
+	dataRequest, ok := req.(*pbgen.DisableTOTPRequest)
+	if !ok {
+		return recorder.Record(
+			ctx,
+			code,
+			fullMethodName,
+			fmt.Sprintf("[AuthService] Invalid request type for DisableTOTP - got: %T - Expected Request have type DisableTOTPRequest Proto", req),
+		)
+	}
+
+	if len(dataRequest.GetToken()) == 0 {
+		return recorder.RecordWithDetails(
+			ctx,
+			code,
+			fullMethodName,
+			"[AuthService] Invalid request type for DisableTOTP - Token is empty - does not meet requirements",
+			recorderr.FieldViolation("token", "must not be empty"),
+		)
+	}
+
+	if len(dataRequest.GetPassword()) == 0 {
+		return recorder.RecordWithDetails(
+			ctx,
+			code,
+			fullMethodName,
+			"[AuthService] Invalid request type for DisableTOTP - Password is empty - does not meet requirements",
+			recorderr.FieldViolation("password", "must not be empty"),
+		)
+	}
+
+	lg.Info(
+		ctx,
+		"[AuthService] DisableTOTP request",
+		slog.String("full_method", fullMethodName),
+		slog.Time("timestamp", time.Now()),
+		slog.String("function", "InterceptDisableTOTP"),
+	)
+
+	sp.AddEvent("validation_completed",
+		trace.WithAttributes(
+			attribute.String("validation_status", "success"),
+		),
+	)
+	sp.SetStatus(otelCodes.Ok, "Request validation successful")
+
+	return nil
+}
+
+func InterceptVerifySecondFactor(ctx context.Context, sp trace.Span, lg *logs.Logger, req any) error {
+	fullMethodName := pbgen.AuthService_VerifySecondFactor_FullMethodName
+	code := codes.InvalidArgument
+	recorder := recorderr.NewErrorRecorder(sp, lg)
+	if req == nil {
+		return recorder.Record(
+			ctx,
+			code,
+			fullMethodName,
+			"[AuthService] Nil request payload for VerifySecondFactor - Expected Request is not nil",
+		)
+	}
+
+	dataRequest, ok := req.(*pbgen.VerifySecondFactorRequest)
+	if !ok {
+		return recorder.Record(
+			ctx,
+			code,
+			fullMethodName,
+			fmt.Sprintf("[AuthService] Invalid request type for VerifySecondFactor - got: %T - Expected Request have type VerifySecondFactorRequest Proto", req),
+		)
+	}
+
+	if len(dataRequest.GetChallengeToken()) == 0 {
+		return recorder.RecordWithDetails(
+			ctx,
+			code,
+			fullMethodName,
+			"[AuthService] Invalid request type for VerifySecondFactor - Challenge Token is empty - does not meet requirements",
+			recorderr.FieldViolation("challenge_token", "must not be empty"),
+		)
+	}
+
+	if len(dataRequest.GetCode()) == 0 {
+		return recorder.RecordWithDetails(
+			ctx,
+			code,
+			fullMethodName,
+			"[AuthService] Invalid request type for VerifySecondFactor - Code is empty - does not meet requirements",
+			recorderr.FieldViolation("code", "must not be empty"),
+		)
+	}
+
+	lg.Info(
+		ctx,
+		"[AuthService] VerifySecondFactor request",
+		slog.String("full_method", fullMethodName),
+		slog.Time("timestamp", time.Now()),
+		slog.String("function", "InterceptVerifySecondFactor"),
+	)
+
+	sp.AddEvent("validation_completed",
+		trace.WithAttributes(
+			attribute.String("validation_status", "success"),
+		),
+	)
+	sp.SetStatus(otelCodes.Ok, "Request validation successful")
+
+	return nil
+}
@@ -45,6 +45,62 @@ func AuthServiceUnaryInterceptor(
 		if err := intercpth.InterceptTokenValidate(ctx, span, logger, req); err != nil {
 			return nil, err
 		}
+	case pbgen.AuthService_RefreshToken_FullMethodName:
+		if err := intercpth.InterceptRefreshToken(ctx, span, logger, req); err != nil {
+			return nil, err
+		}
+	case pbgen.AuthService_Logout_FullMethodName:
+		if err := intercpth.InterceptLogout(ctx, span, logger, req); err != nil {
+			return nil, err
+		}
+	case pbgen.AuthService_ListSessions_FullMethodName:
+		if err := intercpth.InterceptListSessions(ctx, span, logger, req); err != nil {
+			return nil, err
+		}
+	case pbgen.AuthService_RevokeSession_FullMethodName:
+		if err := intercpth.InterceptRevokeSession(ctx, span, logger, req); err != nil {
+			return nil, err
+		}
+	case pbgen.AuthService_VerifyEmail_FullMethodName:
+		if err := intercpth.InterceptVerifyEmail(ctx, span, logger, req); err != nil {
+			return nil, err
+		}
+	case pbgen.AuthService_GetRegistrationStatus_FullMethodName:
+		if err := intercpth.InterceptGetRegistrationStatus(ctx, span, logger, req); err != nil {
+			return nil, err
+		}
+	case pbgen.AuthService_RequestPasswordReset_FullMethodName:
+		if err := intercpth.InterceptRequestPasswordReset(ctx, span, logger, req); err != nil {
+			return nil, err
+		}
+	case pbgen.AuthService_ResetPassword_FullMethodName:
+		if err := intercpth.InterceptResetPassword(ctx, span, logger, req); err != nil {
+			return nil, err
+		}
+	case pbgen.AuthService_ChangePassword_FullMethodName:
+		if err := intercpth.InterceptChangePassword(ctx, span, logger, req); err != nil {
+			return nil, err
+		}
+	case pbgen.AuthService_EnrollTOTP_FullMethodName:
+		if err := intercpth.InterceptEnrollTOTP(ctx, span, logger, req); err != nil {
+			return nil, err
+		}
+	case pbgen.AuthService_ConfirmTOTP_FullMethodName:
+		if err := intercpth.InterceptConfirmTOTP(ctx, span, logger, req); err != nil {
+			return nil, err
+		}
+	case pbgen.AuthService_DisableTOTP_FullMethodName:
+		if err := intercpth.InterceptDisableTOTP(ctx, span, logger, req); err != nil {
+			return nil, err
+		}
+	case pbgen.AuthService_VerifySecondFactor_FullMethodName:
+		if err := intercpth.InterceptVerifySecondFactor(ctx, span, logger, req); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := intercpth.InterceptRules(ctx, span, logger, info.FullMethod, req); err != nil {
+		return nil, err
 	}
 
 	resp, err = handler(ctx, req)
@@ -0,0 +1,52 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+type fileMailer struct {
+	path string
+	mu   *sync.Mutex
+}
+
+// NewFileMailer appends every message to path, or logs it when path is empty.
+func NewFileMailer(path string) Mailer {
+	return fileMailer{path: path, mu: &sync.Mutex{}}
+}
+
+func (m fileMailer) Send(ctx context.Context, msg Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	entry := fmt.Sprintf(
+		"--- %s\nTo: %s\nSubject: %s\n\n%s\n",
+		time.Now().UTC().Format(time.RFC3339),
+		msg.To,
+		msg.Subject,
+		msg.Body,
+	)
+
+	if m.path == "" {
+		log.Print(entry)
+		return nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	f, err := os.OpenFile(m.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	_, err = f.WriteString(entry)
+	return err
+}
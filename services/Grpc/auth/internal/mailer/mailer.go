@@ -0,0 +1,38 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+)
+
+//go:generate mockgen -source=mailer.go -destination=../../test/mocks/mock_mailer.go -package=mocks
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// NewMailerFromEnv picks the delivery by MAILER: "smtp" sends through
+// SMTP_HOST, anything else appends the messages to MAILER_FILE, or to the
+// log when MAILER_FILE is empty, which is enough for local runs.
+func NewMailerFromEnv() (Mailer, error) {
+	switch os.Getenv("MAILER") {
+	case "smtp":
+		return NewSMTPMailer(SMTPConfig{
+			Host:     os.Getenv("SMTP_HOST"),
+			Port:     os.Getenv("SMTP_PORT"),
+			Username: os.Getenv("SMTP_USER"),
+			Password: os.Getenv("SMTP_PASSWORD"),
+			From:     os.Getenv("SMTP_FROM"),
+		})
+	case "", "file", "log":
+		return NewFileMailer(os.Getenv("MAILER_FILE")), nil
+	default:
+		return nil, fmt.Errorf("unknown MAILER %q", os.Getenv("MAILER"))
+	}
+}
@@ -0,0 +1,51 @@
+package models
+
+type UserLogin struct {
+	Id        string `avro:"id" json:"id"`
+	UserId    string `avro:"user_id" json:"user_id"`
+	IpAddress string `avro:"ip_address" json:"ip_address"`
+	UserAgent string `avro:"user_agent" json:"user_agent"`
+	Outcome   string `avro:"outcome" json:"outcome"`
+	LoggedAt  string `avro:"logged_at" json:"logged_at"`
+}
+
+func (UserLogin) Schema() string {
+	return `
+		{
+		  "type": "record",
+		  "name": "UserLogin",
+		  "fields": [
+		    {
+		      "name": "id",
+		      "type": "string",
+		      "default": ""
+		    },
+		    {
+		      "name": "user_id",
+		      "type": "string",
+		      "default": ""
+		    },
+		    {
+		      "name": "ip_address",
+		      "type": "string",
+		      "default": ""
+		    },
+		    {
+		      "name": "user_agent",
+		      "type": "string",
+		      "default": ""
+		    },
+		    {
+		      "name": "outcome",
+		      "type": "string",
+		      "default": ""
+		    },
+		    {
+		      "name": "logged_at",
+		      "type": "string",
+		      "default": ""
+		    }
+		  ]
+		}
+	`
+}
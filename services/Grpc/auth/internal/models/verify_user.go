@@ -0,0 +1,53 @@
+package models
+
+type VerifyAccount struct {
+	Id       string `avro:"id" json:"id"`
+	Verified bool   `avro:"verified" json:"verified"`
+}
+
+func (VerifyAccount) Schema() string {
+	return `
+		{
+		  "type": "record",
+		  "name": "VerifyAuthAccount",
+		  "fields": [
+		    {
+		      "name": "id",
+		      "type": "string",
+		      "default": ""
+		    },
+		    {
+		      "name": "verified",
+		      "type": "boolean",
+		      "default": false
+		    }
+		  ]
+		}
+	`
+}
+
+type VerifyFarmerUser struct {
+	Id       string `avro:"id" json:"id"`
+	Verified bool   `avro:"verified" json:"verified"`
+}
+
+func (VerifyFarmerUser) Schema() string {
+	return `
+		{
+		  "type": "record",
+		  "name": "VerifyFarmerUser",
+		  "fields": [
+		    {
+		      "name": "id",
+		      "type": "string",
+		      "default": ""
+		    },
+		    {
+		      "name": "verified",
+		      "type": "boolean",
+		      "default": false
+		    }
+		  ]
+		}
+	`
+}
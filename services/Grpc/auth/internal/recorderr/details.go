@@ -0,0 +1,42 @@
+package recorderr
+
+import (
+	"github.com/sony-nurdianto/farm/shared_lib/Go/grpcstatus"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// ErrorDomain is the ErrorInfo domain of the errors raised by this service.
+const ErrorDomain = "auth"
+
+// ErrorInfo reasons callers can branch on instead of parsing messages.
+const (
+	ReasonUserExists          = "USER_EXISTS"
+	ReasonUserNotFound        = "USER_NOT_FOUND"
+	ReasonInvalidCredentials  = "INVALID_CREDENTIALS"
+	ReasonLoginThrottled      = "LOGIN_THROTTLED"
+	ReasonTokenInvalid        = "TOKEN_INVALID"
+	ReasonSecondFactorInvalid = "SECOND_FACTOR_INVALID"
+	ReasonSecondFactorState   = "SECOND_FACTOR_STATE"
+	ReasonSessionNotFound     = "SESSION_NOT_FOUND"
+	ReasonRegistrationMissing = "REGISTRATION_NOT_FOUND"
+)
+
+// FieldViolation reports one invalid request field as errdetails.BadRequest.
+func FieldViolation(field, description string) *errdetails.BadRequest {
+	return &errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: field, Description: description},
+		},
+	}
+}
+
+// ErrorInfo tags an error with a machine readable reason of this service.
+func ErrorInfo(reason string) *errdetails.ErrorInfo {
+	return &errdetails.ErrorInfo{Reason: reason, Domain: ErrorDomain}
+}
+
+// RuleViolations reports the violations of a protovalidate.ValidationError
+// as errdetails.BadRequest, ok is false for any other error.
+func RuleViolations(err error) (_ *errdetails.BadRequest, ok bool) {
+	return grpcstatus.Violations("", err)
+}
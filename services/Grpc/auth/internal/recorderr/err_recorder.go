@@ -10,6 +10,7 @@ import (
 	otelTrace "go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
 )
 
 type ErrorRecorder interface {
@@ -18,6 +19,17 @@ type ErrorRecorder interface {
 		code codes.Code,
 		methdoName, msg string,
 	) error
+	RecordWithDetails(
+		ctx context.Context,
+		code codes.Code,
+		methdoName, msg string,
+		details ...protoadapt.MessageV1,
+	) error
+	RecordInternal(
+		ctx context.Context,
+		methdoName string,
+		cause error,
+	) error
 }
 
 type errorRecorder struct {
@@ -50,3 +62,42 @@ func (ier errorRecorder) Record(
 
 	return err
 }
+
+// RecordWithDetails is Record with error details, e.g. errdetails.RetryInfo,
+// attached to the returned status.
+func (ier errorRecorder) RecordWithDetails(
+	ctx context.Context,
+	code codes.Code,
+	methdoName, msg string,
+	details ...protoadapt.MessageV1,
+) error {
+	err := ier.Record(ctx, code, methdoName, msg)
+
+	st, detailErr := status.Convert(err).WithDetails(details...)
+	if detailErr != nil {
+		ier.span.RecordError(detailErr)
+		return err
+	}
+
+	return st.Err()
+}
+
+// RecordInternal records cause on the span and in the log and answers
+// Internal without it, the database and cache errors are not for the caller.
+func (ier errorRecorder) RecordInternal(
+	ctx context.Context,
+	methdoName string,
+	cause error,
+) error {
+	slgAttr := []slog.Attr{
+		slog.String("full_method", methdoName),
+		slog.Int("codes", int(codes.Internal)),
+		slog.Time("error_at", time.Now()),
+	}
+
+	ier.span.RecordError(cause)
+	ier.span.SetStatus(otelCodes.Error, cause.Error())
+	ier.loggr.Error(ctx, "[AuthService] Internal error", cause, slgAttr...)
+
+	return status.Error(codes.Internal, "internal error")
+}
@@ -13,15 +13,18 @@ import (
 	"go.opentelemetry.io/otel/codes"
 )
 
+// publishAvro produces the account and user records in one kafka transaction
+// so the auth and farmer databases never see only half of a change.
 func (rp authRepo) publishAvro(
 	ctx context.Context,
+	userID string,
 	accountTopic string,
 	userTopic string,
-	account *models.InsertAccount,
-	user *models.InsertFarmerUser,
+	account any,
+	user any,
 ) error {
 	tracer := otel.Tracer("auth-service")
-	_, span := tracer.Start(ctx, "Repo:publishAvro")
+	pctx, span := tracer.Start(ctx, "Repo:publishAvro")
 	defer span.End()
 
 	span.SetAttributes(
@@ -31,7 +34,7 @@ func (rp authRepo) publishAvro(
 		attribute.String("messaging.protocol", "avro"),
 		attribute.String("messaging.account_topic", accountTopic),
 		attribute.String("messaging.user_topic", userTopic),
-		attribute.String("user.id", account.Id),
+		attribute.String("user.id", userID),
 	)
 
 	span.AddEvent("serializing_account_data")
@@ -52,13 +55,16 @@ func (rp authRepo) publishAvro(
 	}
 	span.SetAttributes(attribute.Int("messaging.user_payload_size", len(userPayload)))
 
+	// the records carry the trace of the request, so its consumers continue it
 	span.AddEvent("creating_kafka_records")
+	traceHeaders := kev.TraceHeaders(pctx)
 	accountRecord := kev.MessageKafka{
 		TopicPartition: kev.KafkaTopicPartition{
 			Topic:     &accountTopic,
 			Partition: kev.KafkaPartitionAny,
 		},
-		Value: accountPayload,
+		Value:   accountPayload,
+		Headers: traceHeaders,
 	}.Factory()
 
 	userRecord := kev.MessageKafka{
@@ -66,13 +72,18 @@ func (rp authRepo) publishAvro(
 			Topic:     &userTopic,
 			Partition: kev.KafkaPartitionAny,
 		},
-		Value: userPayload,
+		Value:   userPayload,
+		Headers: traceHeaders,
 	}.Factory()
 
 	span.AddEvent("setting_up_transaction_context")
 	txCtx, cancel := context.WithTimeout(context.Background(), time.Millisecond*1000)
 	defer cancel()
 
+	// a producer runs one transaction at a time
+	rp.producerMu.Lock()
+	defer rp.producerMu.Unlock()
+
 	span.AddEvent("beginning_kafka_transaction")
 	if err := rp.authProducer.BeginTransaction(); err != nil {
 		span.RecordError(err)
@@ -159,7 +170,7 @@ func (rp authRepo) CreateUserAsync(
 	)
 
 	span.AddEvent("publishing_to_kafka_message_broker")
-	err := rp.publishAvro(cactx, accountTopic, userTopic, account, user)
+	err := rp.publishAvro(cactx, id, accountTopic, userTopic, account, user)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, "Failed to publish messages")
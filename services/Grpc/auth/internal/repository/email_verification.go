@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/sony-nurdianto/farm/auth/internal/constants"
+	"github.com/sony-nurdianto/farm/auth/internal/models"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+var (
+	ErrVerificationNotFound error = errors.New("email verification is not found")
+	ErrVerificationUsed     error = errors.New("email verification is already used")
+	ErrVerificationExpired  error = errors.New("email verification is expired")
+)
+
+func (rp authRepo) CreateEmailVerification(
+	ctx context.Context,
+	accountID, email, tokenHash string,
+	expiresAt time.Time,
+) error {
+	tracer := otel.Tracer("auth-service")
+	vctx, span := tracer.Start(ctx, "Repo:CreateEmailVerification")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "create_email_verification"),
+		attribute.String("layer", "repository"),
+		attribute.String("db.operation", "INSERT"),
+		attribute.String("user.id", accountID),
+	)
+
+	var hash string
+	row := rp.verificationStmts.createEmailVerificationStmt.QueryRowContext(vctx, tokenHash, accountID, email, expiresAt)
+	if err := row.Scan(&hash); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to insert email verification")
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "Email verification created successfully")
+	return nil
+}
+
+// VerifyEmail consumes the verification token and publishes the verified
+// flag for the accounts and users tables. The token is only marked used once
+// the kafka transaction is committed, so a failed publish can be retried.
+func (rp authRepo) VerifyEmail(ctx context.Context, tokenHash string) (accountID string, _ error) {
+	tracer := otel.Tracer("auth-service")
+	vctx, span := tracer.Start(ctx, "Repo:VerifyEmail")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "verify_email"),
+		attribute.String("layer", "repository"),
+		attribute.String("db.operation", "UPDATE"),
+	)
+
+	tx, err := rp.db.BeginTx(vctx, nil)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to begin transaction")
+		return "", err
+	}
+
+	defer tx.Rollback()
+
+	var email string
+	var expiresAt time.Time
+	var usedAt *time.Time
+
+	row := tx.Stmt(rp.verificationStmts.getEmailVerificationStmt).QueryRowContext(vctx, tokenHash)
+	if err := row.Scan(&accountID, &email, &expiresAt, &usedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			span.SetStatus(codes.Error, "Email verification not found")
+			return "", ErrVerificationNotFound
+		}
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to get email verification")
+		return "", err
+	}
+
+	span.SetAttributes(attribute.String("user.id", accountID))
+
+	if usedAt != nil {
+		span.SetStatus(codes.Error, "Email verification already used")
+		return accountID, ErrVerificationUsed
+	}
+
+	if expiresAt.Before(time.Now()) {
+		span.SetStatus(codes.Error, "Email verification expired")
+		return accountID, ErrVerificationExpired
+	}
+
+	span.AddEvent("publishing_verified_flag")
+	err = rp.publishAvro(
+		vctx,
+		accountID,
+		constants.VERIFY_ACCOUNT_TOPIC,
+		constants.VERIFY_USER_TOPIC,
+		&models.VerifyAccount{Id: accountID, Verified: true},
+		&models.VerifyFarmerUser{Id: accountID, Verified: true},
+	)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to publish verified flag")
+		return accountID, err
+	}
+
+	var hash string
+	row = tx.Stmt(rp.verificationStmts.useEmailVerificationStmt).QueryRowContext(vctx, time.Now().UTC(), tokenHash)
+	if err := row.Scan(&hash); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to mark email verification used")
+		return accountID, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to commit email verification")
+		return accountID, err
+	}
+
+	span.SetStatus(codes.Ok, "Email verified successfully")
+	return accountID, nil
+}
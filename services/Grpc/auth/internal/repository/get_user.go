@@ -28,7 +28,7 @@ func (rp authRepo) GetUserByEmail(ctx context.Context, email string) (user entit
 
 	span.AddEvent("executing_database_query")
 	row := rp.getUserByEmailStmt.QueryRowContext(dbctx, email)
-	err := row.Scan(&user.Id, &user.Email, &user.Password, &user.CreatedAt, &user.UpdatedAt)
+	err := row.Scan(&user.Id, &user.Email, &user.Password, &user.CreatedAt, &user.UpdatedAt, &user.Verified, &user.TOTPEnabled)
 	if err != nil {
 		span.RecordError(err)
 		if err == sql.ErrNoRows {
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// kafkaPingTimeout bounds the metadata request of PingKafka when ctx has no
+// deadline.
+const kafkaPingTimeout = 2 * time.Second
+
+// PingDatabase, PingCache and PingKafka probe the connections of the repo,
+// they drive the health status of the auth service.
+func (rp authRepo) PingDatabase(ctx context.Context) error {
+	return rp.db.PingContext(ctx)
+}
+
+func (rp authRepo) PingCache(ctx context.Context) error {
+	_, err := rp.authCache.Ping(ctx).Result()
+	return err
+}
+
+// PingKafka asks the brokers for the cluster metadata, without the topics.
+func (rp authRepo) PingKafka(ctx context.Context) error {
+	timeout := kafkaPingTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+	}
+
+	_, err := rp.authProducer.GetMetadata(nil, false, int(timeout.Milliseconds()))
+	return err
+}
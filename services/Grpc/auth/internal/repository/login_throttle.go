@@ -0,0 +1,266 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sony-nurdianto/farm/auth/internal/entity"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/database/redis"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+type LoginScope string
+
+const (
+	LoginScopeEmail LoginScope = "email"
+	LoginScopeIP    LoginScope = "ip"
+
+	// lock counts are forgotten after a day without lockouts, so the backoff
+	// starts over for an account that stopped being attacked
+	loginLockCountTTL = 24 * time.Hour
+)
+
+func loginFailureKey(scope LoginScope, value string) string {
+	return fmt.Sprintf("login_fail:%s:%s", scope, value)
+}
+
+func loginLockKey(email string) string {
+	return fmt.Sprintf("login_lock:%s", email)
+}
+
+func loginLockCountKey(email string) string {
+	return fmt.Sprintf("login_lock_count:%s", email)
+}
+
+// loginWindow trims the failures older than window from the sorted set at
+// key, optionally adds one for now, and reads what is left.
+func (rp authRepo) loginWindow(
+	ctx context.Context,
+	key string,
+	window time.Duration,
+	record bool,
+) (lw entity.LoginWindow, _ error) {
+	now := time.Now()
+	pipe := rp.authCache.TxPipeline()
+
+	pipe.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(now.Add(-window).UnixMilli(), 10))
+	if record {
+		pipe.ZAdd(ctx, key, redis.Z{Score: float64(now.UnixMilli()), Member: uuid.NewString()})
+		pipe.PExpire(ctx, key, window)
+	}
+
+	card := pipe.ZCard(ctx, key)
+	oldest := pipe.ZRangeWithScores(ctx, key, 0, 0)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return lw, err
+	}
+
+	lw.Count = card.Val()
+	if members := oldest.Val(); len(members) > 0 {
+		lw.Oldest = time.UnixMilli(int64(members[0].Score))
+	}
+
+	return lw, nil
+}
+
+func (rp authRepo) CountLoginFailures(
+	ctx context.Context,
+	scope LoginScope,
+	value string,
+	window time.Duration,
+) (entity.LoginWindow, error) {
+	tracer := otel.Tracer("auth-service")
+	lctx, span := tracer.Start(ctx, "Repo:CountLoginFailures")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "count_login_failures"),
+		attribute.String("layer", "repository"),
+		attribute.String("login.scope", string(scope)),
+	)
+
+	lw, err := rp.loginWindow(lctx, loginFailureKey(scope, value), window, false)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to count login failures")
+		return lw, err
+	}
+
+	span.SetAttributes(attribute.Int64("login.failures", lw.Count))
+	span.SetStatus(codes.Ok, "Login failures counted")
+	return lw, nil
+}
+
+func (rp authRepo) RecordLoginFailure(
+	ctx context.Context,
+	scope LoginScope,
+	value string,
+	window time.Duration,
+) (entity.LoginWindow, error) {
+	tracer := otel.Tracer("auth-service")
+	lctx, span := tracer.Start(ctx, "Repo:RecordLoginFailure")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "record_login_failure"),
+		attribute.String("layer", "repository"),
+		attribute.String("login.scope", string(scope)),
+	)
+
+	lw, err := rp.loginWindow(lctx, loginFailureKey(scope, value), window, true)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to record login failure")
+		return lw, err
+	}
+
+	span.SetAttributes(attribute.Int64("login.failures", lw.Count))
+	span.SetStatus(codes.Ok, "Login failure recorded")
+	return lw, nil
+}
+
+// ClearLoginFailures forgets the failures and the lockout history of email
+// after a successful sign-in.
+func (rp authRepo) ClearLoginFailures(ctx context.Context, email string) error {
+	tracer := otel.Tracer("auth-service")
+	lctx, span := tracer.Start(ctx, "Repo:ClearLoginFailures")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "clear_login_failures"),
+		attribute.String("layer", "repository"),
+	)
+
+	del := rp.authCache.Del(lctx, loginFailureKey(LoginScopeEmail, email), loginLockCountKey(email))
+	if del.Err() != nil {
+		span.RecordError(del.Err())
+		span.SetStatus(codes.Error, "Failed to clear login failures")
+		return del.Err()
+	}
+
+	span.SetStatus(codes.Ok, "Login failures cleared")
+	return nil
+}
+
+// LoginLockTTL returns how long email stays locked, zero when it is not.
+func (rp authRepo) LoginLockTTL(ctx context.Context, email string) (time.Duration, error) {
+	tracer := otel.Tracer("auth-service")
+	lctx, span := tracer.Start(ctx, "Repo:LoginLockTTL")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "login_lock_ttl"),
+		attribute.String("layer", "repository"),
+	)
+
+	pipe := rp.authCache.TxPipeline()
+	ttl := pipe.PTTL(lctx, loginLockKey(email))
+	if _, err := pipe.Exec(lctx); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to read login lock")
+		return 0, err
+	}
+
+	// negative values mean the key does not exist or never expires
+	if ttl.Val() <= 0 {
+		span.SetStatus(codes.Ok, "Login not locked")
+		return 0, nil
+	}
+
+	span.SetStatus(codes.Ok, "Login locked")
+	return ttl.Val(), nil
+}
+
+// LockLogin locks email for base doubled on every lockout within
+// loginLockCountTTL, capped at max, and returns the lock duration.
+func (rp authRepo) LockLogin(
+	ctx context.Context,
+	email string,
+	base, max time.Duration,
+) (time.Duration, error) {
+	tracer := otel.Tracer("auth-service")
+	lctx, span := tracer.Start(ctx, "Repo:LockLogin")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "lock_login"),
+		attribute.String("layer", "repository"),
+	)
+
+	countKey := loginLockCountKey(email)
+	pipe := rp.authCache.TxPipeline()
+	incr := pipe.Incr(lctx, countKey)
+	pipe.Expire(lctx, countKey, loginLockCountTTL)
+	if _, err := pipe.Exec(lctx); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to count login lock")
+		return 0, err
+	}
+
+	lock := base
+	for i := int64(1); i < incr.Val() && lock < max; i++ {
+		lock *= 2
+	}
+	lock = min(lock, max)
+
+	pipe = rp.authCache.TxPipeline()
+	pipe.Set(lctx, loginLockKey(email), time.Now().UTC().Format(time.RFC3339), lock)
+	pipe.Del(lctx, loginFailureKey(LoginScopeEmail, email))
+	if _, err := pipe.Exec(lctx); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to lock login")
+		return 0, err
+	}
+
+	span.SetAttributes(
+		attribute.Int64("login.lock_count", incr.Val()),
+		attribute.String("login.lock_duration", lock.String()),
+	)
+	span.SetStatus(codes.Ok, "Login locked")
+	return lock, nil
+}
+
+func (rp authRepo) CreateLoginAttempt(ctx context.Context, attempt entity.LoginAttempt) error {
+	tracer := otel.Tracer("auth-service")
+	lctx, span := tracer.Start(ctx, "Repo:CreateLoginAttempt")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "create_login_attempt"),
+		attribute.String("layer", "repository"),
+		attribute.String("db.operation", "INSERT"),
+		attribute.Bool("login.success", attempt.Success),
+		attribute.String("login.reason", attempt.Reason),
+	)
+
+	var accountID any
+	if attempt.AccountId != "" {
+		accountID = attempt.AccountId
+	}
+
+	var id string
+	row := rp.createLoginAttemptStmt.QueryRowContext(
+		lctx,
+		uuid.NewString(),
+		accountID,
+		attempt.Email,
+		attempt.IpAddress,
+		attempt.UserAgent,
+		attempt.Success,
+		attempt.Reason,
+	)
+	if err := row.Scan(&id); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to insert login attempt")
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "Login attempt recorded")
+	return nil
+}
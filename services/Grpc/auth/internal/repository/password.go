@@ -0,0 +1,222 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/sony-nurdianto/farm/auth/internal/entity"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+var (
+	ErrPasswordResetNotFound error = errors.New("password reset is not found")
+	ErrPasswordResetUsed     error = errors.New("password reset is already used")
+	ErrPasswordResetExpired  error = errors.New("password reset is expired")
+)
+
+func (rp authRepo) GetUserByID(ctx context.Context, id string) (user entity.Users, _ error) {
+	tracer := otel.Tracer("auth-service")
+	uctx, span := tracer.Start(ctx, "Repo:GetUserByID")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "get_user_by_id"),
+		attribute.String("layer", "repository"),
+		attribute.String("db.operation", "SELECT"),
+		attribute.String("user.id", id),
+	)
+
+	row := rp.passwordStmts.getUserByIDStmt.QueryRowContext(uctx, id)
+	err := row.Scan(&user.Id, &user.Email, &user.Password, &user.CreatedAt, &user.UpdatedAt, &user.Verified, &user.TOTPEnabled)
+	if err != nil {
+		span.RecordError(err)
+		if err == sql.ErrNoRows {
+			span.SetStatus(codes.Ok, "User not found")
+		} else {
+			span.SetStatus(codes.Error, "Database query failed")
+		}
+		return user, err
+	}
+
+	span.SetStatus(codes.Ok, "User retrieved successfully")
+	return user, nil
+}
+
+func (rp authRepo) UpdatePasswordHash(ctx context.Context, accountID, passwordHash string) error {
+	tracer := otel.Tracer("auth-service")
+	uctx, span := tracer.Start(ctx, "Repo:UpdatePasswordHash")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "update_password_hash"),
+		attribute.String("layer", "repository"),
+		attribute.String("db.operation", "UPDATE"),
+		attribute.String("user.id", accountID),
+	)
+
+	var id string
+	row := rp.passwordStmts.updatePasswordHashStmt.QueryRowContext(uctx, passwordHash, accountID)
+	if err := row.Scan(&id); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to update password hash")
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "Password hash updated successfully")
+	return nil
+}
+
+func (rp authRepo) CreatePasswordReset(
+	ctx context.Context,
+	accountID, tokenHash string,
+	expiresAt time.Time,
+) error {
+	tracer := otel.Tracer("auth-service")
+	pctx, span := tracer.Start(ctx, "Repo:CreatePasswordReset")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "create_password_reset"),
+		attribute.String("layer", "repository"),
+		attribute.String("db.operation", "INSERT"),
+		attribute.String("user.id", accountID),
+	)
+
+	var hash string
+	row := rp.passwordStmts.createPasswordResetStmt.QueryRowContext(pctx, tokenHash, accountID, expiresAt)
+	if err := row.Scan(&hash); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to insert password reset")
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "Password reset created successfully")
+	return nil
+}
+
+// ResetPassword consumes the reset token and stores the new hash in one
+// transaction, so a token can never be used twice.
+func (rp authRepo) ResetPassword(ctx context.Context, tokenHash, passwordHash string) (accountID string, _ error) {
+	tracer := otel.Tracer("auth-service")
+	pctx, span := tracer.Start(ctx, "Repo:ResetPassword")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "reset_password"),
+		attribute.String("layer", "repository"),
+		attribute.String("db.operation", "UPDATE"),
+	)
+
+	tx, err := rp.db.BeginTx(pctx, nil)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to begin transaction")
+		return "", err
+	}
+
+	defer tx.Rollback()
+
+	var expiresAt time.Time
+	var usedAt *time.Time
+
+	row := tx.Stmt(rp.passwordStmts.getPasswordResetStmt).QueryRowContext(pctx, tokenHash)
+	if err := row.Scan(&accountID, &expiresAt, &usedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			span.SetStatus(codes.Error, "Password reset not found")
+			return "", ErrPasswordResetNotFound
+		}
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to get password reset")
+		return "", err
+	}
+
+	span.SetAttributes(attribute.String("user.id", accountID))
+
+	if usedAt != nil {
+		span.SetStatus(codes.Error, "Password reset already used")
+		return accountID, ErrPasswordResetUsed
+	}
+
+	if expiresAt.Before(time.Now()) {
+		span.SetStatus(codes.Error, "Password reset expired")
+		return accountID, ErrPasswordResetExpired
+	}
+
+	var id string
+	row = tx.Stmt(rp.passwordStmts.updatePasswordHashStmt).QueryRowContext(pctx, passwordHash, accountID)
+	if err := row.Scan(&id); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to update password hash")
+		return accountID, err
+	}
+
+	var hash string
+	row = tx.Stmt(rp.passwordStmts.usePasswordResetStmt).QueryRowContext(pctx, time.Now().UTC(), tokenHash)
+	if err := row.Scan(&hash); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to mark password reset used")
+		return accountID, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to commit password reset")
+		return accountID, err
+	}
+
+	span.SetStatus(codes.Ok, "Password reset successfully")
+	return accountID, nil
+}
+
+// RevokeAccountSessions revokes every active session of the account except
+// exceptSessionID, which may be empty, and returns the revoked session IDs.
+func (rp authRepo) RevokeAccountSessions(
+	ctx context.Context,
+	accountID, exceptSessionID string,
+) ([]string, error) {
+	tracer := otel.Tracer("auth-service")
+	sctx, span := tracer.Start(ctx, "Repo:RevokeAccountSessions")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "revoke_account_sessions"),
+		attribute.String("layer", "repository"),
+		attribute.String("db.operation", "UPDATE"),
+		attribute.String("user.id", accountID),
+	)
+
+	rows, err := rp.passwordStmts.revokeAccountSessionsStmt.QueryContext(
+		sctx,
+		time.Now().UTC(),
+		accountID,
+		exceptSessionID,
+	)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to revoke account sessions")
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var revoked []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "Failed to scan revoked session")
+			return nil, err
+		}
+
+		revoked = append(revoked, id)
+	}
+
+	span.SetAttributes(attribute.Int("sessions.revoked", len(revoked)))
+	span.SetStatus(codes.Ok, "Account sessions revoked successfully")
+	return revoked, nil
+}
@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/sony-nurdianto/farm/auth/internal/entity"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+var (
+	ErrRegistrationPending  error = errors.New("registration is already pending for this email")
+	ErrRegistrationNotFound error = errors.New("registration is not found")
+)
+
+// CreateRegistration records a pending signup before its records are
+// published. It fails with ErrRegistrationPending when the email already has
+// a signup in flight.
+func (rp authRepo) CreateRegistration(ctx context.Context, userID, email string) error {
+	tracer := otel.Tracer("auth-service")
+	rctx, span := tracer.Start(ctx, "Repo:CreateRegistration")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "create_registration"),
+		attribute.String("layer", "repository"),
+		attribute.String("db.operation", "INSERT"),
+		attribute.String("user.id", userID),
+	)
+
+	var id string
+	row := rp.registrationStmts.createRegistrationStmt.QueryRowContext(rctx, userID, email)
+	if err := row.Scan(&id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			span.SetStatus(codes.Error, "Registration already pending")
+			return ErrRegistrationPending
+		}
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to insert registration")
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "Registration created successfully")
+	return nil
+}
+
+func (rp authRepo) GetRegistration(ctx context.Context, userID string) (reg entity.Registration, _ error) {
+	tracer := otel.Tracer("auth-service")
+	rctx, span := tracer.Start(ctx, "Repo:GetRegistration")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "get_registration"),
+		attribute.String("layer", "repository"),
+		attribute.String("db.operation", "SELECT"),
+		attribute.String("user.id", userID),
+	)
+
+	row := rp.registrationStmts.getRegistrationStmt.QueryRowContext(rctx, userID)
+	err := row.Scan(
+		&reg.UserId,
+		&reg.Status,
+		&reg.FailureReason,
+		&reg.CreatedAt,
+		&reg.UpdatedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		span.SetStatus(codes.Error, "Registration not found")
+		return reg, ErrRegistrationNotFound
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to get registration")
+		return reg, err
+	}
+
+	span.SetStatus(codes.Ok, "Registration found")
+	return reg, nil
+}
+
+// FailRegistration marks a pending registration failed. A registration that
+// already completed or failed is left as it is.
+func (rp authRepo) FailRegistration(ctx context.Context, userID, reason string) error {
+	tracer := otel.Tracer("auth-service")
+	rctx, span := tracer.Start(ctx, "Repo:FailRegistration")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "fail_registration"),
+		attribute.String("layer", "repository"),
+		attribute.String("db.operation", "UPDATE"),
+		attribute.String("user.id", userID),
+	)
+
+	var id string
+	row := rp.registrationStmts.failRegistrationStmt.QueryRowContext(rctx, reason, userID)
+	if err := row.Scan(&id); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to mark registration failed")
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "Registration marked failed")
+	return nil
+}
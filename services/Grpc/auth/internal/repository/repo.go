@@ -5,12 +5,15 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/sony-nurdianto/farm/auth/internal/concurrent"
 	"github.com/sony-nurdianto/farm/auth/internal/constants"
 	"github.com/sony-nurdianto/farm/auth/internal/entity"
+	"github.com/sony-nurdianto/farm/auth/internal/models"
 	"github.com/sony-nurdianto/farm/shared_lib/Go/database/postgres/pkg"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/database/redis"
 	"github.com/sony-nurdianto/farm/shared_lib/Go/kafkaev/avr"
 	"github.com/sony-nurdianto/farm/shared_lib/Go/kafkaev/kev"
 	"github.com/sony-nurdianto/farm/shared_lib/Go/kafkaev/schrgs"
@@ -21,6 +24,7 @@ import (
 //go:generate mockgen -destination=../../test/mocks/mock_confluent_client.go -package=mocks github.com/confluentinc/confluent-kafka-go/v2/schemaregistry Client
 //go:generate mockgen -package=mocks -destination=../../test/mocks/mock_avr.go  github.com/sony-nurdianto/farm/shared_lib/Go/kafkaev/avr AvrSerdeInstance,AvrSerializer,AvrDeserializer
 //go:generate mockgen -package=mocks -destination=../../test/mocks/mock_kev.go  github.com/sony-nurdianto/farm/shared_lib/Go/kafkaev/kev Kafka,KevProducer
+//go:generate mockgen -package=mocks -destination=../../test/mocks/mock_redis.go  github.com/sony-nurdianto/farm/shared_lib/Go/database/redis RedisInstance
 //go:generate mockgen -package=mocks -destination=../../test/mocks/mock_authrepo.go -source=repo.go
 
 const TRANSACTIONAL_ID string = "register-user"
@@ -28,14 +32,95 @@ const TRANSACTIONAL_ID string = "register-user"
 type AuthRepo interface {
 	CreateUserAsync(ctx context.Context, id, email, fullName, phone, passwordHash string) error
 	GetUserByEmail(ctx context.Context, email string) (user entity.Users, _ error)
+	CreateSession(ctx context.Context, session entity.Session, refreshHash string) (entity.Session, error)
+	RotateRefreshToken(ctx context.Context, oldHash, newHash string, expiresAt time.Time) (entity.Session, error)
+	ListSessions(ctx context.Context, accountID string) ([]entity.Session, error)
+	RevokeSession(ctx context.Context, accountID, sessionID string) (entity.Session, error)
+	RevokeSessionCache(ctx context.Context, sessionID string, ttl time.Duration) error
+	IsSessionRevoked(ctx context.Context, sessionID string) (bool, error)
+	CreateEmailVerification(ctx context.Context, accountID, email, tokenHash string, expiresAt time.Time) error
+	VerifyEmail(ctx context.Context, tokenHash string) (accountID string, _ error)
+	GetUserByID(ctx context.Context, id string) (user entity.Users, _ error)
+	UpdatePasswordHash(ctx context.Context, accountID, passwordHash string) error
+	CreatePasswordReset(ctx context.Context, accountID, tokenHash string, expiresAt time.Time) error
+	ResetPassword(ctx context.Context, tokenHash, passwordHash string) (accountID string, _ error)
+	RevokeAccountSessions(ctx context.Context, accountID, exceptSessionID string) ([]string, error)
+	CountLoginFailures(ctx context.Context, scope LoginScope, value string, window time.Duration) (entity.LoginWindow, error)
+	RecordLoginFailure(ctx context.Context, scope LoginScope, value string, window time.Duration) (entity.LoginWindow, error)
+	ClearLoginFailures(ctx context.Context, email string) error
+	LoginLockTTL(ctx context.Context, email string) (time.Duration, error)
+	LockLogin(ctx context.Context, email string, base, max time.Duration) (time.Duration, error)
+	CreateLoginAttempt(ctx context.Context, attempt entity.LoginAttempt) error
+	PublishUserLogin(ctx context.Context, login models.UserLogin) error
+	SaveTOTPEnrollment(ctx context.Context, accountID, sealedSecret string, recoveryHashes []string) error
+	GetTOTP(ctx context.Context, accountID string) (entity.TOTP, error)
+	EnableTOTP(ctx context.Context, accountID string) error
+	DisableTOTP(ctx context.Context, accountID string) error
+	UseRecoveryCode(ctx context.Context, accountID, codeHash string) (bool, error)
+	UseTOTPStep(ctx context.Context, accountID string, step int64, ttl time.Duration) (bool, error)
+	CreateLoginChallenge(ctx context.Context, challengeHash, accountID string, ttl time.Duration) error
+	AttemptLoginChallenge(ctx context.Context, challengeHash string) (accountID string, attempts int64, _ error)
+	DeleteLoginChallenge(ctx context.Context, challengeHash string) error
+	CreateRegistration(ctx context.Context, userID, email string) error
+	GetRegistration(ctx context.Context, userID string) (entity.Registration, error)
+	FailRegistration(ctx context.Context, userID, reason string) error
 }
 
 type authRepo struct {
-	schemaRegisteryClient schrgs.SchrgsClient
-	db                    pkg.PostgresDatabase
-	avroSerializer        avr.AvrSerializer
-	getUserByEmailStmt    pkg.Stmt
-	authProducer          kev.KevProducer
+	schemaRegisteryClient  schrgs.SchrgsClient
+	db                     pkg.PostgresDatabase
+	avroSerializer         avr.AvrSerializer
+	getUserByEmailStmt     pkg.Stmt
+	sessionStmts           sessionStmts
+	verificationStmts      verificationStmts
+	passwordStmts          passwordStmts
+	totpStmts              totpStmts
+	registrationStmts      registrationStmts
+	createLoginAttemptStmt pkg.Stmt
+	authProducer           kev.KevProducer
+	producerMu             *sync.Mutex
+	authCache              redis.RedisClient
+}
+
+type verificationStmts struct {
+	createEmailVerificationStmt pkg.Stmt
+	getEmailVerificationStmt    pkg.Stmt
+	useEmailVerificationStmt    pkg.Stmt
+}
+
+type totpStmts struct {
+	setTOTPSecretStmt       pkg.Stmt
+	getTOTPStmt             pkg.Stmt
+	enableTOTPStmt          pkg.Stmt
+	disableTOTPStmt         pkg.Stmt
+	deleteRecoveryCodesStmt pkg.Stmt
+	createRecoveryCodeStmt  pkg.Stmt
+	useRecoveryCodeStmt     pkg.Stmt
+}
+
+type registrationStmts struct {
+	createRegistrationStmt pkg.Stmt
+	getRegistrationStmt    pkg.Stmt
+	failRegistrationStmt   pkg.Stmt
+}
+
+type passwordStmts struct {
+	getUserByIDStmt           pkg.Stmt
+	updatePasswordHashStmt    pkg.Stmt
+	createPasswordResetStmt   pkg.Stmt
+	getPasswordResetStmt      pkg.Stmt
+	usePasswordResetStmt      pkg.Stmt
+	revokeAccountSessionsStmt pkg.Stmt
+}
+
+type sessionStmts struct {
+	createSessionStmt          pkg.Stmt
+	createRefreshTokenStmt     pkg.Stmt
+	getRefreshTokenSessionStmt pkg.Stmt
+	useRefreshTokenStmt        pkg.Stmt
+	touchSessionStmt           pkg.Stmt
+	revokeSessionStmt          pkg.Stmt
+	listSessionsStmt           pkg.Stmt
 }
 
 func prepareStmt(query string, db pkg.PostgresDatabase) (pkg.Stmt, error) {
@@ -47,6 +132,17 @@ func prepareStmt(query string, db pkg.PostgresDatabase) (pkg.Stmt, error) {
 	return db.Prepare(facQuery)
 }
 
+func prepareSessionStmt(query string, db pkg.PostgresDatabase) (pkg.Stmt, error) {
+	facQuery := fmt.Sprintf(
+		query,
+		constants.SESSION_TABLE,
+		constants.REFRESH_TOKEN_TABLE,
+		constants.ACCOUNT_TABLE,
+	)
+
+	return db.Prepare(facQuery)
+}
+
 func send(
 	ctx context.Context,
 	send chan any,
@@ -60,8 +156,14 @@ func send(
 }
 
 type repoPgDB struct {
-	db                 pkg.PostgresDatabase
-	getUserByEmailStmt pkg.Stmt
+	db                     pkg.PostgresDatabase
+	getUserByEmailStmt     pkg.Stmt
+	sessionStmts           sessionStmts
+	verificationStmts      verificationStmts
+	passwordStmts          passwordStmts
+	totpStmts              totpStmts
+	registrationStmts      registrationStmts
+	createLoginAttemptStmt pkg.Stmt
 }
 
 func initPostgresDB(ctx context.Context, pgi pkg.PostgresInstance) <-chan any {
@@ -80,7 +182,7 @@ func initPostgresDB(ctx context.Context, pgi pkg.PostgresInstance) <-chan any {
 			os.Getenv("DBNAME"),
 		)
 
-		db, err := pkg.OpenPostgres(dbAddrs, pgi)
+		db, err := pkg.OpenPostgres(dbAddrs, pgi, pkg.WithTelemetry("auth"))
 		if err != nil {
 			res.Error = err
 			send(ctx, out, res)
@@ -129,6 +231,147 @@ func prepareDB(ctx context.Context, dbChan <-chan any) <-chan any {
 
 		res.Value.getUserByEmailStmt = gue
 
+		ss := &res.Value.sessionStmts
+		stmts := []struct {
+			query string
+			stmt  *pkg.Stmt
+		}{
+			{constants.QUERY_CREATE_SESSION, &ss.createSessionStmt},
+			{constants.QUERY_CREATE_REFRESH_TOKEN, &ss.createRefreshTokenStmt},
+			{constants.QUERY_GET_REFRESH_TOKEN_SESSION, &ss.getRefreshTokenSessionStmt},
+			{constants.QUERY_USE_REFRESH_TOKEN, &ss.useRefreshTokenStmt},
+			{constants.QUERY_TOUCH_SESSION, &ss.touchSessionStmt},
+			{constants.QUERY_REVOKE_SESSION, &ss.revokeSessionStmt},
+			{constants.QUERY_LIST_SESSIONS, &ss.listSessionsStmt},
+		}
+
+		for _, st := range stmts {
+			prepared, err := prepareSessionStmt(st.query, dbres.Value)
+			if err != nil {
+				res.Error = err
+				send(ctx, out, res)
+				return
+			}
+			*st.stmt = prepared
+		}
+
+		vs := &res.Value.verificationStmts
+		verifyStmts := []struct {
+			query string
+			stmt  *pkg.Stmt
+		}{
+			{constants.QUERY_CREATE_EMAIL_VERIFICATION, &vs.createEmailVerificationStmt},
+			{constants.QUERY_GET_EMAIL_VERIFICATION, &vs.getEmailVerificationStmt},
+			{constants.QUERY_USE_EMAIL_VERIFICATION, &vs.useEmailVerificationStmt},
+		}
+
+		for _, st := range verifyStmts {
+			prepared, err := dbres.Value.Prepare(fmt.Sprintf(st.query, constants.EMAIL_VERIFICATION_TABLE))
+			if err != nil {
+				res.Error = err
+				send(ctx, out, res)
+				return
+			}
+			*st.stmt = prepared
+		}
+
+		cla, err := dbres.Value.Prepare(fmt.Sprintf(constants.QUERY_CREATE_LOGIN_ATTEMPT, constants.LOGIN_ATTEMPT_TABLE))
+		if err != nil {
+			res.Error = err
+			send(ctx, out, res)
+			return
+		}
+
+		res.Value.createLoginAttemptStmt = cla
+
+		ps := &res.Value.passwordStmts
+		guid, err := prepareStmt(constants.QUERY_GET_USER_BY_ID, dbres.Value)
+		if err != nil {
+			res.Error = err
+			send(ctx, out, res)
+			return
+		}
+
+		ps.getUserByIDStmt = guid
+
+		passStmts := []struct {
+			query string
+			stmt  *pkg.Stmt
+		}{
+			{constants.QUERY_UPDATE_PASSWORD_HASH, &ps.updatePasswordHashStmt},
+			{constants.QUERY_CREATE_PASSWORD_RESET, &ps.createPasswordResetStmt},
+			{constants.QUERY_GET_PASSWORD_RESET, &ps.getPasswordResetStmt},
+			{constants.QUERY_USE_PASSWORD_RESET, &ps.usePasswordResetStmt},
+			{constants.QUERY_REVOKE_ACCOUNT_SESSIONS, &ps.revokeAccountSessionsStmt},
+		}
+
+		for _, st := range passStmts {
+			facQuery := fmt.Sprintf(
+				st.query,
+				constants.PASSWORD_RESET_TABLE,
+				constants.ACCOUNT_TABLE,
+				constants.SESSION_TABLE,
+			)
+
+			prepared, err := dbres.Value.Prepare(facQuery)
+			if err != nil {
+				res.Error = err
+				send(ctx, out, res)
+				return
+			}
+			*st.stmt = prepared
+		}
+
+		ts := &res.Value.totpStmts
+		totpQueryStmts := []struct {
+			query string
+			stmt  *pkg.Stmt
+		}{
+			{constants.QUERY_SET_TOTP_SECRET, &ts.setTOTPSecretStmt},
+			{constants.QUERY_GET_TOTP, &ts.getTOTPStmt},
+			{constants.QUERY_ENABLE_TOTP, &ts.enableTOTPStmt},
+			{constants.QUERY_DISABLE_TOTP, &ts.disableTOTPStmt},
+			{constants.QUERY_DELETE_RECOVERY_CODES, &ts.deleteRecoveryCodesStmt},
+			{constants.QUERY_CREATE_RECOVERY_CODE, &ts.createRecoveryCodeStmt},
+			{constants.QUERY_USE_RECOVERY_CODE, &ts.useRecoveryCodeStmt},
+		}
+
+		for _, st := range totpQueryStmts {
+			facQuery := fmt.Sprintf(
+				st.query,
+				constants.ACCOUNT_TABLE,
+				constants.RECOVERY_CODE_TABLE,
+			)
+
+			prepared, err := dbres.Value.Prepare(facQuery)
+			if err != nil {
+				res.Error = err
+				send(ctx, out, res)
+				return
+			}
+			*st.stmt = prepared
+		}
+
+		rs := &res.Value.registrationStmts
+		regStmts := []struct {
+			query string
+			stmt  *pkg.Stmt
+		}{
+			{constants.QUERY_CREATE_REGISTRATION, &rs.createRegistrationStmt},
+			{constants.QUERY_GET_REGISTRATION, &rs.getRegistrationStmt},
+			{constants.QUERY_FAIL_REGISTRATION, &rs.failRegistrationStmt},
+		}
+
+		for _, st := range regStmts {
+			prepared, err := dbres.Value.Prepare(fmt.Sprintf(st.query, constants.REGISTRATION_TABLE))
+			if err != nil {
+				res.Error = err
+				send(ctx, out, res)
+				return
+			}
+			*st.stmt = prepared
+		}
+
 		send(ctx, out, res)
 	}()
 	return out
@@ -257,16 +500,71 @@ func initAuthProducer(ctx context.Context, kv kev.Kafka) <-chan any {
 	return out
 }
 
+func redisClientConn(ctx context.Context, rdi redis.RedisInstance) (redis.RedisClient, error) {
+	count := 0
+	rdb := redis.NewRedisDB(rdi)
+	var errConn error
+
+	for range 5 {
+		count++
+		rdc, err := rdb.InitRedisClient(
+			ctx,
+			&redis.FailoverOptions{
+				MasterName: os.Getenv("AUTH_REDIS_MASTER_NAME"),
+				SentinelAddrs: []string{
+					os.Getenv("SENTINEL_AUTH_REDIS_ADDR"),
+					os.Getenv("SENTINEL_AUTH_REDIS_ADDR_2"),
+				},
+				Username: os.Getenv("AUTH_REDIS_MASTER_USER_NAME"),
+				Password: os.Getenv("AUTH_REDIS_MASTER_PASSWORD"),
+				DB:       0,
+			},
+		)
+
+		if err == nil {
+			return rdc, nil
+		}
+
+		errConn = err
+		time.Sleep(time.Second * 2)
+	}
+
+	return nil, fmt.Errorf("connection failed after %d attempt: %w", count, errConn)
+}
+
+func initRedisDatabase(ctx context.Context, rdi redis.RedisInstance) <-chan any {
+	out := make(chan any, 1)
+	go func() {
+		defer close(out)
+		var res concurrent.Result[redis.RedisClient]
+
+		rdc, err := redisClientConn(ctx, rdi)
+		if err != nil {
+			res.Error = err
+			send(ctx, out, res)
+			return
+		}
+
+		res.Value = rdc
+		send(ctx, out, res)
+	}()
+
+	return out
+}
+
 func NewAuthRepo(
 	ctx context.Context,
 	sri schrgs.SchemaRegisteryInstance,
 	pgi pkg.PostgresInstance,
 	avri avr.AvrSerdeInstance,
 	kv kev.Kafka,
+	rdi redis.RedisInstance,
 ) (rp authRepo, _ error) {
 	opsCtx, done := context.WithTimeout(ctx, time.Second*30)
 	defer done()
 
+	rp.producerMu = &sync.Mutex{}
+
 	dbch := initPostgresDB(opsCtx, pgi)
 	src := initSchemaRegistery(opsCtx, sri)
 
@@ -274,6 +572,7 @@ func NewAuthRepo(
 		prepareDB(opsCtx, dbch),
 		schemaNSerializerPipe(opsCtx, avri, src),
 		initAuthProducer(opsCtx, kv),
+		initRedisDatabase(opsCtx, rdi),
 	}
 
 	for v := range concurrent.FanIn(opsCtx, chs...) {
@@ -284,6 +583,12 @@ func NewAuthRepo(
 			}
 			rp.db = res.Value.db
 			rp.getUserByEmailStmt = res.Value.getUserByEmailStmt
+			rp.sessionStmts = res.Value.sessionStmts
+			rp.verificationStmts = res.Value.verificationStmts
+			rp.passwordStmts = res.Value.passwordStmts
+			rp.totpStmts = res.Value.totpStmts
+			rp.registrationStmts = res.Value.registrationStmts
+			rp.createLoginAttemptStmt = res.Value.createLoginAttemptStmt
 
 		case concurrent.Result[schemaRegistryPair]:
 			if res.Error != nil {
@@ -297,6 +602,12 @@ func NewAuthRepo(
 				return rp, res.Error
 			}
 			rp.authProducer = res.Value
+
+		case concurrent.Result[redis.RedisClient]:
+			if res.Error != nil {
+				return rp, res.Error
+			}
+			rp.authCache = res.Value
 		}
 	}
 
@@ -307,5 +618,6 @@ func (rp authRepo) CloseRepo() {
 	rp.schemaRegisteryClient.Close()
 	rp.avroSerializer.Close()
 	rp.authProducer.Close()
+	rp.authCache.Close()
 	rp.db.Close()
 }
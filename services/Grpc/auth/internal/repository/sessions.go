@@ -0,0 +1,371 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sony-nurdianto/farm/auth/internal/entity"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/database/redis"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+var (
+	ErrSessionNotFound      error = errors.New("session is not found")
+	ErrSessionRevoked       error = errors.New("session is revoked")
+	ErrSessionExpired       error = errors.New("session is expired")
+	ErrRefreshTokenNotFound error = errors.New("refresh token is not found")
+	ErrRefreshTokenReused   error = errors.New("refresh token is already used")
+)
+
+func revokedSessionKey(sessionID string) string {
+	return fmt.Sprintf("revoked_session:%s", sessionID)
+}
+
+type sessionScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanSession(row sessionScanner, session *entity.Session) error {
+	return row.Scan(
+		&session.Id,
+		&session.AccountId,
+		&session.UserAgent,
+		&session.IpAddress,
+		&session.CreatedAt,
+		&session.LastUsedAt,
+		&session.ExpiresAt,
+		&session.RevokedAt,
+	)
+}
+
+func (rp authRepo) CreateSession(
+	ctx context.Context,
+	session entity.Session,
+	refreshHash string,
+) (res entity.Session, _ error) {
+	tracer := otel.Tracer("auth-service")
+	sctx, span := tracer.Start(ctx, "Repo:CreateSession")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "create_session"),
+		attribute.String("layer", "repository"),
+		attribute.String("db.operation", "INSERT"),
+		attribute.String("user.id", session.AccountId),
+	)
+
+	tx, err := rp.db.BeginTx(sctx, nil)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to begin transaction")
+		return res, err
+	}
+
+	defer tx.Rollback()
+
+	row := tx.Stmt(rp.sessionStmts.createSessionStmt).QueryRowContext(
+		sctx,
+		session.Id,
+		session.AccountId,
+		session.UserAgent,
+		session.IpAddress,
+		session.ExpiresAt,
+	)
+
+	if err := row.Scan(
+		&res.Id,
+		&res.AccountId,
+		&res.UserAgent,
+		&res.IpAddress,
+		&res.CreatedAt,
+		&res.LastUsedAt,
+		&res.ExpiresAt,
+	); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to insert session")
+		return res, err
+	}
+
+	var tokenHash string
+	row = tx.Stmt(rp.sessionStmts.createRefreshTokenStmt).QueryRowContext(sctx, refreshHash, res.Id)
+	if err := row.Scan(&tokenHash); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to insert refresh token")
+		return res, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to commit session")
+		return res, err
+	}
+
+	span.SetAttributes(attribute.String("session.id", res.Id))
+	span.SetStatus(codes.Ok, "Session created successfully")
+	return res, nil
+}
+
+// RotateRefreshToken swaps oldHash for newHash and slides the session expiry.
+// When oldHash was already rotated the session is revoked and returned along
+// with ErrRefreshTokenReused, so the caller can revoke it in the cache too.
+func (rp authRepo) RotateRefreshToken(
+	ctx context.Context,
+	oldHash, newHash string,
+	expiresAt time.Time,
+) (session entity.Session, _ error) {
+	tracer := otel.Tracer("auth-service")
+	sctx, span := tracer.Start(ctx, "Repo:RotateRefreshToken")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "rotate_refresh_token"),
+		attribute.String("layer", "repository"),
+		attribute.String("db.operation", "UPDATE"),
+	)
+
+	tx, err := rp.db.BeginTx(sctx, nil)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to begin transaction")
+		return session, err
+	}
+
+	defer tx.Rollback()
+
+	var usedAt *time.Time
+	row := tx.Stmt(rp.sessionStmts.getRefreshTokenSessionStmt).QueryRowContext(sctx, oldHash)
+	if err := row.Scan(
+		&usedAt,
+		&session.Id,
+		&session.AccountId,
+		&session.UserAgent,
+		&session.IpAddress,
+		&session.CreatedAt,
+		&session.LastUsedAt,
+		&session.ExpiresAt,
+		&session.RevokedAt,
+		&session.AccountVerified,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			span.SetStatus(codes.Error, "Refresh token not found")
+			return session, ErrRefreshTokenNotFound
+		}
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to get refresh token")
+		return session, err
+	}
+
+	span.SetAttributes(
+		attribute.String("session.id", session.Id),
+		attribute.String("user.id", session.AccountId),
+	)
+
+	if session.RevokedAt != nil {
+		span.SetStatus(codes.Error, "Session is revoked")
+		return session, ErrSessionRevoked
+	}
+
+	now := time.Now().UTC()
+
+	if usedAt != nil {
+		span.AddEvent("refresh_token_reuse_detected")
+
+		var revoked entity.Session
+		row := tx.Stmt(rp.sessionStmts.revokeSessionStmt).QueryRowContext(sctx, now, session.Id, session.AccountId)
+		if err := scanSession(row, &revoked); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "Failed to revoke reused session")
+			return session, err
+		}
+
+		if err := tx.Commit(); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "Failed to commit revoked session")
+			return session, err
+		}
+
+		span.SetStatus(codes.Error, "Refresh token reused session revoked")
+		return revoked, ErrRefreshTokenReused
+	}
+
+	if session.ExpiresAt.Before(now) {
+		span.SetStatus(codes.Error, "Session is expired")
+		return session, ErrSessionExpired
+	}
+
+	var usedHash string
+	row = tx.Stmt(rp.sessionStmts.useRefreshTokenStmt).QueryRowContext(sctx, now, oldHash)
+	if err := row.Scan(&usedHash); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to mark refresh token used")
+		return session, err
+	}
+
+	var tokenHash string
+	row = tx.Stmt(rp.sessionStmts.createRefreshTokenStmt).QueryRowContext(sctx, newHash, session.Id)
+	if err := row.Scan(&tokenHash); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to insert refresh token")
+		return session, err
+	}
+
+	row = tx.Stmt(rp.sessionStmts.touchSessionStmt).QueryRowContext(sctx, now, expiresAt, session.Id)
+	if err := row.Scan(&session.LastUsedAt, &session.ExpiresAt); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to update session")
+		return session, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to commit refresh token")
+		return session, err
+	}
+
+	span.SetStatus(codes.Ok, "Refresh token rotated successfully")
+	return session, nil
+}
+
+func (rp authRepo) ListSessions(ctx context.Context, accountID string) ([]entity.Session, error) {
+	tracer := otel.Tracer("auth-service")
+	sctx, span := tracer.Start(ctx, "Repo:ListSessions")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "list_sessions"),
+		attribute.String("layer", "repository"),
+		attribute.String("db.operation", "SELECT"),
+		attribute.String("user.id", accountID),
+	)
+
+	rows, err := rp.sessionStmts.listSessionsStmt.QueryContext(sctx, accountID, time.Now().UTC())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to list sessions")
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var sessions []entity.Session
+	for rows.Next() {
+		var session entity.Session
+		if err := scanSession(rows, &session); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "Failed to scan session")
+			return nil, err
+		}
+
+		sessions = append(sessions, session)
+	}
+
+	span.SetAttributes(attribute.Int("sessions.count", len(sessions)))
+	span.SetStatus(codes.Ok, "Sessions listed successfully")
+	return sessions, nil
+}
+
+func (rp authRepo) RevokeSession(
+	ctx context.Context,
+	accountID, sessionID string,
+) (session entity.Session, _ error) {
+	tracer := otel.Tracer("auth-service")
+	sctx, span := tracer.Start(ctx, "Repo:RevokeSession")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "revoke_session"),
+		attribute.String("layer", "repository"),
+		attribute.String("db.operation", "UPDATE"),
+		attribute.String("user.id", accountID),
+		attribute.String("session.id", sessionID),
+	)
+
+	row := rp.sessionStmts.revokeSessionStmt.QueryRowContext(sctx, time.Now().UTC(), sessionID, accountID)
+	if err := scanSession(row, &session); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			span.SetStatus(codes.Error, "Session not found")
+			return session, ErrSessionNotFound
+		}
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to revoke session")
+		return session, err
+	}
+
+	span.SetStatus(codes.Ok, "Session revoked successfully")
+	return session, nil
+}
+
+// RevokeSessionCache puts the session on the revocation list. ttl only has to
+// outlive the access tokens issued for the session, refresh tokens are
+// checked against the database.
+func (rp authRepo) RevokeSessionCache(ctx context.Context, sessionID string, ttl time.Duration) error {
+	tracer := otel.Tracer("auth-service")
+	sctx, span := tracer.Start(ctx, "Repo:RevokeSessionCache")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "revoke_session_cache"),
+		attribute.String("layer", "repository"),
+		attribute.String("session.id", sessionID),
+	)
+
+	key := revokedSessionKey(sessionID)
+	pipe := rp.authCache.TxPipeline()
+
+	hset := pipe.HSet(sctx, key, "revoked_at", time.Now().UTC().Format(time.RFC3339))
+	if hset.Err() != nil {
+		span.RecordError(hset.Err())
+		span.SetStatus(codes.Error, "Failed to set revoked session")
+		return hset.Err()
+	}
+
+	expire := pipe.Expire(sctx, key, ttl)
+	if expire.Err() != nil {
+		span.RecordError(expire.Err())
+		span.SetStatus(codes.Error, "Failed to expire revoked session")
+		return expire.Err()
+	}
+
+	if _, err := pipe.Exec(sctx); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to revoke session in cache")
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "Session revoked in cache")
+	return nil
+}
+
+func (rp authRepo) IsSessionRevoked(ctx context.Context, sessionID string) (bool, error) {
+	tracer := otel.Tracer("auth-service")
+	sctx, span := tracer.Start(ctx, "Repo:IsSessionRevoked")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "is_session_revoked"),
+		attribute.String("layer", "repository"),
+		attribute.String("session.id", sessionID),
+	)
+
+	err := rp.authCache.HGet(sctx, revokedSessionKey(sessionID), "revoked_at").Err()
+	if errors.Is(err, redis.RedisNil) {
+		span.SetStatus(codes.Ok, "Session is active")
+		return false, nil
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to check revoked session")
+		return false, err
+	}
+
+	span.SetStatus(codes.Ok, "Session is revoked")
+	return true, nil
+}
@@ -0,0 +1,355 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sony-nurdianto/farm/auth/internal/entity"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/database/postgres/pkg"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/database/redis"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+var (
+	ErrTOTPAlreadyEnabled     error = errors.New("totp is already enabled")
+	ErrTOTPNotEnrolled        error = errors.New("totp is not enrolled")
+	ErrLoginChallengeNotFound error = errors.New("login challenge is not found")
+)
+
+func loginChallengeKey(challengeHash string) string {
+	return fmt.Sprintf("login_challenge:%s", challengeHash)
+}
+
+func totpStepKey(accountID string, step int64) string {
+	return fmt.Sprintf("totp_step:%s:%d", accountID, step)
+}
+
+// SaveTOTPEnrollment stores a pending secret and replaces the recovery codes
+// in one transaction. It fails with ErrTOTPAlreadyEnabled for an account that
+// already confirmed an enrollment.
+func (rp authRepo) SaveTOTPEnrollment(
+	ctx context.Context,
+	accountID, sealedSecret string,
+	recoveryHashes []string,
+) error {
+	tracer := otel.Tracer("auth-service")
+	tctx, span := tracer.Start(ctx, "Repo:SaveTOTPEnrollment")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "save_totp_enrollment"),
+		attribute.String("layer", "repository"),
+		attribute.String("db.operation", "UPDATE"),
+		attribute.String("user.id", accountID),
+	)
+
+	tx, err := rp.db.BeginTx(tctx, nil)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to begin transaction")
+		return err
+	}
+
+	defer tx.Rollback()
+
+	var id string
+	row := tx.Stmt(rp.totpStmts.setTOTPSecretStmt).QueryRowContext(tctx, sealedSecret, accountID)
+	if err := row.Scan(&id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			span.SetStatus(codes.Error, "TOTP already enabled")
+			return ErrTOTPAlreadyEnabled
+		}
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to set totp secret")
+		return err
+	}
+
+	if err := rp.replaceRecoveryCodes(tctx, tx, accountID, recoveryHashes); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to replace recovery codes")
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to commit totp enrollment")
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "TOTP enrollment saved")
+	return nil
+}
+
+func (rp authRepo) replaceRecoveryCodes(
+	ctx context.Context,
+	tx pkg.SQLTx,
+	accountID string,
+	recoveryHashes []string,
+) error {
+	rows, err := tx.Stmt(rp.totpStmts.deleteRecoveryCodesStmt).QueryContext(ctx, accountID)
+	if err != nil {
+		return err
+	}
+	rows.Close()
+
+	for _, hash := range recoveryHashes {
+		var created string
+		row := tx.Stmt(rp.totpStmts.createRecoveryCodeStmt).QueryRowContext(ctx, hash, accountID)
+		if err := row.Scan(&created); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (rp authRepo) GetTOTP(ctx context.Context, accountID string) (totp entity.TOTP, _ error) {
+	tracer := otel.Tracer("auth-service")
+	tctx, span := tracer.Start(ctx, "Repo:GetTOTP")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "get_totp"),
+		attribute.String("layer", "repository"),
+		attribute.String("db.operation", "SELECT"),
+		attribute.String("user.id", accountID),
+	)
+
+	var secret sql.NullString
+	row := rp.totpStmts.getTOTPStmt.QueryRowContext(tctx, accountID)
+	if err := row.Scan(&secret, &totp.Enabled); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to get totp")
+		return totp, err
+	}
+
+	totp.Secret = secret.String
+	span.SetStatus(codes.Ok, "TOTP retrieved successfully")
+	return totp, nil
+}
+
+func (rp authRepo) EnableTOTP(ctx context.Context, accountID string) error {
+	tracer := otel.Tracer("auth-service")
+	tctx, span := tracer.Start(ctx, "Repo:EnableTOTP")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "enable_totp"),
+		attribute.String("layer", "repository"),
+		attribute.String("db.operation", "UPDATE"),
+		attribute.String("user.id", accountID),
+	)
+
+	var id string
+	row := rp.totpStmts.enableTOTPStmt.QueryRowContext(tctx, time.Now().UTC(), accountID)
+	if err := row.Scan(&id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			span.SetStatus(codes.Error, "TOTP not enrolled")
+			return ErrTOTPNotEnrolled
+		}
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to enable totp")
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "TOTP enabled")
+	return nil
+}
+
+// DisableTOTP removes the secret and every recovery code of the account.
+func (rp authRepo) DisableTOTP(ctx context.Context, accountID string) error {
+	tracer := otel.Tracer("auth-service")
+	tctx, span := tracer.Start(ctx, "Repo:DisableTOTP")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "disable_totp"),
+		attribute.String("layer", "repository"),
+		attribute.String("db.operation", "UPDATE"),
+		attribute.String("user.id", accountID),
+	)
+
+	tx, err := rp.db.BeginTx(tctx, nil)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to begin transaction")
+		return err
+	}
+
+	defer tx.Rollback()
+
+	var id string
+	row := tx.Stmt(rp.totpStmts.disableTOTPStmt).QueryRowContext(tctx, accountID)
+	if err := row.Scan(&id); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to disable totp")
+		return err
+	}
+
+	if err := rp.replaceRecoveryCodes(tctx, tx, accountID, nil); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to delete recovery codes")
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to commit totp disable")
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "TOTP disabled")
+	return nil
+}
+
+// UseRecoveryCode marks the code used and reports whether it was a valid,
+// unused code of the account.
+func (rp authRepo) UseRecoveryCode(ctx context.Context, accountID, codeHash string) (bool, error) {
+	tracer := otel.Tracer("auth-service")
+	tctx, span := tracer.Start(ctx, "Repo:UseRecoveryCode")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "use_recovery_code"),
+		attribute.String("layer", "repository"),
+		attribute.String("db.operation", "UPDATE"),
+		attribute.String("user.id", accountID),
+	)
+
+	var hash string
+	row := rp.totpStmts.useRecoveryCodeStmt.QueryRowContext(tctx, time.Now().UTC(), accountID, codeHash)
+	if err := row.Scan(&hash); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			span.SetStatus(codes.Ok, "Recovery code not valid")
+			return false, nil
+		}
+
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to use recovery code")
+		return false, err
+	}
+
+	span.SetStatus(codes.Ok, "Recovery code used")
+	return true, nil
+}
+
+// UseTOTPStep reports whether the step was not used yet by the account and
+// marks it used, so a code can not be replayed within its validity.
+func (rp authRepo) UseTOTPStep(ctx context.Context, accountID string, step int64, ttl time.Duration) (bool, error) {
+	tracer := otel.Tracer("auth-service")
+	tctx, span := tracer.Start(ctx, "Repo:UseTOTPStep")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "use_totp_step"),
+		attribute.String("layer", "repository"),
+		attribute.String("user.id", accountID),
+	)
+
+	pipe := rp.authCache.TxPipeline()
+	set := pipe.SetNX(tctx, totpStepKey(accountID, step), 1, ttl)
+	if _, err := pipe.Exec(tctx); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to mark totp step used")
+		return false, err
+	}
+
+	span.SetStatus(codes.Ok, "TOTP step checked")
+	return set.Val(), nil
+}
+
+// CreateLoginChallenge keeps the account waiting for its second factor
+// under the challenge hash for ttl.
+func (rp authRepo) CreateLoginChallenge(
+	ctx context.Context,
+	challengeHash, accountID string,
+	ttl time.Duration,
+) error {
+	tracer := otel.Tracer("auth-service")
+	cctx, span := tracer.Start(ctx, "Repo:CreateLoginChallenge")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "create_login_challenge"),
+		attribute.String("layer", "repository"),
+		attribute.String("user.id", accountID),
+	)
+
+	key := loginChallengeKey(challengeHash)
+	pipe := rp.authCache.TxPipeline()
+	pipe.HSet(cctx, key, "account_id", accountID, "attempts", 0)
+	pipe.Expire(cctx, key, ttl)
+	if _, err := pipe.Exec(cctx); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to create login challenge")
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "Login challenge created")
+	return nil
+}
+
+// AttemptLoginChallenge counts one verification attempt on the challenge
+// and returns the account it belongs to with the attempts made so far.
+func (rp authRepo) AttemptLoginChallenge(
+	ctx context.Context,
+	challengeHash string,
+) (accountID string, attempts int64, _ error) {
+	tracer := otel.Tracer("auth-service")
+	cctx, span := tracer.Start(ctx, "Repo:AttemptLoginChallenge")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "attempt_login_challenge"),
+		attribute.String("layer", "repository"),
+	)
+
+	key := loginChallengeKey(challengeHash)
+	pipe := rp.authCache.TxPipeline()
+	count := pipe.HIncrBy(cctx, key, "attempts", 1)
+	account := pipe.HGet(cctx, key, "account_id")
+	_, err := pipe.Exec(cctx)
+	if errors.Is(err, redis.RedisNil) {
+		// HINCRBY recreated an expired challenge without a ttl, drop it again
+		rp.authCache.Del(cctx, key)
+		span.SetStatus(codes.Error, "Login challenge not found")
+		return "", 0, ErrLoginChallengeNotFound
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to read login challenge")
+		return "", 0, err
+	}
+
+	span.SetAttributes(attribute.String("user.id", account.Val()))
+	span.SetStatus(codes.Ok, "Login challenge attempted")
+	return account.Val(), count.Val(), nil
+}
+
+func (rp authRepo) DeleteLoginChallenge(ctx context.Context, challengeHash string) error {
+	tracer := otel.Tracer("auth-service")
+	cctx, span := tracer.Start(ctx, "Repo:DeleteLoginChallenge")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "delete_login_challenge"),
+		attribute.String("layer", "repository"),
+	)
+
+	if err := rp.authCache.Del(cctx, loginChallengeKey(challengeHash)).Err(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to delete login challenge")
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "Login challenge deleted")
+	return nil
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/sony-nurdianto/farm/auth/internal/constants"
+	"github.com/sony-nurdianto/farm/auth/internal/models"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/kafkaev/kev"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// PublishUserLogin produces a user-login event keyed by the user id, so the
+// events of one user stay ordered on a single partition.
+func (rp authRepo) PublishUserLogin(ctx context.Context, login models.UserLogin) error {
+	tracer := otel.Tracer("auth-service")
+	pctx, span := tracer.Start(ctx, "Repo:PublishUserLogin")
+	defer span.End()
+
+	topic := constants.USER_LOGIN_TOPIC
+	span.SetAttributes(
+		attribute.String("operation", "publish_user_login"),
+		attribute.String("layer", "repository"),
+		attribute.String("messaging.system", "kafka"),
+		attribute.String("messaging.destination", topic),
+		attribute.String("user.id", login.UserId),
+		attribute.String("login.outcome", login.Outcome),
+	)
+
+	payload, err := rp.avroSerializer.Serialize(topic, &login)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to serialize user login")
+		return err
+	}
+
+	record := kev.MessageKafka{
+		TopicPartition: kev.KafkaTopicPartition{
+			Topic:     &topic,
+			Partition: kev.KafkaPartitionAny,
+		},
+		Key:     []byte(login.UserId),
+		Value:   payload,
+		Headers: kev.TraceHeaders(pctx),
+	}.Factory()
+
+	txCtx, cancel := context.WithTimeout(context.Background(), time.Millisecond*1000)
+	defer cancel()
+
+	rp.producerMu.Lock()
+	defer rp.producerMu.Unlock()
+
+	if err := rp.authProducer.BeginTransaction(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to begin kafka transaction")
+		return err
+	}
+
+	if err := rp.authProducer.Produce(&record, nil); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to produce user login")
+		if abortErr := rp.authProducer.AbortTransaction(txCtx); abortErr != nil {
+			span.RecordError(abortErr)
+		}
+		return err
+	}
+
+	if err := rp.authProducer.CommitTransaction(txCtx); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to commit kafka transaction")
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "User login published")
+	return nil
+}
@@ -10,7 +10,9 @@ import (
 	"github.com/sony-nurdianto/farm/shared_lib/Go/observability/otel/logs"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	otelCodes "go.opentelemetry.io/otel/codes"
 )
@@ -28,13 +30,19 @@ func handleRegisterError(ctx context.Context, err error, errRecorder recorderr.E
 	fullMethodName := pbgen.AuthService_RegisterUser_FullMethodName
 	switch {
 	case err == usecase.ErrorUserIsExist:
-		return errRecorder.Record(ctx, codes.AlreadyExists, fullMethodName, err.Error())
+		return errRecorder.RecordWithDetails(
+			ctx,
+			codes.AlreadyExists,
+			fullMethodName,
+			err.Error(),
+			recorderr.ErrorInfo(recorderr.ReasonUserExists),
+		)
 	case errors.Is(err, usecase.ErrorFailedToHasshPassword):
-		return errRecorder.Record(ctx, codes.Internal, fullMethodName, err.Error())
+		return errRecorder.RecordInternal(ctx, fullMethodName, err)
 	case errors.Is(err, usecase.ErrorRegisterUser):
-		return errRecorder.Record(ctx, codes.Internal, fullMethodName, err.Error())
+		return errRecorder.RecordInternal(ctx, fullMethodName, err)
 	default:
-		return errRecorder.Record(ctx, codes.Internal, fullMethodName, err.Error())
+		return errRecorder.RecordInternal(ctx, fullMethodName, err)
 	}
 }
 
@@ -66,13 +74,32 @@ func (ass *AuthServiceServer) RegisterUser(
 
 func handleAutUserErr(ctx context.Context, err error, errRecorder recorderr.ErrorRecorder) error {
 	fullMethodName := pbgen.AuthService_AuthenticateUser_FullMethodName
+
+	var throttled *usecase.LoginThrottledError
+	if errors.As(err, &throttled) {
+		return errRecorder.RecordWithDetails(
+			ctx,
+			codes.ResourceExhausted,
+			fullMethodName,
+			err.Error(),
+			&errdetails.RetryInfo{RetryDelay: durationpb.New(throttled.RetryAfter)},
+			recorderr.ErrorInfo(recorderr.ReasonLoginThrottled),
+		)
+	}
+
+	// both are rejected credentials, callers see 401 rather than 404 or 400
 	switch {
-	case errors.Is(err, usecase.ErrorUserIsNotExsist):
-		return errRecorder.Record(ctx, codes.NotFound, fullMethodName, err.Error())
-	case errors.Is(err, usecase.ErrorPasswordIsInvalid):
-		return errRecorder.Record(ctx, codes.InvalidArgument, fullMethodName, err.Error())
+	case errors.Is(err, usecase.ErrorUserIsNotExsist),
+		errors.Is(err, usecase.ErrorPasswordIsInvalid):
+		return errRecorder.RecordWithDetails(
+			ctx,
+			codes.Unauthenticated,
+			fullMethodName,
+			err.Error(),
+			recorderr.ErrorInfo(recorderr.ReasonInvalidCredentials),
+		)
 	default:
-		return errRecorder.Record(ctx, codes.Internal, fullMethodName, err.Error())
+		return errRecorder.RecordInternal(ctx, fullMethodName, err)
 	}
 }
 
@@ -119,7 +146,519 @@ func (ass *AuthServiceServer) TokenValidate(
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(otelCodes.Error, "Failed Validate Token")
-		return nil, errRecorder.Record(hctx, codes.InvalidArgument, fullMethodName, err.Error())
+		return nil, errRecorder.RecordWithDetails(
+			hctx,
+			codes.InvalidArgument,
+			fullMethodName,
+			err.Error(),
+			recorderr.ErrorInfo(recorderr.ReasonTokenInvalid),
+		)
+	}
+
+	return res, nil
+}
+
+func (ass *AuthServiceServer) GetVerificationKeys(
+	ctx context.Context,
+	in *pbgen.GetVerificationKeysRequest,
+) (*pbgen.GetVerificationKeysResponse, error) {
+	tracer := otel.Tracer("auth-service")
+	hctx, span := tracer.Start(ctx, "ServiceHandler:GetVerificationKeys")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "get_verification_keys"),
+		attribute.String("layer", "handler"),
+	)
+
+	errRecorder := recorderr.NewErrorRecorder(span, logs.NewLogger())
+	fullMethodName := pbgen.AuthService_GetVerificationKeys_FullMethodName
+	res, err := ass.serviceUsecase.GetVerificationKeys(hctx, in)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, "Failed Get Verification Keys")
+		return nil, errRecorder.RecordInternal(hctx, fullMethodName, err)
+	}
+
+	return res, nil
+}
+
+func handleRefreshTokenErr(ctx context.Context, err error, errRecorder recorderr.ErrorRecorder) error {
+	fullMethodName := pbgen.AuthService_RefreshToken_FullMethodName
+	switch {
+	case errors.Is(err, usecase.ErrorRefreshTokenInvalid):
+		return errRecorder.RecordWithDetails(
+			ctx,
+			codes.Unauthenticated,
+			fullMethodName,
+			err.Error(),
+			recorderr.ErrorInfo(recorderr.ReasonTokenInvalid),
+		)
+	case errors.Is(err, usecase.ErrorRefreshTokenReused):
+		return errRecorder.RecordWithDetails(
+			ctx,
+			codes.Unauthenticated,
+			fullMethodName,
+			err.Error(),
+			recorderr.ErrorInfo(recorderr.ReasonTokenInvalid),
+		)
+	default:
+		return errRecorder.RecordInternal(ctx, fullMethodName, err)
+	}
+}
+
+func (ass *AuthServiceServer) RefreshToken(
+	ctx context.Context,
+	in *pbgen.RefreshTokenRequest,
+) (*pbgen.RefreshTokenResponse, error) {
+	tracer := otel.Tracer("auth-service")
+	hctx, span := tracer.Start(ctx, "ServiceHandler:RefreshToken")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "refresh_token"),
+		attribute.String("layer", "handler"),
+	)
+
+	errRecorder := recorderr.NewErrorRecorder(span, logs.NewLogger())
+	res, err := ass.serviceUsecase.RefreshToken(hctx, in)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, "Failed Refresh Token")
+		return nil, handleRefreshTokenErr(hctx, err, errRecorder)
+	}
+
+	return res, nil
+}
+
+func (ass *AuthServiceServer) Logout(
+	ctx context.Context,
+	in *pbgen.LogoutRequest,
+) (*pbgen.LogoutResponse, error) {
+	tracer := otel.Tracer("auth-service")
+	hctx, span := tracer.Start(ctx, "ServiceHandler:Logout")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "logout"),
+		attribute.String("layer", "handler"),
+	)
+
+	errRecorder := recorderr.NewErrorRecorder(span, logs.NewLogger())
+	fullMethodName := pbgen.AuthService_Logout_FullMethodName
+	res, err := ass.serviceUsecase.Logout(hctx, in)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, "Failed Logout")
+		if errors.Is(err, usecase.ErrorTokenInvalid) {
+			return nil, errRecorder.RecordWithDetails(
+				hctx,
+				codes.Unauthenticated,
+				fullMethodName,
+				err.Error(),
+				recorderr.ErrorInfo(recorderr.ReasonTokenInvalid),
+			)
+		}
+		return nil, errRecorder.RecordInternal(hctx, fullMethodName, err)
+	}
+
+	return res, nil
+}
+
+func (ass *AuthServiceServer) ListSessions(
+	ctx context.Context,
+	in *pbgen.ListSessionsRequest,
+) (*pbgen.ListSessionsResponse, error) {
+	tracer := otel.Tracer("auth-service")
+	hctx, span := tracer.Start(ctx, "ServiceHandler:ListSessions")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "list_sessions"),
+		attribute.String("layer", "handler"),
+	)
+
+	errRecorder := recorderr.NewErrorRecorder(span, logs.NewLogger())
+	fullMethodName := pbgen.AuthService_ListSessions_FullMethodName
+	res, err := ass.serviceUsecase.ListSessions(hctx, in)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, "Failed List Sessions")
+		return nil, errRecorder.RecordInternal(hctx, fullMethodName, err)
+	}
+
+	return res, nil
+}
+
+func (ass *AuthServiceServer) RevokeSession(
+	ctx context.Context,
+	in *pbgen.RevokeSessionRequest,
+) (*pbgen.RevokeSessionResponse, error) {
+	tracer := otel.Tracer("auth-service")
+	hctx, span := tracer.Start(ctx, "ServiceHandler:RevokeSession")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "revoke_session"),
+		attribute.String("layer", "handler"),
+	)
+
+	errRecorder := recorderr.NewErrorRecorder(span, logs.NewLogger())
+	fullMethodName := pbgen.AuthService_RevokeSession_FullMethodName
+	res, err := ass.serviceUsecase.RevokeSession(hctx, in)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, "Failed Revoke Session")
+		if errors.Is(err, usecase.ErrorSessionNotFound) {
+			return nil, errRecorder.RecordWithDetails(
+				hctx,
+				codes.NotFound,
+				fullMethodName,
+				err.Error(),
+				recorderr.ErrorInfo(recorderr.ReasonSessionNotFound),
+			)
+		}
+		return nil, errRecorder.RecordInternal(hctx, fullMethodName, err)
+	}
+
+	return res, nil
+}
+
+func (ass *AuthServiceServer) VerifyEmail(
+	ctx context.Context,
+	in *pbgen.VerifyEmailRequest,
+) (*pbgen.VerifyEmailResponse, error) {
+	tracer := otel.Tracer("auth-service")
+	hctx, span := tracer.Start(ctx, "ServiceHandler:VerifyEmail")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "verify_email"),
+		attribute.String("layer", "handler"),
+	)
+
+	errRecorder := recorderr.NewErrorRecorder(span, logs.NewLogger())
+	fullMethodName := pbgen.AuthService_VerifyEmail_FullMethodName
+	res, err := ass.serviceUsecase.VerifyEmail(hctx, in)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, "Failed Verify Email")
+		if errors.Is(err, usecase.ErrorVerificationInvalid) {
+			return nil, errRecorder.RecordWithDetails(
+				hctx,
+				codes.InvalidArgument,
+				fullMethodName,
+				err.Error(),
+				recorderr.ErrorInfo(recorderr.ReasonTokenInvalid),
+			)
+		}
+		return nil, errRecorder.RecordInternal(hctx, fullMethodName, err)
+	}
+
+	return res, nil
+}
+
+func (ass *AuthServiceServer) RequestPasswordReset(
+	ctx context.Context,
+	in *pbgen.RequestPasswordResetRequest,
+) (*pbgen.RequestPasswordResetResponse, error) {
+	tracer := otel.Tracer("auth-service")
+	hctx, span := tracer.Start(ctx, "ServiceHandler:RequestPasswordReset")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "request_password_reset"),
+		attribute.String("layer", "handler"),
+	)
+
+	errRecorder := recorderr.NewErrorRecorder(span, logs.NewLogger())
+	fullMethodName := pbgen.AuthService_RequestPasswordReset_FullMethodName
+	res, err := ass.serviceUsecase.RequestPasswordReset(hctx, in)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, "Failed Request Password Reset")
+		return nil, errRecorder.RecordInternal(hctx, fullMethodName, err)
+	}
+
+	return res, nil
+}
+
+func (ass *AuthServiceServer) ResetPassword(
+	ctx context.Context,
+	in *pbgen.ResetPasswordRequest,
+) (*pbgen.ResetPasswordResponse, error) {
+	tracer := otel.Tracer("auth-service")
+	hctx, span := tracer.Start(ctx, "ServiceHandler:ResetPassword")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "reset_password"),
+		attribute.String("layer", "handler"),
+	)
+
+	errRecorder := recorderr.NewErrorRecorder(span, logs.NewLogger())
+	fullMethodName := pbgen.AuthService_ResetPassword_FullMethodName
+	res, err := ass.serviceUsecase.ResetPassword(hctx, in)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, "Failed Reset Password")
+		if errors.Is(err, usecase.ErrorPasswordResetInvalid) {
+			return nil, errRecorder.RecordWithDetails(
+				hctx,
+				codes.InvalidArgument,
+				fullMethodName,
+				err.Error(),
+				recorderr.ErrorInfo(recorderr.ReasonTokenInvalid),
+			)
+		}
+		return nil, errRecorder.RecordInternal(hctx, fullMethodName, err)
+	}
+
+	return res, nil
+}
+
+func (ass *AuthServiceServer) ChangePassword(
+	ctx context.Context,
+	in *pbgen.ChangePasswordRequest,
+) (*pbgen.ChangePasswordResponse, error) {
+	tracer := otel.Tracer("auth-service")
+	hctx, span := tracer.Start(ctx, "ServiceHandler:ChangePassword")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "change_password"),
+		attribute.String("layer", "handler"),
+	)
+
+	errRecorder := recorderr.NewErrorRecorder(span, logs.NewLogger())
+	fullMethodName := pbgen.AuthService_ChangePassword_FullMethodName
+	res, err := ass.serviceUsecase.ChangePassword(hctx, in)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, "Failed Change Password")
+		switch {
+		case errors.Is(err, usecase.ErrorTokenInvalid):
+			return nil, errRecorder.RecordWithDetails(
+				hctx,
+				codes.Unauthenticated,
+				fullMethodName,
+				err.Error(),
+				recorderr.ErrorInfo(recorderr.ReasonTokenInvalid),
+			)
+		case errors.Is(err, usecase.ErrorPasswordIsInvalid):
+			return nil, errRecorder.RecordWithDetails(
+				hctx,
+				codes.PermissionDenied,
+				fullMethodName,
+				err.Error(),
+				recorderr.ErrorInfo(recorderr.ReasonInvalidCredentials),
+			)
+		case errors.Is(err, usecase.ErrorUserIsNotExsist):
+			return nil, errRecorder.RecordWithDetails(
+				hctx,
+				codes.NotFound,
+				fullMethodName,
+				err.Error(),
+				recorderr.ErrorInfo(recorderr.ReasonUserNotFound),
+			)
+		}
+		return nil, errRecorder.RecordInternal(hctx, fullMethodName, err)
+	}
+
+	return res, nil
+}
+
+func handleTOTPErr(
+	ctx context.Context,
+	fullMethodName string,
+	err error,
+	errRecorder recorderr.ErrorRecorder,
+) error {
+	var throttled *usecase.LoginThrottledError
+	if errors.As(err, &throttled) {
+		return errRecorder.RecordWithDetails(
+			ctx,
+			codes.ResourceExhausted,
+			fullMethodName,
+			err.Error(),
+			&errdetails.RetryInfo{RetryDelay: durationpb.New(throttled.RetryAfter)},
+			recorderr.ErrorInfo(recorderr.ReasonLoginThrottled),
+		)
+	}
+
+	switch {
+	case errors.Is(err, usecase.ErrorTokenInvalid),
+		errors.Is(err, usecase.ErrorLoginChallengeInvalid):
+		return errRecorder.RecordWithDetails(
+			ctx,
+			codes.Unauthenticated,
+			fullMethodName,
+			err.Error(),
+			recorderr.ErrorInfo(recorderr.ReasonTokenInvalid),
+		)
+	case errors.Is(err, usecase.ErrorPasswordIsInvalid):
+		return errRecorder.RecordWithDetails(
+			ctx,
+			codes.PermissionDenied,
+			fullMethodName,
+			err.Error(),
+			recorderr.ErrorInfo(recorderr.ReasonInvalidCredentials),
+		)
+	case errors.Is(err, usecase.ErrorSecondFactorInvalid):
+		return errRecorder.RecordWithDetails(
+			ctx,
+			codes.PermissionDenied,
+			fullMethodName,
+			err.Error(),
+			recorderr.ErrorInfo(recorderr.ReasonSecondFactorInvalid),
+		)
+	case errors.Is(err, usecase.ErrorTOTPAlreadyEnabled),
+		errors.Is(err, usecase.ErrorTOTPNotEnrolled):
+		return errRecorder.RecordWithDetails(
+			ctx,
+			codes.FailedPrecondition,
+			fullMethodName,
+			err.Error(),
+			recorderr.ErrorInfo(recorderr.ReasonSecondFactorState),
+		)
+	case errors.Is(err, usecase.ErrorUserIsNotExsist):
+		return errRecorder.RecordWithDetails(
+			ctx,
+			codes.NotFound,
+			fullMethodName,
+			err.Error(),
+			recorderr.ErrorInfo(recorderr.ReasonUserNotFound),
+		)
+	default:
+		return errRecorder.RecordInternal(ctx, fullMethodName, err)
+	}
+}
+
+func (ass *AuthServiceServer) EnrollTOTP(
+	ctx context.Context,
+	in *pbgen.EnrollTOTPRequest,
+) (*pbgen.EnrollTOTPResponse, error) {
+	tracer := otel.Tracer("auth-service")
+	hctx, span := tracer.Start(ctx, "ServiceHandler:EnrollTOTP")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "enroll_totp"),
+		attribute.String("layer", "handler"),
+	)
+
+	errRecorder := recorderr.NewErrorRecorder(span, logs.NewLogger())
+	res, err := ass.serviceUsecase.EnrollTOTP(hctx, in)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, "Failed Enroll TOTP")
+		return nil, handleTOTPErr(hctx, pbgen.AuthService_EnrollTOTP_FullMethodName, err, errRecorder)
+	}
+
+	return res, nil
+}
+
+func (ass *AuthServiceServer) ConfirmTOTP(
+	ctx context.Context,
+	in *pbgen.ConfirmTOTPRequest,
+) (*pbgen.ConfirmTOTPResponse, error) {
+	tracer := otel.Tracer("auth-service")
+	hctx, span := tracer.Start(ctx, "ServiceHandler:ConfirmTOTP")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "confirm_totp"),
+		attribute.String("layer", "handler"),
+	)
+
+	errRecorder := recorderr.NewErrorRecorder(span, logs.NewLogger())
+	res, err := ass.serviceUsecase.ConfirmTOTP(hctx, in)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, "Failed Confirm TOTP")
+		return nil, handleTOTPErr(hctx, pbgen.AuthService_ConfirmTOTP_FullMethodName, err, errRecorder)
+	}
+
+	return res, nil
+}
+
+func (ass *AuthServiceServer) DisableTOTP(
+	ctx context.Context,
+	in *pbgen.DisableTOTPRequest,
+) (*pbgen.DisableTOTPResponse, error) {
+	tracer := otel.Tracer("auth-service")
+	hctx, span := tracer.Start(ctx, "ServiceHandler:DisableTOTP")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "disable_totp"),
+		attribute.String("layer", "handler"),
+	)
+
+	errRecorder := recorderr.NewErrorRecorder(span, logs.NewLogger())
+	res, err := ass.serviceUsecase.DisableTOTP(hctx, in)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, "Failed Disable TOTP")
+		return nil, handleTOTPErr(hctx, pbgen.AuthService_DisableTOTP_FullMethodName, err, errRecorder)
+	}
+
+	return res, nil
+}
+
+func (ass *AuthServiceServer) VerifySecondFactor(
+	ctx context.Context,
+	in *pbgen.VerifySecondFactorRequest,
+) (*pbgen.AuthenticateUserResponse, error) {
+	tracer := otel.Tracer("auth-service")
+	hctx, span := tracer.Start(ctx, "ServiceHandler:VerifySecondFactor")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "verify_second_factor"),
+		attribute.String("layer", "handler"),
+	)
+
+	errRecorder := recorderr.NewErrorRecorder(span, logs.NewLogger())
+	res, err := ass.serviceUsecase.VerifySecondFactor(hctx, in)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, "Failed Verify Second Factor")
+		return nil, handleTOTPErr(hctx, pbgen.AuthService_VerifySecondFactor_FullMethodName, err, errRecorder)
+	}
+
+	return res, nil
+}
+
+func (ass *AuthServiceServer) GetRegistrationStatus(
+	ctx context.Context,
+	in *pbgen.GetRegistrationStatusRequest,
+) (*pbgen.GetRegistrationStatusResponse, error) {
+	tracer := otel.Tracer("auth-service")
+	hctx, span := tracer.Start(ctx, "ServiceHandler:GetRegistrationStatus")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "get_registration_status"),
+		attribute.String("layer", "handler"),
+	)
+
+	errRecorder := recorderr.NewErrorRecorder(span, logs.NewLogger())
+	fullMethodName := pbgen.AuthService_GetRegistrationStatus_FullMethodName
+	res, err := ass.serviceUsecase.GetRegistrationStatus(hctx, in)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelCodes.Error, "Failed Get Registration Status")
+		if errors.Is(err, usecase.ErrorRegistrationNotFound) {
+			return nil, errRecorder.RecordWithDetails(
+				hctx,
+				codes.NotFound,
+				fullMethodName,
+				err.Error(),
+				recorderr.ErrorInfo(recorderr.ReasonRegistrationMissing),
+			)
+		}
+		return nil, errRecorder.RecordInternal(hctx, fullMethodName, err)
 	}
 
 	return res, nil
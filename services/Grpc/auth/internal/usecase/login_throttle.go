@@ -0,0 +1,232 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sony-nurdianto/farm/auth/internal/constants"
+	"github.com/sony-nurdianto/farm/auth/internal/entity"
+	"github.com/sony-nurdianto/farm/auth/internal/models"
+	"github.com/sony-nurdianto/farm/auth/internal/pbgen"
+	"github.com/sony-nurdianto/farm/auth/internal/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	loginOutcomeSuccess        = "success"
+	loginReasonUnknownEmail    = "unknown_email"
+	loginReasonInvalidPassword = "invalid_password"
+	loginReasonInvalidTOTP     = "invalid_second_factor"
+	loginReasonLocked          = "locked"
+	loginReasonIPThrottled     = "ip_throttled"
+)
+
+type LoginPolicy struct {
+	// Window is the sliding window failed sign-ins are counted in.
+	Window time.Duration
+	// MaxEmailFailures failures for one email within Window lock it.
+	MaxEmailFailures int64
+	// MaxIPFailures failures from one IP within Window throttle that IP.
+	MaxIPFailures int64
+	// LockBase is the first lock duration, doubled on every further lockout
+	// up to LockMax.
+	LockBase time.Duration
+	LockMax  time.Duration
+}
+
+var DefaultLoginPolicy = LoginPolicy{
+	Window:           15 * time.Minute,
+	MaxEmailFailures: 5,
+	MaxIPFailures:    20,
+	LockBase:         time.Minute,
+	LockMax:          time.Hour,
+}
+
+type LoginThrottledError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LoginThrottledError) Error() string {
+	return fmt.Sprintf("%s: retry after %s", ErrorLoginThrottled, e.RetryAfter.Round(time.Second))
+}
+
+func (e *LoginThrottledError) Unwrap() error {
+	return ErrorLoginThrottled
+}
+
+type loginMetrics struct {
+	failures metric.Int64Counter
+	lockouts metric.Int64Counter
+}
+
+func newLoginMetrics() loginMetrics {
+	meter := otel.Meter("auth-service")
+
+	// instrument errors only come from invalid names, the noop
+	// instruments returned alongside are safe to use
+	failures, _ := meter.Int64Counter(
+		"auth.login.failures",
+		metric.WithDescription("Failed sign-in attempts by reason"),
+	)
+	lockouts, _ := meter.Int64Counter(
+		"auth.login.lockouts",
+		metric.WithDescription("Sign-ins rejected by throttling or account lockout"),
+	)
+
+	return loginMetrics{failures, lockouts}
+}
+
+// clientIP prefers the address the gateway forwards in metadata over the one
+// in the request body.
+func clientIP(ctx context.Context, fallback string) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(constants.CLIENT_IP_METADATA); len(v) > 0 && v[0] != "" {
+			return v[0]
+		}
+	}
+
+	return fallback
+}
+
+func loginEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func retryAfter(lw entity.LoginWindow, window time.Duration) time.Duration {
+	return max(time.Until(lw.Oldest.Add(window)), time.Second)
+}
+
+// checkLoginThrottle rejects the sign-in before the password is checked when
+// the email is locked or the IP has too many failures.
+func (su serviceUsecase) checkLoginThrottle(
+	ctx context.Context,
+	span trace.Span,
+	req *pbgen.AuthenticateUserRequest,
+	email, ip string,
+) error {
+	lockTTL, err := su.authRepo.LoginLockTTL(ctx, email)
+	if err != nil {
+		// the limiter fails open, an unavailable cache must not stop sign-in
+		span.RecordError(err)
+	}
+
+	if lockTTL > 0 {
+		su.recordLoginRejected(ctx, span, req, "", email, ip, loginReasonLocked)
+		return &LoginThrottledError{RetryAfter: lockTTL}
+	}
+
+	if ip == "" {
+		return nil
+	}
+
+	ipWindow, err := su.authRepo.CountLoginFailures(ctx, repository.LoginScopeIP, ip, su.loginPolicy.Window)
+	if err != nil {
+		span.RecordError(err)
+		return nil
+	}
+
+	if ipWindow.Count >= su.loginPolicy.MaxIPFailures {
+		su.recordLoginRejected(ctx, span, req, "", email, ip, loginReasonIPThrottled)
+		return &LoginThrottledError{RetryAfter: retryAfter(ipWindow, su.loginPolicy.Window)}
+	}
+
+	return nil
+}
+
+// registerLoginFailure counts a failed sign-in for the email and the IP and
+// locks the email once it reaches MaxEmailFailures.
+func (su serviceUsecase) registerLoginFailure(
+	ctx context.Context,
+	span trace.Span,
+	req *pbgen.AuthenticateUserRequest,
+	accountID, email, ip, reason string,
+) error {
+	su.loginMetrics.failures.Add(ctx, 1, metric.WithAttributes(attribute.String("reason", reason)))
+	su.auditLoginAttempt(ctx, span, req, accountID, email, ip, reason)
+	if accountID != "" {
+		su.publishUserLogin(ctx, span, req, accountID, ip, reason)
+	}
+
+	if ip != "" {
+		if _, err := su.authRepo.RecordLoginFailure(ctx, repository.LoginScopeIP, ip, su.loginPolicy.Window); err != nil {
+			span.RecordError(err)
+		}
+	}
+
+	emailWindow, err := su.authRepo.RecordLoginFailure(ctx, repository.LoginScopeEmail, email, su.loginPolicy.Window)
+	if err != nil {
+		span.RecordError(err)
+		return nil
+	}
+
+	if emailWindow.Count < su.loginPolicy.MaxEmailFailures {
+		return nil
+	}
+
+	lock, err := su.authRepo.LockLogin(ctx, email, su.loginPolicy.LockBase, su.loginPolicy.LockMax)
+	if err != nil {
+		span.RecordError(err)
+		return nil
+	}
+
+	span.AddEvent("login_locked", trace.WithAttributes(attribute.String("login.lock_duration", lock.String())))
+	su.loginMetrics.lockouts.Add(ctx, 1, metric.WithAttributes(attribute.String("reason", loginReasonLocked)))
+	return &LoginThrottledError{RetryAfter: lock}
+}
+
+func (su serviceUsecase) recordLoginRejected(
+	ctx context.Context,
+	span trace.Span,
+	req *pbgen.AuthenticateUserRequest,
+	accountID, email, ip, reason string,
+) {
+	su.loginMetrics.lockouts.Add(ctx, 1, metric.WithAttributes(attribute.String("reason", reason)))
+	su.auditLoginAttempt(ctx, span, req, accountID, email, ip, reason)
+}
+
+func (su serviceUsecase) auditLoginAttempt(
+	ctx context.Context,
+	span trace.Span,
+	req *pbgen.AuthenticateUserRequest,
+	accountID, email, ip, reason string,
+) {
+	err := su.authRepo.CreateLoginAttempt(ctx, entity.LoginAttempt{
+		AccountId: accountID,
+		Email:     email,
+		IpAddress: ip,
+		UserAgent: req.GetUserAgent(),
+		Success:   reason == "",
+		Reason:    reason,
+	})
+	if err != nil {
+		span.RecordError(err)
+	}
+}
+
+// publishUserLogin lets the farmer service keep last_login and the login
+// history farmers can review, it is best effort and never fails a sign-in.
+func (su serviceUsecase) publishUserLogin(
+	ctx context.Context,
+	span trace.Span,
+	req *pbgen.AuthenticateUserRequest,
+	accountID, ip, outcome string,
+) {
+	err := su.authRepo.PublishUserLogin(ctx, models.UserLogin{
+		Id:        uuid.NewString(),
+		UserId:    accountID,
+		IpAddress: ip,
+		UserAgent: req.GetUserAgent(),
+		Outcome:   outcome,
+		LoggedAt:  time.Now().UTC().Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		span.RecordError(err)
+	}
+}
@@ -0,0 +1,264 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/sony-nurdianto/farm/auth/internal/encryption/token"
+	"github.com/sony-nurdianto/farm/auth/internal/mailer"
+	"github.com/sony-nurdianto/farm/auth/internal/pbgen"
+	"github.com/sony-nurdianto/farm/auth/internal/repository"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/observability/otel/logs"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+func (su serviceUsecase) rehashPassword(ctx context.Context, accountID, password string) error {
+	passwordHash, err := su.passEncrypt.HashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	return su.authRepo.UpdatePasswordHash(ctx, accountID, passwordHash)
+}
+
+// revokeAccountSessions ends every session of the account but exceptSessionID
+// and puts them on the revocation list so their access tokens stop working.
+func (su serviceUsecase) revokeAccountSessions(ctx context.Context, accountID, exceptSessionID string) error {
+	revoked, err := su.authRepo.RevokeAccountSessions(ctx, accountID, exceptSessionID)
+	if err != nil {
+		return err
+	}
+
+	for _, sessionID := range revoked {
+		if err := su.authRepo.RevokeSessionCache(ctx, sessionID, token.AccessTokenTTL); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (su serviceUsecase) RequestPasswordReset(
+	ctx context.Context,
+	req *pbgen.RequestPasswordResetRequest,
+) (*pbgen.RequestPasswordResetResponse, error) {
+	tracer := otel.Tracer("auth-service")
+	uctx, span := tracer.Start(ctx, "Usecase:RequestPasswordReset")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "request_password_reset"),
+		attribute.String("layer", "usecase"),
+	)
+
+	// the response is the same whether the email exists or not so the
+	// endpoint can't be used to find registered accounts
+	res := &pbgen.RequestPasswordResetResponse{
+		Status: "Success",
+		Msg:    "If the email is registered a password reset link has been sent",
+	}
+
+	span.AddEvent("get_user_by_email")
+	user, err := su.authRepo.GetUserByEmail(uctx, req.GetEmail())
+	if errors.Is(err, sql.ErrNoRows) {
+		span.SetStatus(codes.Ok, "Password Reset Requested For Unknown Email")
+		return res, nil
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Get User By Email")
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.String("user.id", user.Id))
+
+	resetToken, resetHash, err := token.GenerateOpaqueToken()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Generate Password Reset Token")
+		return nil, err
+	}
+
+	expiresAt := time.Now().UTC().Add(token.PasswordResetTTL)
+	span.AddEvent("create_password_reset")
+	if err := su.authRepo.CreatePasswordReset(uctx, user.Id, resetHash, expiresAt); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Create Password Reset")
+		return nil, err
+	}
+
+	span.AddEvent("send_password_reset")
+	err = su.mailer.Send(uctx, mailer.Message{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf(
+			"Open the link below to reset your password, it expires at %s.\n\n%s?token=%s\n\nIf you did not request a password reset you can ignore this email.\n",
+			expiresAt.Format(time.RFC1123),
+			su.resetURL,
+			resetToken,
+		),
+	})
+	if err != nil {
+		// failing the request would tell the caller the email is registered,
+		// the reset stays valid and can be requested again
+		logs.NewLogger().Error(
+			uctx,
+			"[AuthService] Failed to send password reset",
+			err,
+			slog.String("user.id", user.Id),
+		)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Send Password Reset")
+		return res, nil
+	}
+
+	span.SetStatus(codes.Ok, "Password Reset Requested")
+	return res, nil
+}
+
+func (su serviceUsecase) ResetPassword(
+	ctx context.Context,
+	req *pbgen.ResetPasswordRequest,
+) (*pbgen.ResetPasswordResponse, error) {
+	tracer := otel.Tracer("auth-service")
+	uctx, span := tracer.Start(ctx, "Usecase:ResetPassword")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "reset_password"),
+		attribute.String("layer", "usecase"),
+	)
+
+	span.AddEvent("hash_user_password")
+	passwordHash, err := su.passEncrypt.HashPassword(req.GetNewPassword())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Hash Password")
+		return nil, fmt.Errorf("%w: %s", ErrorFailedToHasshPassword, err)
+	}
+
+	span.AddEvent("reset_user_password")
+	accountID, err := su.authRepo.ResetPassword(uctx, token.HashOpaqueToken(req.GetToken()), passwordHash)
+	if errors.Is(err, repository.ErrPasswordResetNotFound) ||
+		errors.Is(err, repository.ErrPasswordResetUsed) ||
+		errors.Is(err, repository.ErrPasswordResetExpired) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Password Reset Is Invalid")
+		return nil, fmt.Errorf("%w: %s", ErrorPasswordResetInvalid, err)
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Reset Password")
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.String("user.id", accountID))
+
+	span.AddEvent("revoke_account_sessions")
+	if err := su.revokeAccountSessions(uctx, accountID, ""); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Revoke Account Sessions")
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Password Reset")
+	return &pbgen.ResetPasswordResponse{
+		Status: "Success",
+		Msg:    "Password Reset, Please Sign In Again",
+	}, nil
+}
+
+func (su serviceUsecase) ChangePassword(
+	ctx context.Context,
+	req *pbgen.ChangePasswordRequest,
+) (*pbgen.ChangePasswordResponse, error) {
+	tracer := otel.Tracer("auth-service")
+	uctx, span := tracer.Start(ctx, "Usecase:ChangePassword")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "change_password"),
+		attribute.String("layer", "usecase"),
+	)
+
+	value, err := su.tokhen.VerifyWebToken(req.GetToken())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed To VerifyWebToken")
+		return nil, fmt.Errorf("%w: %s", ErrorTokenInvalid, err)
+	}
+
+	span.SetAttributes(
+		attribute.String("user.id", value.Subject),
+		attribute.String("session.id", value.Jti),
+	)
+
+	span.AddEvent("check_session_revoked")
+	revoked, err := su.authRepo.IsSessionRevoked(uctx, value.Jti)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed To Check Session Revoked")
+		return nil, err
+	}
+
+	if revoked {
+		span.SetStatus(codes.Error, "User Session Is Revoked")
+		return nil, fmt.Errorf("%w: session revoked", ErrorTokenInvalid)
+	}
+
+	span.AddEvent("get_user_by_id")
+	user, err := su.authRepo.GetUserByID(uctx, value.Subject)
+	if errors.Is(err, sql.ErrNoRows) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "User Not Found")
+		return nil, fmt.Errorf("%w: user %s is not exist", ErrorUserIsNotExsist, value.Subject)
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Get User By ID")
+		return nil, err
+	}
+
+	span.AddEvent("verify_user_password")
+	isPass, err := su.passEncrypt.VerifyPassword(req.GetCurrentPassword(), user.Password)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Verify User Password")
+		return nil, err
+	}
+
+	if !isPass {
+		span.RecordError(errors.New("Unauthorized Password is Invalid"))
+		span.SetStatus(codes.Error, "User Password Is Invalid")
+		return nil, ErrorPasswordIsInvalid
+	}
+
+	span.AddEvent("update_user_password")
+	if err := su.rehashPassword(uctx, user.Id, req.GetNewPassword()); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Update Password")
+		return nil, err
+	}
+
+	span.AddEvent("revoke_other_sessions")
+	if err := su.revokeAccountSessions(uctx, user.Id, value.Jti); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Revoke Other Sessions")
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Password Changed")
+	return &pbgen.ChangePasswordResponse{
+		Status: "Success",
+		Msg:    "Password Changed, Other Sessions Signed Out",
+	}, nil
+}
@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sony-nurdianto/farm/auth/internal/pbgen"
+	"github.com/sony-nurdianto/farm/auth/internal/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (su serviceUsecase) GetRegistrationStatus(
+	ctx context.Context,
+	req *pbgen.GetRegistrationStatusRequest,
+) (*pbgen.GetRegistrationStatusResponse, error) {
+	tracer := otel.Tracer("auth-service")
+	uctx, span := tracer.Start(ctx, "Usecase:GetRegistrationStatus")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "get_registration_status"),
+		attribute.String("layer", "usecase"),
+		attribute.String("user.id", req.GetId()),
+	)
+
+	reg, err := su.authRepo.GetRegistration(uctx, req.GetId())
+	if errors.Is(err, repository.ErrRegistrationNotFound) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Registration Is Not Found")
+		return nil, fmt.Errorf("%w: %s", ErrorRegistrationNotFound, err)
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Get Registration")
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.String("registration.status", reg.Status))
+	span.SetStatus(codes.Ok, "Registration Found")
+
+	return &pbgen.GetRegistrationStatusResponse{
+		UserId:        reg.UserId,
+		Status:        reg.Status,
+		FailureReason: reg.FailureReason,
+		CreatedAt:     timestamppb.New(reg.CreatedAt),
+		UpdatedAt:     timestamppb.New(reg.UpdatedAt),
+	}, nil
+}
@@ -0,0 +1,228 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sony-nurdianto/farm/auth/internal/encryption/token"
+	"github.com/sony-nurdianto/farm/auth/internal/pbgen"
+	"github.com/sony-nurdianto/farm/auth/internal/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (su serviceUsecase) revokeSession(ctx context.Context, accountID, sessionID string) error {
+	_, err := su.authRepo.RevokeSession(ctx, accountID, sessionID)
+	if errors.Is(err, repository.ErrSessionNotFound) {
+		return ErrorSessionNotFound
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return su.authRepo.RevokeSessionCache(ctx, sessionID, token.AccessTokenTTL)
+}
+
+func (su serviceUsecase) RefreshToken(
+	ctx context.Context,
+	req *pbgen.RefreshTokenRequest,
+) (*pbgen.RefreshTokenResponse, error) {
+	tracer := otel.Tracer("auth-service")
+	uctx, span := tracer.Start(ctx, "Usecase:RefreshToken")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "refresh_token"),
+		attribute.String("layer", "usecase"),
+	)
+
+	span.AddEvent("generate_refresh_token")
+	refreshToken, refreshHash, err := token.GenerateOpaqueToken()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Generate Refresh Token")
+		return nil, err
+	}
+
+	span.AddEvent("rotate_refresh_token")
+	session, err := su.authRepo.RotateRefreshToken(
+		uctx,
+		token.HashOpaqueToken(req.GetRefreshToken()),
+		refreshHash,
+		time.Now().UTC().Add(token.RefreshTokenTTL),
+	)
+
+	if errors.Is(err, repository.ErrRefreshTokenReused) {
+		span.AddEvent("revoke_reused_session")
+		if err := su.authRepo.RevokeSessionCache(uctx, session.Id, token.AccessTokenTTL); err != nil {
+			span.RecordError(err)
+		}
+
+		span.SetStatus(codes.Error, "Refresh Token Reused")
+		return nil, ErrorRefreshTokenReused
+	}
+
+	if errors.Is(err, repository.ErrRefreshTokenNotFound) ||
+		errors.Is(err, repository.ErrSessionRevoked) ||
+		errors.Is(err, repository.ErrSessionExpired) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Refresh Token Is Invalid")
+		return nil, fmt.Errorf("%w: %s", ErrorRefreshTokenInvalid, err)
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Rotate Refresh Token")
+		return nil, err
+	}
+
+	span.SetAttributes(
+		attribute.String("user.id", session.AccountId),
+		attribute.String("session.id", session.Id),
+	)
+
+	span.AddEvent("create_user_token")
+	accessToken, err := su.tokhen.CreateWebToken(token.AccessClaims{
+		Subject:   session.AccountId,
+		SessionID: session.Id,
+		Verified:  session.AccountVerified,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Create User Token")
+		return nil, err
+	}
+
+	res := &pbgen.RefreshTokenResponse{
+		Token:            accessToken,
+		IssuedAt:         timestamppb.Now(),
+		ExpiresAt:        timestamppb.New(time.Now().Add(token.AccessTokenTTL)),
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: timestamppb.New(session.ExpiresAt),
+		SessionId:        session.Id,
+	}
+
+	span.SetStatus(codes.Ok, "Refresh Token Rotated")
+	return res, nil
+}
+
+func (su serviceUsecase) Logout(
+	ctx context.Context,
+	req *pbgen.LogoutRequest,
+) (*pbgen.LogoutResponse, error) {
+	tracer := otel.Tracer("auth-service")
+	uctx, span := tracer.Start(ctx, "Usecase:Logout")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "logout"),
+		attribute.String("layer", "usecase"),
+	)
+
+	// an expired access token still identifies the session to end
+	value, err := su.tokhen.VerifyWebToken(req.GetToken())
+	if err != nil && !errors.Is(err, token.ErrTokenExperied) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed To VerifyWebToken")
+		return nil, fmt.Errorf("%w: %s", ErrorTokenInvalid, err)
+	}
+
+	if value.Jti == "" {
+		span.SetStatus(codes.Error, "Token Has No Session")
+		return nil, fmt.Errorf("%w: token has no session", ErrorTokenInvalid)
+	}
+
+	span.SetAttributes(
+		attribute.String("user.id", value.Subject),
+		attribute.String("session.id", value.Jti),
+	)
+
+	span.AddEvent("revoke_session")
+	err = su.revokeSession(uctx, value.Subject, value.Jti)
+	if err != nil && !errors.Is(err, ErrorSessionNotFound) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Revoke Session")
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "User Logout Success")
+	return &pbgen.LogoutResponse{
+		Status: "Success",
+		Msg:    "User Logout Success",
+	}, nil
+}
+
+func (su serviceUsecase) ListSessions(
+	ctx context.Context,
+	req *pbgen.ListSessionsRequest,
+) (*pbgen.ListSessionsResponse, error) {
+	tracer := otel.Tracer("auth-service")
+	uctx, span := tracer.Start(ctx, "Usecase:ListSessions")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "list_sessions"),
+		attribute.String("layer", "usecase"),
+		attribute.String("user.id", req.GetAccountId()),
+	)
+
+	sessions, err := su.authRepo.ListSessions(uctx, req.GetAccountId())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed List Sessions")
+		return nil, err
+	}
+
+	res := &pbgen.ListSessionsResponse{
+		Sessions: make([]*pbgen.Session, 0, len(sessions)),
+	}
+
+	for _, s := range sessions {
+		res.Sessions = append(res.Sessions, &pbgen.Session{
+			Id:         s.Id,
+			UserAgent:  s.UserAgent,
+			IpAddress:  s.IpAddress,
+			CreatedAt:  timestamppb.New(s.CreatedAt),
+			LastUsedAt: timestamppb.New(s.LastUsedAt),
+			ExpiresAt:  timestamppb.New(s.ExpiresAt),
+			Current:    s.Id == req.GetCurrentSessionId(),
+		})
+	}
+
+	span.SetAttributes(attribute.Int("sessions.count", len(res.Sessions)))
+	span.SetStatus(codes.Ok, "Sessions Listed")
+	return res, nil
+}
+
+func (su serviceUsecase) RevokeSession(
+	ctx context.Context,
+	req *pbgen.RevokeSessionRequest,
+) (*pbgen.RevokeSessionResponse, error) {
+	tracer := otel.Tracer("auth-service")
+	uctx, span := tracer.Start(ctx, "Usecase:RevokeSession")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "revoke_session"),
+		attribute.String("layer", "usecase"),
+		attribute.String("user.id", req.GetAccountId()),
+		attribute.String("session.id", req.GetSessionId()),
+	)
+
+	if err := su.revokeSession(uctx, req.GetAccountId(), req.GetSessionId()); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Revoke Session")
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Session Revoked")
+	return &pbgen.RevokeSessionResponse{
+		Status: "Success",
+		Msg:    "Session Revoked",
+	}, nil
+}
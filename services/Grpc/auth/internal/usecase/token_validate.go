@@ -17,7 +17,7 @@ func (su serviceUsecase) TokenValidate(
 	req *pbgen.TokenValidateRequest,
 ) (*pbgen.TokenValidateResponse, error) {
 	tracer := otel.Tracer("auth-service")
-	_, span := tracer.Start(ctx, "Usecase:TokenValidate")
+	uctx, span := tracer.Start(ctx, "Usecase:TokenValidate")
 	defer span.End()
 
 	span.SetAttributes(
@@ -42,6 +42,21 @@ func (su serviceUsecase) TokenValidate(
 		return nil, err
 	}
 
+	span.AddEvent("check_session_revoked")
+	revoked, err := su.authRepo.IsSessionRevoked(uctx, value.Jti)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed To Check Session Revoked")
+		return nil, err
+	}
+
+	if revoked {
+		res.Valid = false
+		res.Msg = "Session Revoked"
+		span.SetStatus(codes.Error, "User Session Is Revoked")
+		return res, nil
+	}
+
 	res.Valid = true
 	res.ExpiresAt = timestamppb.New(value.Expiration)
 	res.Isuer = &value.Issuer
@@ -0,0 +1,471 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/o1egl/paseto"
+	"github.com/sony-nurdianto/farm/auth/internal/encryption/token"
+	"github.com/sony-nurdianto/farm/auth/internal/encryption/totp"
+	"github.com/sony-nurdianto/farm/auth/internal/entity"
+	"github.com/sony-nurdianto/farm/auth/internal/pbgen"
+	"github.com/sony-nurdianto/farm/auth/internal/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	totpIssuer = "Farm"
+
+	// a challenge is dropped after this many wrong codes, the sign-in has to
+	// start over with the password
+	maxLoginChallengeAttempts = 5
+)
+
+// accessTokenSubject verifies an access token of a session that was not
+// revoked and returns its claims.
+func (su serviceUsecase) accessTokenSubject(
+	ctx context.Context,
+	span trace.Span,
+	accessToken string,
+) (paseto.JSONToken, error) {
+	value, err := su.tokhen.VerifyWebToken(accessToken)
+	if err != nil {
+		return value, fmt.Errorf("%w: %s", ErrorTokenInvalid, err)
+	}
+
+	span.SetAttributes(
+		attribute.String("user.id", value.Subject),
+		attribute.String("session.id", value.Jti),
+	)
+
+	revoked, err := su.authRepo.IsSessionRevoked(ctx, value.Jti)
+	if err != nil {
+		return value, err
+	}
+
+	if revoked {
+		return value, fmt.Errorf("%w: session revoked", ErrorTokenInvalid)
+	}
+
+	return value, nil
+}
+
+func (su serviceUsecase) getUser(ctx context.Context, accountID string) (entity.Users, error) {
+	user, err := su.authRepo.GetUserByID(ctx, accountID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return user, fmt.Errorf("%w: user %s is not exist", ErrorUserIsNotExsist, accountID)
+	}
+
+	return user, err
+}
+
+// verifyTOTPCode accepts a code of the sealed secret once per time step.
+func (su serviceUsecase) verifyTOTPCode(ctx context.Context, accountID, sealedSecret, code string) (bool, error) {
+	secret, err := su.totpCipher.Open(sealedSecret)
+	if err != nil {
+		return false, err
+	}
+
+	step, ok, err := totp.Validate(secret, code, time.Now())
+	if err != nil || !ok {
+		return false, err
+	}
+
+	// a step stays valid while it is within the skew of the current one
+	return su.authRepo.UseTOTPStep(ctx, accountID, step, (2*totp.Skew+1)*totp.Period)
+}
+
+// verifySecondFactor accepts a TOTP code or an unused recovery code.
+func (su serviceUsecase) verifySecondFactor(ctx context.Context, accountID, sealedSecret, code string) (bool, error) {
+	if len(code) == totp.Digits {
+		return su.verifyTOTPCode(ctx, accountID, sealedSecret, code)
+	}
+
+	codeHash := token.HashOpaqueToken(totp.NormalizeRecoveryCode(code))
+	return su.authRepo.UseRecoveryCode(ctx, accountID, codeHash)
+}
+
+func (su serviceUsecase) createLoginChallenge(
+	ctx context.Context,
+	accountID string,
+) (*pbgen.AuthenticateUserResponse, error) {
+	challenge, challengeHash, err := token.GenerateOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := su.authRepo.CreateLoginChallenge(ctx, challengeHash, accountID, token.LoginChallengeTTL); err != nil {
+		return nil, err
+	}
+
+	return &pbgen.AuthenticateUserResponse{
+		Status:               "SecondFactorRequired",
+		Msg:                  "Enter The Code From Your Authenticator App",
+		SecondFactorRequired: true,
+		ChallengeToken:       challenge,
+		ChallengeExpiresAt:   timestamppb.New(time.Now().Add(token.LoginChallengeTTL)),
+	}, nil
+}
+
+func (su serviceUsecase) EnrollTOTP(
+	ctx context.Context,
+	req *pbgen.EnrollTOTPRequest,
+) (*pbgen.EnrollTOTPResponse, error) {
+	tracer := otel.Tracer("auth-service")
+	uctx, span := tracer.Start(ctx, "Usecase:EnrollTOTP")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "enroll_totp"),
+		attribute.String("layer", "usecase"),
+	)
+
+	value, err := su.accessTokenSubject(uctx, span, req.GetToken())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed To Authorize Access Token")
+		return nil, err
+	}
+
+	span.AddEvent("get_user_by_id")
+	user, err := su.getUser(uctx, value.Subject)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Get User By ID")
+		return nil, err
+	}
+
+	if user.TOTPEnabled {
+		span.SetStatus(codes.Error, "TOTP Already Enabled")
+		return nil, ErrorTOTPAlreadyEnabled
+	}
+
+	span.AddEvent("generate_totp_secret")
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Generate TOTP Secret")
+		return nil, err
+	}
+
+	sealed, err := su.totpCipher.Seal(secret)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Seal TOTP Secret")
+		return nil, err
+	}
+
+	recoveryCodes, err := totp.GenerateRecoveryCodes(totp.RecoveryCodeCount)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Generate Recovery Codes")
+		return nil, err
+	}
+
+	recoveryHashes := make([]string, 0, len(recoveryCodes))
+	for _, code := range recoveryCodes {
+		recoveryHashes = append(recoveryHashes, token.HashOpaqueToken(code))
+	}
+
+	span.AddEvent("save_totp_enrollment")
+	err = su.authRepo.SaveTOTPEnrollment(uctx, user.Id, sealed, recoveryHashes)
+	if errors.Is(err, repository.ErrTOTPAlreadyEnabled) {
+		span.SetStatus(codes.Error, "TOTP Already Enabled")
+		return nil, ErrorTOTPAlreadyEnabled
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Save TOTP Enrollment")
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "TOTP Enrollment Pending Confirmation")
+	return &pbgen.EnrollTOTPResponse{
+		OtpauthUri:    totp.KeyURI(totpIssuer, user.Email, secret),
+		Secret:        secret,
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+func (su serviceUsecase) ConfirmTOTP(
+	ctx context.Context,
+	req *pbgen.ConfirmTOTPRequest,
+) (*pbgen.ConfirmTOTPResponse, error) {
+	tracer := otel.Tracer("auth-service")
+	uctx, span := tracer.Start(ctx, "Usecase:ConfirmTOTP")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "confirm_totp"),
+		attribute.String("layer", "usecase"),
+	)
+
+	value, err := su.accessTokenSubject(uctx, span, req.GetToken())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed To Authorize Access Token")
+		return nil, err
+	}
+
+	span.AddEvent("get_totp")
+	state, err := su.authRepo.GetTOTP(uctx, value.Subject)
+	if errors.Is(err, sql.ErrNoRows) {
+		span.SetStatus(codes.Error, "User Not Found")
+		return nil, fmt.Errorf("%w: user %s is not exist", ErrorUserIsNotExsist, value.Subject)
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Get TOTP")
+		return nil, err
+	}
+
+	if state.Enabled {
+		span.SetStatus(codes.Error, "TOTP Already Enabled")
+		return nil, ErrorTOTPAlreadyEnabled
+	}
+
+	if state.Secret == "" {
+		span.SetStatus(codes.Error, "TOTP Not Enrolled")
+		return nil, ErrorTOTPNotEnrolled
+	}
+
+	span.AddEvent("verify_totp_code")
+	ok, err := su.verifyTOTPCode(uctx, value.Subject, state.Secret, req.GetCode())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Verify TOTP Code")
+		return nil, err
+	}
+
+	if !ok {
+		span.SetStatus(codes.Error, "TOTP Code Invalid")
+		return nil, ErrorSecondFactorInvalid
+	}
+
+	span.AddEvent("enable_totp")
+	if err := su.authRepo.EnableTOTP(uctx, value.Subject); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Enable TOTP")
+		if errors.Is(err, repository.ErrTOTPNotEnrolled) {
+			return nil, ErrorTOTPNotEnrolled
+		}
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "TOTP Enabled")
+	return &pbgen.ConfirmTOTPResponse{
+		Status: "Success",
+		Msg:    "Two Factor Authentication Enabled",
+	}, nil
+}
+
+func (su serviceUsecase) DisableTOTP(
+	ctx context.Context,
+	req *pbgen.DisableTOTPRequest,
+) (*pbgen.DisableTOTPResponse, error) {
+	tracer := otel.Tracer("auth-service")
+	uctx, span := tracer.Start(ctx, "Usecase:DisableTOTP")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "disable_totp"),
+		attribute.String("layer", "usecase"),
+	)
+
+	value, err := su.accessTokenSubject(uctx, span, req.GetToken())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed To Authorize Access Token")
+		return nil, err
+	}
+
+	span.AddEvent("get_user_by_id")
+	user, err := su.getUser(uctx, value.Subject)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Get User By ID")
+		return nil, err
+	}
+
+	span.AddEvent("verify_user_password")
+	isPass, err := su.passEncrypt.VerifyPassword(req.GetPassword(), user.Password)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Verify User Password")
+		return nil, err
+	}
+
+	if !isPass {
+		span.SetStatus(codes.Error, "User Password Is Invalid")
+		return nil, ErrorPasswordIsInvalid
+	}
+
+	state, err := su.authRepo.GetTOTP(uctx, user.Id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Get TOTP")
+		return nil, err
+	}
+
+	if state.Secret == "" {
+		span.SetStatus(codes.Error, "TOTP Not Enrolled")
+		return nil, ErrorTOTPNotEnrolled
+	}
+
+	// a pending enrollment can be dropped with the password alone
+	if state.Enabled {
+		span.AddEvent("verify_second_factor")
+		ok, err := su.verifySecondFactor(uctx, user.Id, state.Secret, req.GetCode())
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "Failed Verify Second Factor")
+			return nil, err
+		}
+
+		if !ok {
+			span.SetStatus(codes.Error, "Second Factor Invalid")
+			return nil, ErrorSecondFactorInvalid
+		}
+	}
+
+	span.AddEvent("disable_totp")
+	if err := su.authRepo.DisableTOTP(uctx, user.Id); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Disable TOTP")
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "TOTP Disabled")
+	return &pbgen.DisableTOTPResponse{
+		Status: "Success",
+		Msg:    "Two Factor Authentication Disabled",
+	}, nil
+}
+
+// VerifySecondFactor completes a sign-in that UserSignIn answered with a
+// challenge token. Wrong codes count as failed sign-ins of the account, so
+// the lockout of UserSignIn applies to them as well.
+func (su serviceUsecase) VerifySecondFactor(
+	ctx context.Context,
+	req *pbgen.VerifySecondFactorRequest,
+) (*pbgen.AuthenticateUserResponse, error) {
+	tracer := otel.Tracer("auth-service")
+	uctx, span := tracer.Start(ctx, "Usecase:VerifySecondFactor")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "verify_second_factor"),
+		attribute.String("layer", "usecase"),
+	)
+
+	challengeHash := token.HashOpaqueToken(req.GetChallengeToken())
+
+	span.AddEvent("attempt_login_challenge")
+	accountID, attempts, err := su.authRepo.AttemptLoginChallenge(uctx, challengeHash)
+	if errors.Is(err, repository.ErrLoginChallengeNotFound) {
+		span.SetStatus(codes.Error, "Login Challenge Not Found")
+		return nil, fmt.Errorf("%w: challenge expired or unknown", ErrorLoginChallengeInvalid)
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Attempt Login Challenge")
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.String("user.id", accountID))
+
+	if attempts > maxLoginChallengeAttempts {
+		if err := su.authRepo.DeleteLoginChallenge(uctx, challengeHash); err != nil {
+			span.RecordError(err)
+		}
+		span.SetStatus(codes.Error, "Login Challenge Exhausted")
+		return nil, fmt.Errorf("%w: too many attempts", ErrorLoginChallengeInvalid)
+	}
+
+	user, err := su.getUser(uctx, accountID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Get User By ID")
+		return nil, err
+	}
+
+	email := loginEmail(user.Email)
+	ip := clientIP(uctx, req.GetIpAddress())
+	// the throttle helpers audit against the sign-in request
+	signIn := &pbgen.AuthenticateUserRequest{
+		Email:     user.Email,
+		UserAgent: req.GetUserAgent(),
+		IpAddress: req.GetIpAddress(),
+	}
+
+	span.AddEvent("check_login_throttle")
+	if err := su.checkLoginThrottle(uctx, span, signIn, email, ip); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Second Factor Throttled")
+		return nil, err
+	}
+
+	state, err := su.authRepo.GetTOTP(uctx, user.Id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Get TOTP")
+		return nil, err
+	}
+
+	if !state.Enabled {
+		span.SetStatus(codes.Error, "TOTP Disabled Since Challenge")
+		return nil, fmt.Errorf("%w: two factor authentication is disabled", ErrorLoginChallengeInvalid)
+	}
+
+	span.AddEvent("verify_second_factor")
+	ok, err := su.verifySecondFactor(uctx, user.Id, state.Secret, req.GetCode())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Verify Second Factor")
+		return nil, err
+	}
+
+	if !ok {
+		span.SetStatus(codes.Error, "Second Factor Invalid")
+		if err := su.registerLoginFailure(uctx, span, signIn, user.Id, email, ip, loginReasonInvalidTOTP); err != nil {
+			return nil, err
+		}
+		return nil, ErrorSecondFactorInvalid
+	}
+
+	// the challenge must not be exchanged twice
+	if err := su.authRepo.DeleteLoginChallenge(uctx, challengeHash); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Delete Login Challenge")
+		return nil, err
+	}
+
+	if err := su.authRepo.ClearLoginFailures(uctx, email); err != nil {
+		span.RecordError(err)
+	}
+
+	span.AddEvent("create_user_session")
+	response, err := su.createSignInSession(uctx, user, req.GetUserAgent(), req.GetIpAddress())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Create User Session")
+		return nil, err
+	}
+
+	span.AddEvent("record_user_login")
+	su.auditLoginAttempt(uctx, span, signIn, user.Id, email, ip, "")
+	su.publishUserLogin(uctx, span, signIn, user.Id, ip, loginOutcomeSuccess)
+
+	span.SetStatus(codes.Ok, "Second Factor Verified")
+	return response, nil
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/sony-nurdianto/farm/auth/internal/encryption/passencrypt"
 	"github.com/sony-nurdianto/farm/auth/internal/encryption/token"
+	"github.com/sony-nurdianto/farm/auth/internal/encryption/totp"
+	"github.com/sony-nurdianto/farm/auth/internal/mailer"
 	"github.com/sony-nurdianto/farm/auth/internal/pbgen"
 	"github.com/sony-nurdianto/farm/auth/internal/repository"
 )
@@ -16,6 +18,18 @@ var (
 	ErrorRegisterUser          error = errors.New("Failed To CreateUserAsync")
 	ErrorUserIsNotExsist       error = errors.New("User Is Not Exist")
 	ErrorPasswordIsInvalid     error = errors.New("Invalid Password Credentials")
+	ErrorRefreshTokenInvalid   error = errors.New("Refresh Token Is Invalid")
+	ErrorRefreshTokenReused    error = errors.New("Refresh Token Reused Session Revoked")
+	ErrorSessionNotFound       error = errors.New("Session Is Not Found")
+	ErrorTokenInvalid          error = errors.New("Token Is Invalid")
+	ErrorVerificationInvalid   error = errors.New("Email Verification Is Invalid")
+	ErrorPasswordResetInvalid  error = errors.New("Password Reset Is Invalid")
+	ErrorLoginThrottled        error = errors.New("Too Many Sign In Attempts")
+	ErrorTOTPAlreadyEnabled    error = errors.New("Two Factor Authentication Is Already Enabled")
+	ErrorTOTPNotEnrolled       error = errors.New("Two Factor Authentication Is Not Enrolled")
+	ErrorSecondFactorInvalid   error = errors.New("Second Factor Code Is Invalid")
+	ErrorLoginChallengeInvalid error = errors.New("Login Challenge Is Invalid")
+	ErrorRegistrationNotFound  error = errors.New("Registration Is Not Found")
 )
 
 //go:generate mockgen -package=mocks -destination=../../test/mocks/mock_usecase.go -source=usecase.go
@@ -23,22 +37,56 @@ type ServiceUsecase interface {
 	UserRegister(ctx context.Context, user *pbgen.RegisterUserRequest) (*pbgen.RegisterUserResponse, error)
 	UserSignIn(ctx context.Context, req *pbgen.AuthenticateUserRequest) (*pbgen.AuthenticateUserResponse, error)
 	TokenValidate(ctx context.Context, req *pbgen.TokenValidateRequest) (*pbgen.TokenValidateResponse, error)
+	GetVerificationKeys(ctx context.Context, req *pbgen.GetVerificationKeysRequest) (*pbgen.GetVerificationKeysResponse, error)
+	RefreshToken(ctx context.Context, req *pbgen.RefreshTokenRequest) (*pbgen.RefreshTokenResponse, error)
+	Logout(ctx context.Context, req *pbgen.LogoutRequest) (*pbgen.LogoutResponse, error)
+	ListSessions(ctx context.Context, req *pbgen.ListSessionsRequest) (*pbgen.ListSessionsResponse, error)
+	RevokeSession(ctx context.Context, req *pbgen.RevokeSessionRequest) (*pbgen.RevokeSessionResponse, error)
+	VerifyEmail(ctx context.Context, req *pbgen.VerifyEmailRequest) (*pbgen.VerifyEmailResponse, error)
+	RequestPasswordReset(ctx context.Context, req *pbgen.RequestPasswordResetRequest) (*pbgen.RequestPasswordResetResponse, error)
+	ResetPassword(ctx context.Context, req *pbgen.ResetPasswordRequest) (*pbgen.ResetPasswordResponse, error)
+	ChangePassword(ctx context.Context, req *pbgen.ChangePasswordRequest) (*pbgen.ChangePasswordResponse, error)
+	EnrollTOTP(ctx context.Context, req *pbgen.EnrollTOTPRequest) (*pbgen.EnrollTOTPResponse, error)
+	ConfirmTOTP(ctx context.Context, req *pbgen.ConfirmTOTPRequest) (*pbgen.ConfirmTOTPResponse, error)
+	DisableTOTP(ctx context.Context, req *pbgen.DisableTOTPRequest) (*pbgen.DisableTOTPResponse, error)
+	VerifySecondFactor(ctx context.Context, req *pbgen.VerifySecondFactorRequest) (*pbgen.AuthenticateUserResponse, error)
+	GetRegistrationStatus(ctx context.Context, req *pbgen.GetRegistrationStatusRequest) (*pbgen.GetRegistrationStatusResponse, error)
 }
 
 type serviceUsecase struct {
 	authRepo    repository.AuthRepo
 	passEncrypt passencrypt.PassEncrypt
 	tokhen      token.Tokhan
+	mailer      mailer.Mailer
+	totpCipher  totp.SecretCipher
+	verifyURL   string
+	resetURL    string
+
+	loginPolicy  LoginPolicy
+	loginMetrics loginMetrics
 }
 
+// NewServiceUsecase sends verification links as verifyURL?token=<token> and
+// password reset links as resetURL?token=<token>.
 func NewServiceUsecase(
 	repo repository.AuthRepo,
 	pass passencrypt.PassEncrypt,
 	tokhen token.Tokhan,
+	mail mailer.Mailer,
+	totpCipher totp.SecretCipher,
+	verifyURL string,
+	resetURL string,
 ) ServiceUsecase {
 	return serviceUsecase{
 		authRepo:    repo,
 		passEncrypt: pass,
 		tokhen:      tokhen,
+		mailer:      mail,
+		totpCipher:  totpCipher,
+		verifyURL:   verifyURL,
+		resetURL:    resetURL,
+
+		loginPolicy:  DefaultLoginPolicy,
+		loginMetrics: newLoginMetrics(),
 	}
 }
@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/sony-nurdianto/farm/auth/internal/entity"
 	"github.com/sony-nurdianto/farm/auth/internal/pbgen"
 	"github.com/sony-nurdianto/farm/auth/internal/repository"
 	"go.opentelemetry.io/otel"
@@ -60,10 +61,27 @@ func (su serviceUsecase) UserRegister(ctx context.Context, user *pbgen.RegisterU
 		return nil, ErrorFailedToHasshPassword
 	}
 
-	span.AddEvent("creating_user")
 	userId := uuid.NewString()
 	span.SetAttributes(attribute.String("user.id", userId))
 
+	// the pending registration also closes the window between checkUser and
+	// the account row landing, a second signup for the email conflicts here
+	span.AddEvent("creating_registration")
+	err = su.authRepo.CreateRegistration(uctx, userId, user.GetEmail())
+	if errors.Is(err, repository.ErrRegistrationPending) {
+		err := fmt.Errorf("%w: registration is already pending", ErrorUserIsExist)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Registration already pending")
+		return nil, err
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed to create registration")
+		return nil, fmt.Errorf("%w: %s", ErrorRegisterUser, err)
+	}
+
+	span.AddEvent("creating_user")
 	err = su.authRepo.CreateUserAsync(
 		uctx,
 		userId,
@@ -73,17 +91,30 @@ func (su serviceUsecase) UserRegister(ctx context.Context, user *pbgen.RegisterU
 		passwordHash,
 	)
 	if err != nil {
+		// the kafka transaction was aborted so nothing has to be compensated
+		if ferr := su.authRepo.FailRegistration(uctx, userId, "failed to publish registration"); ferr != nil {
+			span.RecordError(ferr)
+		}
+
 		span.RecordError(err)
 		span.SetStatus(codes.Error, "Failed to create user")
 		return nil, fmt.Errorf("%w: %s", ErrorRegisterUser, err)
 	}
 
+	// the records are committed at this point, a lost email must not fail the signup
+	span.AddEvent("sending_email_verification")
+	if err := su.sendEmailVerification(uctx, userId, user.GetEmail()); err != nil {
+		span.RecordError(err)
+	}
+
 	span.AddEvent("user_registration_completed")
 	span.SetStatus(codes.Ok, "User registered successfully")
 
 	out := &pbgen.RegisterUserResponse{
-		Msg:    "Success Create User",
-		Status: "Success",
+		Msg:                "Registration Pending",
+		Status:             "Success",
+		UserId:             userId,
+		RegistrationStatus: entity.RegistrationPending,
 	}
 	return out, nil
 }
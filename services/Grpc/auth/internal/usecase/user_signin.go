@@ -7,6 +7,9 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/sony-nurdianto/farm/auth/internal/encryption/token"
+	"github.com/sony-nurdianto/farm/auth/internal/entity"
 	"github.com/sony-nurdianto/farm/auth/internal/pbgen"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -24,11 +27,24 @@ func (su serviceUsecase) UserSignIn(ctx context.Context, req *pbgen.Authenticate
 		attribute.String("layer", "usecase"),
 	)
 
+	email := loginEmail(req.GetEmail())
+	ip := clientIP(uctx, req.GetIpAddress())
+
+	span.AddEvent("check_login_throttle")
+	if err := su.checkLoginThrottle(uctx, span, req, email, ip); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Sign In Throttled")
+		return nil, err
+	}
+
 	span.AddEvent("get_user_by_email")
 	user, err := su.authRepo.GetUserByEmail(uctx, req.GetEmail())
 	if errors.Is(err, sql.ErrNoRows) {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, fmt.Sprintf("User with email %s not found", req.GetEmail()))
+		if err := su.registerLoginFailure(uctx, span, req, "", email, ip, loginReasonUnknownEmail); err != nil {
+			return nil, err
+		}
 		return nil, fmt.Errorf("%w: user with email %s is not exist", ErrorUserIsNotExsist, req.GetEmail())
 	}
 
@@ -49,27 +65,99 @@ func (su serviceUsecase) UserSignIn(ctx context.Context, req *pbgen.Authenticate
 	if !isPass {
 		span.RecordError(errors.New("Unauthorized Password is Invalid"))
 		span.SetStatus(codes.Error, "User Password Is Invalid")
+		if err := su.registerLoginFailure(uctx, span, req, user.Id, email, ip, loginReasonInvalidPassword); err != nil {
+			return nil, err
+		}
 		return nil, ErrorPasswordIsInvalid
 	}
 
-	span.AddEvent("create_user_token")
-	createToken, err := su.tokhen.CreateWebToken(user.Id)
+	if su.passEncrypt.NeedsRehash(user.Password) {
+		span.AddEvent("rehash_user_password")
+		if err := su.rehashPassword(uctx, user.Id, req.GetPassword()); err != nil {
+			span.RecordError(err)
+		}
+	}
+
+	if user.TOTPEnabled {
+		span.AddEvent("require_second_factor")
+		response, err := su.createLoginChallenge(uctx, user.Id)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "Failed Create Login Challenge")
+			return nil, err
+		}
+
+		span.SetStatus(codes.Ok, "User SignIn waiting for second factor")
+		return response, nil
+	}
+
+	// with a second factor the failures are only cleared once it is verified,
+	// otherwise the password alone would reset the guesses left for the code
+	if err := su.authRepo.ClearLoginFailures(uctx, email); err != nil {
+		span.RecordError(err)
+	}
+
+	response, err := su.createSignInSession(uctx, user, req.GetUserAgent(), req.GetIpAddress())
 	if err != nil {
 		span.RecordError(err)
-		span.SetStatus(codes.Error, "Failed Create User Token")
+		span.SetStatus(codes.Error, "Failed Create User Session")
 		return nil, err
 	}
 
-	response := &pbgen.AuthenticateUserResponse{
-		Token:     createToken,
-		Status:    "Success",
-		Msg:       "User Authenticated Success Login. Welcome !",
-		IssuedAt:  timestamppb.Now(),
-		ExpiresAt: timestamppb.New(time.Now().Add(1 * time.Hour)),
-	}
+	span.AddEvent("record_user_login")
+	su.auditLoginAttempt(uctx, span, req, user.Id, email, ip, "")
+	su.publishUserLogin(uctx, span, req, user.Id, ip, loginOutcomeSuccess)
 
 	span.AddEvent("user_signin_completed")
 	span.SetStatus(codes.Ok, "User SignIn successfully")
 
 	return response, nil
 }
+
+// createSignInSession starts a session for the user and issues its access and
+// refresh tokens.
+func (su serviceUsecase) createSignInSession(
+	ctx context.Context,
+	user entity.Users,
+	userAgent, ipAddress string,
+) (*pbgen.AuthenticateUserResponse, error) {
+	refreshToken, refreshHash, err := token.GenerateOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := su.authRepo.CreateSession(
+		ctx,
+		entity.Session{
+			Id:        uuid.NewString(),
+			AccountId: user.Id,
+			UserAgent: userAgent,
+			IpAddress: ipAddress,
+			ExpiresAt: time.Now().UTC().Add(token.RefreshTokenTTL),
+		},
+		refreshHash,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	createToken, err := su.tokhen.CreateWebToken(token.AccessClaims{
+		Subject:   user.Id,
+		SessionID: session.Id,
+		Verified:  user.Verified,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbgen.AuthenticateUserResponse{
+		Token:            createToken,
+		Status:           "Success",
+		Msg:              "User Authenticated Success Login. Welcome !",
+		IssuedAt:         timestamppb.Now(),
+		ExpiresAt:        timestamppb.New(time.Now().Add(token.AccessTokenTTL)),
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: timestamppb.New(session.ExpiresAt),
+		SessionId:        session.Id,
+	}, nil
+}
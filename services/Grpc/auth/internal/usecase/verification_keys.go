@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/sony-nurdianto/farm/auth/internal/encryption/token"
+	"github.com/sony-nurdianto/farm/auth/internal/pbgen"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+func (su serviceUsecase) GetVerificationKeys(
+	ctx context.Context,
+	req *pbgen.GetVerificationKeysRequest,
+) (*pbgen.GetVerificationKeysResponse, error) {
+	tracer := otel.Tracer("auth-service")
+	_, span := tracer.Start(ctx, "Usecase:GetVerificationKeys")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "get_verification_keys"),
+		attribute.String("layer", "usecase"),
+	)
+
+	keys := su.tokhen.VerificationKeys()
+	res := &pbgen.GetVerificationKeysResponse{
+		Keys: make([]*pbgen.VerificationKey, 0, len(keys)),
+	}
+
+	for _, k := range keys {
+		res.Keys = append(res.Keys, &pbgen.VerificationKey{
+			Kid:       k.ID,
+			Version:   token.KeyVersion,
+			PublicKey: k.PublicKey,
+			Active:    k.Active,
+		})
+	}
+
+	span.SetAttributes(attribute.Int("keys.count", len(res.Keys)))
+	span.SetStatus(codes.Ok, "Verification Keys Published")
+
+	return res, nil
+}
@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sony-nurdianto/farm/auth/internal/encryption/token"
+	"github.com/sony-nurdianto/farm/auth/internal/mailer"
+	"github.com/sony-nurdianto/farm/auth/internal/pbgen"
+	"github.com/sony-nurdianto/farm/auth/internal/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+func (su serviceUsecase) sendEmailVerification(ctx context.Context, accountID, email string) error {
+	verifyToken, verifyHash, err := token.GenerateOpaqueToken()
+	if err != nil {
+		return err
+	}
+
+	expiresAt := time.Now().UTC().Add(token.EmailVerificationTTL)
+	if err := su.authRepo.CreateEmailVerification(ctx, accountID, email, verifyHash, expiresAt); err != nil {
+		return err
+	}
+
+	return su.mailer.Send(ctx, mailer.Message{
+		To:      email,
+		Subject: "Verify your email",
+		Body: fmt.Sprintf(
+			"Open the link below to verify your email, it expires at %s.\n\n%s?token=%s\n",
+			expiresAt.Format(time.RFC1123),
+			su.verifyURL,
+			verifyToken,
+		),
+	})
+}
+
+func (su serviceUsecase) VerifyEmail(
+	ctx context.Context,
+	req *pbgen.VerifyEmailRequest,
+) (*pbgen.VerifyEmailResponse, error) {
+	tracer := otel.Tracer("auth-service")
+	uctx, span := tracer.Start(ctx, "Usecase:VerifyEmail")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("operation", "verify_email"),
+		attribute.String("layer", "usecase"),
+	)
+
+	accountID, err := su.authRepo.VerifyEmail(uctx, token.HashOpaqueToken(req.GetToken()))
+	if errors.Is(err, repository.ErrVerificationNotFound) ||
+		errors.Is(err, repository.ErrVerificationUsed) ||
+		errors.Is(err, repository.ErrVerificationExpired) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Email Verification Is Invalid")
+		return nil, fmt.Errorf("%w: %s", ErrorVerificationInvalid, err)
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "Failed Verify Email")
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.String("user.id", accountID))
+	span.SetStatus(codes.Ok, "Email Verified")
+
+	return &pbgen.VerifyEmailResponse{
+		Status: "Success",
+		Msg:    "Email Verified",
+	}, nil
+}
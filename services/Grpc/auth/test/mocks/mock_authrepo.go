@@ -5,10 +5,14 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	entity "github.com/sony-nurdianto/farm/auth/internal/entity"
+	models "github.com/sony-nurdianto/farm/auth/internal/models"
+	repository "github.com/sony-nurdianto/farm/auth/internal/repository"
 )
 
 // MockAuthRepo is a mock of AuthRepo interface.
@@ -34,31 +38,498 @@ func (m *MockAuthRepo) EXPECT() *MockAuthRepoMockRecorder {
 	return m.recorder
 }
 
+// AttemptLoginChallenge mocks base method.
+func (m *MockAuthRepo) AttemptLoginChallenge(ctx context.Context, challengeHash string) (string, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttemptLoginChallenge", ctx, challengeHash)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AttemptLoginChallenge indicates an expected call of AttemptLoginChallenge.
+func (mr *MockAuthRepoMockRecorder) AttemptLoginChallenge(ctx, challengeHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttemptLoginChallenge", reflect.TypeOf((*MockAuthRepo)(nil).AttemptLoginChallenge), ctx, challengeHash)
+}
+
+// ClearLoginFailures mocks base method.
+func (m *MockAuthRepo) ClearLoginFailures(ctx context.Context, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearLoginFailures", ctx, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearLoginFailures indicates an expected call of ClearLoginFailures.
+func (mr *MockAuthRepoMockRecorder) ClearLoginFailures(ctx, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearLoginFailures", reflect.TypeOf((*MockAuthRepo)(nil).ClearLoginFailures), ctx, email)
+}
+
+// CountLoginFailures mocks base method.
+func (m *MockAuthRepo) CountLoginFailures(ctx context.Context, scope repository.LoginScope, value string, window time.Duration) (entity.LoginWindow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountLoginFailures", ctx, scope, value, window)
+	ret0, _ := ret[0].(entity.LoginWindow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountLoginFailures indicates an expected call of CountLoginFailures.
+func (mr *MockAuthRepoMockRecorder) CountLoginFailures(ctx, scope, value, window interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountLoginFailures", reflect.TypeOf((*MockAuthRepo)(nil).CountLoginFailures), ctx, scope, value, window)
+}
+
+// CreateEmailVerification mocks base method.
+func (m *MockAuthRepo) CreateEmailVerification(ctx context.Context, accountID, email, tokenHash string, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEmailVerification", ctx, accountID, email, tokenHash, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateEmailVerification indicates an expected call of CreateEmailVerification.
+func (mr *MockAuthRepoMockRecorder) CreateEmailVerification(ctx, accountID, email, tokenHash, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEmailVerification", reflect.TypeOf((*MockAuthRepo)(nil).CreateEmailVerification), ctx, accountID, email, tokenHash, expiresAt)
+}
+
+// CreateLoginAttempt mocks base method.
+func (m *MockAuthRepo) CreateLoginAttempt(ctx context.Context, attempt entity.LoginAttempt) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLoginAttempt", ctx, attempt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateLoginAttempt indicates an expected call of CreateLoginAttempt.
+func (mr *MockAuthRepoMockRecorder) CreateLoginAttempt(ctx, attempt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoginAttempt", reflect.TypeOf((*MockAuthRepo)(nil).CreateLoginAttempt), ctx, attempt)
+}
+
+// CreateLoginChallenge mocks base method.
+func (m *MockAuthRepo) CreateLoginChallenge(ctx context.Context, challengeHash, accountID string, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLoginChallenge", ctx, challengeHash, accountID, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateLoginChallenge indicates an expected call of CreateLoginChallenge.
+func (mr *MockAuthRepoMockRecorder) CreateLoginChallenge(ctx, challengeHash, accountID, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoginChallenge", reflect.TypeOf((*MockAuthRepo)(nil).CreateLoginChallenge), ctx, challengeHash, accountID, ttl)
+}
+
+// CreatePasswordReset mocks base method.
+func (m *MockAuthRepo) CreatePasswordReset(ctx context.Context, accountID, tokenHash string, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePasswordReset", ctx, accountID, tokenHash, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePasswordReset indicates an expected call of CreatePasswordReset.
+func (mr *MockAuthRepoMockRecorder) CreatePasswordReset(ctx, accountID, tokenHash, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePasswordReset", reflect.TypeOf((*MockAuthRepo)(nil).CreatePasswordReset), ctx, accountID, tokenHash, expiresAt)
+}
+
+// CreateRegistration mocks base method.
+func (m *MockAuthRepo) CreateRegistration(ctx context.Context, userID, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRegistration", ctx, userID, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRegistration indicates an expected call of CreateRegistration.
+func (mr *MockAuthRepoMockRecorder) CreateRegistration(ctx, userID, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRegistration", reflect.TypeOf((*MockAuthRepo)(nil).CreateRegistration), ctx, userID, email)
+}
+
+// CreateSession mocks base method.
+func (m *MockAuthRepo) CreateSession(ctx context.Context, session entity.Session, refreshHash string) (entity.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", ctx, session, refreshHash)
+	ret0, _ := ret[0].(entity.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockAuthRepoMockRecorder) CreateSession(ctx, session, refreshHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockAuthRepo)(nil).CreateSession), ctx, session, refreshHash)
+}
+
 // CreateUserAsync mocks base method.
-func (m *MockAuthRepo) CreateUserAsync(id, email, fullName, phone, passwordHash string) error {
+func (m *MockAuthRepo) CreateUserAsync(ctx context.Context, id, email, fullName, phone, passwordHash string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUserAsync", id, email, fullName, phone, passwordHash)
+	ret := m.ctrl.Call(m, "CreateUserAsync", ctx, id, email, fullName, phone, passwordHash)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateUserAsync indicates an expected call of CreateUserAsync.
-func (mr *MockAuthRepoMockRecorder) CreateUserAsync(id, email, fullName, phone, passwordHash interface{}) *gomock.Call {
+func (mr *MockAuthRepoMockRecorder) CreateUserAsync(ctx, id, email, fullName, phone, passwordHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserAsync", reflect.TypeOf((*MockAuthRepo)(nil).CreateUserAsync), ctx, id, email, fullName, phone, passwordHash)
+}
+
+// DeleteLoginChallenge mocks base method.
+func (m *MockAuthRepo) DeleteLoginChallenge(ctx context.Context, challengeHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLoginChallenge", ctx, challengeHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLoginChallenge indicates an expected call of DeleteLoginChallenge.
+func (mr *MockAuthRepoMockRecorder) DeleteLoginChallenge(ctx, challengeHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLoginChallenge", reflect.TypeOf((*MockAuthRepo)(nil).DeleteLoginChallenge), ctx, challengeHash)
+}
+
+// DisableTOTP mocks base method.
+func (m *MockAuthRepo) DisableTOTP(ctx context.Context, accountID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableTOTP", ctx, accountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableTOTP indicates an expected call of DisableTOTP.
+func (mr *MockAuthRepoMockRecorder) DisableTOTP(ctx, accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTOTP", reflect.TypeOf((*MockAuthRepo)(nil).DisableTOTP), ctx, accountID)
+}
+
+// EnableTOTP mocks base method.
+func (m *MockAuthRepo) EnableTOTP(ctx context.Context, accountID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableTOTP", ctx, accountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableTOTP indicates an expected call of EnableTOTP.
+func (mr *MockAuthRepoMockRecorder) EnableTOTP(ctx, accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableTOTP", reflect.TypeOf((*MockAuthRepo)(nil).EnableTOTP), ctx, accountID)
+}
+
+// FailRegistration mocks base method.
+func (m *MockAuthRepo) FailRegistration(ctx context.Context, userID, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailRegistration", ctx, userID, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailRegistration indicates an expected call of FailRegistration.
+func (mr *MockAuthRepoMockRecorder) FailRegistration(ctx, userID, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailRegistration", reflect.TypeOf((*MockAuthRepo)(nil).FailRegistration), ctx, userID, reason)
+}
+
+// GetRegistration mocks base method.
+func (m *MockAuthRepo) GetRegistration(ctx context.Context, userID string) (entity.Registration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegistration", ctx, userID)
+	ret0, _ := ret[0].(entity.Registration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegistration indicates an expected call of GetRegistration.
+func (mr *MockAuthRepoMockRecorder) GetRegistration(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistration", reflect.TypeOf((*MockAuthRepo)(nil).GetRegistration), ctx, userID)
+}
+
+// GetTOTP mocks base method.
+func (m *MockAuthRepo) GetTOTP(ctx context.Context, accountID string) (entity.TOTP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTOTP", ctx, accountID)
+	ret0, _ := ret[0].(entity.TOTP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTOTP indicates an expected call of GetTOTP.
+func (mr *MockAuthRepoMockRecorder) GetTOTP(ctx, accountID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserAsync", reflect.TypeOf((*MockAuthRepo)(nil).CreateUserAsync), id, email, fullName, phone, passwordHash)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTOTP", reflect.TypeOf((*MockAuthRepo)(nil).GetTOTP), ctx, accountID)
 }
 
 // GetUserByEmail mocks base method.
-func (m *MockAuthRepo) GetUserByEmail(email string) (entity.Users, error) {
+func (m *MockAuthRepo) GetUserByEmail(ctx context.Context, email string) (entity.Users, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserByEmail", email)
+	ret := m.ctrl.Call(m, "GetUserByEmail", ctx, email)
 	ret0, _ := ret[0].(entity.Users)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserByEmail indicates an expected call of GetUserByEmail.
-func (mr *MockAuthRepoMockRecorder) GetUserByEmail(email interface{}) *gomock.Call {
+func (mr *MockAuthRepoMockRecorder) GetUserByEmail(ctx, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockAuthRepo)(nil).GetUserByEmail), ctx, email)
+}
+
+// GetUserByID mocks base method.
+func (m *MockAuthRepo) GetUserByID(ctx context.Context, id string) (entity.Users, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByID", ctx, id)
+	ret0, _ := ret[0].(entity.Users)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByID indicates an expected call of GetUserByID.
+func (mr *MockAuthRepoMockRecorder) GetUserByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockAuthRepo)(nil).GetUserByID), ctx, id)
+}
+
+// IsSessionRevoked mocks base method.
+func (m *MockAuthRepo) IsSessionRevoked(ctx context.Context, sessionID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSessionRevoked", ctx, sessionID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsSessionRevoked indicates an expected call of IsSessionRevoked.
+func (mr *MockAuthRepoMockRecorder) IsSessionRevoked(ctx, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSessionRevoked", reflect.TypeOf((*MockAuthRepo)(nil).IsSessionRevoked), ctx, sessionID)
+}
+
+// ListSessions mocks base method.
+func (m *MockAuthRepo) ListSessions(ctx context.Context, accountID string) ([]entity.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", ctx, accountID)
+	ret0, _ := ret[0].([]entity.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockAuthRepoMockRecorder) ListSessions(ctx, accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockAuthRepo)(nil).ListSessions), ctx, accountID)
+}
+
+// LockLogin mocks base method.
+func (m *MockAuthRepo) LockLogin(ctx context.Context, email string, base, max time.Duration) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockLogin", ctx, email, base, max)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockLogin indicates an expected call of LockLogin.
+func (mr *MockAuthRepoMockRecorder) LockLogin(ctx, email, base, max interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockLogin", reflect.TypeOf((*MockAuthRepo)(nil).LockLogin), ctx, email, base, max)
+}
+
+// LoginLockTTL mocks base method.
+func (m *MockAuthRepo) LoginLockTTL(ctx context.Context, email string) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoginLockTTL", ctx, email)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoginLockTTL indicates an expected call of LoginLockTTL.
+func (mr *MockAuthRepoMockRecorder) LoginLockTTL(ctx, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginLockTTL", reflect.TypeOf((*MockAuthRepo)(nil).LoginLockTTL), ctx, email)
+}
+
+// PublishUserLogin mocks base method.
+func (m *MockAuthRepo) PublishUserLogin(ctx context.Context, login models.UserLogin) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishUserLogin", ctx, login)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishUserLogin indicates an expected call of PublishUserLogin.
+func (mr *MockAuthRepoMockRecorder) PublishUserLogin(ctx, login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishUserLogin", reflect.TypeOf((*MockAuthRepo)(nil).PublishUserLogin), ctx, login)
+}
+
+// RecordLoginFailure mocks base method.
+func (m *MockAuthRepo) RecordLoginFailure(ctx context.Context, scope repository.LoginScope, value string, window time.Duration) (entity.LoginWindow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordLoginFailure", ctx, scope, value, window)
+	ret0, _ := ret[0].(entity.LoginWindow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordLoginFailure indicates an expected call of RecordLoginFailure.
+func (mr *MockAuthRepoMockRecorder) RecordLoginFailure(ctx, scope, value, window interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordLoginFailure", reflect.TypeOf((*MockAuthRepo)(nil).RecordLoginFailure), ctx, scope, value, window)
+}
+
+// ResetPassword mocks base method.
+func (m *MockAuthRepo) ResetPassword(ctx context.Context, tokenHash, passwordHash string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", ctx, tokenHash, passwordHash)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockAuthRepoMockRecorder) ResetPassword(ctx, tokenHash, passwordHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockAuthRepo)(nil).ResetPassword), ctx, tokenHash, passwordHash)
+}
+
+// RevokeAccountSessions mocks base method.
+func (m *MockAuthRepo) RevokeAccountSessions(ctx context.Context, accountID, exceptSessionID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAccountSessions", ctx, accountID, exceptSessionID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAccountSessions indicates an expected call of RevokeAccountSessions.
+func (mr *MockAuthRepoMockRecorder) RevokeAccountSessions(ctx, accountID, exceptSessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAccountSessions", reflect.TypeOf((*MockAuthRepo)(nil).RevokeAccountSessions), ctx, accountID, exceptSessionID)
+}
+
+// RevokeSession mocks base method.
+func (m *MockAuthRepo) RevokeSession(ctx context.Context, accountID, sessionID string) (entity.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, accountID, sessionID)
+	ret0, _ := ret[0].(entity.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockAuthRepoMockRecorder) RevokeSession(ctx, accountID, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockAuthRepo)(nil).RevokeSession), ctx, accountID, sessionID)
+}
+
+// RevokeSessionCache mocks base method.
+func (m *MockAuthRepo) RevokeSessionCache(ctx context.Context, sessionID string, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSessionCache", ctx, sessionID, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSessionCache indicates an expected call of RevokeSessionCache.
+func (mr *MockAuthRepoMockRecorder) RevokeSessionCache(ctx, sessionID, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSessionCache", reflect.TypeOf((*MockAuthRepo)(nil).RevokeSessionCache), ctx, sessionID, ttl)
+}
+
+// RotateRefreshToken mocks base method.
+func (m *MockAuthRepo) RotateRefreshToken(ctx context.Context, oldHash, newHash string, expiresAt time.Time) (entity.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateRefreshToken", ctx, oldHash, newHash, expiresAt)
+	ret0, _ := ret[0].(entity.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateRefreshToken indicates an expected call of RotateRefreshToken.
+func (mr *MockAuthRepoMockRecorder) RotateRefreshToken(ctx, oldHash, newHash, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateRefreshToken", reflect.TypeOf((*MockAuthRepo)(nil).RotateRefreshToken), ctx, oldHash, newHash, expiresAt)
+}
+
+// SaveTOTPEnrollment mocks base method.
+func (m *MockAuthRepo) SaveTOTPEnrollment(ctx context.Context, accountID, sealedSecret string, recoveryHashes []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTOTPEnrollment", ctx, accountID, sealedSecret, recoveryHashes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveTOTPEnrollment indicates an expected call of SaveTOTPEnrollment.
+func (mr *MockAuthRepoMockRecorder) SaveTOTPEnrollment(ctx, accountID, sealedSecret, recoveryHashes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTOTPEnrollment", reflect.TypeOf((*MockAuthRepo)(nil).SaveTOTPEnrollment), ctx, accountID, sealedSecret, recoveryHashes)
+}
+
+// UpdatePasswordHash mocks base method.
+func (m *MockAuthRepo) UpdatePasswordHash(ctx context.Context, accountID, passwordHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePasswordHash", ctx, accountID, passwordHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePasswordHash indicates an expected call of UpdatePasswordHash.
+func (mr *MockAuthRepoMockRecorder) UpdatePasswordHash(ctx, accountID, passwordHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePasswordHash", reflect.TypeOf((*MockAuthRepo)(nil).UpdatePasswordHash), ctx, accountID, passwordHash)
+}
+
+// UseRecoveryCode mocks base method.
+func (m *MockAuthRepo) UseRecoveryCode(ctx context.Context, accountID, codeHash string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseRecoveryCode", ctx, accountID, codeHash)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseRecoveryCode indicates an expected call of UseRecoveryCode.
+func (mr *MockAuthRepoMockRecorder) UseRecoveryCode(ctx, accountID, codeHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseRecoveryCode", reflect.TypeOf((*MockAuthRepo)(nil).UseRecoveryCode), ctx, accountID, codeHash)
+}
+
+// UseTOTPStep mocks base method.
+func (m *MockAuthRepo) UseTOTPStep(ctx context.Context, accountID string, step int64, ttl time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseTOTPStep", ctx, accountID, step, ttl)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseTOTPStep indicates an expected call of UseTOTPStep.
+func (mr *MockAuthRepoMockRecorder) UseTOTPStep(ctx, accountID, step, ttl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseTOTPStep", reflect.TypeOf((*MockAuthRepo)(nil).UseTOTPStep), ctx, accountID, step, ttl)
+}
+
+// VerifyEmail mocks base method.
+func (m *MockAuthRepo) VerifyEmail(ctx context.Context, tokenHash string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmail", ctx, tokenHash)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockAuthRepoMockRecorder) VerifyEmail(ctx, tokenHash interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockAuthRepo)(nil).GetUserByEmail), email)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockAuthRepo)(nil).VerifyEmail), ctx, tokenHash)
 }
@@ -8,7 +8,6 @@ import (
 	reflect "reflect"
 
 	schemaregistry "github.com/confluentinc/confluent-kafka-go/v2/schemaregistry"
-	avro "github.com/confluentinc/confluent-kafka-go/v2/schemaregistry/serde/avro"
 	gomock "github.com/golang/mock/gomock"
 	avr "github.com/sony-nurdianto/farm/shared_lib/Go/kafkaev/avr"
 )
@@ -37,7 +36,7 @@ func (m *MockAvrSerdeInstance) EXPECT() *MockAvrSerdeInstanceMockRecorder {
 }
 
 // NewGenericDeserializer mocks base method.
-func (m *MockAvrSerdeInstance) NewGenericDeserializer(arg0 schemaregistry.Client, arg1 int, arg2 *avro.DeserializerConfig) (avr.AvrDeserializer, error) {
+func (m *MockAvrSerdeInstance) NewGenericDeserializer(arg0 schemaregistry.Client, arg1 int, arg2 *avr.DeserializerConfig) (avr.AvrDeserializer, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "NewGenericDeserializer", arg0, arg1, arg2)
 	ret0, _ := ret[0].(avr.AvrDeserializer)
@@ -89,6 +88,20 @@ func (m *MockAvrSerializer) EXPECT() *MockAvrSerializerMockRecorder {
 	return m.recorder
 }
 
+// Close mocks base method.
+func (m *MockAvrSerializer) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockAvrSerializerMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockAvrSerializer)(nil).Close))
+}
+
 // Serialize mocks base method.
 func (m *MockAvrSerializer) Serialize(arg0 string, arg1 interface{}) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -127,6 +140,20 @@ func (m *MockAvrDeserializer) EXPECT() *MockAvrDeserializerMockRecorder {
 	return m.recorder
 }
 
+// Close mocks base method.
+func (m *MockAvrDeserializer) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockAvrDeserializerMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockAvrDeserializer)(nil).Close))
+}
+
 // DeserializeInto mocks base method.
 func (m *MockAvrDeserializer) DeserializeInto(arg0 string, arg1 []byte, arg2 interface{}) error {
 	m.ctrl.T.Helper()
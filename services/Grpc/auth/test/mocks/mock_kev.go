@@ -36,6 +36,21 @@ func (m *MockKafka) EXPECT() *MockKafkaMockRecorder {
 	return m.recorder
 }
 
+// NewConsumer mocks base method.
+func (m *MockKafka) NewConsumer(arg0 *kafka.ConfigMap) (kev.KevConsumer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewConsumer", arg0)
+	ret0, _ := ret[0].(kev.KevConsumer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewConsumer indicates an expected call of NewConsumer.
+func (mr *MockKafkaMockRecorder) NewConsumer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewConsumer", reflect.TypeOf((*MockKafka)(nil).NewConsumer), arg0)
+}
+
 // NewProducer mocks base method.
 func (m *MockKafka) NewProducer(arg0 *kafka.ConfigMap) (kev.KevProducer, error) {
 	m.ctrl.T.Helper()
@@ -156,6 +171,21 @@ func (mr *MockKevProducerMockRecorder) Flush(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Flush", reflect.TypeOf((*MockKevProducer)(nil).Flush), arg0)
 }
 
+// GetMetadata mocks base method.
+func (m *MockKevProducer) GetMetadata(arg0 *string, arg1 bool, arg2 int) (*kafka.Metadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMetadata", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*kafka.Metadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMetadata indicates an expected call of GetMetadata.
+func (mr *MockKevProducerMockRecorder) GetMetadata(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetadata", reflect.TypeOf((*MockKevProducer)(nil).GetMetadata), arg0, arg1, arg2)
+}
+
 // InitTransactions mocks base method.
 func (m *MockKevProducer) InitTransactions(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -48,6 +48,20 @@ func (mr *MockPassEncryptMockRecorder) HashPassword(password interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashPassword", reflect.TypeOf((*MockPassEncrypt)(nil).HashPassword), password)
 }
 
+// NeedsRehash mocks base method.
+func (m *MockPassEncrypt) NeedsRehash(passwordHash string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NeedsRehash", passwordHash)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// NeedsRehash indicates an expected call of NeedsRehash.
+func (mr *MockPassEncryptMockRecorder) NeedsRehash(passwordHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NeedsRehash", reflect.TypeOf((*MockPassEncrypt)(nil).NeedsRehash), passwordHash)
+}
+
 // VerifyPassword mocks base method.
 func (m *MockPassEncrypt) VerifyPassword(password, passwordHash string) (bool, error) {
 	m.ctrl.T.Helper()
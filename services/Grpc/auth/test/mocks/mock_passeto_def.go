@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	ed25519 "crypto/ed25519"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -33,31 +34,31 @@ func (m *MockPassetoToken) EXPECT() *MockPassetoTokenMockRecorder {
 	return m.recorder
 }
 
-// Decrypt mocks base method.
-func (m *MockPassetoToken) Decrypt(token string, key []byte, payload, footer any) error {
+// Sign mocks base method.
+func (m *MockPassetoToken) Sign(privateKey ed25519.PrivateKey, payload, footer any) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Decrypt", token, key, payload, footer)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Sign", privateKey, payload, footer)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Decrypt indicates an expected call of Decrypt.
-func (mr *MockPassetoTokenMockRecorder) Decrypt(token, key, payload, footer interface{}) *gomock.Call {
+// Sign indicates an expected call of Sign.
+func (mr *MockPassetoTokenMockRecorder) Sign(privateKey, payload, footer interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockPassetoToken)(nil).Decrypt), token, key, payload, footer)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockPassetoToken)(nil).Sign), privateKey, payload, footer)
 }
 
-// Encrypt mocks base method.
-func (m *MockPassetoToken) Encrypt(secretKey []byte, payload, footer any) (string, error) {
+// Verify mocks base method.
+func (m *MockPassetoToken) Verify(token string, publicKey ed25519.PublicKey, payload, footer any) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Encrypt", secretKey, payload, footer)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Verify", token, publicKey, payload, footer)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Encrypt indicates an expected call of Encrypt.
-func (mr *MockPassetoTokenMockRecorder) Encrypt(secretKey, payload, footer interface{}) *gomock.Call {
+// Verify indicates an expected call of Verify.
+func (mr *MockPassetoTokenMockRecorder) Verify(token, publicKey, payload, footer interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Encrypt", reflect.TypeOf((*MockPassetoToken)(nil).Encrypt), secretKey, payload, footer)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockPassetoToken)(nil).Verify), token, publicKey, payload, footer)
 }
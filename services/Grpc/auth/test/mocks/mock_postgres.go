@@ -6,6 +6,7 @@ package mocks
 
 import (
 	context "context"
+	sql "database/sql"
 	reflect "reflect"
 	time "time"
 
@@ -74,6 +75,36 @@ func (m *MockPostgresDatabase) EXPECT() *MockPostgresDatabaseMockRecorder {
 	return m.recorder
 }
 
+// Begin mocks base method.
+func (m *MockPostgresDatabase) Begin() (pkg.SQLTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Begin")
+	ret0, _ := ret[0].(pkg.SQLTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Begin indicates an expected call of Begin.
+func (mr *MockPostgresDatabaseMockRecorder) Begin() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Begin", reflect.TypeOf((*MockPostgresDatabase)(nil).Begin))
+}
+
+// BeginTx mocks base method.
+func (m *MockPostgresDatabase) BeginTx(arg0 context.Context, arg1 *sql.TxOptions) (pkg.SQLTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginTx", arg0, arg1)
+	ret0, _ := ret[0].(pkg.SQLTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeginTx indicates an expected call of BeginTx.
+func (mr *MockPostgresDatabaseMockRecorder) BeginTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginTx", reflect.TypeOf((*MockPostgresDatabase)(nil).BeginTx), arg0, arg1)
+}
+
 // Close mocks base method.
 func (m *MockPostgresDatabase) Close() error {
 	m.ctrl.T.Helper()
@@ -88,6 +119,26 @@ func (mr *MockPostgresDatabaseMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockPostgresDatabase)(nil).Close))
 }
 
+// ExecContext mocks base method.
+func (m *MockPostgresDatabase) ExecContext(arg0 context.Context, arg1 string, arg2 ...interface{}) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExecContext", varargs...)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecContext indicates an expected call of ExecContext.
+func (mr *MockPostgresDatabaseMockRecorder) ExecContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecContext", reflect.TypeOf((*MockPostgresDatabase)(nil).ExecContext), varargs...)
+}
+
 // PingContext mocks base method.
 func (m *MockPostgresDatabase) PingContext(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -117,6 +168,45 @@ func (mr *MockPostgresDatabaseMockRecorder) Prepare(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Prepare", reflect.TypeOf((*MockPostgresDatabase)(nil).Prepare), arg0)
 }
 
+// QueryContext mocks base method.
+func (m *MockPostgresDatabase) QueryContext(arg0 context.Context, arg1 string, arg2 ...interface{}) (pkg.Rows, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "QueryContext", varargs...)
+	ret0, _ := ret[0].(pkg.Rows)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryContext indicates an expected call of QueryContext.
+func (mr *MockPostgresDatabaseMockRecorder) QueryContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryContext", reflect.TypeOf((*MockPostgresDatabase)(nil).QueryContext), varargs...)
+}
+
+// QueryRowContext mocks base method.
+func (m *MockPostgresDatabase) QueryRowContext(arg0 context.Context, arg1 string, arg2 ...interface{}) pkg.Row {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "QueryRowContext", varargs...)
+	ret0, _ := ret[0].(pkg.Row)
+	return ret0
+}
+
+// QueryRowContext indicates an expected call of QueryRowContext.
+func (mr *MockPostgresDatabaseMockRecorder) QueryRowContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryRowContext", reflect.TypeOf((*MockPostgresDatabase)(nil).QueryRowContext), varargs...)
+}
+
 // SetConnMaxLifetime mocks base method.
 func (m *MockPostgresDatabase) SetConnMaxLifetime(arg0 time.Duration) {
 	m.ctrl.T.Helper()
@@ -176,6 +266,40 @@ func (m *MockStmt) EXPECT() *MockStmtMockRecorder {
 	return m.recorder
 }
 
+// Close mocks base method.
+func (m *MockStmt) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockStmtMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockStmt)(nil).Close))
+}
+
+// ExecContext mocks base method.
+func (m *MockStmt) ExecContext(arg0 context.Context, arg1 ...interface{}) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExecContext", varargs...)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecContext indicates an expected call of ExecContext.
+func (mr *MockStmtMockRecorder) ExecContext(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecContext", reflect.TypeOf((*MockStmt)(nil).ExecContext), varargs...)
+}
+
 // QueryContext mocks base method.
 func (m *MockStmt) QueryContext(arg0 context.Context, arg1 ...interface{}) (pkg.Rows, error) {
 	m.ctrl.T.Helper()
@@ -215,6 +339,20 @@ func (mr *MockStmtMockRecorder) QueryRowContext(arg0 interface{}, arg1 ...interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryRowContext", reflect.TypeOf((*MockStmt)(nil).QueryRowContext), varargs...)
 }
 
+// ToSQLSTMT mocks base method.
+func (m *MockStmt) ToSQLSTMT() *sql.Stmt {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToSQLSTMT")
+	ret0, _ := ret[0].(*sql.Stmt)
+	return ret0
+}
+
+// ToSQLSTMT indicates an expected call of ToSQLSTMT.
+func (mr *MockStmtMockRecorder) ToSQLSTMT() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToSQLSTMT", reflect.TypeOf((*MockStmt)(nil).ToSQLSTMT))
+}
+
 // MockRow is a mock of Row interface.
 type MockRow struct {
 	ctrl     *gomock.Controller
@@ -238,6 +376,20 @@ func (m *MockRow) EXPECT() *MockRowMockRecorder {
 	return m.recorder
 }
 
+// Err mocks base method.
+func (m *MockRow) Err() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Err")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Err indicates an expected call of Err.
+func (mr *MockRowMockRecorder) Err() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Err", reflect.TypeOf((*MockRow)(nil).Err))
+}
+
 // Scan mocks base method.
 func (m *MockRow) Scan(arg0 ...interface{}) error {
 	m.ctrl.T.Helper()
@@ -115,3 +115,17 @@ func (mr *MockSchemaRegisteryInstanceMockRecorder) NewClient(arg0 interface{}) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewClient", reflect.TypeOf((*MockSchemaRegisteryInstance)(nil).NewClient), arg0)
 }
+
+// NewConfig mocks base method.
+func (m *MockSchemaRegisteryInstance) NewConfig(arg0 string) *schemaregistry.Config {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewConfig", arg0)
+	ret0, _ := ret[0].(*schemaregistry.Config)
+	return ret0
+}
+
+// NewConfig indicates an expected call of NewConfig.
+func (mr *MockSchemaRegisteryInstanceMockRecorder) NewConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewConfig", reflect.TypeOf((*MockSchemaRegisteryInstance)(nil).NewConfig), arg0)
+}
@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: secret_cipher.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockSecretCipher is a mock of SecretCipher interface.
+type MockSecretCipher struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretCipherMockRecorder
+}
+
+// MockSecretCipherMockRecorder is the mock recorder for MockSecretCipher.
+type MockSecretCipherMockRecorder struct {
+	mock *MockSecretCipher
+}
+
+// NewMockSecretCipher creates a new mock instance.
+func NewMockSecretCipher(ctrl *gomock.Controller) *MockSecretCipher {
+	mock := &MockSecretCipher{ctrl: ctrl}
+	mock.recorder = &MockSecretCipherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretCipher) EXPECT() *MockSecretCipherMockRecorder {
+	return m.recorder
+}
+
+// Open mocks base method.
+func (m *MockSecretCipher) Open(ciphertext string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", ciphertext)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockSecretCipherMockRecorder) Open(ciphertext interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockSecretCipher)(nil).Open), ciphertext)
+}
+
+// Seal mocks base method.
+func (m *MockSecretCipher) Seal(plaintext string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Seal", plaintext)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Seal indicates an expected call of Seal.
+func (mr *MockSecretCipherMockRecorder) Seal(plaintext interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seal", reflect.TypeOf((*MockSecretCipher)(nil).Seal), plaintext)
+}
@@ -9,6 +9,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	paseto "github.com/o1egl/paseto"
+	token "github.com/sony-nurdianto/farm/auth/internal/encryption/token"
 )
 
 // MockTokhan is a mock of Tokhan interface.
@@ -35,18 +36,32 @@ func (m *MockTokhan) EXPECT() *MockTokhanMockRecorder {
 }
 
 // CreateWebToken mocks base method.
-func (m *MockTokhan) CreateWebToken(subject string) (string, error) {
+func (m *MockTokhan) CreateWebToken(claims token.AccessClaims) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateWebToken", subject)
+	ret := m.ctrl.Call(m, "CreateWebToken", claims)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateWebToken indicates an expected call of CreateWebToken.
-func (mr *MockTokhanMockRecorder) CreateWebToken(subject interface{}) *gomock.Call {
+func (mr *MockTokhanMockRecorder) CreateWebToken(claims interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebToken", reflect.TypeOf((*MockTokhan)(nil).CreateWebToken), subject)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebToken", reflect.TypeOf((*MockTokhan)(nil).CreateWebToken), claims)
+}
+
+// VerificationKeys mocks base method.
+func (m *MockTokhan) VerificationKeys() []token.VerificationKey {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerificationKeys")
+	ret0, _ := ret[0].([]token.VerificationKey)
+	return ret0
+}
+
+// VerificationKeys indicates an expected call of VerificationKeys.
+func (mr *MockTokhanMockRecorder) VerificationKeys() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerificationKeys", reflect.TypeOf((*MockTokhan)(nil).VerificationKeys))
 }
 
 // VerifyWebToken mocks base method.
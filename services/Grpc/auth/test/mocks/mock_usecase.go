@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -34,47 +35,257 @@ func (m *MockServiceUsecase) EXPECT() *MockServiceUsecaseMockRecorder {
 	return m.recorder
 }
 
+// ChangePassword mocks base method.
+func (m *MockServiceUsecase) ChangePassword(ctx context.Context, req *pbgen.ChangePasswordRequest) (*pbgen.ChangePasswordResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", ctx, req)
+	ret0, _ := ret[0].(*pbgen.ChangePasswordResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockServiceUsecaseMockRecorder) ChangePassword(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockServiceUsecase)(nil).ChangePassword), ctx, req)
+}
+
+// ConfirmTOTP mocks base method.
+func (m *MockServiceUsecase) ConfirmTOTP(ctx context.Context, req *pbgen.ConfirmTOTPRequest) (*pbgen.ConfirmTOTPResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmTOTP", ctx, req)
+	ret0, _ := ret[0].(*pbgen.ConfirmTOTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmTOTP indicates an expected call of ConfirmTOTP.
+func (mr *MockServiceUsecaseMockRecorder) ConfirmTOTP(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmTOTP", reflect.TypeOf((*MockServiceUsecase)(nil).ConfirmTOTP), ctx, req)
+}
+
+// DisableTOTP mocks base method.
+func (m *MockServiceUsecase) DisableTOTP(ctx context.Context, req *pbgen.DisableTOTPRequest) (*pbgen.DisableTOTPResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableTOTP", ctx, req)
+	ret0, _ := ret[0].(*pbgen.DisableTOTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableTOTP indicates an expected call of DisableTOTP.
+func (mr *MockServiceUsecaseMockRecorder) DisableTOTP(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTOTP", reflect.TypeOf((*MockServiceUsecase)(nil).DisableTOTP), ctx, req)
+}
+
+// EnrollTOTP mocks base method.
+func (m *MockServiceUsecase) EnrollTOTP(ctx context.Context, req *pbgen.EnrollTOTPRequest) (*pbgen.EnrollTOTPResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnrollTOTP", ctx, req)
+	ret0, _ := ret[0].(*pbgen.EnrollTOTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnrollTOTP indicates an expected call of EnrollTOTP.
+func (mr *MockServiceUsecaseMockRecorder) EnrollTOTP(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollTOTP", reflect.TypeOf((*MockServiceUsecase)(nil).EnrollTOTP), ctx, req)
+}
+
+// GetRegistrationStatus mocks base method.
+func (m *MockServiceUsecase) GetRegistrationStatus(ctx context.Context, req *pbgen.GetRegistrationStatusRequest) (*pbgen.GetRegistrationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegistrationStatus", ctx, req)
+	ret0, _ := ret[0].(*pbgen.GetRegistrationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegistrationStatus indicates an expected call of GetRegistrationStatus.
+func (mr *MockServiceUsecaseMockRecorder) GetRegistrationStatus(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistrationStatus", reflect.TypeOf((*MockServiceUsecase)(nil).GetRegistrationStatus), ctx, req)
+}
+
+// GetVerificationKeys mocks base method.
+func (m *MockServiceUsecase) GetVerificationKeys(ctx context.Context, req *pbgen.GetVerificationKeysRequest) (*pbgen.GetVerificationKeysResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVerificationKeys", ctx, req)
+	ret0, _ := ret[0].(*pbgen.GetVerificationKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVerificationKeys indicates an expected call of GetVerificationKeys.
+func (mr *MockServiceUsecaseMockRecorder) GetVerificationKeys(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVerificationKeys", reflect.TypeOf((*MockServiceUsecase)(nil).GetVerificationKeys), ctx, req)
+}
+
+// ListSessions mocks base method.
+func (m *MockServiceUsecase) ListSessions(ctx context.Context, req *pbgen.ListSessionsRequest) (*pbgen.ListSessionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", ctx, req)
+	ret0, _ := ret[0].(*pbgen.ListSessionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockServiceUsecaseMockRecorder) ListSessions(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockServiceUsecase)(nil).ListSessions), ctx, req)
+}
+
+// Logout mocks base method.
+func (m *MockServiceUsecase) Logout(ctx context.Context, req *pbgen.LogoutRequest) (*pbgen.LogoutResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", ctx, req)
+	ret0, _ := ret[0].(*pbgen.LogoutResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockServiceUsecaseMockRecorder) Logout(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockServiceUsecase)(nil).Logout), ctx, req)
+}
+
+// RefreshToken mocks base method.
+func (m *MockServiceUsecase) RefreshToken(ctx context.Context, req *pbgen.RefreshTokenRequest) (*pbgen.RefreshTokenResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshToken", ctx, req)
+	ret0, _ := ret[0].(*pbgen.RefreshTokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshToken indicates an expected call of RefreshToken.
+func (mr *MockServiceUsecaseMockRecorder) RefreshToken(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshToken", reflect.TypeOf((*MockServiceUsecase)(nil).RefreshToken), ctx, req)
+}
+
+// RequestPasswordReset mocks base method.
+func (m *MockServiceUsecase) RequestPasswordReset(ctx context.Context, req *pbgen.RequestPasswordResetRequest) (*pbgen.RequestPasswordResetResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestPasswordReset", ctx, req)
+	ret0, _ := ret[0].(*pbgen.RequestPasswordResetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestPasswordReset indicates an expected call of RequestPasswordReset.
+func (mr *MockServiceUsecaseMockRecorder) RequestPasswordReset(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPasswordReset", reflect.TypeOf((*MockServiceUsecase)(nil).RequestPasswordReset), ctx, req)
+}
+
+// ResetPassword mocks base method.
+func (m *MockServiceUsecase) ResetPassword(ctx context.Context, req *pbgen.ResetPasswordRequest) (*pbgen.ResetPasswordResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", ctx, req)
+	ret0, _ := ret[0].(*pbgen.ResetPasswordResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockServiceUsecaseMockRecorder) ResetPassword(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockServiceUsecase)(nil).ResetPassword), ctx, req)
+}
+
+// RevokeSession mocks base method.
+func (m *MockServiceUsecase) RevokeSession(ctx context.Context, req *pbgen.RevokeSessionRequest) (*pbgen.RevokeSessionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, req)
+	ret0, _ := ret[0].(*pbgen.RevokeSessionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockServiceUsecaseMockRecorder) RevokeSession(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockServiceUsecase)(nil).RevokeSession), ctx, req)
+}
+
 // TokenValidate mocks base method.
-func (m *MockServiceUsecase) TokenValidate(req *pbgen.TokenValidateRequest) (*pbgen.TokenValidateResponse, error) {
+func (m *MockServiceUsecase) TokenValidate(ctx context.Context, req *pbgen.TokenValidateRequest) (*pbgen.TokenValidateResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TokenValidate", req)
+	ret := m.ctrl.Call(m, "TokenValidate", ctx, req)
 	ret0, _ := ret[0].(*pbgen.TokenValidateResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // TokenValidate indicates an expected call of TokenValidate.
-func (mr *MockServiceUsecaseMockRecorder) TokenValidate(req interface{}) *gomock.Call {
+func (mr *MockServiceUsecaseMockRecorder) TokenValidate(ctx, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TokenValidate", reflect.TypeOf((*MockServiceUsecase)(nil).TokenValidate), req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TokenValidate", reflect.TypeOf((*MockServiceUsecase)(nil).TokenValidate), ctx, req)
 }
 
 // UserRegister mocks base method.
-func (m *MockServiceUsecase) UserRegister(user *pbgen.RegisterUserRequest) (*pbgen.RegisterUserResponse, error) {
+func (m *MockServiceUsecase) UserRegister(ctx context.Context, user *pbgen.RegisterUserRequest) (*pbgen.RegisterUserResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UserRegister", user)
+	ret := m.ctrl.Call(m, "UserRegister", ctx, user)
 	ret0, _ := ret[0].(*pbgen.RegisterUserResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UserRegister indicates an expected call of UserRegister.
-func (mr *MockServiceUsecaseMockRecorder) UserRegister(user interface{}) *gomock.Call {
+func (mr *MockServiceUsecaseMockRecorder) UserRegister(ctx, user interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserRegister", reflect.TypeOf((*MockServiceUsecase)(nil).UserRegister), user)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserRegister", reflect.TypeOf((*MockServiceUsecase)(nil).UserRegister), ctx, user)
 }
 
 // UserSignIn mocks base method.
-func (m *MockServiceUsecase) UserSignIn(req *pbgen.AuthenticateUserRequest) (*pbgen.AuthenticateUserResponse, error) {
+func (m *MockServiceUsecase) UserSignIn(ctx context.Context, req *pbgen.AuthenticateUserRequest) (*pbgen.AuthenticateUserResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UserSignIn", req)
+	ret := m.ctrl.Call(m, "UserSignIn", ctx, req)
 	ret0, _ := ret[0].(*pbgen.AuthenticateUserResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UserSignIn indicates an expected call of UserSignIn.
-func (mr *MockServiceUsecaseMockRecorder) UserSignIn(req interface{}) *gomock.Call {
+func (mr *MockServiceUsecaseMockRecorder) UserSignIn(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserSignIn", reflect.TypeOf((*MockServiceUsecase)(nil).UserSignIn), ctx, req)
+}
+
+// VerifyEmail mocks base method.
+func (m *MockServiceUsecase) VerifyEmail(ctx context.Context, req *pbgen.VerifyEmailRequest) (*pbgen.VerifyEmailResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmail", ctx, req)
+	ret0, _ := ret[0].(*pbgen.VerifyEmailResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockServiceUsecaseMockRecorder) VerifyEmail(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockServiceUsecase)(nil).VerifyEmail), ctx, req)
+}
+
+// VerifySecondFactor mocks base method.
+func (m *MockServiceUsecase) VerifySecondFactor(ctx context.Context, req *pbgen.VerifySecondFactorRequest) (*pbgen.AuthenticateUserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifySecondFactor", ctx, req)
+	ret0, _ := ret[0].(*pbgen.AuthenticateUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifySecondFactor indicates an expected call of VerifySecondFactor.
+func (mr *MockServiceUsecaseMockRecorder) VerifySecondFactor(ctx, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserSignIn", reflect.TypeOf((*MockServiceUsecase)(nil).UserSignIn), req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifySecondFactor", reflect.TypeOf((*MockServiceUsecase)(nil).VerifySecondFactor), ctx, req)
 }
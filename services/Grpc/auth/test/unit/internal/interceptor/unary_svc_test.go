@@ -8,6 +8,7 @@ import (
 	"github.com/sony-nurdianto/farm/auth/internal/interceptor"
 	"github.com/sony-nurdianto/farm/auth/internal/pbgen"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -201,6 +202,39 @@ func TestUnaryInterceptorRegisterUserSucesss(t *testing.T) {
 	assert.Equal(t, registerResp.Status, "Success")
 }
 
+func TestUnaryInterceptorRegisterUserDeclaredRules(t *testing.T) {
+	handler := func(ctx context.Context, req any) (any, error) {
+		return &pbgen.RegisterUserResponse{}, nil
+	}
+
+	info := &grpc.UnaryServerInfo{
+		FullMethod: pbgen.AuthService_RegisterUser_FullMethodName,
+	}
+
+	_, err := interceptor.AuthServiceUnaryInterceptor(
+		context.Background(),
+		&pbgen.RegisterUserRequest{
+			Email:       "Sony@gmail.com",
+			PhoneNumber: "+62851588206",
+			Password:    "Some@P4assword",
+		},
+		info,
+		handler,
+	)
+
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.ErrorContains(t, err, "full_name")
+
+	details := status.Convert(err).Details()
+	assert.Len(t, details, 1)
+
+	badRequest, ok := details[0].(*errdetails.BadRequest)
+	assert.True(t, ok)
+	assert.Len(t, badRequest.GetFieldViolations(), 1)
+	assert.Equal(t, "full_name", badRequest.GetFieldViolations()[0].GetField())
+}
+
 func TestUnaryInterceptorAuthenticateUserErrorRequestNil(t *testing.T) {
 	handler := func(ctx context.Context, req any) (any, error) {
 		return nil, nil
@@ -410,3 +444,227 @@ func TestUnaryInterceptorTokenValidateSucesss(t *testing.T) {
 	assert.Equal(t, res.ExpiresAt, experiedAt)
 	assert.Equal(t, *res.Subject, subject)
 }
+
+func TestUnaryInterceptorRefreshTokenIsEmpty(t *testing.T) {
+	handler := func(ctx context.Context, req any) (any, error) {
+		return &pbgen.RefreshTokenResponse{}, nil
+	}
+
+	info := &grpc.UnaryServerInfo{
+		FullMethod: pbgen.AuthService_RefreshToken_FullMethodName,
+	}
+
+	_, err := interceptor.AuthServiceUnaryInterceptor(
+		context.Background(),
+		&pbgen.RefreshTokenRequest{},
+		info,
+		handler,
+	)
+
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.ErrorContains(t, err, "RefreshToken is empty")
+}
+
+func TestUnaryInterceptorRevokeSessionIDIsEmpty(t *testing.T) {
+	handler := func(ctx context.Context, req any) (any, error) {
+		return &pbgen.RevokeSessionResponse{}, nil
+	}
+
+	info := &grpc.UnaryServerInfo{
+		FullMethod: pbgen.AuthService_RevokeSession_FullMethodName,
+	}
+
+	_, err := interceptor.AuthServiceUnaryInterceptor(
+		context.Background(),
+		&pbgen.RevokeSessionRequest{AccountId: "user1"},
+		info,
+		handler,
+	)
+
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.ErrorContains(t, err, "SessionId is empty")
+}
+
+func TestUnaryInterceptorLogoutSuccess(t *testing.T) {
+	handler := func(ctx context.Context, req any) (any, error) {
+		return &pbgen.LogoutResponse{Status: "Success"}, nil
+	}
+
+	info := &grpc.UnaryServerInfo{
+		FullMethod: pbgen.AuthService_Logout_FullMethodName,
+	}
+
+	res, err := interceptor.AuthServiceUnaryInterceptor(
+		context.Background(),
+		&pbgen.LogoutRequest{Token: "token"},
+		info,
+		handler,
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Success", res.(*pbgen.LogoutResponse).Status)
+}
+
+func TestUnaryInterceptorResetPasswordWeakPassword(t *testing.T) {
+	handler := func(ctx context.Context, req any) (any, error) {
+		return &pbgen.ResetPasswordResponse{}, nil
+	}
+
+	info := &grpc.UnaryServerInfo{
+		FullMethod: pbgen.AuthService_ResetPassword_FullMethodName,
+	}
+
+	_, err := interceptor.AuthServiceUnaryInterceptor(
+		context.Background(),
+		&pbgen.ResetPasswordRequest{Token: "token", NewPassword: "weak"},
+		info,
+		handler,
+	)
+
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.ErrorContains(t, err, "New Password Invalid")
+
+	details := status.Convert(err).Details()
+	assert.Len(t, details, 1)
+
+	badRequest, ok := details[0].(*errdetails.BadRequest)
+	assert.True(t, ok)
+	assert.Equal(t, "new_password", badRequest.GetFieldViolations()[0].GetField())
+}
+
+func TestUnaryInterceptorChangePasswordSamePassword(t *testing.T) {
+	handler := func(ctx context.Context, req any) (any, error) {
+		return &pbgen.ChangePasswordResponse{}, nil
+	}
+
+	info := &grpc.UnaryServerInfo{
+		FullMethod: pbgen.AuthService_ChangePassword_FullMethodName,
+	}
+
+	_, err := interceptor.AuthServiceUnaryInterceptor(
+		context.Background(),
+		&pbgen.ChangePasswordRequest{
+			Token:           "token",
+			CurrentPassword: "Abcdef1!",
+			NewPassword:     "Abcdef1!",
+		},
+		info,
+		handler,
+	)
+
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.ErrorContains(t, err, "must differ")
+}
+
+func TestUnaryInterceptorRequestPasswordResetSuccess(t *testing.T) {
+	handler := func(ctx context.Context, req any) (any, error) {
+		return &pbgen.RequestPasswordResetResponse{Status: "Success"}, nil
+	}
+
+	info := &grpc.UnaryServerInfo{
+		FullMethod: pbgen.AuthService_RequestPasswordReset_FullMethodName,
+	}
+
+	res, err := interceptor.AuthServiceUnaryInterceptor(
+		context.Background(),
+		&pbgen.RequestPasswordResetRequest{Email: "farmer@example.com"},
+		info,
+		handler,
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Success", res.(*pbgen.RequestPasswordResetResponse).Status)
+}
+
+func TestUnaryInterceptorConfirmTOTPInvalidCode(t *testing.T) {
+	handler := func(ctx context.Context, req any) (any, error) {
+		return &pbgen.ConfirmTOTPResponse{}, nil
+	}
+
+	info := &grpc.UnaryServerInfo{
+		FullMethod: pbgen.AuthService_ConfirmTOTP_FullMethodName,
+	}
+
+	_, err := interceptor.AuthServiceUnaryInterceptor(
+		context.Background(),
+		&pbgen.ConfirmTOTPRequest{Token: "token", Code: "12a456"},
+		info,
+		handler,
+	)
+
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.ErrorContains(t, err, "6 digits")
+}
+
+func TestUnaryInterceptorVerifySecondFactor(t *testing.T) {
+	handler := func(ctx context.Context, req any) (any, error) {
+		return &pbgen.AuthenticateUserResponse{Status: "Success"}, nil
+	}
+
+	info := &grpc.UnaryServerInfo{
+		FullMethod: pbgen.AuthService_VerifySecondFactor_FullMethodName,
+	}
+
+	t.Run("Challenge Token Empty", func(t *testing.T) {
+		_, err := interceptor.AuthServiceUnaryInterceptor(
+			context.Background(),
+			&pbgen.VerifySecondFactorRequest{Code: "123456"},
+			info,
+			handler,
+		)
+
+		assert.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Recovery Code Accepted", func(t *testing.T) {
+		res, err := interceptor.AuthServiceUnaryInterceptor(
+			context.Background(),
+			&pbgen.VerifySecondFactorRequest{ChallengeToken: "challenge", Code: "abcde-fghij"},
+			info,
+			handler,
+		)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Success", res.(*pbgen.AuthenticateUserResponse).Status)
+	})
+}
+
+func TestUnaryInterceptorGetRegistrationStatus(t *testing.T) {
+	handler := func(ctx context.Context, req any) (any, error) {
+		return &pbgen.GetRegistrationStatusResponse{Status: "pending"}, nil
+	}
+
+	info := &grpc.UnaryServerInfo{
+		FullMethod: pbgen.AuthService_GetRegistrationStatus_FullMethodName,
+	}
+
+	t.Run("Id Not UUID", func(t *testing.T) {
+		_, err := interceptor.AuthServiceUnaryInterceptor(
+			context.Background(),
+			&pbgen.GetRegistrationStatusRequest{Id: "not-a-uuid"},
+			info,
+			handler,
+		)
+
+		assert.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Valid Id", func(t *testing.T) {
+		res, err := interceptor.AuthServiceUnaryInterceptor(
+			context.Background(),
+			&pbgen.GetRegistrationStatusRequest{Id: "0b7c2a1e-5f3d-4c8a-9e6b-1d2f3a4b5c6d"},
+			info,
+			handler,
+		)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "pending", res.(*pbgen.GetRegistrationStatusResponse).Status)
+	})
+}
@@ -0,0 +1,51 @@
+package unit_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sony-nurdianto/farm/auth/internal/mailer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileMailer(t *testing.T) {
+	t.Run("FileMailer Append Message", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "mail.log")
+		m := mailer.NewFileMailer(path)
+
+		err := m.Send(context.Background(), mailer.Message{
+			To:      "farmer@mail.com",
+			Subject: "Verify your email",
+			Body:    "token=abc",
+		})
+		assert.NoError(t, err)
+
+		data, err := os.ReadFile(path)
+		assert.NoError(t, err)
+		assert.Contains(t, string(data), "To: farmer@mail.com")
+		assert.Contains(t, string(data), "token=abc")
+	})
+
+	t.Run("FileMailer Error Context Canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := mailer.NewFileMailer("").Send(ctx, mailer.Message{})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestNewSMTPMailer(t *testing.T) {
+	t.Run("NewSMTPMailer Error Missing Config", func(t *testing.T) {
+		_, err := mailer.NewSMTPMailer(mailer.SMTPConfig{Host: "localhost"})
+		assert.ErrorIs(t, err, mailer.ErrSMTPConfig)
+	})
+
+	t.Run("NewMailerFromEnv Unknown Mailer", func(t *testing.T) {
+		t.Setenv("MAILER", "pigeon")
+		_, err := mailer.NewMailerFromEnv()
+		assert.Error(t, err)
+	})
+}
@@ -2,6 +2,7 @@ package unit_test
 
 import (
 	"crypto/rand"
+	"encoding/base64"
 	"errors"
 	"strings"
 	"testing"
@@ -11,6 +12,7 @@ import (
 	"github.com/sony-nurdianto/farm/auth/internal/encryption/passencrypt"
 	"github.com/sony-nurdianto/farm/auth/test/mocks"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/argon2"
 )
 
 type errReader struct{}
@@ -90,4 +92,64 @@ func TestPasswordHash(t *testing.T) {
 		assert.EqualError(t, err, "Failed to Decode Hash String")
 		assert.False(t, res)
 	})
+
+	t.Run("Hash Password PHC Format", func(t *testing.T) {
+		passEncrypt := passencrypt.NewPassEncrypt(rand.Reader, codec.NewBase64Encoder())
+
+		res, err := passEncrypt.HashPassword("Password")
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(res, "$argon2id$v=19$m=65536,t=1,p=4$"))
+		assert.False(t, passEncrypt.NeedsRehash(res))
+	})
+
+	t.Run("Verify Legacy Hash And Needs Rehash", func(t *testing.T) {
+		enc := codec.NewBase64Encoder()
+		salt := []byte(strings.Repeat("s", 32))
+		hash := argon2.IDKey([]byte("Password"), salt, 1, 64*1024, 4, 32)
+		legacy := base64.StdEncoding.EncodeToString(hash) + base64.StdEncoding.EncodeToString(salt)
+
+		passEncrypt := passencrypt.NewPassEncrypt(rand.Reader, enc)
+
+		ok, err := passEncrypt.VerifyPassword("Password", legacy)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.True(t, passEncrypt.NeedsRehash(legacy))
+	})
+
+	t.Run("Needs Rehash When Params Change", func(t *testing.T) {
+		passEncrypt := passencrypt.NewPassEncrypt(rand.Reader, codec.NewBase64Encoder())
+
+		res, err := passEncrypt.HashPassword("Password")
+		assert.NoError(t, err)
+
+		stronger := passEncrypt.WithParams(passencrypt.Params{
+			Time:    2,
+			Memory:  64 * 1024,
+			Threads: 4,
+			KeyLen:  32,
+			SaltLen: 32,
+		})
+
+		ok, err := stronger.VerifyPassword("Password", res)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.True(t, stronger.NeedsRehash(res))
+	})
+
+	t.Run("VerifyPassword Invalid PHC Hash", func(t *testing.T) {
+		passEncrypt := passencrypt.NewPassEncrypt(rand.Reader, codec.NewBase64Encoder())
+
+		ok, err := passEncrypt.VerifyPassword("Password", "$argon2id$v=19$m=1$bad")
+		assert.ErrorIs(t, err, passencrypt.ErrorInvalidHash)
+		assert.False(t, ok)
+	})
+
+	t.Run("VerifyPassword Empty PHC Hash", func(t *testing.T) {
+		passEncrypt := passencrypt.NewPassEncrypt(rand.Reader, codec.NewBase64Encoder())
+
+		salt := base64.RawStdEncoding.EncodeToString([]byte(strings.Repeat("s", 32)))
+		ok, err := passEncrypt.VerifyPassword("AnyPassword", "$argon2id$v=19$m=65536,t=1,p=4$"+salt+"$")
+		assert.ErrorIs(t, err, passencrypt.ErrorInvalidHash)
+		assert.False(t, ok)
+	})
 }
@@ -0,0 +1,77 @@
+package unit_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sony-nurdianto/farm/auth/internal/recorderr"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/observability/otel/logs"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestRecordWithDetails(t *testing.T) {
+	_, span := noop.NewTracerProvider().Tracer("test").Start(context.Background(), "test")
+	recorder := recorderr.NewErrorRecorder(span, logs.NewLogger())
+
+	err := recorder.RecordWithDetails(
+		context.Background(),
+		codes.ResourceExhausted,
+		"/auth.v1.AuthService/AuthenticateUser",
+		"too many sign in attempts",
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(time.Minute)},
+	)
+
+	st := status.Convert(err)
+	assert.Equal(t, codes.ResourceExhausted, st.Code())
+	assert.Equal(t, "too many sign in attempts", st.Message())
+	assert.Len(t, st.Details(), 1)
+
+	info, ok := st.Details()[0].(*errdetails.RetryInfo)
+	assert.True(t, ok)
+	assert.Equal(t, time.Minute, info.GetRetryDelay().AsDuration())
+}
+
+func TestRecordWithErrorInfo(t *testing.T) {
+	_, span := noop.NewTracerProvider().Tracer("test").Start(context.Background(), "test")
+	recorder := recorderr.NewErrorRecorder(span, logs.NewLogger())
+
+	err := recorder.RecordWithDetails(
+		context.Background(),
+		codes.NotFound,
+		"/auth.v1.AuthService/RevokeSession",
+		"session not found",
+		recorderr.ErrorInfo(recorderr.ReasonSessionNotFound),
+	)
+
+	st := status.Convert(err)
+	assert.Equal(t, codes.NotFound, st.Code())
+	assert.Len(t, st.Details(), 1)
+
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	assert.True(t, ok)
+	assert.Equal(t, recorderr.ReasonSessionNotFound, info.GetReason())
+	assert.Equal(t, recorderr.ErrorDomain, info.GetDomain())
+}
+
+func TestRecordInternal(t *testing.T) {
+	_, span := noop.NewTracerProvider().Tracer("test").Start(context.Background(), "test")
+	recorder := recorderr.NewErrorRecorder(span, logs.NewLogger())
+
+	err := recorder.RecordInternal(
+		context.Background(),
+		"/auth.v1.AuthService/ListSessions",
+		errors.New("dial tcp 10.0.3.7:6379: connection refused"),
+	)
+
+	st := status.Convert(err)
+	assert.Equal(t, codes.Internal, st.Code())
+	assert.Equal(t, "internal error", st.Message())
+	assert.NotContains(t, st.Message(), "6379")
+}
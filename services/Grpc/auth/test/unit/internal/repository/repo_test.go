@@ -5,388 +5,96 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
-	"github.com/sony-nurdianto/farm/auth/internal/repository"
-	"github.com/sony-nurdianto/farm/auth/test/mocks"
-	"github.com/sony-nurdianto/farm/shared_lib/Go/kafkaev/kev"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestRepo(t *testing.T) {
-	t.Run("NewPostgreRepo Success", func(t *testing.T) {
-		ctrl := gomock.NewController(t)
-		defer ctrl.Finish()
+	t.Run("NewAuthRepo Success", func(t *testing.T) {
+		d := newRepoDeps(t)
+		d.expectHealthy()
 
-		mockPgI := mocks.NewMockPostgresInstance(ctrl)
-		mockPgDb := mocks.NewMockPostgresDatabase(ctrl)
-		mockStmt := mocks.NewMockStmt(ctrl)
-
-		mockSchrgs := mocks.NewMockSchemaRegisteryInstance(ctrl)
-		mockClient := mocks.NewMockClient(ctrl)
-		mockAvr := mocks.NewMockAvrSerdeInstance(ctrl)
-		mockKev := mocks.NewMockKafka(ctrl)
-
-		mockProducer := mocks.NewMockKevProducer(ctrl)
-
-		mockPgI.EXPECT().
-			Open(gomock.Any(), gomock.Any()).
-			Return(mockPgDb, nil)
-
-		mockPgDb.EXPECT().
-			SetMaxOpenConns(gomock.Any()).
-			Return()
-
-		mockPgDb.EXPECT().
-			SetMaxIdleConns(gomock.Any()).
-			Return()
-
-		mockPgDb.EXPECT().
-			SetConnMaxLifetime(gomock.Any()).
-			Return()
-
-		mockPgDb.EXPECT().
-			PingContext(gomock.Any()).
-			Return(nil)
-
-		mockPgDb.EXPECT().
-			Prepare(gomock.Any()).
-			Return(mockStmt, nil).Times(2)
-
-		mockSchrgs.EXPECT().
-			NewClient(gomock.Any()).
-			Return(mockClient, nil)
-
-		mockKev.EXPECT().
-			NewProducer(gomock.Any()).
-			Return(mockProducer, nil)
-
-		eventsChan := make(chan kev.Event, 1) // buffered supaya gak blocking
-
-		mockProducer.EXPECT().
-			Events().
-			Return(eventsChan).
-			AnyTimes()
-
-		mockProducer.EXPECT().
-			InitTransactions(gomock.Any()).
-			Return(nil)
-
-		mockPgDb.EXPECT().Close().Return(nil)
-		mockProducer.EXPECT().Close().Return()
-		mockClient.EXPECT().Close().Return(nil)
-
-		r, err := repository.NewAuthRepo(mockSchrgs, mockPgI, mockAvr, mockKev)
-
-		r.CloseRepo()
+		rp, err := d.newRepo()
 		assert.NoError(t, err)
-		close(eventsChan)
+		assert.NotNil(t, rp)
 	})
 
-	t.Run("NewPostgreRepo Error OpenPostgres", func(t *testing.T) {
-		ctrl := gomock.NewController(t)
-		defer ctrl.Finish()
-
-		mockPgI := mocks.NewMockPostgresInstance(ctrl)
-		mockSchrgs := mocks.NewMockSchemaRegisteryInstance(ctrl)
-		mockAvr := mocks.NewMockAvrSerdeInstance(ctrl)
-
-		mockKev := mocks.NewMockKafka(ctrl)
-
-		mockPgI.EXPECT().
+	t.Run("NewAuthRepo Error OpenPostgres", func(t *testing.T) {
+		d := newRepoDeps(t)
+		d.pgi.EXPECT().
 			Open(gomock.Any(), gomock.Any()).
 			Return(nil, errors.New("Failed To Open Postgres"))
+		d.expectHealthy()
 
-		_, err := repository.NewAuthRepo(mockSchrgs, mockPgI, mockAvr, mockKev)
-		assert.Error(t, err)
+		_, err := d.newRepo()
+		assert.EqualError(t, err, "Failed To Open Postgres")
 	})
 
-	t.Run("NewPostgreRepo Error PrepareStmt CreateUser", func(t *testing.T) {
-		ctrl := gomock.NewController(t)
-		defer ctrl.Finish()
-
-		mockPgI := mocks.NewMockPostgresInstance(ctrl)
-		mockPgDb := mocks.NewMockPostgresDatabase(ctrl)
-
-		mockSchrgs := mocks.NewMockSchemaRegisteryInstance(ctrl)
-		mockAvr := mocks.NewMockAvrSerdeInstance(ctrl)
-		mockKev := mocks.NewMockKafka(ctrl)
-
-		mockPgI.EXPECT().
-			Open(gomock.Any(), gomock.Any()).
-			Return(mockPgDb, nil)
-
-		mockPgDb.EXPECT().
-			SetMaxOpenConns(gomock.Any()).
-			Return()
-
-		mockPgDb.EXPECT().
-			SetMaxIdleConns(gomock.Any()).
-			Return()
-
-		mockPgDb.EXPECT().
-			SetConnMaxLifetime(gomock.Any()).
-			Return()
-
-		mockPgDb.EXPECT().
-			PingContext(gomock.Any()).
-			Return(nil)
-
-		mockPgDb.EXPECT().
+	t.Run("NewAuthRepo Error PrepareStmt GetUserEmail", func(t *testing.T) {
+		d := newRepoDeps(t)
+		d.db.EXPECT().
 			Prepare(gomock.Any()).
 			Return(nil, errors.New("Failed To Create STMT"))
+		d.expectHealthy()
 
-		_, err := repository.NewAuthRepo(mockSchrgs, mockPgI, mockAvr, mockKev)
-		assert.Error(t, err)
+		_, err := d.newRepo()
 		assert.EqualError(t, err, "Failed To Create STMT")
 	})
 
-	t.Run("NewPostgreRepo Error PrepareStmt GetUserEmail", func(t *testing.T) {
-		ctrl := gomock.NewController(t)
-		defer ctrl.Finish()
-
-		mockPgI := mocks.NewMockPostgresInstance(ctrl)
-		mockPgDb := mocks.NewMockPostgresDatabase(ctrl)
-		mockStmt := mocks.NewMockStmt(ctrl)
-
-		mockSchrgs := mocks.NewMockSchemaRegisteryInstance(ctrl)
-		mockAvr := mocks.NewMockAvrSerdeInstance(ctrl)
-		mockKev := mocks.NewMockKafka(ctrl)
-
-		mockPgI.EXPECT().
-			Open(gomock.Any(), gomock.Any()).
-			Return(mockPgDb, nil)
-
-		mockPgDb.EXPECT().
-			SetMaxOpenConns(gomock.Any()).
-			Return()
-
-		mockPgDb.EXPECT().
-			SetMaxIdleConns(gomock.Any()).
-			Return()
+	t.Run("NewAuthRepo Error PrepareStmt Session", func(t *testing.T) {
+		d := newRepoDeps(t)
+		gomock.InOrder(
+			d.db.EXPECT().Prepare(gomock.Any()).Return(d.stmt, nil),
+			d.db.EXPECT().Prepare(gomock.Any()).Return(nil, errors.New("Failed to Create STMT CreateSession")),
+		)
+		d.expectHealthy()
 
-		mockPgDb.EXPECT().
-			SetConnMaxLifetime(gomock.Any()).
-			Return()
-
-		mockPgDb.EXPECT().
-			PingContext(gomock.Any()).
-			Return(nil)
-
-		mockPgDb.EXPECT().
-			Prepare(gomock.Any()).
-			Return(mockStmt, nil)
-
-		mockPgDb.EXPECT().
-			Prepare(gomock.Any()).
-			Return(nil, errors.New("Failed to Create STMT GetUSerEmail"))
-
-		_, err := repository.NewAuthRepo(mockSchrgs, mockPgI, mockAvr, mockKev)
-		assert.Error(t, err)
-		assert.EqualError(t, err, "Failed to Create STMT GetUSerEmail")
+		_, err := d.newRepo()
+		assert.EqualError(t, err, "Failed to Create STMT CreateSession")
 	})
 
-	t.Run("NewPostgreRepo Error PrepareStmt GetUserEmail", func(t *testing.T) {
-		ctrl := gomock.NewController(t)
-		defer ctrl.Finish()
-
-		mockPgI := mocks.NewMockPostgresInstance(ctrl)
-		mockPgDb := mocks.NewMockPostgresDatabase(ctrl)
-		mockStmt := mocks.NewMockStmt(ctrl)
-
-		mockSchrgs := mocks.NewMockSchemaRegisteryInstance(ctrl)
-		mockAvr := mocks.NewMockAvrSerdeInstance(ctrl)
-		mockKev := mocks.NewMockKafka(ctrl)
-
-		mockPgI.EXPECT().
-			Open(gomock.Any(), gomock.Any()).
-			Return(mockPgDb, nil)
-
-		mockPgDb.EXPECT().
-			SetMaxOpenConns(gomock.Any()).
-			Return()
-
-		mockPgDb.EXPECT().
-			SetMaxIdleConns(gomock.Any()).
-			Return()
-
-		mockPgDb.EXPECT().
-			SetConnMaxLifetime(gomock.Any()).
-			Return()
-
-		mockPgDb.EXPECT().
-			PingContext(gomock.Any()).
-			Return(nil)
-
-		mockPgDb.EXPECT().
-			Prepare(gomock.Any()).
-			Return(mockStmt, nil)
-
-		mockPgDb.EXPECT().
-			Prepare(gomock.Any()).
-			Return(nil, errors.New("Failed to Create STMT GetUSerEmail"))
-
-		_, err := repository.NewAuthRepo(mockSchrgs, mockPgI, mockAvr, mockKev)
-		assert.Error(t, err)
-		assert.EqualError(t, err, "Failed to Create STMT GetUSerEmail")
-	})
-
-	t.Run("NewPostgreRepo Error NewSchemaRegistery", func(t *testing.T) {
-		ctrl := gomock.NewController(t)
-		defer ctrl.Finish()
-
-		mockPgI := mocks.NewMockPostgresInstance(ctrl)
-		mockPgDb := mocks.NewMockPostgresDatabase(ctrl)
-		mockStmt := mocks.NewMockStmt(ctrl)
-
-		mockSchrgs := mocks.NewMockSchemaRegisteryInstance(ctrl)
-		mockAvr := mocks.NewMockAvrSerdeInstance(ctrl)
-		mockKev := mocks.NewMockKafka(ctrl)
-
-		mockPgI.EXPECT().
-			Open(gomock.Any(), gomock.Any()).
-			Return(mockPgDb, nil)
-
-		mockPgDb.EXPECT().
-			SetMaxOpenConns(gomock.Any()).
-			Return()
-
-		mockPgDb.EXPECT().
-			SetMaxIdleConns(gomock.Any()).
-			Return()
-
-		mockPgDb.EXPECT().
-			SetConnMaxLifetime(gomock.Any()).
-			Return()
-
-		mockPgDb.EXPECT().
-			PingContext(gomock.Any()).
-			Return(nil)
-
-		mockPgDb.EXPECT().
-			Prepare(gomock.Any()).
-			Return(mockStmt, nil).Times(2)
-
-		mockSchrgs.EXPECT().
+	t.Run("NewAuthRepo Error NewSchemaRegistery", func(t *testing.T) {
+		d := newRepoDeps(t)
+		d.sri.EXPECT().NewConfig(gomock.Any()).Return(nil)
+		d.sri.EXPECT().
 			NewClient(gomock.Any()).
 			Return(nil, errors.New("Failed To Create NewClient"))
+		d.expectHealthy()
 
-		_, err := repository.NewAuthRepo(mockSchrgs, mockPgI, mockAvr, mockKev)
-		assert.Error(t, err)
+		_, err := d.newRepo()
 		assert.EqualError(t, err, "Failed To Create NewClient")
 	})
 
-	t.Run("NewPostgreRepo Error Producer", func(t *testing.T) {
-		ctrl := gomock.NewController(t)
-		defer ctrl.Finish()
-
-		mockPgI := mocks.NewMockPostgresInstance(ctrl)
-		mockPgDb := mocks.NewMockPostgresDatabase(ctrl)
-		mockStmt := mocks.NewMockStmt(ctrl)
-
-		mockSchrgs := mocks.NewMockSchemaRegisteryInstance(ctrl)
-		mockAvr := mocks.NewMockAvrSerdeInstance(ctrl)
-		mockKev := mocks.NewMockKafka(ctrl)
-		mockClient := mocks.NewMockClient(ctrl)
-
-		mockPgI.EXPECT().
-			Open(gomock.Any(), gomock.Any()).
-			Return(mockPgDb, nil)
-
-		mockPgDb.EXPECT().
-			SetMaxOpenConns(gomock.Any()).
-			Return()
-
-		mockPgDb.EXPECT().
-			SetMaxIdleConns(gomock.Any()).
-			Return()
-
-		mockPgDb.EXPECT().
-			SetConnMaxLifetime(gomock.Any()).
-			Return()
+	t.Run("NewAuthRepo Error NewGenericSerializer", func(t *testing.T) {
+		d := newRepoDeps(t)
+		d.avr.EXPECT().
+			NewGenericSerializer(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("Something Wrong"))
+		d.expectHealthy()
 
-		mockPgDb.EXPECT().
-			PingContext(gomock.Any()).
-			Return(nil)
-
-		mockPgDb.EXPECT().
-			Prepare(gomock.Any()).
-			Return(mockStmt, nil).Times(2)
-
-		mockSchrgs.EXPECT().
-			NewClient(gomock.Any()).
-			Return(mockClient, nil)
+		_, err := d.newRepo()
+		assert.EqualError(t, err, "Something Wrong")
+	})
 
-		mockKev.EXPECT().
+	t.Run("NewAuthRepo Error Producer", func(t *testing.T) {
+		d := newRepoDeps(t)
+		d.kafka.EXPECT().
 			NewProducer(gomock.Any()).
 			Return(nil, errors.New("Failed  Create Producer"))
+		d.expectHealthy()
 
-		_, err := repository.NewAuthRepo(mockSchrgs, mockPgI, mockAvr, mockKev)
-		assert.Error(t, err)
+		_, err := d.newRepo()
 		assert.EqualError(t, err, "failed to create kafka producer: Failed  Create Producer")
 	})
 
-	t.Run("NewPostgreRepo Error InitTransactions", func(t *testing.T) {
-		ctrl := gomock.NewController(t)
-		defer ctrl.Finish()
-
-		mockPgI := mocks.NewMockPostgresInstance(ctrl)
-		mockPgDb := mocks.NewMockPostgresDatabase(ctrl)
-		mockStmt := mocks.NewMockStmt(ctrl)
-
-		mockSchrgs := mocks.NewMockSchemaRegisteryInstance(ctrl)
-		mockClient := mocks.NewMockClient(ctrl)
-		mockAvr := mocks.NewMockAvrSerdeInstance(ctrl)
-		mockKev := mocks.NewMockKafka(ctrl)
-
-		mockProducer := mocks.NewMockKevProducer(ctrl)
-
-		mockPgI.EXPECT().
-			Open(gomock.Any(), gomock.Any()).
-			Return(mockPgDb, nil)
-
-		mockPgDb.EXPECT().
-			SetMaxOpenConns(gomock.Any()).
-			Return()
-
-		mockPgDb.EXPECT().
-			SetMaxIdleConns(gomock.Any()).
-			Return()
-
-		mockPgDb.EXPECT().
-			SetConnMaxLifetime(gomock.Any()).
-			Return()
-
-		mockPgDb.EXPECT().
-			PingContext(gomock.Any()).
-			Return(nil)
-
-		mockPgDb.EXPECT().
-			Prepare(gomock.Any()).
-			Return(mockStmt, nil).Times(2)
-
-		mockSchrgs.EXPECT().
-			NewClient(gomock.Any()).
-			Return(mockClient, nil)
-
-		mockKev.EXPECT().
-			NewProducer(gomock.Any()).
-			Return(mockProducer, nil)
-
-		eventsChan := make(chan kev.Event, 1)
-
-		mockProducer.EXPECT().
-			Events().
-			Return(eventsChan).
-			AnyTimes()
-
-		mockProducer.EXPECT().
+	t.Run("NewAuthRepo Error InitTransactions", func(t *testing.T) {
+		d := newRepoDeps(t)
+		d.producer.EXPECT().
 			InitTransactions(gomock.Any()).
-			Return(errors.New("Error Init Transactions")).AnyTimes()
-
-		_, err := repository.NewAuthRepo(mockSchrgs, mockPgI, mockAvr, mockKev)
+			Return(errors.New("Error Init Transactions")).
+			Times(5)
+		d.expectHealthy()
 
-		assert.Error(t, err)
+		_, err := d.newRepo()
 		assert.EqualError(t, err, "init transactions failed after 5 attempts: Error Init Transactions")
-
-		close(eventsChan)
 	})
 }
@@ -0,0 +1,135 @@
+package unit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/sony-nurdianto/farm/auth/internal/repository"
+	"github.com/sony-nurdianto/farm/auth/test/mocks"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/kafkaev/kev"
+	"github.com/stretchr/testify/require"
+)
+
+// repoDeps are the dependencies of a repo under test. A test expects the
+// calls that differ from a healthy setup first, expectHealthy then answers
+// every other call.
+type repoDeps struct {
+	ctrl       *gomock.Controller
+	pgi        *mocks.MockPostgresInstance
+	db         *mocks.MockPostgresDatabase
+	stmt       *mocks.MockStmt
+	sri        *mocks.MockSchemaRegisteryInstance
+	client     *mocks.MockClient
+	avr        *mocks.MockAvrSerdeInstance
+	serializer *mocks.MockAvrSerializer
+	kafka      *mocks.MockKafka
+	producer   *mocks.MockKevProducer
+	rdi        *mocks.MockRedisInstance
+	events     chan kev.Event
+}
+
+func newRepoDeps(t *testing.T) *repoDeps {
+	ctrl := gomock.NewController(t)
+
+	d := &repoDeps{
+		ctrl:       ctrl,
+		pgi:        mocks.NewMockPostgresInstance(ctrl),
+		db:         mocks.NewMockPostgresDatabase(ctrl),
+		stmt:       mocks.NewMockStmt(ctrl),
+		sri:        mocks.NewMockSchemaRegisteryInstance(ctrl),
+		client:     mocks.NewMockClient(ctrl),
+		avr:        mocks.NewMockAvrSerdeInstance(ctrl),
+		serializer: mocks.NewMockAvrSerializer(ctrl),
+		kafka:      mocks.NewMockKafka(ctrl),
+		producer:   mocks.NewMockKevProducer(ctrl),
+		rdi:        mocks.NewMockRedisInstance(ctrl),
+		events:     make(chan kev.Event, 1),
+	}
+
+	// ends the event loop of the producer pool
+	t.Cleanup(func() { close(d.events) })
+
+	return d
+}
+
+// expectHealthy answers every call of the repo like healthy dependencies,
+// every statement is prepared as stmt.
+func (d *repoDeps) expectHealthy() {
+	d.pgi.EXPECT().Open("postgres", gomock.Any()).Return(d.db, nil).AnyTimes()
+	d.db.EXPECT().SetMaxOpenConns(gomock.Any()).AnyTimes()
+	d.db.EXPECT().SetMaxIdleConns(gomock.Any()).AnyTimes()
+	d.db.EXPECT().SetConnMaxLifetime(gomock.Any()).AnyTimes()
+	d.db.EXPECT().PingContext(gomock.Any()).Return(nil).AnyTimes()
+	d.db.EXPECT().Prepare(gomock.Any()).Return(d.stmt, nil).AnyTimes()
+
+	d.sri.EXPECT().NewConfig(gomock.Any()).Return(nil).AnyTimes()
+	d.sri.EXPECT().NewClient(gomock.Any()).Return(d.client, nil).AnyTimes()
+	d.avr.EXPECT().NewGenericSerializer(gomock.Any(), gomock.Any(), gomock.Any()).Return(d.serializer, nil).AnyTimes()
+
+	d.kafka.EXPECT().NewProducer(gomock.Any()).Return(d.producer, nil).AnyTimes()
+	d.producer.EXPECT().Events().Return(d.events).AnyTimes()
+	d.producer.EXPECT().InitTransactions(gomock.Any()).Return(nil).AnyTimes()
+
+	d.rdi.EXPECT().NewFailoverClient(gomock.Any()).Return(newFakeClient(fakeRedis{})).AnyTimes()
+}
+
+func (d *repoDeps) newRepo() (repository.AuthRepo, error) {
+	return repository.NewAuthRepo(context.Background(), d.sri, d.pgi, d.avr, d.kafka, d.rdi)
+}
+
+// setupRepo answers a repo of healthy dependencies.
+func setupRepo(t *testing.T) (repository.AuthRepo, *repoDeps) {
+	d := newRepoDeps(t)
+	d.expectHealthy()
+
+	rp, err := d.newRepo()
+	require.NoError(t, err)
+
+	return rp, d
+}
+
+// fakeRedis answers the commands of a go-redis client with success, without
+// a server.
+type fakeRedis struct{}
+
+func newFakeClient(f fakeRedis) *goredis.Client {
+	client := goredis.NewClient(&goredis.Options{Addr: "fake:6379"})
+	client.AddHook(f)
+	return client
+}
+
+func (fakeRedis) DialHook(next goredis.DialHook) goredis.DialHook {
+	return next
+}
+
+func (f fakeRedis) ProcessHook(goredis.ProcessHook) goredis.ProcessHook {
+	return func(_ context.Context, cmd goredis.Cmder) error {
+		f.answer(cmd)
+		return cmd.Err()
+	}
+}
+
+func (f fakeRedis) ProcessPipelineHook(goredis.ProcessPipelineHook) goredis.ProcessPipelineHook {
+	return func(_ context.Context, cmds []goredis.Cmder) error {
+		for _, cmd := range cmds {
+			if name := cmd.Name(); name == "multi" || name == "exec" {
+				continue
+			}
+			f.answer(cmd)
+		}
+		return nil
+	}
+}
+
+func (fakeRedis) answer(cmd goredis.Cmder) {
+	switch c := cmd.(type) {
+	case *goredis.StatusCmd:
+		c.SetVal("OK")
+	case *goredis.BoolCmd:
+		c.SetVal(true)
+	case *goredis.IntCmd:
+		c.SetVal(1)
+	}
+}
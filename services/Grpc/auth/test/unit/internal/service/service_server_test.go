@@ -13,6 +13,8 @@ import (
 	"github.com/sony-nurdianto/farm/auth/internal/usecase"
 	"github.com/sony-nurdianto/farm/auth/test/mocks"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -22,7 +24,7 @@ func TestServiceRegisterUserIsExist(t *testing.T) {
 
 	mockUsecase := mocks.NewMockServiceUsecase(ctrl)
 	mockUsecase.EXPECT().
-		UserRegister(gomock.Any()).
+		UserRegister(gomock.Any(), gomock.Any()).
 		Return(
 			nil,
 			usecase.ErrorUserIsExist,
@@ -50,7 +52,7 @@ func TestServiceRegisterFailedHashPassword(t *testing.T) {
 
 	mockUsecase := mocks.NewMockServiceUsecase(ctrl)
 	mockUsecase.EXPECT().
-		UserRegister(gomock.Any()).
+		UserRegister(gomock.Any(), gomock.Any()).
 		Return(
 			nil,
 			usecase.ErrorFailedToHasshPassword,
@@ -78,7 +80,7 @@ func TestServiceRegisterCreateUserError(t *testing.T) {
 
 	mockUsecase := mocks.NewMockServiceUsecase(ctrl)
 	mockUsecase.EXPECT().
-		UserRegister(gomock.Any()).
+		UserRegister(gomock.Any(), gomock.Any()).
 		Return(
 			nil,
 			usecase.ErrorRegisterUser,
@@ -106,7 +108,7 @@ func TestServiceRegisterUnknownError(t *testing.T) {
 
 	mockUsecase := mocks.NewMockServiceUsecase(ctrl)
 	mockUsecase.EXPECT().
-		UserRegister(gomock.Any()).
+		UserRegister(gomock.Any(), gomock.Any()).
 		Return(
 			nil,
 			errors.New("Something Wrong"),
@@ -134,7 +136,7 @@ func TestServiceRegisterSuccess(t *testing.T) {
 
 	mockUsecase := mocks.NewMockServiceUsecase(ctrl)
 	mockUsecase.EXPECT().
-		UserRegister(gomock.Any()).
+		UserRegister(gomock.Any(), gomock.Any()).
 		Return(
 			&pbgen.RegisterUserResponse{
 				Status: "Success",
@@ -165,7 +167,7 @@ func TestServiceUserLoginErrorUserIsNotExsist(t *testing.T) {
 
 	mockUsecase := mocks.NewMockServiceUsecase(ctrl)
 	mockUsecase.EXPECT().
-		UserSignIn(gomock.Any()).
+		UserSignIn(gomock.Any(), gomock.Any()).
 		Return(nil, usecase.ErrorUserIsNotExsist)
 
 	svc := service.NewAuthServiceServer(mockUsecase)
@@ -189,7 +191,7 @@ func TestServiceUserLoginErrorPasswordInvalid(t *testing.T) {
 
 	mockUsecase := mocks.NewMockServiceUsecase(ctrl)
 	mockUsecase.EXPECT().
-		UserSignIn(gomock.Any()).
+		UserSignIn(gomock.Any(), gomock.Any()).
 		Return(nil, usecase.ErrorPasswordIsInvalid)
 
 	svc := service.NewAuthServiceServer(mockUsecase)
@@ -213,7 +215,7 @@ func TestServiceUserLoginErrorInternal(t *testing.T) {
 
 	mockUsecase := mocks.NewMockServiceUsecase(ctrl)
 	mockUsecase.EXPECT().
-		UserSignIn(gomock.Any()).
+		UserSignIn(gomock.Any(), gomock.Any()).
 		Return(nil, errors.New("Db Is Invalid"))
 
 	svc := service.NewAuthServiceServer(mockUsecase)
@@ -228,7 +230,8 @@ func TestServiceUserLoginErrorInternal(t *testing.T) {
 		request,
 	)
 	assert.Error(t, err)
-	assert.ErrorContains(t, err, "Db Is Invalid")
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.NotContains(t, err.Error(), "Db Is Invalid")
 }
 
 func TestServiceUserLoginSuccess(t *testing.T) {
@@ -237,7 +240,7 @@ func TestServiceUserLoginSuccess(t *testing.T) {
 
 	mockUsecase := mocks.NewMockServiceUsecase(ctrl)
 	mockUsecase.EXPECT().
-		UserSignIn(gomock.Any()).
+		UserSignIn(gomock.Any(), gomock.Any()).
 		Return(
 			&pbgen.AuthenticateUserResponse{
 				Token:     "Token",
@@ -269,7 +272,7 @@ func TestServiceTokenValidateError(t *testing.T) {
 
 	mockUsecase := mocks.NewMockServiceUsecase(ctrl)
 	mockUsecase.EXPECT().
-		TokenValidate(gomock.Any()).
+		TokenValidate(gomock.Any(), gomock.Any()).
 		Return(
 			nil,
 			token.ErrDecryptFailed,
@@ -296,7 +299,7 @@ func TestServiceTokenValidateTokenExperied(t *testing.T) {
 
 	mockUsecase := mocks.NewMockServiceUsecase(ctrl)
 	mockUsecase.EXPECT().
-		TokenValidate(gomock.Any()).
+		TokenValidate(gomock.Any(), gomock.Any()).
 		Return(
 			&pbgen.TokenValidateResponse{
 				Valid: false,
@@ -331,7 +334,7 @@ func TestServiceTokenValidate(t *testing.T) {
 
 	mockUsecase := mocks.NewMockServiceUsecase(ctrl)
 	mockUsecase.EXPECT().
-		TokenValidate(gomock.Any()).
+		TokenValidate(gomock.Any(), gomock.Any()).
 		Return(
 			&pbgen.TokenValidateResponse{
 				Valid:     true,
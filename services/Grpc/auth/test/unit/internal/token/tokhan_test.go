@@ -1,6 +1,7 @@
 package unit_test
 
 import (
+	"crypto/ed25519"
 	"errors"
 	"testing"
 	"time"
@@ -12,22 +13,65 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+const signedToken = "v2.public.cGF5bG9hZA.eyJraWQiOiJrMSJ9"
+
+func newKeyRing(t *testing.T) token.KeyRing {
+	_, priv, err := ed25519.GenerateKey(nil)
+	assert.NoError(t, err)
+
+	keys, err := token.NewKeyRing("k1", map[string]ed25519.PrivateKey{"k1": priv}, nil)
+	assert.NoError(t, err)
+
+	return keys
+}
+
+func TestNewKeyRing(t *testing.T) {
+	t.Run("NewKeyRing Error Active Key Not Set", func(t *testing.T) {
+		_, priv, _ := ed25519.GenerateKey(nil)
+
+		_, err := token.NewKeyRing("k2", map[string]ed25519.PrivateKey{"k1": priv}, nil)
+		assert.ErrorIs(t, err, token.ErrActiveKeyNotSet)
+	})
+
+	t.Run("NewKeyRing Publish Signing And Retired Keys", func(t *testing.T) {
+		_, priv, _ := ed25519.GenerateKey(nil)
+		oldPub, _, _ := ed25519.GenerateKey(nil)
+
+		keys, err := token.NewKeyRing(
+			"k2",
+			map[string]ed25519.PrivateKey{"k2": priv},
+			map[string]ed25519.PublicKey{"k1": oldPub},
+		)
+		assert.NoError(t, err)
+
+		vks := keys.VerificationKeys()
+		assert.Len(t, vks, 2)
+		assert.Equal(t, "k1", vks[0].ID)
+		assert.False(t, vks[0].Active)
+		assert.Equal(t, "k2", vks[1].ID)
+		assert.True(t, vks[1].Active)
+
+		_, err = keys.PublicKey("k3")
+		assert.ErrorIs(t, err, token.ErrKeyIDNotFound)
+	})
+}
+
 func TestCreateWebToken(t *testing.T) {
-	t.Run("CreateWebToken Error Encrypt", func(t *testing.T) {
+	t.Run("CreateWebToken Error Sign", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		mocksPasTok := mocks.NewMockPassetoToken(ctrl)
 
 		mocksPasTok.EXPECT().
-			Encrypt(
+			Sign(
 				gomock.Any(),
 				gomock.Any(),
 				gomock.Any(),
-			).Return("", errors.New("Failed To Encrypt Token"))
+			).Return("", errors.New("Failed To Sign Token"))
 
-		tokhan := token.NewTokhan(mocksPasTok)
-		_, err := tokhan.CreateWebToken("something")
+		tokhan := token.NewTokhan(mocksPasTok, newKeyRing(t))
+		_, err := tokhan.CreateWebToken(token.AccessClaims{Subject: "something", SessionID: "s1"})
 		assert.Error(t, err)
 	})
 
@@ -38,36 +82,83 @@ func TestCreateWebToken(t *testing.T) {
 		mocksPasTok := mocks.NewMockPassetoToken(ctrl)
 
 		mocksPasTok.EXPECT().
-			Encrypt(
+			Sign(
 				gomock.Any(),
 				gomock.Any(),
 				gomock.Any(),
 			).Return("", nil)
 
-		tokhan := token.NewTokhan(mocksPasTok)
-		_, err := tokhan.CreateWebToken("something")
+		tokhan := token.NewTokhan(mocksPasTok, newKeyRing(t))
+		_, err := tokhan.CreateWebToken(token.AccessClaims{Subject: "something", SessionID: "s1"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("CreateWebToken Sign And Verify With Key ID", func(t *testing.T) {
+		tokhan := token.NewTokhan(token.NewPassetoToken(), newKeyRing(t))
+
+		tok, err := tokhan.CreateWebToken(token.AccessClaims{Subject: "user1", SessionID: "s1", Verified: true})
 		assert.NoError(t, err)
+
+		var footer struct {
+			KeyID string `json:"kid"`
+		}
+		assert.NoError(t, paseto.ParseFooter(tok, &footer))
+		assert.Equal(t, "k1", footer.KeyID)
+
+		value, err := tokhan.VerifyWebToken(tok)
+		assert.NoError(t, err)
+		assert.Equal(t, "user1", value.Subject)
+		assert.Equal(t, "s1", value.Jti)
+		assert.Equal(t, "true", value.Get(token.VerifiedClaim))
+	})
+}
+
+func TestOpaqueToken(t *testing.T) {
+	t.Run("GenerateOpaqueToken Stores Only Hash", func(t *testing.T) {
+		tok, hash, err := token.GenerateOpaqueToken()
+		assert.NoError(t, err)
+		assert.NotEmpty(t, tok)
+		assert.Len(t, hash, 64)
+		assert.NotEqual(t, tok, hash)
+		assert.Equal(t, hash, token.HashOpaqueToken(tok))
+	})
+
+	t.Run("GenerateOpaqueToken Unique", func(t *testing.T) {
+		tok1, _, _ := token.GenerateOpaqueToken()
+		tok2, _, _ := token.GenerateOpaqueToken()
+		assert.NotEqual(t, tok1, tok2)
 	})
 }
 
 func TestVerifyWebToken(t *testing.T) {
-	t.Run("VerifyWebToken Error Decrypt", func(t *testing.T) {
+	t.Run("VerifyWebToken Error Verify", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		mocksPasTok := mocks.NewMockPassetoToken(ctrl)
 
 		mocksPasTok.EXPECT().
-			Decrypt(
+			Verify(
 				gomock.Any(),
 				gomock.Any(),
 				gomock.Any(),
 				gomock.Any(),
-			).Return(errors.New("Failed To Encrypt Token"))
+			).Return(errors.New("Failed To Verify Token"))
 
-		tokhan := token.NewTokhan(mocksPasTok)
-		_, err := tokhan.VerifyWebToken("something")
-		assert.Error(t, err)
+		tokhan := token.NewTokhan(mocksPasTok, newKeyRing(t))
+		_, err := tokhan.VerifyWebToken(signedToken)
+		assert.ErrorIs(t, err, token.ErrDecryptFailed)
+	})
+
+	t.Run("VerifyWebToken Error Unknown Key ID", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mocksPasTok := mocks.NewMockPassetoToken(ctrl)
+
+		tokhan := token.NewTokhan(mocksPasTok, newKeyRing(t))
+		_, err := tokhan.VerifyWebToken("v2.public.cGF5bG9hZA.eyJraWQiOiJrOSJ9")
+		assert.ErrorIs(t, err, token.ErrDecryptFailed)
 	})
 
 	t.Run("VerifyWebToken Error Token Expired", func(t *testing.T) {
@@ -82,20 +173,20 @@ func TestVerifyWebToken(t *testing.T) {
 		mocksPasTok := mocks.NewMockPassetoToken(ctrl)
 
 		mocksPasTok.EXPECT().
-			Decrypt(gomock.Any(), gomock.Any(), gomock.Any(), nil).
-			DoAndReturn(func(t string, key []byte, payload any, footer any) error {
+			Verify(gomock.Any(), gomock.Any(), gomock.Any(), nil).
+			DoAndReturn(func(t string, key ed25519.PublicKey, payload any, footer any) error {
 				p := payload.(*paseto.JSONToken)
 				*p = expiredToken
 				return nil
 			})
 
-		tokhan := token.NewTokhan(mocksPasTok)
-		_, err := tokhan.VerifyWebToken("something")
+		tokhan := token.NewTokhan(mocksPasTok, newKeyRing(t))
+		_, err := tokhan.VerifyWebToken(signedToken)
 		assert.Error(t, err)
 		assert.ErrorIs(t, err, token.ErrTokenExperied)
 	})
 
-	t.Run("VerifyWebToken Error Token Expired", func(t *testing.T) {
+	t.Run("VerifyWebToken Success", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
@@ -107,15 +198,15 @@ func TestVerifyWebToken(t *testing.T) {
 		mocksPasTok := mocks.NewMockPassetoToken(ctrl)
 
 		mocksPasTok.EXPECT().
-			Decrypt(gomock.Any(), gomock.Any(), gomock.Any(), nil).
-			DoAndReturn(func(t string, key []byte, payload any, footer any) error {
+			Verify(gomock.Any(), gomock.Any(), gomock.Any(), nil).
+			DoAndReturn(func(t string, key ed25519.PublicKey, payload any, footer any) error {
 				p := payload.(*paseto.JSONToken)
 				*p = tok
 				return nil
 			})
 
-		tokhan := token.NewTokhan(mocksPasTok)
-		tokDec, err := tokhan.VerifyWebToken("something")
+		tokhan := token.NewTokhan(mocksPasTok, newKeyRing(t))
+		tokDec, err := tokhan.VerifyWebToken(signedToken)
 		assert.NoError(t, err)
 		assert.Equal(t, tokDec.Subject, tok.Subject)
 	})
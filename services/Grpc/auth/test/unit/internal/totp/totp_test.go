@@ -0,0 +1,145 @@
+package unit_test
+
+import (
+	"encoding/base32"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sony-nurdianto/farm/auth/internal/encryption/totp"
+	"github.com/stretchr/testify/assert"
+)
+
+// RFC 6238 appendix B SHA1 seed, truncated to 6 digits
+var rfcSecret = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+func TestTOTPCode(t *testing.T) {
+	cases := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+
+	for _, c := range cases {
+		code, err := totp.Code(rfcSecret, totp.Step(time.Unix(c.unix, 0)))
+		assert.NoError(t, err)
+		assert.Equal(t, c.code, code)
+	}
+}
+
+func TestTOTPValidate(t *testing.T) {
+	now := time.Unix(1111111109, 0)
+
+	t.Run("Current Step", func(t *testing.T) {
+		step, ok, err := totp.Validate(rfcSecret, "081804", now)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, totp.Step(now), step)
+	})
+
+	t.Run("Previous Step Within Skew", func(t *testing.T) {
+		step, ok, err := totp.Validate(rfcSecret, "081804", now.Add(totp.Period))
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, totp.Step(now), step)
+	})
+
+	t.Run("Outside Skew", func(t *testing.T) {
+		_, ok, err := totp.Validate(rfcSecret, "081804", now.Add(3*totp.Period))
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("Wrong Length", func(t *testing.T) {
+		_, ok, err := totp.Validate(rfcSecret, "0818", now)
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("Invalid Secret", func(t *testing.T) {
+		_, _, err := totp.Validate("not base32!", "081804", now)
+		assert.Error(t, err)
+	})
+}
+
+func TestTOTPGenerateSecret(t *testing.T) {
+	secret, err := totp.GenerateSecret()
+	assert.NoError(t, err)
+
+	_, err = totp.Code(secret, totp.Step(time.Now()))
+	assert.NoError(t, err)
+}
+
+func TestTOTPKeyURI(t *testing.T) {
+	uri := totp.KeyURI("Farm", "farmer@example.com", "ABC")
+
+	parsed, err := url.Parse(uri)
+	assert.NoError(t, err)
+	assert.Equal(t, "otpauth", parsed.Scheme)
+	assert.Equal(t, "totp", parsed.Host)
+	assert.Equal(t, "/Farm:farmer@example.com", parsed.Path)
+	assert.Equal(t, "ABC", parsed.Query().Get("secret"))
+	assert.Equal(t, "Farm", parsed.Query().Get("issuer"))
+}
+
+func TestTOTPRecoveryCodes(t *testing.T) {
+	codes, err := totp.GenerateRecoveryCodes(totp.RecoveryCodeCount)
+	assert.NoError(t, err)
+	assert.Len(t, codes, totp.RecoveryCodeCount)
+
+	seen := make(map[string]bool)
+	for _, code := range codes {
+		assert.Len(t, code, 11)
+		assert.Equal(t, code, totp.NormalizeRecoveryCode(strings.ToUpper(strings.ReplaceAll(code, "-", " "))))
+		seen[code] = true
+	}
+	assert.Len(t, seen, len(codes))
+}
+
+func TestSecretCipher(t *testing.T) {
+	key := []byte("0123456789abcdef0123456789abcdef")
+
+	t.Run("Seal And Open", func(t *testing.T) {
+		sc, err := totp.NewSecretCipher(key)
+		assert.NoError(t, err)
+
+		sealed, err := sc.Seal("SECRET")
+		assert.NoError(t, err)
+		assert.NotContains(t, sealed, "SECRET")
+
+		opened, err := sc.Open(sealed)
+		assert.NoError(t, err)
+		assert.Equal(t, "SECRET", opened)
+	})
+
+	t.Run("Wrong Key", func(t *testing.T) {
+		sc, _ := totp.NewSecretCipher(key)
+		sealed, _ := sc.Seal("SECRET")
+
+		other, _ := totp.NewSecretCipher([]byte("fedcba9876543210fedcba9876543210"))
+		_, err := other.Open(sealed)
+		assert.Error(t, err)
+	})
+
+	t.Run("Malformed Ciphertext", func(t *testing.T) {
+		sc, _ := totp.NewSecretCipher(key)
+		_, err := sc.Open("AA==")
+		assert.ErrorIs(t, err, totp.ErrMalformedCiphertext)
+	})
+
+	t.Run("Invalid Key Size", func(t *testing.T) {
+		_, err := totp.NewSecretCipher([]byte("short"))
+		assert.ErrorIs(t, err, totp.ErrEncryptionKeySize)
+	})
+
+	t.Run("Key Not Set", func(t *testing.T) {
+		t.Setenv("TOTP_ENCRYPTION_KEY", "")
+		_, err := totp.LoadSecretCipher()
+		assert.ErrorIs(t, err, totp.ErrEncryptionKeyNotSet)
+	})
+}
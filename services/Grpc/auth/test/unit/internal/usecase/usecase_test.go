@@ -1,6 +1,7 @@
 package unit_test
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"testing"
@@ -11,12 +12,37 @@ import (
 	"github.com/sony-nurdianto/farm/auth/internal/encryption/token"
 	"github.com/sony-nurdianto/farm/auth/internal/entity"
 	"github.com/sony-nurdianto/farm/auth/internal/pbgen"
+	"github.com/sony-nurdianto/farm/auth/internal/repository"
 	"github.com/sony-nurdianto/farm/auth/internal/usecase"
 	"github.com/sony-nurdianto/farm/auth/test/mocks"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const (
+	verifyURL = "https://farm.test/verify-email"
+	resetURL  = "https://farm.test/reset-password"
+)
+
+// newServiceUsecase answers a usecase whose mailer sends every message.
+func newServiceUsecase(
+	ctrl *gomock.Controller,
+	repo *mocks.MockAuthRepo,
+	pass *mocks.MockPassEncrypt,
+	tokhan *mocks.MockTokhan,
+) usecase.ServiceUsecase {
+	mail := mocks.NewMockMailer(ctrl)
+	mail.EXPECT().Send(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	return usecase.NewServiceUsecase(repo, pass, tokhan, mail, mocks.NewMockSecretCipher(ctrl), verifyURL, resetURL)
+}
+
+// expectLoginAllowed answers the throttle of a sign-in without an IP like an
+// email that is not locked.
+func expectLoginAllowed(repo *mocks.MockAuthRepo) {
+	repo.EXPECT().LoginLockTTL(gomock.Any(), gomock.Any()).Return(time.Duration(0), nil)
+}
+
 func TestUseCaseUserRegisterUserExsist(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -26,10 +52,10 @@ func TestUseCaseUserRegisterUserExsist(t *testing.T) {
 	mocksTokhan := mocks.NewMockTokhan(ctrl)
 
 	mockAuthRepo.EXPECT().
-		GetUserByEmail(gomock.Any()).
+		GetUserByEmail(gomock.Any(), gomock.Any()).
 		Return(entity.Users{Email: "test@gmail.com"}, nil)
 
-	uc := usecase.NewServiceUsecase(mockAuthRepo, mocksPassEn, mocksTokhan)
+	uc := newServiceUsecase(ctrl, mockAuthRepo, mocksPassEn, mocksTokhan)
 
 	req := &pbgen.RegisterUserRequest{
 		FullName:    "test",
@@ -38,7 +64,7 @@ func TestUseCaseUserRegisterUserExsist(t *testing.T) {
 		Password:    "Something",
 	}
 
-	_, err := uc.UserRegister(req)
+	_, err := uc.UserRegister(context.Background(), req)
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, usecase.ErrorUserIsExist)
 }
@@ -51,10 +77,10 @@ func TestUserRegister_DatabaseError(t *testing.T) {
 	mocksTokhan := mocks.NewMockTokhan(ctrl)
 
 	mockAuthRepo.EXPECT().
-		GetUserByEmail(gomock.Any()).
+		GetUserByEmail(gomock.Any(), gomock.Any()).
 		Return(entity.Users{}, sql.ErrConnDone)
 
-	uc := usecase.NewServiceUsecase(mockAuthRepo, mocksPassEn, mocksTokhan)
+	uc := newServiceUsecase(ctrl, mockAuthRepo, mocksPassEn, mocksTokhan)
 
 	req := &pbgen.RegisterUserRequest{
 		FullName:    "test",
@@ -63,7 +89,7 @@ func TestUserRegister_DatabaseError(t *testing.T) {
 		Password:    "Something",
 	}
 
-	_, err := uc.UserRegister(req)
+	_, err := uc.UserRegister(context.Background(), req)
 	assert.Error(t, err)
 	assert.EqualError(t, err, "sql: connection is already closed")
 }
@@ -76,14 +102,14 @@ func TestUserRegister_HashPasswordError(t *testing.T) {
 	mocksTokhan := mocks.NewMockTokhan(ctrl)
 
 	mockAuthRepo.EXPECT().
-		GetUserByEmail(gomock.Any()).
+		GetUserByEmail(gomock.Any(), gomock.Any()).
 		Return(entity.Users{}, sql.ErrNoRows)
 
 	mocksPassEn.EXPECT().
 		HashPassword(gomock.Any()).
 		Return("", errors.New("Failed To HashPassword"))
 
-	uc := usecase.NewServiceUsecase(mockAuthRepo, mocksPassEn, mocksTokhan)
+	uc := newServiceUsecase(ctrl, mockAuthRepo, mocksPassEn, mocksTokhan)
 
 	req := &pbgen.RegisterUserRequest{
 		FullName:    "test",
@@ -92,9 +118,9 @@ func TestUserRegister_HashPasswordError(t *testing.T) {
 		Password:    "Something",
 	}
 
-	_, err := uc.UserRegister(req)
+	_, err := uc.UserRegister(context.Background(), req)
 	assert.Error(t, err)
-	assert.EqualError(t, err, "Failed To HashPassword")
+	assert.ErrorIs(t, err, usecase.ErrorFailedToHasshPassword)
 }
 
 func TestUserRegister_CreateUserAsyncdError(t *testing.T) {
@@ -105,13 +131,17 @@ func TestUserRegister_CreateUserAsyncdError(t *testing.T) {
 	mocksTokhan := mocks.NewMockTokhan(ctrl)
 
 	mockAuthRepo.EXPECT().
-		GetUserByEmail(gomock.Any()).
+		GetUserByEmail(gomock.Any(), gomock.Any()).
 		Return(entity.Users{}, sql.ErrNoRows)
 
 	mocksPassEn.EXPECT().
 		HashPassword(gomock.Any()).
 		Return("HashPassword", nil)
 
+	mockAuthRepo.EXPECT().
+		CreateRegistration(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil)
+
 	mockAuthRepo.EXPECT().
 		CreateUserAsync(
 			gomock.Any(),
@@ -119,10 +149,15 @@ func TestUserRegister_CreateUserAsyncdError(t *testing.T) {
 			gomock.Any(),
 			gomock.Any(),
 			gomock.Any(),
+			gomock.Any(),
 		).
 		Return(errors.New("Failed Create User"))
 
-	uc := usecase.NewServiceUsecase(mockAuthRepo, mocksPassEn, mocksTokhan)
+	mockAuthRepo.EXPECT().
+		FailRegistration(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	uc := newServiceUsecase(ctrl, mockAuthRepo, mocksPassEn, mocksTokhan)
 
 	req := &pbgen.RegisterUserRequest{
 		FullName:    "test",
@@ -131,9 +166,10 @@ func TestUserRegister_CreateUserAsyncdError(t *testing.T) {
 		Password:    "Something",
 	}
 
-	_, err := uc.UserRegister(req)
+	_, err := uc.UserRegister(context.Background(), req)
 	assert.Error(t, err)
-	assert.EqualError(t, err, "Failed To CreateUserAsync: Failed Create User")
+	assert.ErrorIs(t, err, usecase.ErrorRegisterUser)
+	assert.ErrorContains(t, err, "Failed Create User")
 }
 
 func TestUserRegister_Success(t *testing.T) {
@@ -144,13 +180,17 @@ func TestUserRegister_Success(t *testing.T) {
 	mocksTokhan := mocks.NewMockTokhan(ctrl)
 
 	mockAuthRepo.EXPECT().
-		GetUserByEmail(gomock.Any()).
+		GetUserByEmail(gomock.Any(), gomock.Any()).
 		Return(entity.Users{}, sql.ErrNoRows)
 
 	mocksPassEn.EXPECT().
 		HashPassword(gomock.Any()).
 		Return("HashPassword", nil)
 
+	mockAuthRepo.EXPECT().
+		CreateRegistration(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil)
+
 	mockAuthRepo.EXPECT().
 		CreateUserAsync(
 			gomock.Any(),
@@ -158,10 +198,15 @@ func TestUserRegister_Success(t *testing.T) {
 			gomock.Any(),
 			gomock.Any(),
 			gomock.Any(),
+			gomock.Any(),
 		).
 		Return(nil)
 
-	uc := usecase.NewServiceUsecase(mockAuthRepo, mocksPassEn, mocksTokhan)
+	mockAuthRepo.EXPECT().
+		CreateEmailVerification(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	uc := newServiceUsecase(ctrl, mockAuthRepo, mocksPassEn, mocksTokhan)
 
 	req := &pbgen.RegisterUserRequest{
 		FullName:    "test",
@@ -170,10 +215,11 @@ func TestUserRegister_Success(t *testing.T) {
 		Password:    "Something",
 	}
 
-	out, err := uc.UserRegister(req)
+	out, err := uc.UserRegister(context.Background(), req)
 	assert.NoError(t, err)
-	assert.Equal(t, out.Msg, "Success Create User")
+	assert.Equal(t, out.Msg, "Registration Pending")
 	assert.Equal(t, out.Status, "Success")
+	assert.NotEmpty(t, out.UserId)
 }
 
 func TestUserSignIn_ErrorUserNotExsist(t *testing.T) {
@@ -183,18 +229,28 @@ func TestUserSignIn_ErrorUserNotExsist(t *testing.T) {
 	mocksPassEn := mocks.NewMockPassEncrypt(ctrl)
 	mocksTokhan := mocks.NewMockTokhan(ctrl)
 
+	expectLoginAllowed(mockAuthRepo)
+
 	mockAuthRepo.EXPECT().
-		GetUserByEmail(gomock.Any()).
+		GetUserByEmail(gomock.Any(), gomock.Any()).
 		Return(entity.Users{}, sql.ErrNoRows)
 
-	uc := usecase.NewServiceUsecase(mockAuthRepo, mocksPassEn, mocksTokhan)
+	mockAuthRepo.EXPECT().
+		CreateLoginAttempt(gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	mockAuthRepo.EXPECT().
+		RecordLoginFailure(gomock.Any(), repository.LoginScopeEmail, "test@gmail.com", gomock.Any()).
+		Return(entity.LoginWindow{Count: 1}, nil)
+
+	uc := newServiceUsecase(ctrl, mockAuthRepo, mocksPassEn, mocksTokhan)
 
 	req := &pbgen.AuthenticateUserRequest{
 		Email:    "test@gmail.com",
 		Password: "Something",
 	}
 
-	_, err := uc.UserSignIn(req)
+	_, err := uc.UserSignIn(context.Background(), req)
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, usecase.ErrorUserIsNotExsist)
 }
@@ -206,18 +262,20 @@ func TestUserSignIn_ErrorGetUserByEmail(t *testing.T) {
 	mocksPassEn := mocks.NewMockPassEncrypt(ctrl)
 	mocksTokhan := mocks.NewMockTokhan(ctrl)
 
+	expectLoginAllowed(mockAuthRepo)
+
 	mockAuthRepo.EXPECT().
-		GetUserByEmail(gomock.Any()).
+		GetUserByEmail(gomock.Any(), gomock.Any()).
 		Return(entity.Users{}, errors.New("Db is Not Defined"))
 
-	uc := usecase.NewServiceUsecase(mockAuthRepo, mocksPassEn, mocksTokhan)
+	uc := newServiceUsecase(ctrl, mockAuthRepo, mocksPassEn, mocksTokhan)
 
 	req := &pbgen.AuthenticateUserRequest{
 		Email:    "test@gmail.com",
 		Password: "Something",
 	}
 
-	_, err := uc.UserSignIn(req)
+	_, err := uc.UserSignIn(context.Background(), req)
 	assert.Error(t, err)
 	assert.EqualError(t, err, "Db is Not Defined")
 }
@@ -229,22 +287,24 @@ func TestUserSignIn_ErrVerifyPassword(t *testing.T) {
 	mocksPassEn := mocks.NewMockPassEncrypt(ctrl)
 	mocksTokhan := mocks.NewMockTokhan(ctrl)
 
+	expectLoginAllowed(mockAuthRepo)
+
 	mockAuthRepo.EXPECT().
-		GetUserByEmail(gomock.Any()).
+		GetUserByEmail(gomock.Any(), gomock.Any()).
 		Return(entity.Users{}, nil)
 
 	mocksPassEn.EXPECT().
 		VerifyPassword(gomock.Any(), gomock.Any()).
 		Return(false, errors.New("Error VerifyPassword"))
 
-	uc := usecase.NewServiceUsecase(mockAuthRepo, mocksPassEn, mocksTokhan)
+	uc := newServiceUsecase(ctrl, mockAuthRepo, mocksPassEn, mocksTokhan)
 
 	req := &pbgen.AuthenticateUserRequest{
 		Email:    "test@gmail.com",
 		Password: "Something",
 	}
 
-	_, err := uc.UserSignIn(req)
+	_, err := uc.UserSignIn(context.Background(), req)
 	assert.Error(t, err)
 	assert.EqualError(t, err, "Error VerifyPassword")
 }
@@ -256,22 +316,36 @@ func TestUserSignIn_ErrPasswordInvalid(t *testing.T) {
 	mocksPassEn := mocks.NewMockPassEncrypt(ctrl)
 	mocksTokhan := mocks.NewMockTokhan(ctrl)
 
+	expectLoginAllowed(mockAuthRepo)
+
 	mockAuthRepo.EXPECT().
-		GetUserByEmail(gomock.Any()).
-		Return(entity.Users{}, nil)
+		GetUserByEmail(gomock.Any(), gomock.Any()).
+		Return(entity.Users{Id: "user-id"}, nil)
 
 	mocksPassEn.EXPECT().
 		VerifyPassword(gomock.Any(), gomock.Any()).
 		Return(false, nil)
 
-	uc := usecase.NewServiceUsecase(mockAuthRepo, mocksPassEn, mocksTokhan)
+	mockAuthRepo.EXPECT().
+		CreateLoginAttempt(gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	mockAuthRepo.EXPECT().
+		RecordLoginFailure(gomock.Any(), repository.LoginScopeEmail, "test@gmail.com", gomock.Any()).
+		Return(entity.LoginWindow{Count: 1}, nil)
+
+	mockAuthRepo.EXPECT().
+		PublishUserLogin(gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	uc := newServiceUsecase(ctrl, mockAuthRepo, mocksPassEn, mocksTokhan)
 
 	req := &pbgen.AuthenticateUserRequest{
 		Email:    "test@gmail.com",
 		Password: "Something",
 	}
 
-	_, err := uc.UserSignIn(req)
+	_, err := uc.UserSignIn(context.Background(), req)
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, usecase.ErrorPasswordIsInvalid)
 }
@@ -283,26 +357,42 @@ func TestUserSignIn_ErrCreateWebToken(t *testing.T) {
 	mocksPassEn := mocks.NewMockPassEncrypt(ctrl)
 	mocksTokhan := mocks.NewMockTokhan(ctrl)
 
+	expectLoginAllowed(mockAuthRepo)
+
 	mockAuthRepo.EXPECT().
-		GetUserByEmail(gomock.Any()).
+		GetUserByEmail(gomock.Any(), gomock.Any()).
 		Return(entity.Users{}, nil)
 
 	mocksPassEn.EXPECT().
 		VerifyPassword(gomock.Any(), gomock.Any()).
 		Return(true, nil)
 
+	mocksPassEn.EXPECT().
+		NeedsRehash(gomock.Any()).
+		Return(false)
+
+	mockAuthRepo.EXPECT().
+		ClearLoginFailures(gomock.Any(), "test@gmail.com").
+		Return(nil)
+
+	mockAuthRepo.EXPECT().
+		CreateSession(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, session entity.Session, _ string) (entity.Session, error) {
+			return session, nil
+		})
+
 	mocksTokhan.EXPECT().
 		CreateWebToken(gomock.Any()).
 		Return("", errors.New("Unexpected Error when create web token"))
 
-	uc := usecase.NewServiceUsecase(mockAuthRepo, mocksPassEn, mocksTokhan)
+	uc := newServiceUsecase(ctrl, mockAuthRepo, mocksPassEn, mocksTokhan)
 
 	req := &pbgen.AuthenticateUserRequest{
 		Email:    "test@gmail.com",
 		Password: "Something",
 	}
 
-	_, err := uc.UserSignIn(req)
+	_, err := uc.UserSignIn(context.Background(), req)
 	assert.Error(t, err)
 	assert.EqualError(t, err, "Unexpected Error when create web token")
 }
@@ -314,30 +404,55 @@ func TestUserSignIn_Success(t *testing.T) {
 	mocksPassEn := mocks.NewMockPassEncrypt(ctrl)
 	mocksTokhan := mocks.NewMockTokhan(ctrl)
 
+	expectLoginAllowed(mockAuthRepo)
+
 	mockAuthRepo.EXPECT().
-		GetUserByEmail(gomock.Any()).
+		GetUserByEmail(gomock.Any(), gomock.Any()).
 		Return(entity.Users{}, nil)
 
 	mocksPassEn.EXPECT().
 		VerifyPassword(gomock.Any(), gomock.Any()).
 		Return(true, nil)
 
+	mocksPassEn.EXPECT().
+		NeedsRehash(gomock.Any()).
+		Return(false)
+
+	mockAuthRepo.EXPECT().
+		ClearLoginFailures(gomock.Any(), "test@gmail.com").
+		Return(nil)
+
+	mockAuthRepo.EXPECT().
+		CreateSession(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, session entity.Session, _ string) (entity.Session, error) {
+			return session, nil
+		})
+
 	mocksTokhan.EXPECT().
 		CreateWebToken(gomock.Any()).
 		Return("Token", nil)
 
-	uc := usecase.NewServiceUsecase(mockAuthRepo, mocksPassEn, mocksTokhan)
+	mockAuthRepo.EXPECT().
+		CreateLoginAttempt(gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	mockAuthRepo.EXPECT().
+		PublishUserLogin(gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	uc := newServiceUsecase(ctrl, mockAuthRepo, mocksPassEn, mocksTokhan)
 
 	req := &pbgen.AuthenticateUserRequest{
 		Email:    "test@gmail.com",
 		Password: "Something",
 	}
 
-	out, err := uc.UserSignIn(req)
+	out, err := uc.UserSignIn(context.Background(), req)
 	assert.NoError(t, err)
 	assert.Equal(t, out.Token, "Token")
 	assert.Equal(t, out.Msg, "User Authenticated Success Login. Welcome !")
 	assert.Equal(t, out.Status, "Success")
+	assert.NotEmpty(t, out.RefreshToken)
 }
 
 func TestTokenValidateExperied(t *testing.T) {
@@ -355,13 +470,13 @@ func TestTokenValidateExperied(t *testing.T) {
 			Subject:    "user-id",
 		}, token.ErrTokenExperied)
 
-	uc := usecase.NewServiceUsecase(mockAuthRepo, mocksPassEn, mocksTokhan)
+	uc := newServiceUsecase(ctrl, mockAuthRepo, mocksPassEn, mocksTokhan)
 
 	req := &pbgen.TokenValidateRequest{
 		Token: "token",
 	}
 
-	res, err := uc.TokenValidate(req)
+	res, err := uc.TokenValidate(context.Background(), req)
 	assert.NoError(t, err)
 	assert.Nil(t, res.Isuer)
 	assert.Nil(t, res.Subject)
@@ -381,13 +496,13 @@ func TestTokenValidateErrorVerifyWebToken(t *testing.T) {
 		VerifyWebToken(gomock.Any()).
 		Return(paseto.JSONToken{}, token.ErrDecryptFailed)
 
-	uc := usecase.NewServiceUsecase(mockAuthRepo, mocksPassEn, mocksTokhan)
+	uc := newServiceUsecase(ctrl, mockAuthRepo, mocksPassEn, mocksTokhan)
 
 	req := &pbgen.TokenValidateRequest{
 		Token: "token",
 	}
 
-	res, err := uc.TokenValidate(req)
+	res, err := uc.TokenValidate(context.Background(), req)
 	assert.Error(t, err)
 	assert.Nil(t, res)
 	assert.EqualError(t, err, token.ErrDecryptFailed.Error())
@@ -404,19 +519,24 @@ func TestTokenValidateSuccess(t *testing.T) {
 		Issuer:     "auth",
 		Expiration: time.Now().Add(time.Hour * 1),
 		Subject:    "user-id",
+		Jti:        "session-id",
 	}
 
 	mocksTokhan.EXPECT().
 		VerifyWebToken(gomock.Any()).
 		Return(expRes, nil)
 
-	uc := usecase.NewServiceUsecase(mockAuthRepo, mocksPassEn, mocksTokhan)
+	mockAuthRepo.EXPECT().
+		IsSessionRevoked(gomock.Any(), "session-id").
+		Return(false, nil)
+
+	uc := newServiceUsecase(ctrl, mockAuthRepo, mocksPassEn, mocksTokhan)
 
 	req := &pbgen.TokenValidateRequest{
 		Token: "token",
 	}
 
-	res, err := uc.TokenValidate(req)
+	res, err := uc.TokenValidate(context.Background(), req)
 	assert.NoError(t, err)
 	assert.Equal(t, *res.Isuer, expRes.Issuer)
 	assert.Equal(t, *res.Subject, expRes.Subject)
@@ -2,36 +2,54 @@ package main
 
 import (
 	"context"
-	"fmt"
+	"crypto/rand"
+	"errors"
 	"log"
-	"net"
 	"os"
-	"os/signal"
-	"sync"
-	"syscall"
 
 	"github.com/joho/godotenv"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/interceptor"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/pagetoken"
 	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/pbgen"
 	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/repo"
 	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/services"
 	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/usescase"
 	"github.com/sony-nurdianto/farm/shared_lib/Go/database/postgres/pkg"
 	"github.com/sony-nurdianto/farm/shared_lib/Go/database/redis"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/observability"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/runtime"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/runtime/health"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 func main() {
 	godotenv.Load()
 
-	ctx, stop := signal.NotifyContext(
-		context.Background(),
-		os.Interrupt,
-		syscall.SIGTERM,
+	ctx, stop := runtime.SignalContext()
+	defer stop()
+
+	serviceObsName := "farm-service"
+
+	connColl, err := grpc.NewClient(
+		os.Getenv("OTELCOLLECTORADDR"),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
 	)
+	if err != nil {
+		log.Fatalln(err)
+	}
 
-	defer stop()
+	obs := observability.NewObservability(
+		serviceObsName,
+		connColl,
+	)
 
-	// serviceName := "farm-service"
+	tp, mp, lp, err := obs.Init(ctx)
+	if err != nil {
+		log.Fatalln(err)
+	}
 
 	farmRepo, err := repo.NewFarmRepo(
 		ctx, pkg.NewPostgresInstance(), redis.NewRedisInstance(),
@@ -40,38 +58,82 @@ func main() {
 		log.Fatalln(err)
 	}
 
-	farmUsecase := usescase.NewFarmUsecase(farmRepo)
+	pageTokenKey, err := loadPageTokenKey()
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	farmUsecase := usescase.NewFarmUsecase(farmRepo, pagetoken.NewCodec(pageTokenKey))
 
 	svc := services.NewFarmServiceServer(farmUsecase)
-	svr := grpc.NewServer()
+	rpcMetrics := interceptor.NewMetrics(mp.Meter(serviceObsName))
+	svr := grpc.NewServer(
+		grpc.StatsHandler(
+			otelgrpc.NewServerHandler(
+				otelgrpc.WithTracerProvider(tp),
+				otelgrpc.WithMeterProvider(mp),
+				otelgrpc.WithPropagators(otel.GetTextMapPropagator()),
+			),
+		),
+		grpc.ChainUnaryInterceptor(
+			rpcMetrics.UnaryInterceptor,
+			interceptor.ValidateUnaryInterceptor,
+		),
+		grpc.ChainStreamInterceptor(
+			rpcMetrics.StreamInterceptor,
+			interceptor.ValidateStreamInterceptor,
+		),
+	)
 
 	pbgen.RegisterFarmServiceServer(svr, &svc)
 
-	listener, err := net.Listen("tcp", ":50051")
-	if err != nil {
-		log.Fatalln(err)
-	}
-
-	defer listener.Close()
+	checker := health.NewChecker([]health.Probe{
+		{Name: "postgres", Check: farmRepo.PingDatabase},
+		{Name: "redis", Check: farmRepo.PingCache},
+	})
+	checker.Register(svr)
+
+	runner := runtime.NewRunner()
+	runner.Register(
+		runtime.Hook{
+			Name: "observability",
+			Stop: func(ctx context.Context) error {
+				return errors.Join(tp.Shutdown(ctx), mp.Shutdown(ctx), lp.Shutdown(ctx))
+			},
+		},
+		runtime.Hook{
+			Name:      "farm-repo",
+			DependsOn: []string{"observability"},
+			Stop: func(context.Context) error {
+				farmRepo.CloseRepo()
+				return nil
+			},
+		},
+		runtime.ServerHook("grpc-server", ":50051", svr, "farm-repo"),
+		checker.Hook("grpc-server"),
+	)
 
-	go func(lis net.Listener) {
-		if err := svr.Serve(lis); err != nil {
-			log.Fatalln(err)
-		}
-	}(listener)
+	runner.Main(ctx)
+}
 
-	var once sync.Once
+// loadPageTokenKey answers the FARM_PAGE_TOKEN_SECRET that signs the page
+// tokens. Only a development setup with FARM_PAGE_TOKEN_DEV_RANDOM_KEY=true
+// may run without it, its tokens do not survive a restart nor work across
+// replicas.
+func loadPageTokenKey() ([]byte, error) {
+	if key := os.Getenv("FARM_PAGE_TOKEN_SECRET"); key != "" {
+		return []byte(key), nil
+	}
 
-	for {
-		select {
-		case <-ctx.Done():
-			fmt.Println("Server Stoping, Gracefully Stop...")
-			svr.GracefulStop()
-			fmt.Println("Aplication Quit")
-			return
-		default:
-			once.Do(func() { fmt.Println("Server Is Running At 0.0.0.0:50051") })
+	if os.Getenv("FARM_PAGE_TOKEN_DEV_RANDOM_KEY") != "true" {
+		return nil, errors.New("FARM_PAGE_TOKEN_SECRET is not set")
+	}
 
-		}
+	log.Println("FARM_PAGE_TOKEN_SECRET is not set, signing page tokens with a random key for development")
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
 	}
+
+	return key, nil
 }
@@ -65,7 +65,7 @@ const (
 				farm_status = coalesce($4,farm_status),
 				description = coalesce($5,description),
 				updated_at = $6
-		where id = $7
+		where id = $7 and farmer_id = $8 and deleted_at is null
 		returning 
 				id, 
 				farmer_id, 
@@ -90,6 +90,14 @@ const (
 			postal_code = coalesce($6,postal_code),
 			updated_at = $7 
 		where id = $8
+			and deleted_at is null
+			and exists (
+				select 1
+				from farms f
+				where f.address_id = addresses.id
+					and f.farmer_id = $9
+					and f.deleted_at is null
+			)
 		returning 
 			id,
 			street,
@@ -102,7 +110,40 @@ const (
 			updated_at
 	`
 
+	QueryFarmOwner = `
+		select farmer_id, address_id
+		from farms
+		where id = $1 and deleted_at is null
+		for update
+	`
+
+	QueryFarmAddressOwner = `
+		select f.farmer_id
+		from addresses a
+		join farms f on f.address_id = a.id
+		where a.id = $1
+			and a.deleted_at is null
+			and f.deleted_at is null
+	`
+
 	QueryDeleteFarm = `
-		delete from farms where id = $1
+		update farms
+		set
+				deleted_at = $1,
+				updated_at = $1
+		where id = $2 and farmer_id = $3 and deleted_at is null
+		returning
+				id,
+				farmer_id,
+				address_id;
+	`
+
+	QueryDeleteFarmAddress = `
+		update addresses
+		set
+			deleted_at = $1,
+			updated_at = $1
+		where id = $2 and deleted_at is null
+		returning id
 	`
 )
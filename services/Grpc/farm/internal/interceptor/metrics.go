@@ -0,0 +1,92 @@
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Metrics records the rate, the errors and the duration of every RPC per
+// method and status code.
+type Metrics struct {
+	requestCount    metric.Int64Counter
+	errorCount      metric.Int64Counter
+	requestDuration metric.Float64Histogram
+}
+
+func NewMetrics(meter metric.Meter) Metrics {
+	requestCount, _ := meter.Int64Counter(
+		"rpc_server_request_count_total",
+		metric.WithDescription("Handled RPCs by method and status code"),
+	)
+	errorCount, _ := meter.Int64Counter(
+		"rpc_server_error_count_total",
+		metric.WithDescription("RPCs answered with an error by method, status code and error type"),
+	)
+	requestDuration, _ := meter.Float64Histogram(
+		"rpc_server_request_duration_seconds",
+		metric.WithDescription("Time taken to handle an RPC, streams included"),
+		metric.WithUnit("s"),
+	)
+
+	return Metrics{
+		requestCount:    requestCount,
+		errorCount:      errorCount,
+		requestDuration: requestDuration,
+	}
+}
+
+func (m Metrics) UnaryInterceptor(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (resp any, err error) {
+	defer m.record(ctx, info.FullMethod, time.Now(), &err)
+
+	return handler(ctx, req)
+}
+
+func (m Metrics) StreamInterceptor(
+	srv any,
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) (err error) {
+	defer m.record(ss.Context(), info.FullMethod, time.Now(), &err)
+
+	return handler(srv, ss)
+}
+
+func (m Metrics) record(ctx context.Context, method string, start time.Time, err *error) {
+	code := status.Code(*err)
+	attrs := []attribute.KeyValue{
+		attribute.String("rpc.method", method),
+		attribute.String("rpc.grpc.status_code", code.String()),
+	}
+
+	m.requestCount.Add(ctx, 1, metric.WithAttributes(attrs...))
+	m.requestDuration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(attrs...))
+
+	if code != codes.OK {
+		errorAttrs := append(attrs, attribute.String("error_type", errorType(code)))
+		m.errorCount.Add(ctx, 1, metric.WithAttributes(errorAttrs...))
+	}
+}
+
+// errorType tells the errors of the caller from the errors of the service,
+// like the 4xx and 5xx classes of the gateway.
+func errorType(code codes.Code) string {
+	switch code {
+	case codes.Unknown, codes.Internal, codes.Unavailable, codes.DataLoss,
+		codes.Unimplemented, codes.DeadlineExceeded:
+		return "server_error"
+	default:
+		return "client_error"
+	}
+}
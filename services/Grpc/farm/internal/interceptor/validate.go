@@ -0,0 +1,67 @@
+package interceptor
+
+import (
+	"context"
+	"fmt"
+
+	"buf.build/go/protovalidate"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/grpcstatus"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// ValidateUnaryInterceptor rejects requests breaking the buf.validate rules
+// declared in farm.proto before they reach the service.
+func ValidateUnaryInterceptor(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (resp any, err error) {
+	if err := validate("", req); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// ValidateStreamInterceptor validates every received message. On client
+// streams like CreateFarm and UpdateFarms the field paths are prefixed with
+// the index of the offending item, e.g. "[2].farm.farm_size".
+func ValidateStreamInterceptor(
+	srv any,
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	return handler(srv, &validatingStream{ServerStream: ss, indexed: info.IsClientStream})
+}
+
+type validatingStream struct {
+	grpc.ServerStream
+	indexed  bool
+	received int
+}
+
+func (vs *validatingStream) RecvMsg(m any) error {
+	if err := vs.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	var prefix string
+	if vs.indexed {
+		prefix = fmt.Sprintf("[%d]", vs.received)
+	}
+	vs.received++
+
+	return validate(prefix, m)
+}
+
+func validate(prefix string, req any) error {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	return grpcstatus.ValidationStatus(prefix, protovalidate.Validate(msg))
+}
@@ -31,3 +31,18 @@ type FarmWithAddress struct {
 	Farm
 	FarmAddress
 }
+
+type FacetCount struct {
+	Value string
+	Count int
+}
+
+// FarmSearchResult is a page of the farms matching a search, Total and the
+// facets count every match.
+type FarmSearchResult struct {
+	Farms        []FarmWithAddress
+	Total        int
+	FarmTypes    []FacetCount
+	FarmStatuses []FacetCount
+	Provinces    []FacetCount
+}
@@ -2,6 +2,7 @@ package models
 
 type UpdateFarm struct {
 	ID          string
+	FarmerID    string
 	FarmName    string
 	FarmType    string
 	FarmSize    float64
@@ -11,6 +12,7 @@ type UpdateFarm struct {
 
 type UpdateFarmAddress struct {
 	ID          string
+	FarmerID    string
 	Street      string
 	Village     string
 	SubDistrict string
@@ -7,6 +7,7 @@
 package pbgen
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -71,6 +72,63 @@ func (SortOrder) EnumDescriptor() ([]byte, []int) {
 	return file_farm_v1_farm_proto_rawDescGZIP(), []int{0}
 }
 
+// FarmSortField is the column a farm list is ordered by, ties are broken by
+// the farm id.
+type FarmSortField int32
+
+const (
+	FarmSortField_FARM_SORT_FIELD_UNSPECIFIED FarmSortField = 0
+	FarmSortField_FARM_SORT_FIELD_CREATED_AT  FarmSortField = 1
+	FarmSortField_FARM_SORT_FIELD_NAME        FarmSortField = 2
+	FarmSortField_FARM_SORT_FIELD_SIZE        FarmSortField = 3
+	FarmSortField_FARM_SORT_FIELD_UPDATED_AT  FarmSortField = 4
+)
+
+// Enum value maps for FarmSortField.
+var (
+	FarmSortField_name = map[int32]string{
+		0: "FARM_SORT_FIELD_UNSPECIFIED",
+		1: "FARM_SORT_FIELD_CREATED_AT",
+		2: "FARM_SORT_FIELD_NAME",
+		3: "FARM_SORT_FIELD_SIZE",
+		4: "FARM_SORT_FIELD_UPDATED_AT",
+	}
+	FarmSortField_value = map[string]int32{
+		"FARM_SORT_FIELD_UNSPECIFIED": 0,
+		"FARM_SORT_FIELD_CREATED_AT":  1,
+		"FARM_SORT_FIELD_NAME":        2,
+		"FARM_SORT_FIELD_SIZE":        3,
+		"FARM_SORT_FIELD_UPDATED_AT":  4,
+	}
+)
+
+func (x FarmSortField) Enum() *FarmSortField {
+	p := new(FarmSortField)
+	*p = x
+	return p
+}
+
+func (x FarmSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FarmSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_farm_v1_farm_proto_enumTypes[1].Descriptor()
+}
+
+func (FarmSortField) Type() protoreflect.EnumType {
+	return &file_farm_v1_farm_proto_enumTypes[1]
+}
+
+func (x FarmSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FarmSortField.Descriptor instead.
+func (FarmSortField) EnumDescriptor() ([]byte, []int) {
+	return file_farm_v1_farm_proto_rawDescGZIP(), []int{1}
+}
+
 type FarmAddress struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -379,6 +437,8 @@ func (x *CreateFarmAddress) GetPostalCode() string {
 	return ""
 }
 
+// farm_type and farm_status mirror farm_type_enum and farm_status_enum,
+// farm_size fits the NUMERIC(6, 2) column
 type CreateFarm struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FarmerId      string                 `protobuf:"bytes,1,opt,name=farmer_id,json=farmerId,proto3" json:"farmer_id,omitempty"`
@@ -463,9 +523,12 @@ func (x *CreateFarm) GetDescription() string {
 	return ""
 }
 
+// fields left empty keep their stored value, so the rules only apply to
+// the fields that are set
 type UpdateFarmData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FarmerId      string                 `protobuf:"bytes,2,opt,name=farmer_id,json=farmerId,proto3" json:"farmer_id,omitempty"`
 	FarmName      string                 `protobuf:"bytes,3,opt,name=farm_name,json=farmName,proto3" json:"farm_name,omitempty"`
 	FarmType      string                 `protobuf:"bytes,4,opt,name=farm_type,json=farmType,proto3" json:"farm_type,omitempty"`
 	FarmSize      float64                `protobuf:"fixed64,5,opt,name=farm_size,json=farmSize,proto3" json:"farm_size,omitempty"`
@@ -512,6 +575,13 @@ func (x *UpdateFarmData) GetId() string {
 	return ""
 }
 
+func (x *UpdateFarmData) GetFarmerId() string {
+	if x != nil {
+		return x.FarmerId
+	}
+	return ""
+}
+
 func (x *UpdateFarmData) GetFarmName() string {
 	if x != nil {
 		return x.FarmName
@@ -556,6 +626,7 @@ type UpdateFarmAddressData struct {
 	City          string                 `protobuf:"bytes,5,opt,name=city,proto3" json:"city,omitempty"`
 	Province      string                 `protobuf:"bytes,6,opt,name=province,proto3" json:"province,omitempty"`
 	PostalCode    string                 `protobuf:"bytes,7,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	FarmerId      string                 `protobuf:"bytes,8,opt,name=farmer_id,json=farmerId,proto3" json:"farmer_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -639,12 +710,22 @@ func (x *UpdateFarmAddressData) GetPostalCode() string {
 	return ""
 }
 
+func (x *UpdateFarmAddressData) GetFarmerId() string {
+	if x != nil {
+		return x.FarmerId
+	}
+	return ""
+}
+
 type CreateFarmRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Farm          *CreateFarm            `protobuf:"bytes,1,opt,name=farm,proto3" json:"farm,omitempty"`
-	Address       *CreateFarmAddress     `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Farm    *CreateFarm            `protobuf:"bytes,1,opt,name=farm,proto3" json:"farm,omitempty"`
+	Address *CreateFarmAddress     `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// makes the item idempotent: a retried item with the same id and farmer
+	// returns the farm created the first time instead of a new one
+	ClientRequestId string `protobuf:"bytes,3,opt,name=client_request_id,json=clientRequestId,proto3" json:"client_request_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *CreateFarmRequest) Reset() {
@@ -691,15 +772,23 @@ func (x *CreateFarmRequest) GetAddress() *CreateFarmAddress {
 	return nil
 }
 
+func (x *CreateFarmRequest) GetClientRequestId() string {
+	if x != nil {
+		return x.ClientRequestId
+	}
+	return ""
+}
+
 type CreateFarmResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FarmId        string                 `protobuf:"bytes,1,opt,name=farm_id,json=farmId,proto3" json:"farm_id,omitempty"`
-	FarmName      string                 `protobuf:"bytes,2,opt,name=farm_name,json=farmName,proto3" json:"farm_name,omitempty"`
-	AddressId     string                 `protobuf:"bytes,3,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
-	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	Msg           string                 `protobuf:"bytes,5,opt,name=msg,proto3" json:"msg,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	FarmId          string                 `protobuf:"bytes,1,opt,name=farm_id,json=farmId,proto3" json:"farm_id,omitempty"`
+	FarmName        string                 `protobuf:"bytes,2,opt,name=farm_name,json=farmName,proto3" json:"farm_name,omitempty"`
+	AddressId       string                 `protobuf:"bytes,3,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	Status          string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Msg             string                 `protobuf:"bytes,5,opt,name=msg,proto3" json:"msg,omitempty"`
+	ClientRequestId string                 `protobuf:"bytes,6,opt,name=client_request_id,json=clientRequestId,proto3" json:"client_request_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *CreateFarmResponse) Reset() {
@@ -767,6 +856,13 @@ func (x *CreateFarmResponse) GetMsg() string {
 	return ""
 }
 
+func (x *CreateFarmResponse) GetClientRequestId() string {
+	if x != nil {
+		return x.ClientRequestId
+	}
+	return ""
+}
+
 type GetFarmByIDRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -863,20 +959,158 @@ func (x *GetFarmByIDResponse) GetFarm() *Farm {
 	return nil
 }
 
-type GetFarmListRequest struct {
+// FarmListFilter narrows a farm list, the filters that are set must all
+// match and the ranges include their bounds.
+type FarmListFilter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	FarmerId      string                 `protobuf:"bytes,1,opt,name=farmer_id,json=farmerId,proto3" json:"farmer_id,omitempty"`
-	SearchName    string                 `protobuf:"bytes,2,opt,name=search_name,json=searchName,proto3" json:"search_name,omitempty"`
-	SortOrder     SortOrder              `protobuf:"varint,3,opt,name=sort_order,json=sortOrder,proto3,enum=farm.v1.SortOrder" json:"sort_order,omitempty"`
-	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	FarmTypes     []string               `protobuf:"bytes,1,rep,name=farm_types,json=farmTypes,proto3" json:"farm_types,omitempty"`
+	FarmStatuses  []string               `protobuf:"bytes,2,rep,name=farm_statuses,json=farmStatuses,proto3" json:"farm_statuses,omitempty"`
+	Province      string                 `protobuf:"bytes,3,opt,name=province,proto3" json:"province,omitempty"`
+	City          string                 `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`
+	PostalCode    string                 `protobuf:"bytes,5,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	MinFarmSize   *float64               `protobuf:"fixed64,6,opt,name=min_farm_size,json=minFarmSize,proto3,oneof" json:"min_farm_size,omitempty"`
+	MaxFarmSize   *float64               `protobuf:"fixed64,7,opt,name=max_farm_size,json=maxFarmSize,proto3,oneof" json:"max_farm_size,omitempty"`
+	CreatedFrom   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	UpdatedFrom   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_from,json=updatedFrom,proto3" json:"updated_from,omitempty"`
+	UpdatedTo     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_to,json=updatedTo,proto3" json:"updated_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FarmListFilter) Reset() {
+	*x = FarmListFilter{}
+	mi := &file_farm_v1_farm_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FarmListFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FarmListFilter) ProtoMessage() {}
+
+func (x *FarmListFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_v1_farm_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FarmListFilter.ProtoReflect.Descriptor instead.
+func (*FarmListFilter) Descriptor() ([]byte, []int) {
+	return file_farm_v1_farm_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FarmListFilter) GetFarmTypes() []string {
+	if x != nil {
+		return x.FarmTypes
+	}
+	return nil
+}
+
+func (x *FarmListFilter) GetFarmStatuses() []string {
+	if x != nil {
+		return x.FarmStatuses
+	}
+	return nil
+}
+
+func (x *FarmListFilter) GetProvince() string {
+	if x != nil {
+		return x.Province
+	}
+	return ""
+}
+
+func (x *FarmListFilter) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *FarmListFilter) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *FarmListFilter) GetMinFarmSize() float64 {
+	if x != nil && x.MinFarmSize != nil {
+		return *x.MinFarmSize
+	}
+	return 0
+}
+
+func (x *FarmListFilter) GetMaxFarmSize() float64 {
+	if x != nil && x.MaxFarmSize != nil {
+		return *x.MaxFarmSize
+	}
+	return 0
+}
+
+func (x *FarmListFilter) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *FarmListFilter) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *FarmListFilter) GetUpdatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedFrom
+	}
+	return nil
+}
+
+func (x *FarmListFilter) GetUpdatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedTo
+	}
+	return nil
+}
+
+type GetFarmListRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	FarmerId   string                 `protobuf:"bytes,1,opt,name=farmer_id,json=farmerId,proto3" json:"farmer_id,omitempty"`
+	SearchName string                 `protobuf:"bytes,2,opt,name=search_name,json=searchName,proto3" json:"search_name,omitempty"`
+	// SortOrder_UKNOWN sorts ascending
+	SortOrder SortOrder `protobuf:"varint,3,opt,name=sort_order,json=sortOrder,proto3,enum=farm.v1.SortOrder" json:"sort_order,omitempty"`
+	// limit 0 lists every farm
+	Limit  int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int32 `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	// page_token continues the list after the last farm of a previous page, it
+	// is that page's next_page_token and only valid with the same filters.
+	// offset is ignored when it is set.
+	PageToken string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// include_total counts the matching farms on a page_token request too, the
+	// total is always sent without a page_token.
+	IncludeTotal bool            `protobuf:"varint,7,opt,name=include_total,json=includeTotal,proto3" json:"include_total,omitempty"`
+	Filter       *FarmListFilter `protobuf:"bytes,8,opt,name=filter,proto3" json:"filter,omitempty"`
+	// sort_by defaults to created_at
+	SortBy        FarmSortField `protobuf:"varint,9,opt,name=sort_by,json=sortBy,proto3,enum=farm.v1.FarmSortField" json:"sort_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetFarmListRequest) Reset() {
 	*x = GetFarmListRequest{}
-	mi := &file_farm_v1_farm_proto_msgTypes[10]
+	mi := &file_farm_v1_farm_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -888,7 +1122,7 @@ func (x *GetFarmListRequest) String() string {
 func (*GetFarmListRequest) ProtoMessage() {}
 
 func (x *GetFarmListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_farm_v1_farm_proto_msgTypes[10]
+	mi := &file_farm_v1_farm_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -901,7 +1135,7 @@ func (x *GetFarmListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFarmListRequest.ProtoReflect.Descriptor instead.
 func (*GetFarmListRequest) Descriptor() ([]byte, []int) {
-	return file_farm_v1_farm_proto_rawDescGZIP(), []int{10}
+	return file_farm_v1_farm_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetFarmListRequest) GetFarmerId() string {
@@ -939,17 +1173,47 @@ func (x *GetFarmListRequest) GetOffset() int32 {
 	return 0
 }
 
+func (x *GetFarmListRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetFarmListRequest) GetIncludeTotal() bool {
+	if x != nil {
+		return x.IncludeTotal
+	}
+	return false
+}
+
+func (x *GetFarmListRequest) GetFilter() *FarmListFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *GetFarmListRequest) GetSortBy() FarmSortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return FarmSortField_FARM_SORT_FIELD_UNSPECIFIED
+}
+
 type GetFarmListResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Farms         *Farm                  `protobuf:"bytes,1,opt,name=farms,proto3" json:"farms,omitempty"`
-	Total         *int32                 `protobuf:"varint,2,opt,name=total,proto3,oneof" json:"total,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Farms *Farm                  `protobuf:"bytes,1,opt,name=farms,proto3" json:"farms,omitempty"`
+	Total *int32                 `protobuf:"varint,2,opt,name=total,proto3,oneof" json:"total,omitempty"`
+	// next_page_token is sent in the last message when more farms follow.
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetFarmListResponse) Reset() {
 	*x = GetFarmListResponse{}
-	mi := &file_farm_v1_farm_proto_msgTypes[11]
+	mi := &file_farm_v1_farm_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -961,7 +1225,7 @@ func (x *GetFarmListResponse) String() string {
 func (*GetFarmListResponse) ProtoMessage() {}
 
 func (x *GetFarmListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_farm_v1_farm_proto_msgTypes[11]
+	mi := &file_farm_v1_farm_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -974,7 +1238,7 @@ func (x *GetFarmListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFarmListResponse.ProtoReflect.Descriptor instead.
 func (*GetFarmListResponse) Descriptor() ([]byte, []int) {
-	return file_farm_v1_farm_proto_rawDescGZIP(), []int{11}
+	return file_farm_v1_farm_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetFarmListResponse) GetFarms() *Farm {
@@ -991,6 +1255,239 @@ func (x *GetFarmListResponse) GetTotal() int32 {
 	return 0
 }
 
+func (x *GetFarmListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// SearchFarmsRequest searches the farms of farmer_id by name, description
+// and address. Each word of query matches words it prefixes and words one
+// typo away, the filters narrow the result and the facets alike.
+type SearchFarmsRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	FarmerId     string                 `protobuf:"bytes,1,opt,name=farmer_id,json=farmerId,proto3" json:"farmer_id,omitempty"`
+	Query        string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	FarmTypes    []string               `protobuf:"bytes,3,rep,name=farm_types,json=farmTypes,proto3" json:"farm_types,omitempty"`
+	FarmStatuses []string               `protobuf:"bytes,4,rep,name=farm_statuses,json=farmStatuses,proto3" json:"farm_statuses,omitempty"`
+	Provinces    []string               `protobuf:"bytes,5,rep,name=provinces,proto3" json:"provinces,omitempty"`
+	// limit 0 returns the default page of 10 farms
+	Limit         int32 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32 `protobuf:"varint,7,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchFarmsRequest) Reset() {
+	*x = SearchFarmsRequest{}
+	mi := &file_farm_v1_farm_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchFarmsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFarmsRequest) ProtoMessage() {}
+
+func (x *SearchFarmsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_v1_farm_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFarmsRequest.ProtoReflect.Descriptor instead.
+func (*SearchFarmsRequest) Descriptor() ([]byte, []int) {
+	return file_farm_v1_farm_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SearchFarmsRequest) GetFarmerId() string {
+	if x != nil {
+		return x.FarmerId
+	}
+	return ""
+}
+
+func (x *SearchFarmsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchFarmsRequest) GetFarmTypes() []string {
+	if x != nil {
+		return x.FarmTypes
+	}
+	return nil
+}
+
+func (x *SearchFarmsRequest) GetFarmStatuses() []string {
+	if x != nil {
+		return x.FarmStatuses
+	}
+	return nil
+}
+
+func (x *SearchFarmsRequest) GetProvinces() []string {
+	if x != nil {
+		return x.Provinces
+	}
+	return nil
+}
+
+func (x *SearchFarmsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchFarmsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type FacetCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacetCount) Reset() {
+	*x = FacetCount{}
+	mi := &file_farm_v1_farm_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacetCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetCount) ProtoMessage() {}
+
+func (x *FacetCount) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_v1_farm_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetCount.ProtoReflect.Descriptor instead.
+func (*FacetCount) Descriptor() ([]byte, []int) {
+	return file_farm_v1_farm_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *FacetCount) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *FacetCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// SearchFarmsResponse holds a page of the matching farms, total and the
+// facets count every match.
+type SearchFarmsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Farms         []*Farm                `protobuf:"bytes,1,rep,name=farms,proto3" json:"farms,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	FarmTypes     []*FacetCount          `protobuf:"bytes,3,rep,name=farm_types,json=farmTypes,proto3" json:"farm_types,omitempty"`
+	FarmStatuses  []*FacetCount          `protobuf:"bytes,4,rep,name=farm_statuses,json=farmStatuses,proto3" json:"farm_statuses,omitempty"`
+	Provinces     []*FacetCount          `protobuf:"bytes,5,rep,name=provinces,proto3" json:"provinces,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchFarmsResponse) Reset() {
+	*x = SearchFarmsResponse{}
+	mi := &file_farm_v1_farm_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchFarmsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFarmsResponse) ProtoMessage() {}
+
+func (x *SearchFarmsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_farm_v1_farm_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFarmsResponse.ProtoReflect.Descriptor instead.
+func (*SearchFarmsResponse) Descriptor() ([]byte, []int) {
+	return file_farm_v1_farm_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SearchFarmsResponse) GetFarms() []*Farm {
+	if x != nil {
+		return x.Farms
+	}
+	return nil
+}
+
+func (x *SearchFarmsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchFarmsResponse) GetFarmTypes() []*FacetCount {
+	if x != nil {
+		return x.FarmTypes
+	}
+	return nil
+}
+
+func (x *SearchFarmsResponse) GetFarmStatuses() []*FacetCount {
+	if x != nil {
+		return x.FarmStatuses
+	}
+	return nil
+}
+
+func (x *SearchFarmsResponse) GetProvinces() []*FacetCount {
+	if x != nil {
+		return x.Provinces
+	}
+	return nil
+}
+
 type UpdateFarmsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Farm          *UpdateFarmData        `protobuf:"bytes,1,opt,name=farm,proto3,oneof" json:"farm,omitempty"`
@@ -1001,7 +1498,7 @@ type UpdateFarmsRequest struct {
 
 func (x *UpdateFarmsRequest) Reset() {
 	*x = UpdateFarmsRequest{}
-	mi := &file_farm_v1_farm_proto_msgTypes[12]
+	mi := &file_farm_v1_farm_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1013,7 +1510,7 @@ func (x *UpdateFarmsRequest) String() string {
 func (*UpdateFarmsRequest) ProtoMessage() {}
 
 func (x *UpdateFarmsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_farm_v1_farm_proto_msgTypes[12]
+	mi := &file_farm_v1_farm_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1026,7 +1523,7 @@ func (x *UpdateFarmsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateFarmsRequest.ProtoReflect.Descriptor instead.
 func (*UpdateFarmsRequest) Descriptor() ([]byte, []int) {
-	return file_farm_v1_farm_proto_rawDescGZIP(), []int{12}
+	return file_farm_v1_farm_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *UpdateFarmsRequest) GetFarm() *UpdateFarmData {
@@ -1056,7 +1553,7 @@ type UpdateFarmsResponse struct {
 
 func (x *UpdateFarmsResponse) Reset() {
 	*x = UpdateFarmsResponse{}
-	mi := &file_farm_v1_farm_proto_msgTypes[13]
+	mi := &file_farm_v1_farm_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1068,7 +1565,7 @@ func (x *UpdateFarmsResponse) String() string {
 func (*UpdateFarmsResponse) ProtoMessage() {}
 
 func (x *UpdateFarmsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_farm_v1_farm_proto_msgTypes[13]
+	mi := &file_farm_v1_farm_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1081,7 +1578,7 @@ func (x *UpdateFarmsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateFarmsResponse.ProtoReflect.Descriptor instead.
 func (*UpdateFarmsResponse) Descriptor() ([]byte, []int) {
-	return file_farm_v1_farm_proto_rawDescGZIP(), []int{13}
+	return file_farm_v1_farm_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *UpdateFarmsResponse) GetFarmId() string {
@@ -1121,14 +1618,15 @@ func (x *UpdateFarmsResponse) GetMsg() string {
 
 type DeleteFarmRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FarmerId      string                 `protobuf:"bytes,2,opt,name=farmer_id,json=farmerId,proto3" json:"farmer_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteFarmRequest) Reset() {
 	*x = DeleteFarmRequest{}
-	mi := &file_farm_v1_farm_proto_msgTypes[14]
+	mi := &file_farm_v1_farm_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1140,7 +1638,7 @@ func (x *DeleteFarmRequest) String() string {
 func (*DeleteFarmRequest) ProtoMessage() {}
 
 func (x *DeleteFarmRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_farm_v1_farm_proto_msgTypes[14]
+	mi := &file_farm_v1_farm_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1153,12 +1651,19 @@ func (x *DeleteFarmRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteFarmRequest.ProtoReflect.Descriptor instead.
 func (*DeleteFarmRequest) Descriptor() ([]byte, []int) {
-	return file_farm_v1_farm_proto_rawDescGZIP(), []int{14}
+	return file_farm_v1_farm_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *DeleteFarmRequest) GetValue() string {
+func (x *DeleteFarmRequest) GetId() string {
 	if x != nil {
-		return x.Value
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteFarmRequest) GetFarmerId() string {
+	if x != nil {
+		return x.FarmerId
 	}
 	return ""
 }
@@ -1174,7 +1679,7 @@ type DeleteFarmResponse struct {
 
 func (x *DeleteFarmResponse) Reset() {
 	*x = DeleteFarmResponse{}
-	mi := &file_farm_v1_farm_proto_msgTypes[15]
+	mi := &file_farm_v1_farm_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1186,7 +1691,7 @@ func (x *DeleteFarmResponse) String() string {
 func (*DeleteFarmResponse) ProtoMessage() {}
 
 func (x *DeleteFarmResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_farm_v1_farm_proto_msgTypes[15]
+	mi := &file_farm_v1_farm_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1199,7 +1704,7 @@ func (x *DeleteFarmResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteFarmResponse.ProtoReflect.Descriptor instead.
 func (*DeleteFarmResponse) Descriptor() ([]byte, []int) {
-	return file_farm_v1_farm_proto_rawDescGZIP(), []int{15}
+	return file_farm_v1_farm_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *DeleteFarmResponse) GetId() string {
@@ -1227,7 +1732,7 @@ var File_farm_v1_farm_proto protoreflect.FileDescriptor
 
 const file_farm_v1_farm_proto_rawDesc = "" +
 	"\n" +
-	"\x12farm/v1/farm.proto\x12\afarm.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb9\x02\n" +
+	"\x12farm/v1/farm.proto\x12\afarm.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb9\x02\n" +
 	"\vFarmAddress\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
 	"\x06street\x18\x02 \x01(\tR\x06street\x12\x18\n" +
@@ -1255,71 +1760,126 @@ const file_farm_v1_farm_proto_rawDesc = "" +
 	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb9\x01\n" +
-	"\x11CreateFarmAddress\x12\x16\n" +
-	"\x06street\x18\x01 \x01(\tR\x06street\x12\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xdd\x01\n" +
+	"\x11CreateFarmAddress\x12\x1f\n" +
+	"\x06street\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06street\x12\x18\n" +
 	"\avillage\x18\x02 \x01(\tR\avillage\x12!\n" +
-	"\fsub_district\x18\x03 \x01(\tR\vsubDistrict\x12\x12\n" +
-	"\x04city\x18\x04 \x01(\tR\x04city\x12\x1a\n" +
-	"\bprovince\x18\x05 \x01(\tR\bprovince\x12\x1f\n" +
-	"\vpostal_code\x18\x06 \x01(\tR\n" +
-	"postalCode\"\xc3\x01\n" +
+	"\fsub_district\x18\x03 \x01(\tR\vsubDistrict\x12\x1b\n" +
+	"\x04city\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04city\x12#\n" +
+	"\bprovince\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\bprovince\x12(\n" +
+	"\vpostal_code\x18\x06 \x01(\tB\a\xbaH\x04r\x02\x18\n" +
+	"R\n" +
+	"postalCode\"\xca\x02\n" +
 	"\n" +
-	"CreateFarm\x12\x1b\n" +
-	"\tfarmer_id\x18\x01 \x01(\tR\bfarmerId\x12\x1b\n" +
-	"\tfarm_name\x18\x02 \x01(\tR\bfarmName\x12\x1b\n" +
-	"\tfarm_type\x18\x03 \x01(\tR\bfarmType\x12\x1b\n" +
-	"\tfarm_size\x18\x04 \x01(\x01R\bfarmSize\x12\x1f\n" +
-	"\vfarm_status\x18\x05 \x01(\tR\n" +
+	"CreateFarm\x12%\n" +
+	"\tfarmer_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bfarmerId\x12'\n" +
+	"\tfarm_name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xe1\x01R\bfarmName\x12J\n" +
+	"\tfarm_type\x18\x03 \x01(\tB-\xbaH*r(R\bCROPLANDR\aORCHARDR\x05RANCHR\x05MIXEDR\x05OTHERR\bfarmType\x124\n" +
+	"\tfarm_size\x18\x04 \x01(\x01B\x17\xbaH\x14\x12\x12\x11\x00\x00\x00\x00\x00\x88\xc3@!\x00\x00\x00\x00\x00\x00\x00\x00R\bfarmSize\x12H\n" +
+	"\vfarm_status\x18\x05 \x01(\tB'\xbaH$r\"R\x06ACTIVER\bINACTIVER\x04SOLDR\bDESERTEDR\n" +
 	"farmStatus\x12 \n" +
-	"\vdescription\x18\x06 \x01(\tR\vdescription\"\xba\x01\n" +
-	"\x0eUpdateFarmData\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
-	"\tfarm_name\x18\x03 \x01(\tR\bfarmName\x12\x1b\n" +
-	"\tfarm_type\x18\x04 \x01(\tR\bfarmType\x12\x1b\n" +
-	"\tfarm_size\x18\x05 \x01(\x01R\bfarmSize\x12\x1f\n" +
-	"\vfarm_status\x18\x06 \x01(\tR\n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\"\xf2\x02\n" +
+	"\x0eUpdateFarmData\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12%\n" +
+	"\tfarmer_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bfarmerId\x12(\n" +
+	"\tfarm_name\x18\x03 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\x18\xe1\x01R\bfarmName\x12M\n" +
+	"\tfarm_type\x18\x04 \x01(\tB0\xbaH-\xd8\x01\x01r(R\bCROPLANDR\aORCHARDR\x05RANCHR\x05MIXEDR\x05OTHERR\bfarmType\x127\n" +
+	"\tfarm_size\x18\x05 \x01(\x01B\x1a\xbaH\x17\xd8\x01\x01\x12\x12\x11\x00\x00\x00\x00\x00\x88\xc3@!\x00\x00\x00\x00\x00\x00\x00\x00R\bfarmSize\x12K\n" +
+	"\vfarm_status\x18\x06 \x01(\tB*\xbaH'\xd8\x01\x01r\"R\x06ACTIVER\bINACTIVER\x04SOLDR\bDESERTEDR\n" +
 	"farmStatus\x12 \n" +
-	"\vdescription\x18\a \x01(\tR\vdescription\"\xcd\x01\n" +
-	"\x15UpdateFarmAddressData\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\vdescription\x18\a \x01(\tR\vdescription\"\x87\x02\n" +
+	"\x15UpdateFarmAddressData\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x16\n" +
 	"\x06street\x18\x02 \x01(\tR\x06street\x12\x18\n" +
 	"\avillage\x18\x03 \x01(\tR\avillage\x12!\n" +
 	"\fsub_district\x18\x04 \x01(\tR\vsubDistrict\x12\x12\n" +
 	"\x04city\x18\x05 \x01(\tR\x04city\x12\x1a\n" +
-	"\bprovince\x18\x06 \x01(\tR\bprovince\x12\x1f\n" +
-	"\vpostal_code\x18\a \x01(\tR\n" +
-	"postalCode\"r\n" +
-	"\x11CreateFarmRequest\x12'\n" +
-	"\x04farm\x18\x01 \x01(\v2\x13.farm.v1.CreateFarmR\x04farm\x124\n" +
-	"\aaddress\x18\x02 \x01(\v2\x1a.farm.v1.CreateFarmAddressR\aaddress\"\x93\x01\n" +
+	"\bprovince\x18\x06 \x01(\tR\bprovince\x12(\n" +
+	"\vpostal_code\x18\a \x01(\tB\a\xbaH\x04r\x02\x18\n" +
+	"R\n" +
+	"postalCode\x12%\n" +
+	"\tfarmer_id\x18\b \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bfarmerId\"\xb8\x01\n" +
+	"\x11CreateFarmRequest\x12/\n" +
+	"\x04farm\x18\x01 \x01(\v2\x13.farm.v1.CreateFarmB\x06\xbaH\x03\xc8\x01\x01R\x04farm\x12<\n" +
+	"\aaddress\x18\x02 \x01(\v2\x1a.farm.v1.CreateFarmAddressB\x06\xbaH\x03\xc8\x01\x01R\aaddress\x124\n" +
+	"\x11client_request_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\x0fclientRequestId\"\xbf\x01\n" +
 	"\x12CreateFarmResponse\x12\x17\n" +
 	"\afarm_id\x18\x01 \x01(\tR\x06farmId\x12\x1b\n" +
 	"\tfarm_name\x18\x02 \x01(\tR\bfarmName\x12\x1d\n" +
 	"\n" +
 	"address_id\x18\x03 \x01(\tR\taddressId\x12\x16\n" +
 	"\x06status\x18\x04 \x01(\tR\x06status\x12\x10\n" +
-	"\x03msg\x18\x05 \x01(\tR\x03msg\"A\n" +
-	"\x12GetFarmByIDRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
-	"\tfarmer_id\x18\x02 \x01(\tR\bfarmerId\"8\n" +
+	"\x03msg\x18\x05 \x01(\tR\x03msg\x12*\n" +
+	"\x11client_request_id\x18\x06 \x01(\tR\x0fclientRequestId\"U\n" +
+	"\x12GetFarmByIDRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12%\n" +
+	"\tfarmer_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bfarmerId\"8\n" +
 	"\x13GetFarmByIDResponse\x12!\n" +
-	"\x04farm\x18\x01 \x01(\v2\r.farm.v1.FarmR\x04farm\"\xb3\x01\n" +
-	"\x12GetFarmListRequest\x12\x1b\n" +
-	"\tfarmer_id\x18\x01 \x01(\tR\bfarmerId\x12\x1f\n" +
+	"\x04farm\x18\x01 \x01(\v2\r.farm.v1.FarmR\x04farm\"\xde\x06\n" +
+	"\x0eFarmListFilter\x12Q\n" +
+	"\n" +
+	"farm_types\x18\x01 \x03(\tB2\xbaH/\x92\x01,\"*r(R\bCROPLANDR\aORCHARDR\x05RANCHR\x05MIXEDR\x05OTHERR\tfarmTypes\x12Q\n" +
+	"\rfarm_statuses\x18\x02 \x03(\tB,\xbaH)\x92\x01&\"$r\"R\x06ACTIVER\bINACTIVER\x04SOLDR\bDESERTEDR\ffarmStatuses\x12\x1a\n" +
+	"\bprovince\x18\x03 \x01(\tR\bprovince\x12\x12\n" +
+	"\x04city\x18\x04 \x01(\tR\x04city\x12(\n" +
+	"\vpostal_code\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x18\n" +
+	"R\n" +
+	"postalCode\x127\n" +
+	"\rmin_farm_size\x18\x06 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00H\x00R\vminFarmSize\x88\x01\x01\x127\n" +
+	"\rmax_farm_size\x18\a \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00H\x01R\vmaxFarmSize\x88\x01\x01\x12=\n" +
+	"\fcreated_from\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\x12=\n" +
+	"\fupdated_from\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vupdatedFrom\x129\n" +
+	"\n" +
+	"updated_to\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedTo:\xc1\x01\xbaH\xbd\x01\x1a\xba\x01\n" +
+	" farm_list_filter.farm_size_range\x124min_farm_size must not be greater than max_farm_size\x1a`!has(this.min_farm_size) || !has(this.max_farm_size) || this.min_farm_size <= this.max_farm_sizeB\x10\n" +
+	"\x0e_min_farm_sizeB\x10\n" +
+	"\x0e_max_farm_size\"\x93\x03\n" +
+	"\x12GetFarmListRequest\x12%\n" +
+	"\tfarmer_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bfarmerId\x12\x1f\n" +
 	"\vsearch_name\x18\x02 \x01(\tR\n" +
-	"searchName\x121\n" +
+	"searchName\x12;\n" +
+	"\n" +
+	"sort_order\x18\x03 \x01(\x0e2\x12.farm.v1.SortOrderB\b\xbaH\x05\x82\x01\x02\x10\x01R\tsortOrder\x12\x1d\n" +
+	"\x05limit\x18\x04 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x05limit\x12\x1f\n" +
+	"\x06offset\x18\x05 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x06offset\x12'\n" +
 	"\n" +
-	"sort_order\x18\x03 \x01(\x0e2\x12.farm.v1.SortOrderR\tsortOrder\x12\x14\n" +
-	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x05 \x01(\x05R\x06offset\"_\n" +
+	"page_token\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x04R\tpageToken\x12#\n" +
+	"\rinclude_total\x18\a \x01(\bR\fincludeTotal\x12/\n" +
+	"\x06filter\x18\b \x01(\v2\x17.farm.v1.FarmListFilterR\x06filter\x129\n" +
+	"\asort_by\x18\t \x01(\x0e2\x16.farm.v1.FarmSortFieldB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06sortBy\"\x87\x01\n" +
 	"\x13GetFarmListResponse\x12#\n" +
 	"\x05farms\x18\x01 \x01(\v2\r.farm.v1.FarmR\x05farms\x12\x19\n" +
-	"\x05total\x18\x02 \x01(\x05H\x00R\x05total\x88\x01\x01B\b\n" +
-	"\x06_total\"\x9a\x01\n" +
+	"\x05total\x18\x02 \x01(\x05H\x00R\x05total\x88\x01\x01\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageTokenB\b\n" +
+	"\x06_total\"\xe1\x02\n" +
+	"\x12SearchFarmsRequest\x12%\n" +
+	"\tfarmer_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bfarmerId\x12\x1e\n" +
+	"\x05query\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xc8\x01R\x05query\x12Q\n" +
+	"\n" +
+	"farm_types\x18\x03 \x03(\tB2\xbaH/\x92\x01,\"*r(R\bCROPLANDR\aORCHARDR\x05RANCHR\x05MIXEDR\x05OTHERR\tfarmTypes\x12Q\n" +
+	"\rfarm_statuses\x18\x04 \x03(\tB,\xbaH)\x92\x01&\"$r\"R\x06ACTIVER\bINACTIVER\x04SOLDR\bDESERTEDR\ffarmStatuses\x12\x1c\n" +
+	"\tprovinces\x18\x05 \x03(\tR\tprovinces\x12\x1f\n" +
+	"\x05limit\x18\x06 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\x05limit\x12\x1f\n" +
+	"\x06offset\x18\a \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x06offset\"8\n" +
+	"\n" +
+	"FacetCount\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\xf1\x01\n" +
+	"\x13SearchFarmsResponse\x12#\n" +
+	"\x05farms\x18\x01 \x03(\v2\r.farm.v1.FarmR\x05farms\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x122\n" +
+	"\n" +
+	"farm_types\x18\x03 \x03(\v2\x13.farm.v1.FacetCountR\tfarmTypes\x128\n" +
+	"\rfarm_statuses\x18\x04 \x03(\v2\x13.farm.v1.FacetCountR\ffarmStatuses\x121\n" +
+	"\tprovinces\x18\x05 \x03(\v2\x13.farm.v1.FacetCountR\tprovinces\"\x81\x02\n" +
 	"\x12UpdateFarmsRequest\x120\n" +
 	"\x04farm\x18\x01 \x01(\v2\x17.farm.v1.UpdateFarmDataH\x00R\x04farm\x88\x01\x01\x12=\n" +
-	"\aaddress\x18\x02 \x01(\v2\x1e.farm.v1.UpdateFarmAddressDataH\x01R\aaddress\x88\x01\x01B\a\n" +
+	"\aaddress\x18\x02 \x01(\v2\x1e.farm.v1.UpdateFarmAddressDataH\x01R\aaddress\x88\x01\x01:e\xbaHb\x1a`\n" +
+	"\x1cupdate_farms.farm_or_address\x12\x1bfarm or address must be set\x1a#has(this.farm) || has(this.address)B\a\n" +
 	"\x05_farmB\n" +
 	"\n" +
 	"\b_address\"\xcc\x01\n" +
@@ -1334,9 +1894,10 @@ const file_farm_v1_farm_proto_rawDesc = "" +
 	"\b_farm_idB\f\n" +
 	"\n" +
 	"_farm_nameB\r\n" +
-	"\v_address_id\")\n" +
-	"\x11DeleteFarmRequest\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\tR\x05value\"N\n" +
+	"\v_address_id\"T\n" +
+	"\x11DeleteFarmRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12%\n" +
+	"\tfarmer_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bfarmerId\"N\n" +
 	"\x12DeleteFarmResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x10\n" +
@@ -1344,7 +1905,13 @@ const file_farm_v1_farm_proto_rawDesc = "" +
 	"\tSortOrder\x12\x14\n" +
 	"\x10SortOrder_UKNOWN\x10\x00\x12\x11\n" +
 	"\rSortOrder_ASC\x10\x01\x12\x12\n" +
-	"\x0eSortOrder_DESC\x10\x022\x83\x03\n" +
+	"\x0eSortOrder_DESC\x10\x02*\xa4\x01\n" +
+	"\rFarmSortField\x12\x1f\n" +
+	"\x1bFARM_SORT_FIELD_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aFARM_SORT_FIELD_CREATED_AT\x10\x01\x12\x18\n" +
+	"\x14FARM_SORT_FIELD_NAME\x10\x02\x12\x18\n" +
+	"\x14FARM_SORT_FIELD_SIZE\x10\x03\x12\x1e\n" +
+	"\x1aFARM_SORT_FIELD_UPDATED_AT\x10\x042\xcd\x03\n" +
 	"\vFarmService\x12I\n" +
 	"\n" +
 	"CreateFarm\x12\x1a.farm.v1.CreateFarmRequest\x1a\x1b.farm.v1.CreateFarmResponse(\x010\x01\x12H\n" +
@@ -1352,7 +1919,8 @@ const file_farm_v1_farm_proto_rawDesc = "" +
 	"\vGetFarmList\x12\x1b.farm.v1.GetFarmListRequest\x1a\x1c.farm.v1.GetFarmListResponse0\x01\x12L\n" +
 	"\vUpdateFarms\x12\x1b.farm.v1.UpdateFarmsRequest\x1a\x1c.farm.v1.UpdateFarmsResponse(\x010\x01\x12E\n" +
 	"\n" +
-	"DeleteFarm\x12\x1a.farm.v1.DeleteFarmRequest\x1a\x1b.farm.v1.DeleteFarmResponseb\x06proto3"
+	"DeleteFarm\x12\x1a.farm.v1.DeleteFarmRequest\x1a\x1b.farm.v1.DeleteFarmResponse\x12H\n" +
+	"\vSearchFarms\x12\x1b.farm.v1.SearchFarmsRequest\x1a\x1c.farm.v1.SearchFarmsResponseb\x06proto3"
 
 var (
 	file_farm_v1_farm_proto_rawDescOnce sync.Once
@@ -1366,56 +1934,73 @@ func file_farm_v1_farm_proto_rawDescGZIP() []byte {
 	return file_farm_v1_farm_proto_rawDescData
 }
 
-var file_farm_v1_farm_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_farm_v1_farm_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_farm_v1_farm_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_farm_v1_farm_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_farm_v1_farm_proto_goTypes = []any{
 	(SortOrder)(0),                // 0: farm.v1.SortOrder
-	(*FarmAddress)(nil),           // 1: farm.v1.FarmAddress
-	(*Farm)(nil),                  // 2: farm.v1.Farm
-	(*CreateFarmAddress)(nil),     // 3: farm.v1.CreateFarmAddress
-	(*CreateFarm)(nil),            // 4: farm.v1.CreateFarm
-	(*UpdateFarmData)(nil),        // 5: farm.v1.UpdateFarmData
-	(*UpdateFarmAddressData)(nil), // 6: farm.v1.UpdateFarmAddressData
-	(*CreateFarmRequest)(nil),     // 7: farm.v1.CreateFarmRequest
-	(*CreateFarmResponse)(nil),    // 8: farm.v1.CreateFarmResponse
-	(*GetFarmByIDRequest)(nil),    // 9: farm.v1.GetFarmByIDRequest
-	(*GetFarmByIDResponse)(nil),   // 10: farm.v1.GetFarmByIDResponse
-	(*GetFarmListRequest)(nil),    // 11: farm.v1.GetFarmListRequest
-	(*GetFarmListResponse)(nil),   // 12: farm.v1.GetFarmListResponse
-	(*UpdateFarmsRequest)(nil),    // 13: farm.v1.UpdateFarmsRequest
-	(*UpdateFarmsResponse)(nil),   // 14: farm.v1.UpdateFarmsResponse
-	(*DeleteFarmRequest)(nil),     // 15: farm.v1.DeleteFarmRequest
-	(*DeleteFarmResponse)(nil),    // 16: farm.v1.DeleteFarmResponse
-	(*timestamppb.Timestamp)(nil), // 17: google.protobuf.Timestamp
+	(FarmSortField)(0),            // 1: farm.v1.FarmSortField
+	(*FarmAddress)(nil),           // 2: farm.v1.FarmAddress
+	(*Farm)(nil),                  // 3: farm.v1.Farm
+	(*CreateFarmAddress)(nil),     // 4: farm.v1.CreateFarmAddress
+	(*CreateFarm)(nil),            // 5: farm.v1.CreateFarm
+	(*UpdateFarmData)(nil),        // 6: farm.v1.UpdateFarmData
+	(*UpdateFarmAddressData)(nil), // 7: farm.v1.UpdateFarmAddressData
+	(*CreateFarmRequest)(nil),     // 8: farm.v1.CreateFarmRequest
+	(*CreateFarmResponse)(nil),    // 9: farm.v1.CreateFarmResponse
+	(*GetFarmByIDRequest)(nil),    // 10: farm.v1.GetFarmByIDRequest
+	(*GetFarmByIDResponse)(nil),   // 11: farm.v1.GetFarmByIDResponse
+	(*FarmListFilter)(nil),        // 12: farm.v1.FarmListFilter
+	(*GetFarmListRequest)(nil),    // 13: farm.v1.GetFarmListRequest
+	(*GetFarmListResponse)(nil),   // 14: farm.v1.GetFarmListResponse
+	(*SearchFarmsRequest)(nil),    // 15: farm.v1.SearchFarmsRequest
+	(*FacetCount)(nil),            // 16: farm.v1.FacetCount
+	(*SearchFarmsResponse)(nil),   // 17: farm.v1.SearchFarmsResponse
+	(*UpdateFarmsRequest)(nil),    // 18: farm.v1.UpdateFarmsRequest
+	(*UpdateFarmsResponse)(nil),   // 19: farm.v1.UpdateFarmsResponse
+	(*DeleteFarmRequest)(nil),     // 20: farm.v1.DeleteFarmRequest
+	(*DeleteFarmResponse)(nil),    // 21: farm.v1.DeleteFarmResponse
+	(*timestamppb.Timestamp)(nil), // 22: google.protobuf.Timestamp
 }
 var file_farm_v1_farm_proto_depIdxs = []int32{
-	17, // 0: farm.v1.FarmAddress.created_at:type_name -> google.protobuf.Timestamp
-	17, // 1: farm.v1.FarmAddress.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 2: farm.v1.Farm.address:type_name -> farm.v1.FarmAddress
-	17, // 3: farm.v1.Farm.created_at:type_name -> google.protobuf.Timestamp
-	17, // 4: farm.v1.Farm.updated_at:type_name -> google.protobuf.Timestamp
-	4,  // 5: farm.v1.CreateFarmRequest.farm:type_name -> farm.v1.CreateFarm
-	3,  // 6: farm.v1.CreateFarmRequest.address:type_name -> farm.v1.CreateFarmAddress
-	2,  // 7: farm.v1.GetFarmByIDResponse.farm:type_name -> farm.v1.Farm
-	0,  // 8: farm.v1.GetFarmListRequest.sort_order:type_name -> farm.v1.SortOrder
-	2,  // 9: farm.v1.GetFarmListResponse.farms:type_name -> farm.v1.Farm
-	5,  // 10: farm.v1.UpdateFarmsRequest.farm:type_name -> farm.v1.UpdateFarmData
-	6,  // 11: farm.v1.UpdateFarmsRequest.address:type_name -> farm.v1.UpdateFarmAddressData
-	7,  // 12: farm.v1.FarmService.CreateFarm:input_type -> farm.v1.CreateFarmRequest
-	9,  // 13: farm.v1.FarmService.GetFarmByID:input_type -> farm.v1.GetFarmByIDRequest
-	11, // 14: farm.v1.FarmService.GetFarmList:input_type -> farm.v1.GetFarmListRequest
-	13, // 15: farm.v1.FarmService.UpdateFarms:input_type -> farm.v1.UpdateFarmsRequest
-	15, // 16: farm.v1.FarmService.DeleteFarm:input_type -> farm.v1.DeleteFarmRequest
-	8,  // 17: farm.v1.FarmService.CreateFarm:output_type -> farm.v1.CreateFarmResponse
-	10, // 18: farm.v1.FarmService.GetFarmByID:output_type -> farm.v1.GetFarmByIDResponse
-	12, // 19: farm.v1.FarmService.GetFarmList:output_type -> farm.v1.GetFarmListResponse
-	14, // 20: farm.v1.FarmService.UpdateFarms:output_type -> farm.v1.UpdateFarmsResponse
-	16, // 21: farm.v1.FarmService.DeleteFarm:output_type -> farm.v1.DeleteFarmResponse
-	17, // [17:22] is the sub-list for method output_type
-	12, // [12:17] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	22, // 0: farm.v1.FarmAddress.created_at:type_name -> google.protobuf.Timestamp
+	22, // 1: farm.v1.FarmAddress.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 2: farm.v1.Farm.address:type_name -> farm.v1.FarmAddress
+	22, // 3: farm.v1.Farm.created_at:type_name -> google.protobuf.Timestamp
+	22, // 4: farm.v1.Farm.updated_at:type_name -> google.protobuf.Timestamp
+	5,  // 5: farm.v1.CreateFarmRequest.farm:type_name -> farm.v1.CreateFarm
+	4,  // 6: farm.v1.CreateFarmRequest.address:type_name -> farm.v1.CreateFarmAddress
+	3,  // 7: farm.v1.GetFarmByIDResponse.farm:type_name -> farm.v1.Farm
+	22, // 8: farm.v1.FarmListFilter.created_from:type_name -> google.protobuf.Timestamp
+	22, // 9: farm.v1.FarmListFilter.created_to:type_name -> google.protobuf.Timestamp
+	22, // 10: farm.v1.FarmListFilter.updated_from:type_name -> google.protobuf.Timestamp
+	22, // 11: farm.v1.FarmListFilter.updated_to:type_name -> google.protobuf.Timestamp
+	0,  // 12: farm.v1.GetFarmListRequest.sort_order:type_name -> farm.v1.SortOrder
+	12, // 13: farm.v1.GetFarmListRequest.filter:type_name -> farm.v1.FarmListFilter
+	1,  // 14: farm.v1.GetFarmListRequest.sort_by:type_name -> farm.v1.FarmSortField
+	3,  // 15: farm.v1.GetFarmListResponse.farms:type_name -> farm.v1.Farm
+	3,  // 16: farm.v1.SearchFarmsResponse.farms:type_name -> farm.v1.Farm
+	16, // 17: farm.v1.SearchFarmsResponse.farm_types:type_name -> farm.v1.FacetCount
+	16, // 18: farm.v1.SearchFarmsResponse.farm_statuses:type_name -> farm.v1.FacetCount
+	16, // 19: farm.v1.SearchFarmsResponse.provinces:type_name -> farm.v1.FacetCount
+	6,  // 20: farm.v1.UpdateFarmsRequest.farm:type_name -> farm.v1.UpdateFarmData
+	7,  // 21: farm.v1.UpdateFarmsRequest.address:type_name -> farm.v1.UpdateFarmAddressData
+	8,  // 22: farm.v1.FarmService.CreateFarm:input_type -> farm.v1.CreateFarmRequest
+	10, // 23: farm.v1.FarmService.GetFarmByID:input_type -> farm.v1.GetFarmByIDRequest
+	13, // 24: farm.v1.FarmService.GetFarmList:input_type -> farm.v1.GetFarmListRequest
+	18, // 25: farm.v1.FarmService.UpdateFarms:input_type -> farm.v1.UpdateFarmsRequest
+	20, // 26: farm.v1.FarmService.DeleteFarm:input_type -> farm.v1.DeleteFarmRequest
+	15, // 27: farm.v1.FarmService.SearchFarms:input_type -> farm.v1.SearchFarmsRequest
+	9,  // 28: farm.v1.FarmService.CreateFarm:output_type -> farm.v1.CreateFarmResponse
+	11, // 29: farm.v1.FarmService.GetFarmByID:output_type -> farm.v1.GetFarmByIDResponse
+	14, // 30: farm.v1.FarmService.GetFarmList:output_type -> farm.v1.GetFarmListResponse
+	19, // 31: farm.v1.FarmService.UpdateFarms:output_type -> farm.v1.UpdateFarmsResponse
+	21, // 32: farm.v1.FarmService.DeleteFarm:output_type -> farm.v1.DeleteFarmResponse
+	17, // 33: farm.v1.FarmService.SearchFarms:output_type -> farm.v1.SearchFarmsResponse
+	28, // [28:34] is the sub-list for method output_type
+	22, // [22:28] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_farm_v1_farm_proto_init() }
@@ -1423,16 +2008,17 @@ func file_farm_v1_farm_proto_init() {
 	if File_farm_v1_farm_proto != nil {
 		return
 	}
-	file_farm_v1_farm_proto_msgTypes[11].OneofWrappers = []any{}
+	file_farm_v1_farm_proto_msgTypes[10].OneofWrappers = []any{}
 	file_farm_v1_farm_proto_msgTypes[12].OneofWrappers = []any{}
-	file_farm_v1_farm_proto_msgTypes[13].OneofWrappers = []any{}
+	file_farm_v1_farm_proto_msgTypes[16].OneofWrappers = []any{}
+	file_farm_v1_farm_proto_msgTypes[17].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_farm_v1_farm_proto_rawDesc), len(file_farm_v1_farm_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   16,
+			NumEnums:      2,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
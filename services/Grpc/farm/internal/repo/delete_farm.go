@@ -0,0 +1,86 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/models"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/database/postgres/pkg"
+)
+
+func (fr farmRepo) DeleteFarm(
+	ctx context.Context,
+	opts *pkg.TxOpts,
+	id string,
+	farmerID string,
+) (res models.Farm, err error) {
+	ctx, span := spans.Start(ctx, "DeleteFarm")
+	defer func() { spans.End(span, err) }()
+
+	farmID, err := uuid.Parse(id)
+	if err != nil {
+		return res, ErrInvalidFarmID
+	}
+
+	tx, err := fr.farmDB.db.BeginTx(ctx, opts)
+	if err != nil {
+		return res, err
+	}
+
+	defer tx.Rollback()
+
+	addressID, err := farmOwner(ctx, tx.Stmt(fr.farmDB.farmOwnerStmt), farmID, farmerID)
+	if err != nil {
+		return res, err
+	}
+
+	deletedAt := time.Now().UTC()
+
+	res, err = softDeleteFarm(ctx, tx.Stmt(fr.farmDB.deleteFarmStmt), deletedAt, farmID, farmerID)
+	if err != nil {
+		return res, err
+	}
+
+	if err := softDeleteFarmAddress(ctx, tx.Stmt(fr.farmDB.deleteFarmAddressStmt), deletedAt, addressID); err != nil {
+		return res, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return res, err
+	}
+
+	res.UpdatedAt = deletedAt
+	return res, nil
+}
+
+func softDeleteFarm(
+	ctx context.Context, tx pkg.Stmt, deletedAt time.Time, farmID uuid.UUID, farmerID string,
+) (res models.Farm, err error) {
+	row := tx.QueryRowContext(ctx, deletedAt, farmID, farmerID)
+	if err := row.Scan(&res.ID, &res.FarmerID, &res.AddressesID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return res, ErrFarmNotExist
+		}
+
+		return res, err
+	}
+
+	return res, nil
+}
+
+// softDeleteFarmAddress marks the address of a deleted farm deleted too, an
+// address that is already gone is left alone.
+func softDeleteFarmAddress(
+	ctx context.Context, tx pkg.Stmt, deletedAt time.Time, addressID string,
+) (err error) {
+	var deletedAddrID string
+	row := tx.QueryRowContext(ctx, deletedAt, addressID)
+	if err := row.Scan(&deletedAddrID); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	return nil
+}
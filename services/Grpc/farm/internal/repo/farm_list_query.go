@@ -0,0 +1,181 @@
+package repo
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/constants"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/models"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/pbgen"
+)
+
+// farmListSortColumns is the only source of column names in an ORDER BY,
+// every value a client sends reaches the SQL as a parameter.
+var farmListSortColumns = map[pbgen.FarmSortField]string{
+	pbgen.FarmSortField_FARM_SORT_FIELD_UNSPECIFIED: "f.created_at",
+	pbgen.FarmSortField_FARM_SORT_FIELD_CREATED_AT:  "f.created_at",
+	pbgen.FarmSortField_FARM_SORT_FIELD_NAME:        "f.farm_name",
+	pbgen.FarmSortField_FARM_SORT_FIELD_SIZE:        "f.farm_size",
+	pbgen.FarmSortField_FARM_SORT_FIELD_UPDATED_AT:  "f.updated_at",
+}
+
+// FarmSortKey is the value of farm in the column the list is sorted by, a
+// page token keeps it to continue after farm.
+func FarmSortKey(field pbgen.FarmSortField, farm models.FarmWithAddress) string {
+	switch field {
+	case pbgen.FarmSortField_FARM_SORT_FIELD_NAME:
+		return farm.FarmName
+	case pbgen.FarmSortField_FARM_SORT_FIELD_SIZE:
+		return strconv.FormatFloat(farm.FarmSize, 'f', -1, 64)
+	case pbgen.FarmSortField_FARM_SORT_FIELD_UPDATED_AT:
+		return farm.Farm.UpdatedAt.Format(time.RFC3339Nano)
+	default:
+		return farm.Farm.CreatedAt.Format(time.RFC3339Nano)
+	}
+}
+
+func parseFarmSortKey(field pbgen.FarmSortField, key string) (any, error) {
+	switch field {
+	case pbgen.FarmSortField_FARM_SORT_FIELD_NAME:
+		return key, nil
+	case pbgen.FarmSortField_FARM_SORT_FIELD_SIZE:
+		return strconv.ParseFloat(key, 64)
+	default:
+		return time.Parse(time.RFC3339Nano, key)
+	}
+}
+
+// farmListQuery collects the conditions of a farm list, the values are
+// numbered as they are added.
+type farmListQuery struct {
+	conds []string
+	args  []any
+}
+
+func (q *farmListQuery) arg(v any) string {
+	q.args = append(q.args, v)
+	return "$" + strconv.Itoa(len(q.args))
+}
+
+// where adds a condition, every %s of cond is replaced by the placeholder
+// of the value at the same position.
+func (q *farmListQuery) where(cond string, vals ...any) {
+	placeholders := make([]any, 0, len(vals))
+	for _, v := range vals {
+		placeholders = append(placeholders, q.arg(v))
+	}
+
+	q.conds = append(q.conds, fmt.Sprintf(cond, placeholders...))
+}
+
+func (q *farmListQuery) whereIn(column string, vals []string) {
+	if len(vals) == 0 {
+		return
+	}
+
+	placeholders := make([]string, 0, len(vals))
+	for _, v := range vals {
+		placeholders = append(placeholders, q.arg(v))
+	}
+
+	q.conds = append(q.conds, column+" IN ("+strings.Join(placeholders, ", ")+")")
+}
+
+func newFarmListQuery(req *pbgen.GetFarmListRequest) *farmListQuery {
+	q := &farmListQuery{}
+
+	q.where("f.farmer_id = %s", req.GetFarmerId())
+	q.conds = append(q.conds, "f.deleted_at IS NULL")
+
+	if req.GetSearchName() != "" {
+		q.where("f.farm_name ILIKE '%%' || %s || '%%'", req.GetSearchName())
+	}
+
+	filter := req.GetFilter()
+	if filter == nil {
+		return q
+	}
+
+	q.whereIn("f.farm_type", filter.GetFarmTypes())
+	q.whereIn("f.farm_status", filter.GetFarmStatuses())
+
+	if filter.GetProvince() != "" {
+		q.where("a.province = %s", filter.GetProvince())
+	}
+	if filter.GetCity() != "" {
+		q.where("a.city = %s", filter.GetCity())
+	}
+	if filter.GetPostalCode() != "" {
+		q.where("a.postal_code = %s", filter.GetPostalCode())
+	}
+
+	if filter.MinFarmSize != nil {
+		q.where("f.farm_size >= %s", filter.GetMinFarmSize())
+	}
+	if filter.MaxFarmSize != nil {
+		q.where("f.farm_size <= %s", filter.GetMaxFarmSize())
+	}
+
+	if filter.CreatedFrom != nil {
+		q.where("f.created_at >= %s", filter.GetCreatedFrom().AsTime())
+	}
+	if filter.CreatedTo != nil {
+		q.where("f.created_at <= %s", filter.GetCreatedTo().AsTime())
+	}
+	if filter.UpdatedFrom != nil {
+		q.where("f.updated_at >= %s", filter.GetUpdatedFrom().AsTime())
+	}
+	if filter.UpdatedTo != nil {
+		q.where("f.updated_at <= %s", filter.GetUpdatedTo().AsTime())
+	}
+
+	return q
+}
+
+func (q *farmListQuery) countSQL() string {
+	return constants.QueryCountFarmList + " WHERE " + strings.Join(q.conds, " AND ")
+}
+
+// selectSQL orders by the sort column and the id. After a page token it
+// continues past the last farm of the previous page, offset is used
+// otherwise.
+func (q *farmListQuery) selectSQL(req *pbgen.GetFarmListRequest, after *farmListCursor) (string, error) {
+	column := farmListSortColumns[req.GetSortBy()]
+
+	dir, cmp := "ASC", ">"
+	if req.GetSortOrder() == pbgen.SortOrder_SortOrder_DESC {
+		dir, cmp = "DESC", "<"
+	}
+
+	if after != nil {
+		key, err := parseFarmSortKey(req.GetSortBy(), after.key)
+		if err != nil {
+			return "", err
+		}
+
+		q.where("("+column+", f.id) "+cmp+" (%s, %s)", key, after.id)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(constants.QuerySelectFarmList)
+	sb.WriteString(" WHERE ")
+	sb.WriteString(strings.Join(q.conds, " AND "))
+	fmt.Fprintf(&sb, " ORDER BY %s %s, f.id %s", column, dir, dir)
+
+	// a limit of 0 lists every farm
+	if req.GetLimit() > 0 {
+		sb.WriteString(" LIMIT " + q.arg(req.GetLimit()))
+	}
+	if after == nil && req.GetOffset() > 0 {
+		sb.WriteString(" OFFSET " + q.arg(req.GetOffset()))
+	}
+
+	return sb.String(), nil
+}
+
+type farmListCursor struct {
+	key string
+	id  string
+}
@@ -0,0 +1,63 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/database/postgres/pkg"
+)
+
+var (
+	ErrFarmNotExist        = errors.New("farm is not exist")
+	ErrFarmAddressNotExist = errors.New("farm address is not exist")
+	ErrFarmExists          = errors.New("farm already exists")
+
+	ErrInvalidFarmID        = errors.New("farm id is not a valid uuid")
+	ErrInvalidFarmAddressID = errors.New("farm address id is not a valid uuid")
+)
+
+// farmOwner answers the address of the farm of farmerID. The farm of another
+// farmer does not exist for farmerID, so its id tells nothing about it.
+func farmOwner(
+	ctx context.Context, tx pkg.Stmt, farmID uuid.UUID, farmerID string,
+) (addressID string, err error) {
+	var owner string
+	row := tx.QueryRowContext(ctx, farmID)
+	if err := row.Scan(&owner, &addressID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", ErrFarmNotExist
+		}
+
+		return "", err
+	}
+
+	if owner != farmerID {
+		return "", ErrFarmNotExist
+	}
+
+	return addressID, nil
+}
+
+// farmAddressOwner checks that the address belongs to a farm of farmerID,
+// like farmOwner the address of another farmer does not exist.
+func farmAddressOwner(
+	ctx context.Context, tx pkg.Stmt, addressID uuid.UUID, farmerID string,
+) error {
+	var owner string
+	row := tx.QueryRowContext(ctx, addressID)
+	if err := row.Scan(&owner); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrFarmAddressNotExist
+		}
+
+		return err
+	}
+
+	if owner != farmerID {
+		return ErrFarmAddressNotExist
+	}
+
+	return nil
+}
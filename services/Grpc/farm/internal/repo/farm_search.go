@@ -0,0 +1,250 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/models"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/pbgen"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/observability/otel/trace"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// farmSearchIndex is the RediSearch index the Events/farm consumer keeps
+// over the farm:{id}:{farmerID} hashes.
+const farmSearchIndex = "idx:farms"
+
+const farmSearchDefaultLimit = 10
+
+// escapeTag escapes a value of a tag query, every rune that is not a letter,
+// a digit or an underscore would otherwise be read as query syntax.
+func escapeTag(val string) string {
+	var sb strings.Builder
+	for _, r := range val {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' {
+			sb.WriteRune('\\')
+		}
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
+
+func tagQuery(field string, vals []string) string {
+	escaped := make([]string, 0, len(vals))
+	for _, v := range vals {
+		escaped = append(escaped, escapeTag(v))
+	}
+
+	return "@" + field + ":{" + strings.Join(escaped, "|") + "}"
+}
+
+// termQuery matches the words that term prefixes, from four letters on it
+// also matches the words one typo away.
+func termQuery(term string) string {
+	switch n := len([]rune(term)); {
+	case n < 2:
+		return term
+	case n < 4:
+		return term + "*"
+	default:
+		return "(" + term + "*|%" + term + "%)"
+	}
+}
+
+// farmSearchQuery scopes the search to the farms of the farmer. The words
+// of the query are split on anything but letters and digits, so they reach
+// the index without query syntax.
+func farmSearchQuery(req *pbgen.SearchFarmsRequest) string {
+	parts := []string{tagQuery("farmer_id", []string{req.GetFarmerId()})}
+
+	if len(req.GetFarmTypes()) > 0 {
+		parts = append(parts, tagQuery("farm_type", req.GetFarmTypes()))
+	}
+	if len(req.GetFarmStatuses()) > 0 {
+		parts = append(parts, tagQuery("farm_status", req.GetFarmStatuses()))
+	}
+	if len(req.GetProvinces()) > 0 {
+		parts = append(parts, tagQuery("province", req.GetProvinces()))
+	}
+
+	terms := strings.FieldsFunc(strings.ToLower(req.GetQuery()), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, term := range terms {
+		parts = append(parts, termQuery(term))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func (fr farmRepo) SearchFarms(
+	ctx context.Context,
+	req *pbgen.SearchFarmsRequest,
+) (res models.FarmSearchResult, err error) {
+	ctx, span := spans.StartClient(ctx, "SearchFarms", trace.CacheAttrs("FT.SEARCH")...)
+	defer func() {
+		span.SetAttributes(attribute.Int("farm.search.total", res.Total))
+		spans.End(span, err)
+	}()
+
+	query := farmSearchQuery(req)
+
+	limit := int(req.GetLimit())
+	if limit == 0 {
+		limit = farmSearchDefaultLimit
+	}
+
+	reply, err := fr.farmCache.Do(
+		ctx,
+		"FT.SEARCH", farmSearchIndex, query,
+		"LIMIT", req.GetOffset(), limit,
+	).Slice()
+	if err != nil {
+		return res, err
+	}
+
+	res.Total, res.Farms, err = parseFarmSearch(reply)
+	if err != nil {
+		return res, err
+	}
+
+	facets := []struct {
+		field string
+		dst   *[]models.FacetCount
+	}{
+		{"farm_type", &res.FarmTypes},
+		{"farm_status", &res.FarmStatuses},
+		{"province", &res.Provinces},
+	}
+	for _, facet := range facets {
+		counts, err := fr.farmFacet(ctx, query, facet.field)
+		if err != nil {
+			return res, err
+		}
+		*facet.dst = counts
+	}
+
+	return res, nil
+}
+
+// farmFacet counts the matches of query per value of field, the most
+// common value first.
+func (fr farmRepo) farmFacet(ctx context.Context, query string, field string) (_ []models.FacetCount, err error) {
+	ctx, span := spans.StartClient(ctx, "SearchFarms.facet", trace.CacheAttrs("FT.AGGREGATE")...)
+	span.SetAttributes(attribute.String("farm.search.facet", field))
+	defer func() { spans.End(span, err) }()
+
+	reply, err := fr.farmCache.Do(
+		ctx,
+		"FT.AGGREGATE", farmSearchIndex, query,
+		"GROUPBY", 1, "@"+field,
+		"REDUCE", "COUNT", 0, "AS", "count",
+		"SORTBY", 2, "@count", "DESC",
+	).Slice()
+	if err != nil {
+		return nil, err
+	}
+
+	var counts []models.FacetCount
+
+	// the reply is the number of groups followed by one field list per group
+	for _, row := range reply[min(1, len(reply)):] {
+		vals, err := replyFields(row)
+		if err != nil {
+			return nil, err
+		}
+
+		if vals[field] == "" {
+			continue
+		}
+
+		count, err := strconv.Atoi(vals["count"])
+		if err != nil {
+			return nil, fmt.Errorf("farm search facet count: %w", err)
+		}
+
+		counts = append(counts, models.FacetCount{Value: vals[field], Count: count})
+	}
+
+	return counts, nil
+}
+
+// parseFarmSearch reads an FT.SEARCH reply, the total followed by the key
+// and the field list of every farm on the page.
+func parseFarmSearch(reply []any) (int, []models.FarmWithAddress, error) {
+	if len(reply) == 0 {
+		return 0, nil, fmt.Errorf("farm search: empty reply")
+	}
+
+	total, ok := reply[0].(int64)
+	if !ok {
+		return 0, nil, fmt.Errorf("farm search: unexpected total %T", reply[0])
+	}
+
+	var farms []models.FarmWithAddress
+	for i := 1; i+1 < len(reply); i += 2 {
+		key, _ := reply[i].(string)
+
+		vals, err := replyFields(reply[i+1])
+		if err != nil {
+			return 0, nil, err
+		}
+
+		farms = append(farms, farmFromHash(key, vals))
+	}
+
+	return int(total), farms, nil
+}
+
+func replyFields(row any) (map[string]string, error) {
+	list, ok := row.([]any)
+	if !ok {
+		return nil, fmt.Errorf("farm search: unexpected field list %T", row)
+	}
+
+	vals := make(map[string]string, len(list)/2)
+	for i := 0; i+1 < len(list); i += 2 {
+		name, _ := list[i].(string)
+		val, _ := list[i+1].(string)
+		vals[name] = val
+	}
+
+	return vals, nil
+}
+
+// farmFromHash reads a farm hash written by the Events/farm consumer. The
+// farm id is taken from the key, older hashes have it overwritten by the
+// address id.
+func farmFromHash(key string, vals map[string]string) (farm models.FarmWithAddress) {
+	farm.Farm.ID = vals["id"]
+	if parts := strings.Split(key, ":"); len(parts) == 3 {
+		farm.Farm.ID = parts[1]
+	}
+
+	farm.FarmerID = vals["farmer_id"]
+	farm.FarmName = vals["farm_name"]
+	farm.FarmType = vals["farm_type"]
+	farm.FarmSize, _ = strconv.ParseFloat(vals["farm_size"], 64)
+	farm.FarmStatus = vals["farm_status"]
+	farm.Description = vals["description"]
+	farm.Farm.CreatedAt, _ = time.Parse(time.RFC3339Nano, vals["created_at"])
+	farm.Farm.UpdatedAt, _ = time.Parse(time.RFC3339Nano, vals["updated_at"])
+
+	farm.FarmAddress.ID = vals["address_id"]
+	farm.AddressesID = vals["address_id"]
+	farm.Street = vals["street"]
+	farm.Village = vals["village"]
+	farm.SubDistrict = vals["sub_district"]
+	farm.City = vals["city"]
+	farm.Province = vals["province"]
+	farm.PostalCode = vals["postal_code"]
+	farm.FarmAddress.CreatedAt, _ = time.Parse(time.RFC3339Nano, vals["address_created_at"])
+	farm.FarmAddress.UpdatedAt, _ = time.Parse(time.RFC3339Nano, vals["address_updated_at"])
+
+	return farm
+}
@@ -2,18 +2,24 @@ package repo
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 
 	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/models"
 	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/pbgen"
 	"github.com/sony-nurdianto/farm/shared_lib/Go/database/postgres/pkg"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 func (fr farmRepo) GetTotalFarms(
 	ctx context.Context,
 	req *pbgen.GetFarmListRequest,
-) (int, error) {
-	var total int
-	row := fr.farmDB.totalFarmsData.QueryRowContext(ctx, req.GetFarmerId(), req.SearchName)
+) (total int, err error) {
+	ctx, span := spans.Start(ctx, "GetTotalFarms")
+	defer func() { spans.End(span, err) }()
+
+	q := newFarmListQuery(req)
+	row := fr.farmDB.db.QueryRowContext(ctx, q.countSQL(), q.args...)
 
 	if err := row.Scan(&total); err != nil {
 		return 0, err
@@ -22,9 +28,9 @@ func (fr farmRepo) GetTotalFarms(
 	return total, nil
 }
 
-func farmWithAddressScanner(rws pkg.Rows) ([]models.FarmWithAddress, error) {
-	var res []models.FarmWithAddress
-
+// scanFarmsWithAddress hands every row to yield as soon as it is read, and
+// closes the rows.
+func scanFarmsWithAddress(rws pkg.Rows, yield func(models.FarmWithAddress) error) (count int, _ error) {
 	defer rws.Close()
 
 	for rws.Next() {
@@ -48,51 +54,85 @@ func farmWithAddressScanner(rws pkg.Rows) ([]models.FarmWithAddress, error) {
 			&farm.Province,
 			&farm.PostalCode,
 		); err != nil {
-			return res, err
+			return count, err
 		}
 
-		res = append(res, farm)
+		if err := yield(farm); err != nil {
+			return count, err
+		}
+		count++
 	}
 
-	return res, nil
+	// a connection lost in the middle of the rows ends Next like the last row
+	if err := rws.Err(); err != nil {
+		return count, err
+	}
+
+	return count, nil
 }
 
+// GetFarms reads the first page of the list, yield gets every farm while the
+// rows are still read.
 func (fr farmRepo) GetFarms(
 	ctx context.Context,
 	req *pbgen.GetFarmListRequest,
-) (res []models.FarmWithAddress, _ error) {
-	if req.SortOrder == pbgen.SortOrder_SortOrder_ASC {
-		rows, err := fr.farmDB.getFarmsAscStmt.QueryContext(ctx, req.GetFarmerId(), req.SearchName, req.Limit, req.Offset)
-		if err != nil {
-			return res, err
-		}
+	yield func(models.FarmWithAddress) error,
+) error {
+	return fr.listFarms(ctx, req, nil, yield)
+}
 
-		res, err = farmWithAddressScanner(rows)
-		if err != nil {
-			return res, err
-		}
+// GetFarmsAfter reads the page that follows the farm with sortKey and id,
+// sortKey is the FarmSortKey of that farm.
+func (fr farmRepo) GetFarmsAfter(
+	ctx context.Context,
+	req *pbgen.GetFarmListRequest,
+	sortKey string,
+	id string,
+	yield func(models.FarmWithAddress) error,
+) error {
+	return fr.listFarms(ctx, req, &farmListCursor{key: sortKey, id: id}, yield)
+}
 
+func (fr farmRepo) listFarms(
+	ctx context.Context,
+	req *pbgen.GetFarmListRequest,
+	after *farmListCursor,
+	yield func(models.FarmWithAddress) error,
+) (err error) {
+	var count int
+
+	ctx, span := spans.Start(ctx, "ListFarms")
+	defer func() {
+		span.SetAttributes(
+			attribute.Bool("farm.list.keyset", after != nil),
+			attribute.Int("farm.list.rows", count),
+		)
+		spans.End(span, err)
+	}()
+
+	q := newFarmListQuery(req)
+	query, err := q.selectSQL(req, after)
+	if err != nil {
+		return err
 	}
 
-	if req.SortOrder == pbgen.SortOrder_SortOrder_DESC {
-		rows, err := fr.farmDB.getFarmsDescStmt.QueryContext(ctx, req.GetFarmerId(), req.SearchName, req.Limit, req.Offset)
-		if err != nil {
-			return res, err
-		}
-
-		res, err = farmWithAddressScanner(rows)
-		if err != nil {
-			return res, err
-		}
+	rows, err := fr.farmDB.db.QueryContext(ctx, query, q.args...)
+	if err != nil {
+		return err
 	}
-	return res, nil
+
+	count, err = scanFarmsWithAddress(rows, yield)
+	return err
 }
 
 func (fr farmRepo) GetFarmByID(
 	ctx context.Context,
 	id string,
 	farmerID string,
-) (res models.FarmWithAddress, _ error) {
+) (res models.FarmWithAddress, err error) {
+	ctx, span := spans.Start(ctx, "GetFarmByID")
+	defer func() { spans.End(span, err) }()
+
 	// cache, err := fr.getFarmCache(ctx, id, farmerID)
 	// if err == nil {
 	// 	log.Println("Return From Cache")
@@ -119,9 +159,18 @@ func (fr farmRepo) GetFarmByID(
 		&res.Province,
 		&res.PostalCode,
 	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return res, ErrFarmNotExist
+		}
+
 		return res, err
 	}
 
+	// the farm of another farmer does not exist for farmerID
+	if res.FarmerID != farmerID {
+		return models.FarmWithAddress{}, ErrFarmNotExist
+	}
+
 	res.AddressesID = res.FarmAddress.ID
 
 	// go func(f models.Farm, a models.FarmAddress) {
@@ -0,0 +1,14 @@
+package repo
+
+import "context"
+
+// PingDatabase and PingCache probe the connections of the repo, they drive
+// the health status of the farm service.
+func (fr farmRepo) PingDatabase(ctx context.Context) error {
+	return fr.farmDB.db.PingContext(ctx)
+}
+
+func (fr farmRepo) PingCache(ctx context.Context) error {
+	_, err := fr.farmCache.Ping(ctx).Result()
+	return err
+}
@@ -2,6 +2,7 @@ package repo
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/models"
 	"github.com/sony-nurdianto/farm/shared_lib/Go/database/postgres/pkg"
@@ -9,7 +10,7 @@ import (
 
 func (fr farmRepo) insertFarmAddress(
 	ctx context.Context, tx pkg.Stmt, address models.FarmAddress,
-) (res models.FarmAddress, _ error) {
+) (res models.FarmAddress, err error) {
 	row := tx.QueryRowContext(
 		ctx,
 		address.ID,
@@ -45,7 +46,7 @@ func (fr farmRepo) insertFarmAddress(
 
 func (fr farmRepo) insertFarm(
 	ctx context.Context, tx pkg.Stmt, farm models.Farm,
-) (res models.Farm, _ error) {
+) (res models.Farm, err error) {
 	row := tx.QueryRowContext(
 		ctx,
 		farm.ID,
@@ -86,7 +87,10 @@ func (fr farmRepo) CreateFarm(
 	opts pkg.TxOpts,
 	farm models.Farm,
 	farmAddr models.FarmAddress,
-) (res models.FarmWithAddress, _ error) {
+) (res models.FarmWithAddress, err error) {
+	ctx, span := spans.Start(ctx, "CreateFarm")
+	defer func() { spans.End(span, err) }()
+
 	tx, err := fr.farmDB.db.BeginTx(ctx, &opts)
 	if err != nil {
 		return res, err
@@ -97,20 +101,31 @@ func (fr farmRepo) CreateFarm(
 	txAddrStmt := tx.Stmt(fr.farmDB.createFarmAddressStmt)
 	addrRes, err := fr.insertFarmAddress(ctx, txAddrStmt, farmAddr)
 	if err != nil {
-		return res, err
+		return res, createError(err)
 	}
 
 	txFarmStmt := tx.Stmt(fr.farmDB.createFarmStmt)
 	farmRes, err := fr.insertFarm(ctx, txFarmStmt, farm)
 	if err != nil {
-		return res, err
+		return res, createError(err)
 	}
 
 	if err := tx.Commit(); err != nil {
-		return res, err
+		return res, createError(err)
 	}
 
 	res.Farm = farmRes
 	res.FarmAddress = addrRes
 	return res, nil
 }
+
+// createError reports a farm or an address another transaction created with
+// the same id as ErrFarmExists, the ids of an item retried with its
+// client_request_id are the ids of its first attempt.
+func createError(err error) error {
+	if pkg.IsConflict(err) {
+		return fmt.Errorf("%w: %w", ErrFarmExists, err)
+	}
+
+	return err
+}
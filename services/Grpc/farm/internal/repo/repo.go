@@ -18,8 +18,11 @@ type FarmRepo interface {
 	CreateFarm(ctx context.Context, opts pkg.TxOpts, farm models.Farm, farmAddr models.FarmAddress) (models.FarmWithAddress, error)
 	UpdateFarm(ctx context.Context, opts *pkg.TxOpts, farm *models.UpdateFarm, address *models.UpdateFarmAddress) (*models.Farm, *models.FarmAddress, error)
 	GetTotalFarms(ctx context.Context, req *pbgen.GetFarmListRequest) (int, error)
-	GetFarms(ctx context.Context, req *pbgen.GetFarmListRequest) (res []models.FarmWithAddress, _ error)
+	GetFarms(ctx context.Context, req *pbgen.GetFarmListRequest, yield func(models.FarmWithAddress) error) error
+	GetFarmsAfter(ctx context.Context, req *pbgen.GetFarmListRequest, sortKey string, id string, yield func(models.FarmWithAddress) error) error
 	GetFarmByID(ctx context.Context, id string, farmerID string) (res models.FarmWithAddress, _ error)
+	DeleteFarm(ctx context.Context, opts *pkg.TxOpts, id string, farmerID string) (res models.Farm, _ error)
+	SearchFarms(ctx context.Context, req *pbgen.SearchFarmsRequest) (res models.FarmSearchResult, _ error)
 }
 
 type farmRepo struct {
@@ -34,13 +37,12 @@ const (
 	UpdateFarmStmtType        string = "UpdateFarmStmtType"
 	UpdateFarmAddressStmtType string = "UpdateFarmAddressStmtType"
 
-	GetFarmsStmtDescType   string = "GetFarmsStmtDescType"
-	GetFarmsStmtAscType    string = "GetFarmsStmtAscType"
-	TotalFarmsDataStmtType string = "TotalFarmsDataStmtType"
-
 	GetFarmByIDType string = "GetFarmByIDType"
 
-	DeleteFarmStmtType string = "DeleteFarmStmtType"
+	FarmOwnerStmtType         string = "FarmOwnerStmtType"
+	FarmAddressOwnerStmtType  string = "FarmAddressOwnerStmtType"
+	DeleteFarmStmtType        string = "DeleteFarmStmtType"
+	DeleteFarmAddressStmtType string = "DeleteFarmAddressStmtType"
 )
 
 type farmStmt struct {
@@ -56,13 +58,12 @@ type farmDB struct {
 	updateFarmStmt       pkg.Stmt
 	updateFarmAddresStmt pkg.Stmt
 
-	getFarmsAscStmt  pkg.Stmt
-	getFarmsDescStmt pkg.Stmt
-	totalFarmsData   pkg.Stmt
-
 	getFarmByIDStmt pkg.Stmt
 
-	deleteFarmStmt pkg.Stmt
+	farmOwnerStmt         pkg.Stmt
+	farmAddressOwnerStmt  pkg.Stmt
+	deleteFarmStmt        pkg.Stmt
+	deleteFarmAddressStmt pkg.Stmt
 }
 
 func initPostgresDB(
@@ -76,7 +77,7 @@ func initPostgresDB(
 		defer close(out)
 		var res concurent.Result[pkg.PostgresDatabase]
 
-		db, err := pkg.OpenPostgres(addr, pgi)
+		db, err := pkg.OpenPostgres(addr, pgi, pkg.WithTelemetry("farm"))
 		if err != nil {
 			res.Error = err
 			concurent.SendResult(ctx, out, res)
@@ -144,13 +145,12 @@ func prepareFarmDB(ctx context.Context, dbChan <-chan any) <-chan any {
 			prepareStmt(ctx, db.Value, constants.QueryUpdateFarm, UpdateFarmStmtType),
 			prepareStmt(ctx, db.Value, constants.QueryUpdateFarmAddress, UpdateFarmAddressStmtType),
 
-			prepareStmt(ctx, db.Value, constants.QueryGetFarmAsc, GetFarmsStmtAscType),
-			prepareStmt(ctx, db.Value, constants.QueryGetFarmDesc, GetFarmsStmtDescType),
-			prepareStmt(ctx, db.Value, constants.QueryTotalFarm, TotalFarmsDataStmtType),
-
 			prepareStmt(ctx, db.Value, constants.QueryGetFarmByID, GetFarmByIDType),
 
+			prepareStmt(ctx, db.Value, constants.QueryFarmOwner, FarmOwnerStmtType),
+			prepareStmt(ctx, db.Value, constants.QueryFarmAddressOwner, FarmAddressOwnerStmtType),
 			prepareStmt(ctx, db.Value, constants.QueryDeleteFarm, DeleteFarmStmtType),
+			prepareStmt(ctx, db.Value, constants.QueryDeleteFarmAddress, DeleteFarmAddressStmtType),
 		}
 
 		dbFarm := farmDB{
@@ -180,16 +180,16 @@ func prepareFarmDB(ctx context.Context, dbChan <-chan any) <-chan any {
 				dbFarm.updateFarmStmt = vRes.Value.stmt
 			case UpdateFarmAddressStmtType:
 				dbFarm.updateFarmAddresStmt = vRes.Value.stmt
-			case GetFarmsStmtAscType:
-				dbFarm.getFarmsAscStmt = vRes.Value.stmt
-			case GetFarmsStmtDescType:
-				dbFarm.getFarmsDescStmt = vRes.Value.stmt
-			case TotalFarmsDataStmtType:
-				dbFarm.totalFarmsData = vRes.Value.stmt
 			case GetFarmByIDType:
 				dbFarm.getFarmByIDStmt = vRes.Value.stmt
+			case FarmOwnerStmtType:
+				dbFarm.farmOwnerStmt = vRes.Value.stmt
+			case FarmAddressOwnerStmtType:
+				dbFarm.farmAddressOwnerStmt = vRes.Value.stmt
 			case DeleteFarmStmtType:
 				dbFarm.deleteFarmStmt = vRes.Value.stmt
+			case DeleteFarmAddressStmtType:
+				dbFarm.deleteFarmAddressStmt = vRes.Value.stmt
 			}
 		}
 
@@ -218,6 +218,9 @@ func redisClientConn(ctx context.Context, rdi redis.RedisInstance) (redis.RedisC
 				Username: os.Getenv("FARM_REDIS_MASTER_USER_NAME"),
 				Password: os.Getenv("FARM_REDIS_MASTER_PASSWORD"),
 				DB:       0,
+				// the farm search reads the RediSearch replies in their
+				// RESP2 shape
+				Protocol: 2,
 			},
 		)
 
@@ -281,20 +284,14 @@ func NewFarmRepo(
 	return fr, nil
 }
 
-func (fr farmRepo) DeleteFarm(ctx context.Context, id string) (string, error) {
-	row := fr.farmDB.deleteFarmStmt.QueryRowContext(ctx, id)
-	if err := row.Err(); err != nil {
-		return "", err
-	}
-
-	return id, nil
-}
-
 func (fr farmRepo) CloseRepo() {
 	fr.farmDB.createFarmStmt.Close()
 	fr.farmDB.createFarmAddressStmt.Close()
 	fr.farmDB.updateFarmStmt.Close()
 	fr.farmDB.updateFarmAddresStmt.Close()
+	fr.farmDB.farmOwnerStmt.Close()
+	fr.farmDB.farmAddressOwnerStmt.Close()
 	fr.farmDB.deleteFarmStmt.Close()
+	fr.farmDB.deleteFarmAddressStmt.Close()
 	fr.farmDB.db.Close()
 }
@@ -0,0 +1,14 @@
+package repo
+
+import "github.com/sony-nurdianto/farm/shared_lib/Go/observability/otel/trace"
+
+// spans of the repo calls. A farm that does not exist, already exists or
+// has an id that is not a uuid is an answer of the repo, not a failure of it.
+var spans = trace.NewRepoSpans(
+	"farm-service",
+	ErrFarmNotExist,
+	ErrFarmAddressNotExist,
+	ErrFarmExists,
+	ErrInvalidFarmID,
+	ErrInvalidFarmAddressID,
+)
@@ -2,6 +2,8 @@ package repo
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
@@ -10,13 +12,8 @@ import (
 )
 
 func changeFarmAddreses(
-	ctx context.Context, tx pkg.Stmt, address *models.UpdateFarmAddress,
-) (res models.FarmAddress, _ error) {
-	addressID, err := uuid.Parse(address.ID)
-	if err != nil {
-		return res, err
-	}
-
+	ctx context.Context, tx pkg.Stmt, addressID uuid.UUID, address *models.UpdateFarmAddress,
+) (res models.FarmAddress, err error) {
 	row := tx.QueryRowContext(
 		ctx,
 		address.Street,
@@ -27,6 +24,7 @@ func changeFarmAddreses(
 		address.PostalCode,
 		time.Now().UTC(), // updated_at
 		addressID,
+		address.FarmerID,
 	)
 
 	if err := row.Err(); err != nil {
@@ -44,6 +42,9 @@ func changeFarmAddreses(
 		&res.CreatedAt,
 		&res.UpdatedAt,
 	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return res, ErrFarmAddressNotExist
+		}
 		return res, err
 	}
 
@@ -51,13 +52,8 @@ func changeFarmAddreses(
 }
 
 func changeFarm(
-	ctx context.Context, tx pkg.Stmt, farm *models.UpdateFarm,
-) (res models.Farm, _ error) {
-	farmID, err := uuid.Parse(farm.ID)
-	if err != nil {
-		return res, err
-	}
-
+	ctx context.Context, tx pkg.Stmt, farmID uuid.UUID, farm *models.UpdateFarm,
+) (res models.Farm, err error) {
 	row := tx.QueryRowContext(
 		ctx,
 		farm.FarmName,
@@ -67,6 +63,7 @@ func changeFarm(
 		farm.Description,
 		time.Now().UTC(),
 		farmID,
+		farm.FarmerID,
 	)
 
 	if err := row.Err(); err != nil {
@@ -85,6 +82,9 @@ func changeFarm(
 		&res.CreatedAt,
 		&res.UpdatedAt,
 	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return res, ErrFarmNotExist
+		}
 		return res, err
 	}
 
@@ -93,7 +93,23 @@ func changeFarm(
 
 func (fr farmRepo) UpdateFarm(
 	ctx context.Context, opts *pkg.TxOpts, farm *models.UpdateFarm, address *models.UpdateFarmAddress,
-) (*models.Farm, *models.FarmAddress, error) {
+) (_ *models.Farm, _ *models.FarmAddress, err error) {
+	ctx, span := spans.Start(ctx, "UpdateFarm")
+	defer func() { spans.End(span, err) }()
+
+	// the ids are checked before a transaction is opened for them
+	var farmID, addressID uuid.UUID
+	if farm != nil {
+		if farmID, err = uuid.Parse(farm.ID); err != nil {
+			return nil, nil, ErrInvalidFarmID
+		}
+	}
+	if address != nil {
+		if addressID, err = uuid.Parse(address.ID); err != nil {
+			return nil, nil, ErrInvalidFarmAddressID
+		}
+	}
+
 	tx, err := fr.farmDB.db.BeginTx(ctx, opts)
 	if err != nil {
 		return nil, nil, err
@@ -105,8 +121,12 @@ func (fr farmRepo) UpdateFarm(
 	var farmAddrRes *models.FarmAddress
 
 	if farm != nil {
+		if _, err := farmOwner(ctx, tx.Stmt(fr.farmDB.farmOwnerStmt), farmID, farm.FarmerID); err != nil {
+			return nil, nil, err
+		}
+
 		txFarmStmt := tx.Stmt(fr.farmDB.updateFarmStmt)
-		farm, err := changeFarm(ctx, txFarmStmt, farm)
+		farm, err := changeFarm(ctx, txFarmStmt, farmID, farm)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -115,15 +135,21 @@ func (fr farmRepo) UpdateFarm(
 	}
 
 	if address != nil {
+		if err := farmAddressOwner(ctx, tx.Stmt(fr.farmDB.farmAddressOwnerStmt), addressID, address.FarmerID); err != nil {
+			return nil, nil, err
+		}
+
 		txAddrStmt := tx.Stmt(fr.farmDB.updateFarmAddresStmt)
-		addRes, err := changeFarmAddreses(ctx, txAddrStmt, address)
+		addRes, err := changeFarmAddreses(ctx, txAddrStmt, addressID, address)
 		if err != nil {
 			return nil, nil, err
 		}
 		farmAddrRes = &addRes
 	}
 
-	tx.Commit()
+	if err := tx.Commit(); err != nil {
+		return nil, nil, err
+	}
 
 	return farmRes, farmAddrRes, nil
 }
@@ -7,7 +7,6 @@ import (
 
 	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/pbgen"
 	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/usescase"
-	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
@@ -36,13 +35,13 @@ func (fss FarmServiceServer) CreateFarm(stream pbgen.FarmService_CreateFarmServe
 				return nil
 			}
 			if err != nil {
-				return status.Error(codes.Internal, err.Error())
+				return recvError(ctx, err)
 			}
 
 			createFarm := fss.farmUc.InsertUsers(ctx, msg)
 
 			if err := stream.Send(createFarm); err != nil {
-				return status.Error(codes.Internal, err.Error())
+				return internalError(ctx, "[FarmService] Send created farm failed", err)
 			}
 
 		}
@@ -52,44 +51,49 @@ func (fss FarmServiceServer) CreateFarm(stream pbgen.FarmService_CreateFarmServe
 func (fss FarmServiceServer) GetFarmByID(ctx context.Context, in *pbgen.GetFarmByIDRequest) (*pbgen.GetFarmByIDResponse, error) {
 	res, err := fss.farmUc.GetFarmByID(ctx, in)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, farmError(ctx, err)
 	}
 
 	return res, nil
 }
 
+// GetFarmList sends every farm as soon as it is read, then the total and
+// last the next_page_token. Counting the farms does not hold back the first
+// one, a bad page token still fails before any message.
 func (fss FarmServiceServer) GetFarmList(in *pbgen.GetFarmListRequest, stream pbgen.FarmService_GetFarmListServer) error {
 	ctx := stream.Context()
 
-	totalFarm, err := fss.farmUc.GetTotalFarms(ctx, in)
+	nextPageToken, err := fss.farmUc.GetFarms(ctx, in, stream.Send)
 	if err != nil {
-		return status.Error(codes.Internal, err.Error())
+		// the client that went away is not a failure of the list
+		if ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		return farmListError(ctx, err)
 	}
 
-	total := int32(totalFarm)
+	if in.GetPageToken() == "" || in.GetIncludeTotal() {
+		totalFarm, err := fss.farmUc.GetTotalFarms(ctx, in)
+		if err != nil {
+			return internalError(ctx, "[FarmService] Farm total failed", err)
+		}
 
-	err = stream.Send(&pbgen.GetFarmListResponse{
-		Total: &total,
-	})
-	if err != nil {
-		return status.Error(codes.Internal, err.Error())
-	}
+		total := int32(totalFarm)
 
-	farms, err := fss.farmUc.GetFarms(ctx, in)
-	if err != nil {
-		return status.Error(codes.Internal, err.Error())
+		err = stream.Send(&pbgen.GetFarmListResponse{
+			Total: &total,
+		})
+		if err != nil {
+			return internalError(ctx, "[FarmService] Send farm total failed", err)
+		}
 	}
 
-	for _, v := range farms {
-		select {
-		case <-ctx.Done():
-			return nil
-		default:
-			if err := stream.Send(v); err != nil {
-				return status.Error(codes.Internal, err.Error())
-			}
+	if nextPageToken == "" {
+		return nil
+	}
 
-		}
+	if err := stream.Send(&pbgen.GetFarmListResponse{NextPageToken: nextPageToken}); err != nil {
+		return internalError(ctx, "[FarmService] Send page token failed", err)
 	}
 
 	return nil
@@ -108,21 +112,31 @@ func (fss FarmServiceServer) UpdateFarms(stream pbgen.FarmService_UpdateFarmsSer
 				return nil
 			}
 			if err != nil {
-				return status.Error(codes.Internal, err.Error())
-			}
-
-			if msg.Farm == nil && msg.Address == nil {
-				return status.Error(codes.InvalidArgument, "at least farm or farm address have value")
+				return recvError(ctx, err)
 			}
 
 			updateFarm := fss.farmUc.UpdateUsers(ctx, msg)
 			if err := stream.Send(updateFarm); err != nil {
-				return status.Error(codes.Internal, err.Error())
+				return internalError(ctx, "[FarmService] Send updated farm failed", err)
 			}
 		}
 	}
 }
 
 func (fss FarmServiceServer) DeleteFarm(ctx context.Context, in *pbgen.DeleteFarmRequest) (*pbgen.DeleteFarmResponse, error) {
-	return nil, nil
+	res, err := fss.farmUc.DeleteFarm(ctx, in)
+	if err != nil {
+		return nil, farmError(ctx, err)
+	}
+
+	return res, nil
+}
+
+func (fss FarmServiceServer) SearchFarms(ctx context.Context, in *pbgen.SearchFarmsRequest) (*pbgen.SearchFarmsResponse, error) {
+	res, err := fss.farmUc.SearchFarms(ctx, in)
+	if err != nil {
+		return nil, internalError(ctx, "[FarmService] Farm search failed", err)
+	}
+
+	return res, nil
 }
@@ -0,0 +1,67 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/pagetoken"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/repo"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/grpcstatus"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/observability/otel/logs"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorDomain is the ErrorInfo domain of the errors raised by this service.
+const errorDomain grpcstatus.Domain = "farm"
+
+const reasonFarmNotFound = "FARM_NOT_FOUND"
+
+// recvError keeps the status of a failed Recv, e.g. the InvalidArgument of
+// the validation interceptor, and reports anything else as Internal.
+func recvError(ctx context.Context, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	return internalError(ctx, "[FarmService] Receive failed", err)
+}
+
+// farmError maps the repo errors of a farm lookup to a status.
+func farmError(ctx context.Context, err error) error {
+	switch {
+	case errors.Is(err, repo.ErrFarmNotExist), errors.Is(err, repo.ErrFarmAddressNotExist):
+		return errorDomain.WithReason(codes.NotFound, reasonFarmNotFound, err.Error())
+	case errors.Is(err, repo.ErrInvalidFarmID):
+		return grpcstatus.InvalidArgument(&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "id", Description: "value must be a valid UUID"},
+			},
+		})
+	default:
+		return internalError(ctx, "[FarmService] Farm lookup failed", err)
+	}
+}
+
+// farmListError reports a page token that does not verify as a violation of
+// the page_token field.
+func farmListError(ctx context.Context, err error) error {
+	if errors.Is(err, pagetoken.ErrInvalid) {
+		return grpcstatus.WithDetails(codes.InvalidArgument, err.Error(), &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "page_token", Description: err.Error()},
+			},
+		})
+	}
+
+	return internalError(ctx, "[FarmService] Farm list failed", err)
+}
+
+// internalError logs the cause of a failure and answers Internal without it,
+// the database and cache errors are not for the caller.
+func internalError(ctx context.Context, msg string, err error) error {
+	logs.NewLogger().Error(ctx, msg, err)
+
+	return status.Error(codes.Internal, "internal error")
+}
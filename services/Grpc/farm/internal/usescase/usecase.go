@@ -2,13 +2,17 @@ package usescase
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/models"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/pagetoken"
 	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/pbgen"
 	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/repo"
 	"github.com/sony-nurdianto/farm/shared_lib/Go/database/postgres/pkg"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/observability/otel/logs"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -16,27 +20,51 @@ type FarmUsecase interface {
 	InsertUsers(ctx context.Context, req *pbgen.CreateFarmRequest) *pbgen.CreateFarmResponse
 	UpdateUsers(ctx context.Context, req *pbgen.UpdateFarmsRequest) *pbgen.UpdateFarmsResponse
 	GetTotalFarms(ctx context.Context, req *pbgen.GetFarmListRequest) (int, error)
-	GetFarms(ctx context.Context, req *pbgen.GetFarmListRequest) ([]*pbgen.GetFarmListResponse, error)
+	GetFarms(ctx context.Context, req *pbgen.GetFarmListRequest, send func(*pbgen.GetFarmListResponse) error) (string, error)
 	GetFarmByID(ctx context.Context, req *pbgen.GetFarmByIDRequest) (*pbgen.GetFarmByIDResponse, error)
+	DeleteFarm(ctx context.Context, req *pbgen.DeleteFarmRequest) (*pbgen.DeleteFarmResponse, error)
+	SearchFarms(ctx context.Context, req *pbgen.SearchFarmsRequest) (*pbgen.SearchFarmsResponse, error)
 }
 
 type farmUsecase struct {
-	repo repo.FarmRepo
+	repo       repo.FarmRepo
+	pageTokens pagetoken.Codec
 }
 
-func NewFarmUsecase(r repo.FarmRepo) farmUsecase {
+func NewFarmUsecase(r repo.FarmRepo, pageTokens pagetoken.Codec) farmUsecase {
 	return farmUsecase{
-		repo: r,
+		repo:       r,
+		pageTokens: pageTokens,
 	}
 }
 
+// clientRequestNamespace derives the ids of a farm created with a
+// client_request_id, so a retried item maps to the farm of its first attempt.
+var clientRequestNamespace = uuid.MustParse("9e213b9f-5739-47a6-b564-2fae44c4747f")
+
+func clientRequestIDs(farmerID, clientRequestID string) (farmID, addressID string) {
+	name := farmerID + ":" + clientRequestID
+	farmID = uuid.NewSHA1(clientRequestNamespace, []byte(name)).String()
+	addressID = uuid.NewSHA1(clientRequestNamespace, []byte(name+":address")).String()
+	return farmID, addressID
+}
+
 func (fu farmUsecase) InsertUsers(ctx context.Context, req *pbgen.CreateFarmRequest) *pbgen.CreateFarmResponse {
 	txOpts := pkg.TxOpts{
 		Isolation: pkg.LevelSerializable,
 		ReadOnly:  false,
 	}
 
-	fAddrID := uuid.NewString()
+	res := &pbgen.CreateFarmResponse{ClientRequestId: req.GetClientRequestId()}
+
+	farmID, fAddrID := uuid.NewString(), uuid.NewString()
+	if req.GetClientRequestId() != "" {
+		farmID, fAddrID = clientRequestIDs(req.Farm.GetFarmerId(), req.GetClientRequestId())
+
+		if fu.answerCreated(ctx, req, farmID, res) {
+			return res
+		}
+	}
 
 	farmAddr := models.FarmAddress{
 		ID:          fAddrID,
@@ -51,7 +79,7 @@ func (fu farmUsecase) InsertUsers(ctx context.Context, req *pbgen.CreateFarmRequ
 	}
 
 	farm := models.Farm{
-		ID:          uuid.NewString(),
+		ID:          farmID,
 		FarmerID:    req.Farm.GetFarmerId(),
 		FarmName:    req.Farm.GetFarmName(),
 		FarmType:    req.Farm.GetFarmType(),
@@ -63,15 +91,19 @@ func (fu farmUsecase) InsertUsers(ctx context.Context, req *pbgen.CreateFarmRequ
 		UpdatedAt:   time.Now().UTC(),
 	}
 
-	res := new(pbgen.CreateFarmResponse)
-
 	users, err := fu.repo.CreateFarm(
 		ctx, txOpts, farm, farmAddr,
 	)
 	if err != nil {
+		// an item with the same client_request_id sent concurrently created
+		// the farm first
+		if errors.Is(err, repo.ErrFarmExists) && req.GetClientRequestId() != "" && fu.answerCreated(ctx, req, farmID, res) {
+			return res
+		}
+
 		res.FarmName = req.Farm.GetFarmName()
 		res.Status = "Error"
-		res.Msg = err.Error()
+		res.Msg = itemError(ctx, "[FarmUsecase] Create farm failed", err)
 
 		return res
 	}
@@ -85,18 +117,50 @@ func (fu farmUsecase) InsertUsers(ctx context.Context, req *pbgen.CreateFarmRequ
 	return res
 }
 
+// answerCreated answers the farm an earlier attempt of req created with
+// farmID, it is false when there is none yet.
+func (fu farmUsecase) answerCreated(
+	ctx context.Context,
+	req *pbgen.CreateFarmRequest,
+	farmID string,
+	res *pbgen.CreateFarmResponse,
+) bool {
+	created, err := fu.repo.GetFarmByID(ctx, farmID, req.Farm.GetFarmerId())
+	if errors.Is(err, repo.ErrFarmNotExist) {
+		return false
+	}
+
+	if err != nil {
+		res.FarmName = req.Farm.GetFarmName()
+		res.Status = "Error"
+		res.Msg = itemError(ctx, "[FarmUsecase] Created farm lookup failed", err)
+
+		return true
+	}
+
+	res.FarmId = created.Farm.ID
+	res.FarmName = created.FarmName
+	res.AddressId = created.AddressesID
+	res.Status = "Success"
+	res.Msg = "Farm Already Created"
+
+	return true
+}
+
 func (fu farmUsecase) UpdateUsers(ctx context.Context, req *pbgen.UpdateFarmsRequest) *pbgen.UpdateFarmsResponse {
 	txOpts := pkg.TxOpts{
 		Isolation: pkg.LevelSerializable,
 		ReadOnly:  false,
 	}
 
-	farm := new(models.UpdateFarm)
-	farmAddr := new(models.UpdateFarmAddress)
+	var farm *models.UpdateFarm
+	var farmAddr *models.UpdateFarmAddress
 
 	if req.Farm != nil {
 		farmValue := req.Farm
+		farm = new(models.UpdateFarm)
 		farm.ID = farmValue.Id
+		farm.FarmerID = farmValue.FarmerId
 		farm.FarmName = farmValue.FarmName
 		farm.FarmSize = farmValue.FarmSize
 		farm.FarmStatus = farmValue.FarmStatus
@@ -106,7 +170,9 @@ func (fu farmUsecase) UpdateUsers(ctx context.Context, req *pbgen.UpdateFarmsReq
 
 	if req.Address != nil {
 		farmAddrValue := req.Address
+		farmAddr = new(models.UpdateFarmAddress)
 		farmAddr.ID = farmAddrValue.Id
+		farmAddr.FarmerID = farmAddrValue.FarmerId
 		farmAddr.Street = farmAddrValue.Street
 		farmAddr.SubDistrict = farmAddrValue.SubDistrict
 		farmAddr.City = farmAddrValue.City
@@ -119,8 +185,25 @@ func (fu farmUsecase) UpdateUsers(ctx context.Context, req *pbgen.UpdateFarmsReq
 
 	updateFarm, updateFarmAddr, err := fu.repo.UpdateFarm(ctx, &txOpts, farm, farmAddr)
 	if err != nil {
-		res.Status = "Error"
+		if req.Farm != nil {
+			res.FarmId = &req.Farm.Id
+		}
+		if req.Address != nil {
+			res.AddressId = &req.Address.Id
+		}
+
 		res.Msg = err.Error()
+
+		switch {
+		case errors.Is(err, repo.ErrFarmNotExist), errors.Is(err, repo.ErrFarmAddressNotExist):
+			res.Status = "NotFound"
+		case errors.Is(err, repo.ErrInvalidFarmID), errors.Is(err, repo.ErrInvalidFarmAddressID):
+			res.Status = "BadRequest"
+		default:
+			res.Status = "Error"
+			res.Msg = itemError(ctx, "[FarmUsecase] Update farm failed", err)
+		}
+
 		return res
 	}
 
@@ -142,16 +225,35 @@ func (fu farmUsecase) GetTotalFarms(ctx context.Context, req *pbgen.GetFarmListR
 	return fu.repo.GetTotalFarms(ctx, req)
 }
 
-func (fu farmUsecase) GetFarms(ctx context.Context, req *pbgen.GetFarmListRequest) ([]*pbgen.GetFarmListResponse, error) {
-	var res []*pbgen.GetFarmListResponse
-
-	farms, err := fu.repo.GetFarms(ctx, req)
-	if err != nil {
-		return nil, err
-	}
+// listFilter binds a page token to the filters and the ordering of the list
+// it was issued for, the page size may change between pages.
+func listFilter(req *pbgen.GetFarmListRequest) string {
+	filter, _ := proto.MarshalOptions{Deterministic: true}.Marshal(req.GetFilter())
+
+	return pagetoken.Filter(
+		req.GetFarmerId(),
+		req.GetSearchName(),
+		req.GetSortOrder().String(),
+		req.GetSortBy().String(),
+		string(filter),
+	)
+}
 
-	for _, v := range farms {
-		farm := &pbgen.GetFarmListResponse{
+// GetFarms sends one message per farm while the page is still read. It
+// answers the next_page_token when the page is full.
+func (fu farmUsecase) GetFarms(
+	ctx context.Context,
+	req *pbgen.GetFarmListRequest,
+	send func(*pbgen.GetFarmListResponse) error,
+) (nextPageToken string, _ error) {
+	var last models.FarmWithAddress
+	var count int
+
+	yield := func(v models.FarmWithAddress) error {
+		last = v
+		count++
+
+		return send(&pbgen.GetFarmListResponse{
 			Farms: &pbgen.Farm{
 				Id:          v.Farm.ID,
 				FarmerId:    v.FarmerID,
@@ -172,12 +274,34 @@ func (fu farmUsecase) GetFarms(ctx context.Context, req *pbgen.GetFarmListReques
 				CreatedAt: timestamppb.New(v.Farm.CreatedAt),
 				UpdatedAt: timestamppb.New(v.Farm.UpdatedAt),
 			},
+		})
+	}
+
+	var err error
+	if req.GetPageToken() == "" {
+		err = fu.repo.GetFarms(ctx, req, yield)
+	} else {
+		var cur pagetoken.Cursor
+		cur, err = fu.pageTokens.Decode(req.GetPageToken(), listFilter(req))
+		if err != nil {
+			return "", err
 		}
 
-		res = append(res, farm)
+		err = fu.repo.GetFarmsAfter(ctx, req, cur.SortKey, cur.ID, yield)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	// a short page is the last one
+	if req.GetLimit() == 0 || count < int(req.GetLimit()) {
+		return "", nil
 	}
 
-	return res, nil
+	return fu.pageTokens.Encode(pagetoken.Cursor{
+		SortKey: repo.FarmSortKey(req.GetSortBy(), last),
+		ID:      last.Farm.ID,
+	}, listFilter(req))
 }
 
 func (fu farmUsecase) GetFarmByID(ctx context.Context, req *pbgen.GetFarmByIDRequest) (*pbgen.GetFarmByIDResponse, error) {
@@ -211,3 +335,83 @@ func (fu farmUsecase) GetFarmByID(ctx context.Context, req *pbgen.GetFarmByIDReq
 
 	return res, nil
 }
+
+func (fu farmUsecase) DeleteFarm(ctx context.Context, req *pbgen.DeleteFarmRequest) (*pbgen.DeleteFarmResponse, error) {
+	txOpts := pkg.TxOpts{
+		Isolation: pkg.LevelSerializable,
+		ReadOnly:  false,
+	}
+
+	farm, err := fu.repo.DeleteFarm(ctx, &txOpts, req.GetId(), req.GetFarmerId())
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pbgen.DeleteFarmResponse{
+		Id:     farm.ID,
+		Status: "Success",
+		Msg:    "Success Delete Farm",
+	}
+
+	return res, nil
+}
+
+func facetCounts(facets []models.FacetCount) []*pbgen.FacetCount {
+	res := make([]*pbgen.FacetCount, 0, len(facets))
+	for _, f := range facets {
+		res = append(res, &pbgen.FacetCount{Value: f.Value, Count: int32(f.Count)})
+	}
+
+	return res
+}
+
+func (fu farmUsecase) SearchFarms(ctx context.Context, req *pbgen.SearchFarmsRequest) (*pbgen.SearchFarmsResponse, error) {
+	found, err := fu.repo.SearchFarms(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pbgen.SearchFarmsResponse{
+		Total:        int32(found.Total),
+		FarmTypes:    facetCounts(found.FarmTypes),
+		FarmStatuses: facetCounts(found.FarmStatuses),
+		Provinces:    facetCounts(found.Provinces),
+	}
+
+	for _, v := range found.Farms {
+		res.Farms = append(res.Farms, &pbgen.Farm{
+			Id:          v.Farm.ID,
+			FarmerId:    v.FarmerID,
+			FarmName:    v.FarmName,
+			FarmType:    v.FarmType,
+			FarmSize:    v.FarmSize,
+			FarmStatus:  v.FarmStatus,
+			Description: v.Description,
+			Address: &pbgen.FarmAddress{
+				Id:          v.FarmAddress.ID,
+				Street:      v.Street,
+				Village:     v.Village,
+				SubDistrict: v.SubDistrict,
+				City:        v.City,
+				Province:    v.Province,
+				PostalCode:  v.PostalCode,
+			},
+			CreatedAt: timestamppb.New(v.Farm.CreatedAt),
+			UpdatedAt: timestamppb.New(v.Farm.UpdatedAt),
+		})
+	}
+
+	return res, nil
+}
+
+// itemError logs the cause of a failed item of a stream and answers the msg
+// of its response without it, a farm that already exists aside.
+func itemError(ctx context.Context, msg string, err error) string {
+	if errors.Is(err, repo.ErrFarmExists) {
+		return repo.ErrFarmExists.Error()
+	}
+
+	logs.NewLogger().Error(ctx, msg, err)
+
+	return "internal error"
+}
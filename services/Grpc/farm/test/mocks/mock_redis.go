@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/sony-nurdianto/farm/shared_lib/Go/database/redis (interfaces: RedisInstance)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	redis "github.com/redis/go-redis/v9"
+)
+
+// MockRedisInstance is a mock of RedisInstance interface.
+type MockRedisInstance struct {
+	ctrl     *gomock.Controller
+	recorder *MockRedisInstanceMockRecorder
+}
+
+// MockRedisInstanceMockRecorder is the mock recorder for MockRedisInstance.
+type MockRedisInstanceMockRecorder struct {
+	mock *MockRedisInstance
+}
+
+// NewMockRedisInstance creates a new mock instance.
+func NewMockRedisInstance(ctrl *gomock.Controller) *MockRedisInstance {
+	mock := &MockRedisInstance{ctrl: ctrl}
+	mock.recorder = &MockRedisInstanceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRedisInstance) EXPECT() *MockRedisInstanceMockRecorder {
+	return m.recorder
+}
+
+// NewFailoverClient mocks base method.
+func (m *MockRedisInstance) NewFailoverClient(arg0 *redis.FailoverOptions) *redis.Client {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewFailoverClient", arg0)
+	ret0, _ := ret[0].(*redis.Client)
+	return ret0
+}
+
+// NewFailoverClient indicates an expected call of NewFailoverClient.
+func (mr *MockRedisInstanceMockRecorder) NewFailoverClient(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewFailoverClient", reflect.TypeOf((*MockRedisInstance)(nil).NewFailoverClient), arg0)
+}
@@ -0,0 +1,157 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/repo/repo.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/sony-nurdianto/farm/services/Grpc/farm/internal/models"
+	pbgen "github.com/sony-nurdianto/farm/services/Grpc/farm/internal/pbgen"
+	pkg "github.com/sony-nurdianto/farm/shared_lib/Go/database/postgres/pkg"
+)
+
+// MockFarmRepo is a mock of FarmRepo interface.
+type MockFarmRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockFarmRepoMockRecorder
+}
+
+// MockFarmRepoMockRecorder is the mock recorder for MockFarmRepo.
+type MockFarmRepoMockRecorder struct {
+	mock *MockFarmRepo
+}
+
+// NewMockFarmRepo creates a new mock instance.
+func NewMockFarmRepo(ctrl *gomock.Controller) *MockFarmRepo {
+	mock := &MockFarmRepo{ctrl: ctrl}
+	mock.recorder = &MockFarmRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFarmRepo) EXPECT() *MockFarmRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateFarm mocks base method.
+func (m *MockFarmRepo) CreateFarm(ctx context.Context, opts pkg.TxOpts, farm models.Farm, farmAddr models.FarmAddress) (models.FarmWithAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFarm", ctx, opts, farm, farmAddr)
+	ret0, _ := ret[0].(models.FarmWithAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFarm indicates an expected call of CreateFarm.
+func (mr *MockFarmRepoMockRecorder) CreateFarm(ctx, opts, farm, farmAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFarm", reflect.TypeOf((*MockFarmRepo)(nil).CreateFarm), ctx, opts, farm, farmAddr)
+}
+
+// DeleteFarm mocks base method.
+func (m *MockFarmRepo) DeleteFarm(ctx context.Context, opts *pkg.TxOpts, id, farmerID string) (models.Farm, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFarm", ctx, opts, id, farmerID)
+	ret0, _ := ret[0].(models.Farm)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFarm indicates an expected call of DeleteFarm.
+func (mr *MockFarmRepoMockRecorder) DeleteFarm(ctx, opts, id, farmerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFarm", reflect.TypeOf((*MockFarmRepo)(nil).DeleteFarm), ctx, opts, id, farmerID)
+}
+
+// GetFarmByID mocks base method.
+func (m *MockFarmRepo) GetFarmByID(ctx context.Context, id, farmerID string) (models.FarmWithAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFarmByID", ctx, id, farmerID)
+	ret0, _ := ret[0].(models.FarmWithAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFarmByID indicates an expected call of GetFarmByID.
+func (mr *MockFarmRepoMockRecorder) GetFarmByID(ctx, id, farmerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFarmByID", reflect.TypeOf((*MockFarmRepo)(nil).GetFarmByID), ctx, id, farmerID)
+}
+
+// GetFarms mocks base method.
+func (m *MockFarmRepo) GetFarms(ctx context.Context, req *pbgen.GetFarmListRequest, yield func(models.FarmWithAddress) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFarms", ctx, req, yield)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetFarms indicates an expected call of GetFarms.
+func (mr *MockFarmRepoMockRecorder) GetFarms(ctx, req, yield interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFarms", reflect.TypeOf((*MockFarmRepo)(nil).GetFarms), ctx, req, yield)
+}
+
+// GetFarmsAfter mocks base method.
+func (m *MockFarmRepo) GetFarmsAfter(ctx context.Context, req *pbgen.GetFarmListRequest, sortKey, id string, yield func(models.FarmWithAddress) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFarmsAfter", ctx, req, sortKey, id, yield)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetFarmsAfter indicates an expected call of GetFarmsAfter.
+func (mr *MockFarmRepoMockRecorder) GetFarmsAfter(ctx, req, sortKey, id, yield interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFarmsAfter", reflect.TypeOf((*MockFarmRepo)(nil).GetFarmsAfter), ctx, req, sortKey, id, yield)
+}
+
+// GetTotalFarms mocks base method.
+func (m *MockFarmRepo) GetTotalFarms(ctx context.Context, req *pbgen.GetFarmListRequest) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalFarms", ctx, req)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalFarms indicates an expected call of GetTotalFarms.
+func (mr *MockFarmRepoMockRecorder) GetTotalFarms(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalFarms", reflect.TypeOf((*MockFarmRepo)(nil).GetTotalFarms), ctx, req)
+}
+
+// SearchFarms mocks base method.
+func (m *MockFarmRepo) SearchFarms(ctx context.Context, req *pbgen.SearchFarmsRequest) (models.FarmSearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchFarms", ctx, req)
+	ret0, _ := ret[0].(models.FarmSearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchFarms indicates an expected call of SearchFarms.
+func (mr *MockFarmRepoMockRecorder) SearchFarms(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchFarms", reflect.TypeOf((*MockFarmRepo)(nil).SearchFarms), ctx, req)
+}
+
+// UpdateFarm mocks base method.
+func (m *MockFarmRepo) UpdateFarm(ctx context.Context, opts *pkg.TxOpts, farm *models.UpdateFarm, address *models.UpdateFarmAddress) (*models.Farm, *models.FarmAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFarm", ctx, opts, farm, address)
+	ret0, _ := ret[0].(*models.Farm)
+	ret1, _ := ret[1].(*models.FarmAddress)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateFarm indicates an expected call of UpdateFarm.
+func (mr *MockFarmRepoMockRecorder) UpdateFarm(ctx, opts, farm, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFarm", reflect.TypeOf((*MockFarmRepo)(nil).UpdateFarm), ctx, opts, farm, address)
+}
@@ -0,0 +1,139 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/usescase/usecase.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	pbgen "github.com/sony-nurdianto/farm/services/Grpc/farm/internal/pbgen"
+)
+
+// MockFarmUsecase is a mock of FarmUsecase interface.
+type MockFarmUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockFarmUsecaseMockRecorder
+}
+
+// MockFarmUsecaseMockRecorder is the mock recorder for MockFarmUsecase.
+type MockFarmUsecaseMockRecorder struct {
+	mock *MockFarmUsecase
+}
+
+// NewMockFarmUsecase creates a new mock instance.
+func NewMockFarmUsecase(ctrl *gomock.Controller) *MockFarmUsecase {
+	mock := &MockFarmUsecase{ctrl: ctrl}
+	mock.recorder = &MockFarmUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFarmUsecase) EXPECT() *MockFarmUsecaseMockRecorder {
+	return m.recorder
+}
+
+// DeleteFarm mocks base method.
+func (m *MockFarmUsecase) DeleteFarm(ctx context.Context, req *pbgen.DeleteFarmRequest) (*pbgen.DeleteFarmResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFarm", ctx, req)
+	ret0, _ := ret[0].(*pbgen.DeleteFarmResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFarm indicates an expected call of DeleteFarm.
+func (mr *MockFarmUsecaseMockRecorder) DeleteFarm(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFarm", reflect.TypeOf((*MockFarmUsecase)(nil).DeleteFarm), ctx, req)
+}
+
+// GetFarmByID mocks base method.
+func (m *MockFarmUsecase) GetFarmByID(ctx context.Context, req *pbgen.GetFarmByIDRequest) (*pbgen.GetFarmByIDResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFarmByID", ctx, req)
+	ret0, _ := ret[0].(*pbgen.GetFarmByIDResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFarmByID indicates an expected call of GetFarmByID.
+func (mr *MockFarmUsecaseMockRecorder) GetFarmByID(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFarmByID", reflect.TypeOf((*MockFarmUsecase)(nil).GetFarmByID), ctx, req)
+}
+
+// GetFarms mocks base method.
+func (m *MockFarmUsecase) GetFarms(ctx context.Context, req *pbgen.GetFarmListRequest, send func(*pbgen.GetFarmListResponse) error) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFarms", ctx, req, send)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFarms indicates an expected call of GetFarms.
+func (mr *MockFarmUsecaseMockRecorder) GetFarms(ctx, req, send interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFarms", reflect.TypeOf((*MockFarmUsecase)(nil).GetFarms), ctx, req, send)
+}
+
+// GetTotalFarms mocks base method.
+func (m *MockFarmUsecase) GetTotalFarms(ctx context.Context, req *pbgen.GetFarmListRequest) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalFarms", ctx, req)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalFarms indicates an expected call of GetTotalFarms.
+func (mr *MockFarmUsecaseMockRecorder) GetTotalFarms(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalFarms", reflect.TypeOf((*MockFarmUsecase)(nil).GetTotalFarms), ctx, req)
+}
+
+// InsertUsers mocks base method.
+func (m *MockFarmUsecase) InsertUsers(ctx context.Context, req *pbgen.CreateFarmRequest) *pbgen.CreateFarmResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertUsers", ctx, req)
+	ret0, _ := ret[0].(*pbgen.CreateFarmResponse)
+	return ret0
+}
+
+// InsertUsers indicates an expected call of InsertUsers.
+func (mr *MockFarmUsecaseMockRecorder) InsertUsers(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertUsers", reflect.TypeOf((*MockFarmUsecase)(nil).InsertUsers), ctx, req)
+}
+
+// SearchFarms mocks base method.
+func (m *MockFarmUsecase) SearchFarms(ctx context.Context, req *pbgen.SearchFarmsRequest) (*pbgen.SearchFarmsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchFarms", ctx, req)
+	ret0, _ := ret[0].(*pbgen.SearchFarmsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchFarms indicates an expected call of SearchFarms.
+func (mr *MockFarmUsecaseMockRecorder) SearchFarms(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchFarms", reflect.TypeOf((*MockFarmUsecase)(nil).SearchFarms), ctx, req)
+}
+
+// UpdateUsers mocks base method.
+func (m *MockFarmUsecase) UpdateUsers(ctx context.Context, req *pbgen.UpdateFarmsRequest) *pbgen.UpdateFarmsResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUsers", ctx, req)
+	ret0, _ := ret[0].(*pbgen.UpdateFarmsResponse)
+	return ret0
+}
+
+// UpdateUsers indicates an expected call of UpdateUsers.
+func (mr *MockFarmUsecaseMockRecorder) UpdateUsers(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUsers", reflect.TypeOf((*MockFarmUsecase)(nil).UpdateUsers), ctx, req)
+}
@@ -0,0 +1,164 @@
+package unit_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/interceptor"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/pbgen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// recvStream answers msgs from RecvMsg, then io.EOF.
+type recvStream struct {
+	grpc.ServerStream
+	msgs []proto.Message
+}
+
+func (rs *recvStream) Context() context.Context {
+	return context.Background()
+}
+
+func (rs *recvStream) RecvMsg(m any) error {
+	if len(rs.msgs) == 0 {
+		return io.EOF
+	}
+
+	proto.Merge(m.(proto.Message), rs.msgs[0])
+	rs.msgs = rs.msgs[1:]
+
+	return nil
+}
+
+func createFarmRequest(farmSize float64) *pbgen.CreateFarmRequest {
+	return &pbgen.CreateFarmRequest{
+		Farm: &pbgen.CreateFarm{
+			FarmerId:   "8f0d8a5e-4b0c-4f6e-9a34-2f7d6a1b9c10",
+			FarmName:   "North Field",
+			FarmType:   "CROPLAND",
+			FarmSize:   farmSize,
+			FarmStatus: "ACTIVE",
+		},
+		Address: &pbgen.CreateFarmAddress{
+			Street:   "Jl. Merdeka 1",
+			City:     "Bandung",
+			Province: "Jawa Barat",
+		},
+	}
+}
+
+// receiveAll receives until the first error, it answers the items received
+// and that error.
+func receiveAll(ss grpc.ServerStream) (received int, err error) {
+	for {
+		if err := ss.RecvMsg(&pbgen.CreateFarmRequest{}); err != nil {
+			return received, err
+		}
+		received++
+	}
+}
+
+func TestValidateStreamInterceptor(t *testing.T) {
+	t.Run("Client Stream Indexes Field Paths", func(t *testing.T) {
+		stream := &recvStream{msgs: []proto.Message{
+			createFarmRequest(12),
+			createFarmRequest(3),
+			createFarmRequest(0),
+		}}
+
+		var received int
+		err := interceptor.ValidateStreamInterceptor(
+			nil,
+			stream,
+			&grpc.StreamServerInfo{FullMethod: "/farm.FarmService/CreateFarm", IsClientStream: true},
+			func(_ any, ss grpc.ServerStream) error {
+				var err error
+				received, err = receiveAll(ss)
+				return err
+			},
+		)
+
+		assert.Equal(t, 2, received)
+
+		st := status.Convert(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, "invalid request: [2].farm.farm_size value must be greater than 0 and less than 10000", st.Message())
+
+		require.Len(t, st.Details(), 1)
+		badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+		require.True(t, ok)
+		require.Len(t, badRequest.GetFieldViolations(), 1)
+		assert.Equal(t, "[2].farm.farm_size", badRequest.GetFieldViolations()[0].GetField())
+	})
+
+	t.Run("Missing Item Message", func(t *testing.T) {
+		req := createFarmRequest(12)
+		req.Address = nil
+		stream := &recvStream{msgs: []proto.Message{req}}
+
+		err := interceptor.ValidateStreamInterceptor(
+			nil,
+			stream,
+			&grpc.StreamServerInfo{FullMethod: "/farm.FarmService/CreateFarm", IsClientStream: true},
+			func(_ any, ss grpc.ServerStream) error {
+				_, err := receiveAll(ss)
+				return err
+			},
+		)
+
+		st := status.Convert(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+
+		badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+		require.True(t, ok)
+		assert.Equal(t, "[0].address", badRequest.GetFieldViolations()[0].GetField())
+	})
+
+	t.Run("Server Stream Is Not Indexed", func(t *testing.T) {
+		stream := &recvStream{msgs: []proto.Message{
+			&pbgen.GetFarmListRequest{FarmerId: "not-a-uuid"},
+		}}
+
+		err := interceptor.ValidateStreamInterceptor(
+			nil,
+			stream,
+			&grpc.StreamServerInfo{FullMethod: "/farm.FarmService/GetFarmList", IsServerStream: true},
+			func(_ any, ss grpc.ServerStream) error {
+				return ss.RecvMsg(&pbgen.GetFarmListRequest{})
+			},
+		)
+
+		st := status.Convert(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+
+		badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+		require.True(t, ok)
+		assert.Equal(t, "farmer_id", badRequest.GetFieldViolations()[0].GetField())
+	})
+
+	t.Run("Valid Items Reach The Handler", func(t *testing.T) {
+		stream := &recvStream{msgs: []proto.Message{createFarmRequest(12), createFarmRequest(40)}}
+
+		var received int
+		err := interceptor.ValidateStreamInterceptor(
+			nil,
+			stream,
+			&grpc.StreamServerInfo{FullMethod: "/farm.FarmService/CreateFarm", IsClientStream: true},
+			func(_ any, ss grpc.ServerStream) error {
+				var err error
+				received, err = receiveAll(ss)
+				return err
+			},
+		)
+
+		assert.ErrorIs(t, err, io.EOF)
+		assert.Equal(t, 2, received)
+	})
+}
@@ -0,0 +1,82 @@
+package unit_test
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/pagetoken"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodec(t *testing.T) {
+	codec := pagetoken.NewCodec([]byte("page-token-key"))
+	filter := pagetoken.Filter("farmer-1", "CROPLAND", "ACTIVE")
+	cursor := pagetoken.Cursor{SortKey: "North Field", ID: "8f0d8a5e-4b0c-4f6e-9a34-2f7d6a1b9c10"}
+
+	token, err := codec.Encode(cursor, filter)
+	require.NoError(t, err)
+
+	t.Run("Round Trip", func(t *testing.T) {
+		got, err := codec.Decode(token, filter)
+		require.NoError(t, err)
+		assert.Equal(t, cursor, got)
+	})
+
+	t.Run("Tampered Body", func(t *testing.T) {
+		_, sig, _ := strings.Cut(token, ".")
+		body := base64.RawURLEncoding.EncodeToString(
+			[]byte(`{"k":"Zzz","i":"8f0d8a5e-4b0c-4f6e-9a34-2f7d6a1b9c10","f":"` + filter + `"}`),
+		)
+
+		_, err := codec.Decode(body+"."+sig, filter)
+		assert.ErrorIs(t, err, pagetoken.ErrInvalid)
+	})
+
+	t.Run("Tampered Signature", func(t *testing.T) {
+		body, _, _ := strings.Cut(token, ".")
+		sig := base64.RawURLEncoding.EncodeToString(make([]byte, 32))
+
+		_, err := codec.Decode(body+"."+sig, filter)
+		assert.ErrorIs(t, err, pagetoken.ErrInvalid)
+	})
+
+	t.Run("Signed With Another Key", func(t *testing.T) {
+		other, err := pagetoken.NewCodec([]byte("other-key")).Encode(cursor, filter)
+		require.NoError(t, err)
+
+		_, err = codec.Decode(other, filter)
+		assert.ErrorIs(t, err, pagetoken.ErrInvalid)
+	})
+
+	t.Run("Filter Mismatch", func(t *testing.T) {
+		_, err := codec.Decode(token, pagetoken.Filter("farmer-1", "ORCHARD", "ACTIVE"))
+		assert.ErrorIs(t, err, pagetoken.ErrInvalid)
+	})
+
+	t.Run("Malformed", func(t *testing.T) {
+		body, sig, _ := strings.Cut(token, ".")
+
+		for name, malformed := range map[string]string{
+			"empty":             "",
+			"no separator":      body + sig,
+			"body not base64":   "!!!." + sig,
+			"sig not base64":    body + ".!!!",
+			"padded base64":     body + "=." + sig,
+			"signature missing": body + ".",
+		} {
+			_, err := codec.Decode(malformed, filter)
+			assert.ErrorIs(t, err, pagetoken.ErrInvalid, name)
+		}
+	})
+}
+
+func TestFilter(t *testing.T) {
+	assert.Equal(t, pagetoken.Filter("a", "b"), pagetoken.Filter("a", "b"))
+	assert.Len(t, pagetoken.Filter("a", "b"), 32)
+
+	// the parts are separated, so moving a character across them changes
+	// the filter
+	assert.NotEqual(t, pagetoken.Filter("ab", "c"), pagetoken.Filter("a", "bc"))
+}
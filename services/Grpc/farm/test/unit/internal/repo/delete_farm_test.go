@@ -0,0 +1,54 @@
+package unit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/constants"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/models"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/repo"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/database/postgres/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteFarm(t *testing.T) {
+	ctx := context.Background()
+	opts := &pkg.TxOpts{Isolation: pkg.LevelSerializable}
+
+	t.Run("Invalid Farm ID", func(t *testing.T) {
+		fr, _, _ := setupRepo(t)
+
+		_, err := fr.DeleteFarm(ctx, opts, "farm-1", "farmer-1")
+		assert.ErrorIs(t, err, repo.ErrInvalidFarmID)
+	})
+
+	t.Run("Farm Of Another Farmer", func(t *testing.T) {
+		fr, fdb, _ := setupRepo(t)
+		ctrl := gomock.NewController(t)
+
+		fdb.beginTx(ctrl)
+		fdb.stmt(constants.QueryFarmOwner).EXPECT().QueryRowContext(gomock.Any(), gomock.Any()).
+			Return(scanRow(ctrl, nil, "farmer-2", updateAddressID))
+
+		// the farm is not deleted and answers like a farm that is not there
+		_, err := fr.DeleteFarm(ctx, opts, updateFarmID, "farmer-1")
+		assert.ErrorIs(t, err, repo.ErrFarmNotExist)
+	})
+}
+
+func TestUpdateFarm_InvalidIDs(t *testing.T) {
+	t.Run("Farm", func(t *testing.T) {
+		fr, _, _ := setupRepo(t)
+
+		_, _, err := fr.UpdateFarm(context.Background(), nil, &models.UpdateFarm{ID: "farm-1"}, nil)
+		assert.ErrorIs(t, err, repo.ErrInvalidFarmID)
+	})
+
+	t.Run("Address", func(t *testing.T) {
+		fr, _, _ := setupRepo(t)
+
+		_, _, err := fr.UpdateFarm(context.Background(), nil, nil, &models.UpdateFarmAddress{ID: "address-1"})
+		assert.ErrorIs(t, err, repo.ErrInvalidFarmAddressID)
+	})
+}
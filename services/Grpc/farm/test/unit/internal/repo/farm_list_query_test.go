@@ -0,0 +1,209 @@
+package unit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/constants"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/models"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/pbgen"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const listFarmerID = "8f0d8a5e-4b0c-4f6e-9a34-2f7d6a1b9c10"
+
+// listWhere is the condition of every list, the farms of the farmer that are
+// not deleted.
+const listWhere = " WHERE f.farmer_id = $1 AND f.deleted_at IS NULL"
+
+func ignoreFarm(models.FarmWithAddress) error { return nil }
+
+func TestGetTotalFarms_Query(t *testing.T) {
+	createdFrom := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	updatedTo := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		filter    *pbgen.FarmListFilter
+		search    string
+		wantWhere string
+		wantArgs  []any
+	}{
+		{
+			name:     "Farmer Only",
+			filter:   &pbgen.FarmListFilter{},
+			wantArgs: []any{listFarmerID},
+		},
+		{
+			name:      "Search Name",
+			search:    "north",
+			wantWhere: " AND f.farm_name ILIKE '%' || $2 || '%'",
+			wantArgs:  []any{listFarmerID, "north"},
+		},
+		{
+			name: "Farm Types And Statuses",
+			filter: &pbgen.FarmListFilter{
+				FarmTypes:    []string{"CROPLAND", "ORCHARD"},
+				FarmStatuses: []string{"ACTIVE"},
+			},
+			wantWhere: " AND f.farm_type IN ($2, $3) AND f.farm_status IN ($4)",
+			wantArgs:  []any{listFarmerID, "CROPLAND", "ORCHARD", "ACTIVE"},
+		},
+		{
+			name: "Address, Size And Dates",
+			filter: &pbgen.FarmListFilter{
+				City:        "Bandung",
+				PostalCode:  "40111",
+				MinFarmSize: proto.Float64(0),
+				CreatedFrom: timestamppb.New(createdFrom),
+				UpdatedTo:   timestamppb.New(updatedTo),
+			},
+			wantWhere: " AND a.city = $2 AND a.postal_code = $3" +
+				" AND f.farm_size >= $4" +
+				" AND f.created_at >= $5" +
+				" AND f.updated_at <= $6",
+			wantArgs: []any{listFarmerID, "Bandung", "40111", float64(0), createdFrom, updatedTo},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fr, fdb, _ := setupRepo(t)
+			ctrl := gomock.NewController(t)
+
+			row := mocks.NewMockRow(ctrl)
+			row.EXPECT().Err().Return(nil).AnyTimes()
+			row.EXPECT().Scan(gomock.Any()).DoAndReturn(func(dest ...any) error {
+				*dest[0].(*int) = 3
+				return nil
+			})
+
+			query := constants.QueryCountFarmList + listWhere + tt.wantWhere
+			fdb.db.EXPECT().QueryRowContext(gomock.Any(), query, tt.wantArgs...).Return(row)
+
+			total, err := fr.GetTotalFarms(context.Background(), &pbgen.GetFarmListRequest{
+				FarmerId:   listFarmerID,
+				SearchName: tt.search,
+				Filter:     tt.filter,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, 3, total)
+		})
+	}
+}
+
+func TestGetFarms_Query(t *testing.T) {
+	createdAt := time.Date(2026, 5, 6, 7, 8, 9, 123456789, time.UTC)
+
+	tests := []struct {
+		name     string
+		req      *pbgen.GetFarmListRequest
+		after    []string
+		wantSQL  string
+		wantArgs []any
+	}{
+		{
+			name:     "Default Sort",
+			req:      &pbgen.GetFarmListRequest{},
+			wantSQL:  " ORDER BY f.created_at ASC, f.id ASC",
+			wantArgs: []any{listFarmerID},
+		},
+		{
+			name: "Sort By Size Descending",
+			req: &pbgen.GetFarmListRequest{
+				SortBy:    pbgen.FarmSortField_FARM_SORT_FIELD_SIZE,
+				SortOrder: pbgen.SortOrder_SortOrder_DESC,
+			},
+			wantSQL:  " ORDER BY f.farm_size DESC, f.id DESC",
+			wantArgs: []any{listFarmerID},
+		},
+		{
+			name:     "Limit And Offset",
+			req:      &pbgen.GetFarmListRequest{Limit: 20, Offset: 40},
+			wantSQL:  " ORDER BY f.created_at ASC, f.id ASC LIMIT $2 OFFSET $3",
+			wantArgs: []any{listFarmerID, int32(20), int32(40)},
+		},
+		{
+			name: "Keyset Ascending",
+			req: &pbgen.GetFarmListRequest{
+				SortBy: pbgen.FarmSortField_FARM_SORT_FIELD_NAME,
+				Limit:  20,
+			},
+			after: []string{"North Field", "farm-1"},
+			wantSQL: " AND (f.farm_name, f.id) > ($2, $3)" +
+				" ORDER BY f.farm_name ASC, f.id ASC LIMIT $4",
+			wantArgs: []any{listFarmerID, "North Field", "farm-1", int32(20)},
+		},
+		{
+			name: "Keyset Descending",
+			req: &pbgen.GetFarmListRequest{
+				SortBy:    pbgen.FarmSortField_FARM_SORT_FIELD_SIZE,
+				SortOrder: pbgen.SortOrder_SortOrder_DESC,
+				Limit:     20,
+			},
+			after: []string{"12.5", "farm-1"},
+			wantSQL: " AND (f.farm_size, f.id) < ($2, $3)" +
+				" ORDER BY f.farm_size DESC, f.id DESC LIMIT $4",
+			wantArgs: []any{listFarmerID, 12.5, "farm-1", int32(20)},
+		},
+		{
+			name:  "Keyset Ignores Offset",
+			req:   &pbgen.GetFarmListRequest{Limit: 20, Offset: 40, PageToken: "token"},
+			after: []string{createdAt.Format(time.RFC3339Nano), "farm-1"},
+			wantSQL: " AND (f.created_at, f.id) > ($2, $3)" +
+				" ORDER BY f.created_at ASC, f.id ASC LIMIT $4",
+			wantArgs: []any{listFarmerID, createdAt, "farm-1", int32(20)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fr, fdb, _ := setupRepo(t)
+			ctrl := gomock.NewController(t)
+
+			var events []string
+			query := constants.QuerySelectFarmList + listWhere + tt.wantSQL
+			fdb.db.EXPECT().QueryContext(gomock.Any(), query, tt.wantArgs...).
+				Return(farmRows(ctrl, &events, nil), nil)
+
+			ctx := context.Background()
+			tt.req.FarmerId = listFarmerID
+
+			var err error
+			if tt.after == nil {
+				err = fr.GetFarms(ctx, tt.req, ignoreFarm)
+			} else {
+				err = fr.GetFarmsAfter(ctx, tt.req, tt.after[0], tt.after[1], ignoreFarm)
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGetFarmsAfter_InvalidSortKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		sortBy pbgen.FarmSortField
+		key    string
+	}{
+		{name: "Size", sortBy: pbgen.FarmSortField_FARM_SORT_FIELD_SIZE, key: "large"},
+		{name: "Created At", sortBy: pbgen.FarmSortField_FARM_SORT_FIELD_CREATED_AT, key: "yesterday"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// the query never reaches the database
+			fr, _, _ := setupRepo(t)
+			req := &pbgen.GetFarmListRequest{FarmerId: listFarmerID, SortBy: tt.sortBy}
+
+			err := fr.GetFarmsAfter(context.Background(), req, tt.key, "farm-1", ignoreFarm)
+			assert.Error(t, err)
+		})
+	}
+}
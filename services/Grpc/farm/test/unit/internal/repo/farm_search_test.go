@@ -0,0 +1,110 @@
+package unit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/models"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/pbgen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchFarmsQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		req   *pbgen.SearchFarmsRequest
+		query string
+	}{
+		{
+			name:  "Scoped To The Farmer",
+			req:   &pbgen.SearchFarmsRequest{FarmerId: "3f2a-9b1c"},
+			query: `@farmer_id:{3f2a\-9b1c}`,
+		},
+		{
+			name: "Facet Filters",
+			req: &pbgen.SearchFarmsRequest{
+				FarmerId:     "farmer_1",
+				FarmTypes:    []string{"CROPLAND", "ORCHARD"},
+				FarmStatuses: []string{"ACTIVE"},
+				Provinces:    []string{"Jawa Barat"},
+			},
+			query: `@farmer_id:{farmer_1} @farm_type:{CROPLAND|ORCHARD} @farm_status:{ACTIVE} @province:{Jawa\ Barat}`,
+		},
+		{
+			name:  "Short Words Match Whole, Longer Ones By Prefix And Typo",
+			req:   &pbgen.SearchFarmsRequest{FarmerId: "farmer_1", Query: "a Bogor rice"},
+			query: `@farmer_id:{farmer_1} a (bogor*|%bogor%) (rice*|%rice%)`,
+		},
+		{
+			name:  "Prefix Only Below Four Letters",
+			req:   &pbgen.SearchFarmsRequest{FarmerId: "farmer_1", Query: "tea"},
+			query: `@farmer_id:{farmer_1} tea*`,
+		},
+		{
+			name:  "Query Syntax Is Dropped",
+			req:   &pbgen.SearchFarmsRequest{FarmerId: "farmer_1", Query: `@farmer_id:{*} -(padi)|"sawah"`},
+			query: `@farmer_id:{farmer_1} (farmer*|%farmer%) id* (padi*|%padi%) (sawah*|%sawah%)`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fr, _, cache := setupRepo(t)
+			cache.reply = func(args []any) (any, error) {
+				return []any{int64(0)}, nil
+			}
+
+			_, err := fr.SearchFarms(context.Background(), tt.req)
+			require.NoError(t, err)
+
+			searches := cache.commands("FT.SEARCH")
+			require.Len(t, searches, 1)
+			assert.Equal(t, []any{"FT.SEARCH", "idx:farms", tt.query, "LIMIT", int32(0), 10}, searches[0])
+
+			// every facet counts the matches of the same query
+			for _, aggregate := range cache.commands("FT.AGGREGATE") {
+				assert.Equal(t, tt.query, aggregate[2])
+			}
+		})
+	}
+}
+
+func TestSearchFarms(t *testing.T) {
+	fr, _, cache := setupRepo(t)
+	cache.reply = func(args []any) (any, error) {
+		if args[0] == "FT.SEARCH" {
+			return []any{
+				int64(12),
+				"farm:farm-1:farmer-1",
+				[]any{"id", "address-1", "farm_name", "North Field", "farm_size", "1.5", "province", "Jawa Barat"},
+			}, nil
+		}
+
+		if args[5] == "@farm_type" {
+			return []any{
+				int64(2),
+				[]any{"farm_type", "CROPLAND", "count", "9"},
+				[]any{"farm_type", "ORCHARD", "count", "3"},
+			}, nil
+		}
+		return []any{int64(0)}, nil
+	}
+
+	res, err := fr.SearchFarms(context.Background(), &pbgen.SearchFarmsRequest{
+		FarmerId: "farmer-1",
+		Limit:    5,
+		Offset:   10,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []any{"LIMIT", int32(10), 5}, cache.commands("FT.SEARCH")[0][3:])
+	assert.Equal(t, 12, res.Total)
+	require.Len(t, res.Farms, 1)
+	assert.Equal(t, "farm-1", res.Farms[0].Farm.ID)
+	assert.Equal(t, "North Field", res.Farms[0].FarmName)
+	assert.Equal(t, 1.5, res.Farms[0].FarmSize)
+	assert.Equal(t, []models.FacetCount{{Value: "CROPLAND", Count: 9}, {Value: "ORCHARD", Count: 3}}, res.FarmTypes)
+	assert.Empty(t, res.FarmStatuses)
+	assert.Len(t, cache.commands("FT.AGGREGATE"), 3)
+}
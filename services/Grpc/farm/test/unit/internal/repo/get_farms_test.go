@@ -0,0 +1,89 @@
+package unit_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/models"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/pbgen"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// farmRows expects rows that return the farms with ids and then stop with
+// iterErr, and records when the next row is read.
+func farmRows(ctrl *gomock.Controller, events *[]string, iterErr error, ids ...string) *mocks.MockRows {
+	rows := mocks.NewMockRows(ctrl)
+
+	i := 0
+	rows.EXPECT().Next().DoAndReturn(func() bool {
+		*events = append(*events, "next")
+		i++
+		return i <= len(ids)
+	}).AnyTimes()
+	rows.EXPECT().Scan(gomock.Any()).DoAndReturn(func(dest ...any) error {
+		*dest[0].(*string) = ids[i-1]
+		return nil
+	}).AnyTimes()
+	rows.EXPECT().Err().Return(iterErr).AnyTimes()
+	rows.EXPECT().Close().Return(nil)
+
+	return rows
+}
+
+func TestGetFarms(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Every Farm Is Yielded Before The Next Row Is Read", func(t *testing.T) {
+		fr, fdb, _ := setupRepo(t)
+		ctrl := gomock.NewController(t)
+
+		var events []string
+		fdb.db.EXPECT().QueryContext(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(farmRows(ctrl, &events, nil, "farm-1", "farm-2"), nil)
+
+		err := fr.GetFarms(ctx, &pbgen.GetFarmListRequest{FarmerId: "farmer-1"}, func(farm models.FarmWithAddress) error {
+			events = append(events, farm.Farm.ID)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"next", "farm-1", "next", "farm-2", "next"}, events)
+	})
+
+	t.Run("Failed Yield Stops The Read", func(t *testing.T) {
+		fr, fdb, _ := setupRepo(t)
+		ctrl := gomock.NewController(t)
+
+		var events []string
+		fdb.db.EXPECT().QueryContext(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(farmRows(ctrl, &events, nil, "farm-1", "farm-2"), nil)
+
+		errSend := errors.New("stream closed")
+		req := &pbgen.GetFarmListRequest{FarmerId: "farmer-1", SortBy: pbgen.FarmSortField_FARM_SORT_FIELD_NAME}
+		err := fr.GetFarmsAfter(ctx, req, "North Field", "farm-0",
+			func(models.FarmWithAddress) error { return errSend },
+		)
+		assert.ErrorIs(t, err, errSend)
+		assert.Equal(t, []string{"next"}, events)
+	})
+	t.Run("Lost Connection Fails The Read", func(t *testing.T) {
+		fr, fdb, _ := setupRepo(t)
+		ctrl := gomock.NewController(t)
+
+		var events []string
+		errConn := errors.New("read tcp 10.0.3.5:5432: connection reset by peer")
+		fdb.db.EXPECT().QueryContext(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(farmRows(ctrl, &events, errConn, "farm-1"), nil)
+
+		var yielded []string
+		err := fr.GetFarms(ctx, &pbgen.GetFarmListRequest{FarmerId: "farmer-1"}, func(farm models.FarmWithAddress) error {
+			yielded = append(yielded, farm.Farm.ID)
+			return nil
+		})
+		assert.ErrorIs(t, err, errConn)
+		assert.Equal(t, []string{"farm-1"}, yielded)
+	})
+}
@@ -0,0 +1,169 @@
+package unit_test
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/repo"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/test/mocks"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/database/postgres/pkg"
+	"github.com/stretchr/testify/require"
+)
+
+// farmDB is the database behind a repo under test. Every statement the
+// repo prepares gets its own mock, found by its query, and a transaction
+// runs the statements of the database.
+type farmDB struct {
+	db    *mocks.MockPostgresDatabase
+	stmts map[string]*mocks.MockStmt
+	byRef map[*sql.Stmt]*mocks.MockStmt
+}
+
+// stmt returns the mock of the statement prepared for query.
+func (fdb *farmDB) stmt(query string) *mocks.MockStmt {
+	return fdb.stmts[query]
+}
+
+// beginTx expects a transaction that runs the prepared statements.
+func (fdb *farmDB) beginTx(ctrl *gomock.Controller) *mocks.MockSQLTx {
+	tx := mocks.NewMockSQLTx(ctrl)
+	fdb.db.EXPECT().BeginTx(gomock.Any(), gomock.Any()).Return(tx, nil)
+
+	tx.EXPECT().Stmt(gomock.Any()).DoAndReturn(func(st pkg.Stmt) pkg.Stmt {
+		return fdb.byRef[st.ToSQLSTMT()]
+	}).AnyTimes()
+	tx.EXPECT().Rollback().Return(nil).AnyTimes()
+
+	return tx
+}
+
+func setupRepo(t *testing.T) (repo.FarmRepo, *farmDB, *fakeRedis) {
+	ctrl := gomock.NewController(t)
+
+	fdb := &farmDB{
+		db:    mocks.NewMockPostgresDatabase(ctrl),
+		stmts: make(map[string]*mocks.MockStmt),
+		byRef: make(map[*sql.Stmt]*mocks.MockStmt),
+	}
+
+	var mu sync.Mutex
+	fdb.db.EXPECT().SetMaxOpenConns(gomock.Any())
+	fdb.db.EXPECT().SetMaxIdleConns(gomock.Any())
+	fdb.db.EXPECT().SetConnMaxLifetime(gomock.Any())
+	fdb.db.EXPECT().PingContext(gomock.Any()).Return(nil)
+	fdb.db.EXPECT().Prepare(gomock.Any()).DoAndReturn(func(query string) (pkg.Stmt, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		ref := &sql.Stmt{}
+		st := mocks.NewMockStmt(ctrl)
+		st.EXPECT().ToSQLSTMT().Return(ref).AnyTimes()
+
+		fdb.stmts[query] = st
+		fdb.byRef[ref] = st
+		return st, nil
+	}).AnyTimes()
+
+	mockPgi := mocks.NewMockPostgresInstance(ctrl)
+	mockPgi.EXPECT().Open("postgres", gomock.Any()).Return(fdb.db, nil)
+
+	cache := &fakeRedis{}
+	mockRdi := mocks.NewMockRedisInstance(ctrl)
+	mockRdi.EXPECT().NewFailoverClient(gomock.Any()).Return(newFakeClient(cache))
+
+	fr, err := repo.NewFarmRepo(context.Background(), mockPgi, mockRdi)
+	require.NoError(t, err)
+
+	cache.reset()
+	return fr, fdb, cache
+}
+
+// fakeRedis answers the commands of a go-redis client without a server. It
+// records the arguments of every command and replies with reply, or with
+// success when reply is nil.
+type fakeRedis struct {
+	mu    sync.Mutex
+	cmds  [][]any
+	reply func(args []any) (any, error)
+}
+
+func newFakeClient(f *fakeRedis) *goredis.Client {
+	client := goredis.NewClient(&goredis.Options{Addr: "fake:6379"})
+	client.AddHook(f)
+	return client
+}
+
+func (f *fakeRedis) DialHook(next goredis.DialHook) goredis.DialHook {
+	return next
+}
+
+func (f *fakeRedis) ProcessHook(goredis.ProcessHook) goredis.ProcessHook {
+	return func(_ context.Context, cmd goredis.Cmder) error {
+		f.answer(cmd)
+		return cmd.Err()
+	}
+}
+
+func (f *fakeRedis) ProcessPipelineHook(goredis.ProcessPipelineHook) goredis.ProcessPipelineHook {
+	return func(_ context.Context, cmds []goredis.Cmder) error {
+		for _, cmd := range cmds {
+			if name := cmd.Name(); name == "multi" || name == "exec" {
+				continue
+			}
+			f.answer(cmd)
+		}
+		return nil
+	}
+}
+
+func (f *fakeRedis) answer(cmd goredis.Cmder) {
+	f.mu.Lock()
+	f.cmds = append(f.cmds, cmd.Args())
+	reply := f.reply
+	f.mu.Unlock()
+
+	var val any
+	if reply != nil {
+		var err error
+		if val, err = reply(cmd.Args()); err != nil {
+			cmd.SetErr(err)
+			return
+		}
+	}
+
+	switch c := cmd.(type) {
+	case *goredis.StatusCmd:
+		c.SetVal("OK")
+	case *goredis.BoolCmd:
+		c.SetVal(true)
+	case *goredis.IntCmd:
+		c.SetVal(1)
+	case *goredis.Cmd:
+		c.SetVal(val)
+	}
+}
+
+// commands returns the recorded commands named name.
+func (f *fakeRedis) commands(name string) [][]any {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var cmds [][]any
+	for _, args := range f.cmds {
+		if n, _ := args[0].(string); strings.EqualFold(n, name) {
+			cmds = append(cmds, args)
+		}
+	}
+	return cmds
+}
+
+func (f *fakeRedis) reset() {
+	f.mu.Lock()
+	f.cmds = nil
+	f.mu.Unlock()
+}
@@ -0,0 +1,126 @@
+package unit_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/constants"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/models"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/repo"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/test/mocks"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/database/postgres/pkg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	updateFarmID    = "3f1c6b2e-8a4d-4c1e-9b7a-2d5e6f708192"
+	updateAddressID = "7a2b9c4d-1e3f-4a5b-8c6d-0e1f2a3b4c5d"
+)
+
+// scanRow expects a row that scans values into the first destinations, or
+// fails the scan with err.
+func scanRow(ctrl *gomock.Controller, err error, values ...string) *mocks.MockRow {
+	row := mocks.NewMockRow(ctrl)
+	row.EXPECT().Err().Return(nil).AnyTimes()
+	row.EXPECT().Scan(gomock.Any()).DoAndReturn(func(dest ...any) error {
+		if err != nil {
+			return err
+		}
+		for i, v := range values {
+			*dest[i].(*string) = v
+		}
+		return nil
+	})
+
+	return row
+}
+
+func updateFarm() *models.UpdateFarm {
+	return &models.UpdateFarm{ID: updateFarmID, FarmerID: "farmer-1", FarmName: "North Field"}
+}
+
+func updateAddress() *models.UpdateFarmAddress {
+	return &models.UpdateFarmAddress{ID: updateAddressID, FarmerID: "farmer-1", Street: "Jl. Merdeka 1"}
+}
+
+func TestUpdateFarm(t *testing.T) {
+	ctx := context.Background()
+	opts := &pkg.TxOpts{Isolation: pkg.LevelSerializable}
+
+	t.Run("Farm Of Another Farmer", func(t *testing.T) {
+		fr, fdb, _ := setupRepo(t)
+		ctrl := gomock.NewController(t)
+
+		fdb.beginTx(ctrl)
+		fdb.stmt(constants.QueryFarmOwner).EXPECT().QueryRowContext(gomock.Any(), gomock.Any()).
+			Return(scanRow(ctrl, nil, "farmer-2", updateAddressID))
+
+		// neither the update nor the commit runs, the farm answers like a
+		// farm that is not there
+		_, _, err := fr.UpdateFarm(ctx, opts, updateFarm(), nil)
+		assert.ErrorIs(t, err, repo.ErrFarmNotExist)
+	})
+
+	t.Run("Address Of Another Farmer", func(t *testing.T) {
+		fr, fdb, _ := setupRepo(t)
+		ctrl := gomock.NewController(t)
+
+		fdb.beginTx(ctrl)
+		fdb.stmt(constants.QueryFarmAddressOwner).EXPECT().QueryRowContext(gomock.Any(), gomock.Any()).
+			Return(scanRow(ctrl, nil, "farmer-2"))
+
+		_, _, err := fr.UpdateFarm(ctx, opts, nil, updateAddress())
+		assert.ErrorIs(t, err, repo.ErrFarmAddressNotExist)
+	})
+
+	t.Run("Missing Farm", func(t *testing.T) {
+		fr, fdb, _ := setupRepo(t)
+		ctrl := gomock.NewController(t)
+
+		fdb.beginTx(ctrl)
+		fdb.stmt(constants.QueryFarmOwner).EXPECT().QueryRowContext(gomock.Any(), gomock.Any()).
+			Return(scanRow(ctrl, sql.ErrNoRows))
+
+		_, _, err := fr.UpdateFarm(ctx, opts, updateFarm(), nil)
+		assert.ErrorIs(t, err, repo.ErrFarmNotExist)
+	})
+
+	t.Run("Missing Address", func(t *testing.T) {
+		fr, fdb, _ := setupRepo(t)
+		ctrl := gomock.NewController(t)
+
+		fdb.beginTx(ctrl)
+		fdb.stmt(constants.QueryFarmAddressOwner).EXPECT().QueryRowContext(gomock.Any(), gomock.Any()).
+			Return(scanRow(ctrl, sql.ErrNoRows))
+
+		_, _, err := fr.UpdateFarm(ctx, opts, nil, updateAddress())
+		assert.ErrorIs(t, err, repo.ErrFarmAddressNotExist)
+	})
+
+	t.Run("Failed Commit", func(t *testing.T) {
+		fr, fdb, _ := setupRepo(t)
+		ctrl := gomock.NewController(t)
+
+		tx := fdb.beginTx(ctrl)
+		fdb.stmt(constants.QueryFarmOwner).EXPECT().QueryRowContext(gomock.Any(), gomock.Any()).
+			Return(scanRow(ctrl, nil, "farmer-1", updateAddressID))
+		fdb.stmt(constants.QueryUpdateFarm).EXPECT().QueryRowContext(gomock.Any(), gomock.Any()).
+			Return(scanRow(ctrl, nil, updateFarmID))
+		fdb.stmt(constants.QueryFarmAddressOwner).EXPECT().QueryRowContext(gomock.Any(), gomock.Any()).
+			Return(scanRow(ctrl, nil, "farmer-1"))
+		fdb.stmt(constants.QueryUpdateFarmAddress).EXPECT().QueryRowContext(gomock.Any(), gomock.Any()).
+			Return(scanRow(ctrl, nil, updateAddressID))
+
+		errSerialize := errors.New("pq: could not serialize access due to concurrent update")
+		tx.EXPECT().Commit().Return(errSerialize)
+
+		farm, addr, err := fr.UpdateFarm(ctx, opts, updateFarm(), updateAddress())
+		require.ErrorIs(t, err, errSerialize)
+		assert.Nil(t, farm)
+		assert.Nil(t, addr)
+	})
+}
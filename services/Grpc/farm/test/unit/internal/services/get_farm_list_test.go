@@ -0,0 +1,106 @@
+package unit_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/pbgen"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/services"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// farmListStream records the messages GetFarmList sends.
+type farmListStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*pbgen.GetFarmListResponse
+}
+
+func (s *farmListStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *farmListStream) Send(msg *pbgen.GetFarmListResponse) error {
+	s.sent = append(s.sent, msg)
+	return nil
+}
+
+func farmMessage(id string) *pbgen.GetFarmListResponse {
+	return &pbgen.GetFarmListResponse{Farms: &pbgen.Farm{Id: id}}
+}
+
+func TestGetFarmList(t *testing.T) {
+	t.Run("Farms Are Sent Before The Total", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc := mocks.NewMockFarmUsecase(ctrl)
+		stream := &farmListStream{ctx: context.Background()}
+		req := &pbgen.GetFarmListRequest{Limit: 2}
+
+		uc.EXPECT().GetFarms(gomock.Any(), req, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ *pbgen.GetFarmListRequest, send func(*pbgen.GetFarmListResponse) error) (string, error) {
+				require.NoError(t, send(farmMessage("farm-1")))
+				// the farm is on the wire before the next row is read
+				assert.Len(t, stream.sent, 1)
+				require.NoError(t, send(farmMessage("farm-2")))
+				return "next-page", nil
+			},
+		)
+		uc.EXPECT().GetTotalFarms(gomock.Any(), req).Return(7, nil)
+
+		err := services.NewFarmServiceServer(uc).GetFarmList(req, stream)
+		require.NoError(t, err)
+
+		require.Len(t, stream.sent, 4)
+		assert.Equal(t, "farm-1", stream.sent[0].GetFarms().GetId())
+		assert.Equal(t, "farm-2", stream.sent[1].GetFarms().GetId())
+		assert.Equal(t, int32(7), stream.sent[2].GetTotal())
+		assert.Equal(t, "next-page", stream.sent[3].GetNextPageToken())
+	})
+
+	t.Run("Later Pages Skip The Total", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc := mocks.NewMockFarmUsecase(ctrl)
+		stream := &farmListStream{ctx: context.Background()}
+		req := &pbgen.GetFarmListRequest{PageToken: "token"}
+
+		uc.EXPECT().GetFarms(gomock.Any(), req, gomock.Any()).Return("", nil)
+
+		err := services.NewFarmServiceServer(uc).GetFarmList(req, stream)
+		require.NoError(t, err)
+		assert.Empty(t, stream.sent)
+	})
+
+	t.Run("Failed Read Is Not Counted", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc := mocks.NewMockFarmUsecase(ctrl)
+		stream := &farmListStream{ctx: context.Background()}
+		req := &pbgen.GetFarmListRequest{}
+
+		uc.EXPECT().GetFarms(gomock.Any(), req, gomock.Any()).Return("", errors.New("connection reset"))
+
+		err := services.NewFarmServiceServer(uc).GetFarmList(req, stream)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Empty(t, stream.sent)
+	})
+
+	t.Run("Client Gone", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		uc := mocks.NewMockFarmUsecase(ctrl)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		stream := &farmListStream{ctx: ctx}
+		req := &pbgen.GetFarmListRequest{}
+
+		uc.EXPECT().GetFarms(gomock.Any(), req, gomock.Any()).Return("", context.Canceled)
+
+		err := services.NewFarmServiceServer(uc).GetFarmList(req, stream)
+		assert.Equal(t, codes.Canceled, status.Code(err))
+	})
+}
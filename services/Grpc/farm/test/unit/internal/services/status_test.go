@@ -0,0 +1,102 @@
+package unit_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/pbgen"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/repo"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/services"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFarmError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode codes.Code
+		wantMsg  string
+	}{
+		{
+			name:     "Farm Not Exist",
+			err:      repo.ErrFarmNotExist,
+			wantCode: codes.NotFound,
+			wantMsg:  repo.ErrFarmNotExist.Error(),
+		},
+		{
+			name:     "Farm Address Not Exist",
+			err:      repo.ErrFarmAddressNotExist,
+			wantCode: codes.NotFound,
+			wantMsg:  repo.ErrFarmAddressNotExist.Error(),
+		},
+		{
+			name:     "Invalid Farm ID",
+			err:      repo.ErrInvalidFarmID,
+			wantCode: codes.InvalidArgument,
+			wantMsg:  "invalid request: id value must be a valid UUID",
+		},
+		{
+			name:     "Internal Hides The Cause",
+			err:      fmt.Errorf("pq: password authentication failed for user %q", "farm"),
+			wantCode: codes.Internal,
+			wantMsg:  "internal error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			uc := mocks.NewMockFarmUsecase(ctrl)
+			fss := services.NewFarmServiceServer(uc)
+
+			uc.EXPECT().GetFarmByID(gomock.Any(), gomock.Any()).Return(nil, tt.err)
+			uc.EXPECT().DeleteFarm(gomock.Any(), gomock.Any()).Return(nil, tt.err)
+
+			_, getErr := fss.GetFarmByID(context.Background(), &pbgen.GetFarmByIDRequest{})
+			_, deleteErr := fss.DeleteFarm(context.Background(), &pbgen.DeleteFarmRequest{})
+
+			for _, err := range []error{getErr, deleteErr} {
+				st := status.Convert(err)
+				assert.Equal(t, tt.wantCode, st.Code())
+				assert.Equal(t, tt.wantMsg, st.Message())
+			}
+		})
+	}
+}
+
+func TestFarmError_InvalidFarmIDField(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	uc := mocks.NewMockFarmUsecase(ctrl)
+	uc.EXPECT().GetFarmByID(gomock.Any(), gomock.Any()).Return(nil, repo.ErrInvalidFarmID)
+
+	_, err := services.NewFarmServiceServer(uc).GetFarmByID(context.Background(), &pbgen.GetFarmByIDRequest{})
+	st := status.Convert(err)
+
+	require.Len(t, st.Details(), 1)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	assert.Equal(t, "id", badRequest.GetFieldViolations()[0].GetField())
+}
+
+func TestFarmListError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	uc := mocks.NewMockFarmUsecase(ctrl)
+	req := &pbgen.GetFarmListRequest{}
+
+	uc.EXPECT().GetFarms(gomock.Any(), req, gomock.Any()).
+		Return("", errors.New("dial tcp 10.0.0.7:5432: connect: connection refused"))
+
+	err := services.NewFarmServiceServer(uc).GetFarmList(req, &farmListStream{ctx: context.Background()})
+	st := status.Convert(err)
+
+	assert.Equal(t, codes.Internal, st.Code())
+	assert.Equal(t, "internal error", st.Message())
+}
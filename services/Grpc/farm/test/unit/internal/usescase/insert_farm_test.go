@@ -0,0 +1,102 @@
+package unit_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/models"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/pagetoken"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/pbgen"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/repo"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/usescase"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/test/mocks"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/database/postgres/pkg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createFarmRequest(clientRequestID string) *pbgen.CreateFarmRequest {
+	return &pbgen.CreateFarmRequest{
+		Farm:            &pbgen.CreateFarm{FarmerId: "farmer-1", FarmName: "North Field"},
+		Address:         &pbgen.CreateFarmAddress{Street: "Jl. Merdeka 1"},
+		ClientRequestId: clientRequestID,
+	}
+}
+
+var errDuplicate = fmt.Errorf("%w: pq: duplicate key value violates unique constraint", repo.ErrFarmExists)
+
+func TestInsertUsers(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Concurrent Item With The Same Client Request ID", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		fr := mocks.NewMockFarmRepo(ctrl)
+		uc := usescase.NewFarmUsecase(fr, pagetoken.NewCodec([]byte("key")))
+
+		var created models.FarmWithAddress
+
+		// the farm does not exist yet on the first lookup, the concurrent
+		// item created it before the insert
+		gomock.InOrder(
+			fr.EXPECT().GetFarmByID(gomock.Any(), gomock.Any(), "farmer-1").
+				Return(models.FarmWithAddress{}, repo.ErrFarmNotExist),
+			fr.EXPECT().CreateFarm(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ pkg.TxOpts, farm models.Farm, addr models.FarmAddress) (models.FarmWithAddress, error) {
+					created.Farm = farm
+					created.FarmAddress = addr
+					created.AddressesID = addr.ID
+					return models.FarmWithAddress{}, errDuplicate
+				}),
+			fr.EXPECT().GetFarmByID(gomock.Any(), gomock.Any(), "farmer-1").
+				DoAndReturn(func(_ context.Context, id string, _ string) (models.FarmWithAddress, error) {
+					assert.Equal(t, created.Farm.ID, id)
+					return created, nil
+				}),
+		)
+
+		res := uc.InsertUsers(ctx, createFarmRequest("req-1"))
+
+		assert.Equal(t, "Success", res.GetStatus())
+		assert.Equal(t, "Farm Already Created", res.GetMsg())
+		assert.Equal(t, created.Farm.ID, res.GetFarmId())
+		assert.Equal(t, created.AddressesID, res.GetAddressId())
+		assert.Equal(t, "North Field", res.GetFarmName())
+		assert.Equal(t, "req-1", res.GetClientRequestId())
+	})
+
+	t.Run("Client Request ID Picks The Same Farm ID", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		fr := mocks.NewMockFarmRepo(ctrl)
+		uc := usescase.NewFarmUsecase(fr, pagetoken.NewCodec([]byte("key")))
+
+		var ids []string
+		fr.EXPECT().GetFarmByID(gomock.Any(), gomock.Any(), "farmer-1").
+			DoAndReturn(func(_ context.Context, id string, _ string) (models.FarmWithAddress, error) {
+				ids = append(ids, id)
+				var farm models.FarmWithAddress
+				farm.Farm.ID = id
+				return farm, nil
+			}).Times(2)
+
+		uc.InsertUsers(ctx, createFarmRequest("req-1"))
+		uc.InsertUsers(ctx, createFarmRequest("req-1"))
+
+		require.Len(t, ids, 2)
+		assert.Equal(t, ids[0], ids[1])
+	})
+
+	t.Run("Conflict Without Client Request ID", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		fr := mocks.NewMockFarmRepo(ctrl)
+		uc := usescase.NewFarmUsecase(fr, pagetoken.NewCodec([]byte("key")))
+
+		fr.EXPECT().CreateFarm(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(models.FarmWithAddress{}, errDuplicate)
+
+		res := uc.InsertUsers(ctx, createFarmRequest(""))
+
+		assert.Equal(t, "Error", res.GetStatus())
+	})
+}
@@ -0,0 +1,63 @@
+package unit_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/pagetoken"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/pbgen"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/repo"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/internal/usescase"
+	"github.com/sony-nurdianto/farm/services/Grpc/farm/test/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateUsers(t *testing.T) {
+	cases := []struct {
+		name       string
+		err        error
+		wantStatus string
+		wantMsg    string
+	}{
+		{
+			name:       "Missing Farm",
+			err:        repo.ErrFarmNotExist,
+			wantStatus: "NotFound",
+			wantMsg:    repo.ErrFarmNotExist.Error(),
+		},
+		{
+			name:       "Missing Address",
+			err:        repo.ErrFarmAddressNotExist,
+			wantStatus: "NotFound",
+			wantMsg:    repo.ErrFarmAddressNotExist.Error(),
+		},
+		{
+			name:       "Failed Commit",
+			err:        fmt.Errorf("commit: %w", errors.New("pq: could not serialize access due to concurrent update")),
+			wantStatus: "Error",
+			wantMsg:    "internal error",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			fr := mocks.NewMockFarmRepo(ctrl)
+			uc := usescase.NewFarmUsecase(fr, pagetoken.NewCodec([]byte("key")))
+
+			fr.EXPECT().UpdateFarm(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, nil, tc.err)
+
+			res := uc.UpdateUsers(context.Background(), &pbgen.UpdateFarmsRequest{
+				Farm: &pbgen.UpdateFarmData{Id: "farm-1", FarmerId: "farmer-1"},
+			})
+
+			assert.Equal(t, tc.wantStatus, res.GetStatus())
+			assert.Equal(t, tc.wantMsg, res.GetMsg())
+			assert.Equal(t, "farm-1", res.GetFarmId())
+		})
+	}
+}
@@ -2,13 +2,8 @@ package main
 
 import (
 	"context"
-	"fmt"
-	"log/slog"
-	"net"
+	"errors"
 	"os"
-	"os/signal"
-	"sync"
-	"syscall"
 
 	"github.com/joho/godotenv"
 	"github.com/sony-nurdianto/farm/services/Grpc/farmer/internal/interceptor"
@@ -20,6 +15,8 @@ import (
 	"github.com/sony-nurdianto/farm/shared_lib/Go/database/redis"
 	"github.com/sony-nurdianto/farm/shared_lib/Go/observability"
 	"github.com/sony-nurdianto/farm/shared_lib/Go/observability/otel/logs"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/runtime"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/runtime/health"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/otel"
 	"google.golang.org/grpc"
@@ -30,7 +27,7 @@ func main() {
 	godotenv.Load()
 	logger := logs.NewLogger()
 
-	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	ctx, stop := runtime.SignalContext()
 	defer stop()
 
 	serviceObsName := "farmer-service"
@@ -53,10 +50,6 @@ func main() {
 		logger.Fatal(ctx, "Failed Initiated Observability", err)
 	}
 
-	defer tp.Shutdown(ctx)
-	defer mp.Shutdown(ctx)
-	defer lp.Shutdown(ctx)
-
 	svr := grpc.NewServer(
 		grpc.StatsHandler(
 			otelgrpc.NewServerHandler(
@@ -65,8 +58,9 @@ func main() {
 				otelgrpc.WithPropagators(otel.GetTextMapPropagator()),
 			),
 		),
-		grpc.UnaryInterceptor(
+		grpc.ChainUnaryInterceptor(
 			interceptor.AuthServiceUnaryInterceptor,
+			interceptor.ValidateUnaryInterceptor,
 		),
 	)
 
@@ -87,38 +81,23 @@ func main() {
 	)
 	pbgen.RegisterFarmerServiceServer(svr, svc)
 
-	lis, err := net.Listen("tcp", ":50051")
-	if err != nil {
-		logger.Fatal(ctx, "Failed To Create Listener", err)
-	}
+	checker := health.NewChecker([]health.Probe{
+		{Name: "postgres", Check: farmerRepo.PingDatabase},
+		{Name: "redis", Check: farmerRepo.PingCache},
+	})
+	checker.Register(svr)
+
+	runner := runtime.NewRunner()
+	runner.Register(
+		runtime.Hook{
+			Name: "observability",
+			Stop: func(ctx context.Context) error {
+				return errors.Join(tp.Shutdown(ctx), mp.Shutdown(ctx), lp.Shutdown(ctx))
+			},
+		},
+		runtime.ServerHook("grpc-server", ":50051", svr, "observability"),
+		checker.Hook("grpc-server"),
+	)
 
-	go func(listener net.Listener) {
-		if err := svr.Serve(listener); err != nil {
-			logger.Fatal(context.Background(), err.Error(), err)
-		}
-	}(lis)
-
-	var once sync.Once
-
-	for {
-		select {
-		case <-ctx.Done():
-			logger.Info(
-				ctx,
-				"Server Stoping, Gracefully Stop ...",
-				slog.String("service_name", serviceObsName),
-				slog.String("operations", "Gracefully Shutdown"),
-			)
-			svr.GracefulStop()
-			logger.Info(
-				ctx,
-				"Aplication Quit ...",
-				slog.String("service_name", serviceObsName),
-				slog.String("operations", "Info"),
-			)
-			return
-		default:
-			once.Do(func() { fmt.Println("Server is Running ....") })
-		}
-	}
+	runner.Main(ctx)
 }
@@ -19,3 +19,11 @@ const (
 		WHERE id = $2
 	`
 )
+
+const LoginAuditQueryByUser = `
+	SELECT id, ip_address, user_agent, outcome, logged_at
+	FROM login_audit
+	WHERE user_id = $1
+	ORDER BY logged_at DESC
+	LIMIT $2
+`
@@ -0,0 +1,27 @@
+package interceptor
+
+import (
+	"context"
+
+	"buf.build/go/protovalidate"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/grpcstatus"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// ValidateUnaryInterceptor rejects requests breaking the buf.validate rules
+// declared in farmer.proto before they reach the service.
+func ValidateUnaryInterceptor(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (resp any, err error) {
+	if msg, ok := req.(proto.Message); ok {
+		if err := grpcstatus.ValidationStatus("", protovalidate.Validate(msg)); err != nil {
+			return nil, err
+		}
+	}
+
+	return handler(ctx, req)
+}
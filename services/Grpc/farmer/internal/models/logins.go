@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+type Login struct {
+	ID        string
+	IpAddress string
+	UserAgent string
+	Outcome   string
+	LoggedAt  time.Time
+}
@@ -0,0 +1,14 @@
+package repo
+
+import "context"
+
+// PingDatabase and PingCache probe the connections of the repo, they drive
+// the health status of the farmer service.
+func (fr farmerRepo) PingDatabase(ctx context.Context) error {
+	return fr.farmerDB.db.PingContext(ctx)
+}
+
+func (fr farmerRepo) PingCache(ctx context.Context) error {
+	_, err := fr.farmerCache.Ping(ctx).Result()
+	return err
+}
@@ -0,0 +1,37 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/sony-nurdianto/farm/services/Grpc/farmer/internal/models"
+)
+
+func (fr farmerRepo) ListLogins(ctx context.Context, userID string, limit int) ([]models.Login, error) {
+	rows, err := fr.farmerDB.listLoginsStmt.QueryContext(ctx, userID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	logins := make([]models.Login, 0, limit)
+	for rows.Next() {
+		var l models.Login
+		if err := rows.Scan(
+			&l.ID,
+			&l.IpAddress,
+			&l.UserAgent,
+			&l.Outcome,
+			&l.LoggedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		logins = append(logins, l)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return logins, nil
+}
@@ -17,6 +17,7 @@ import (
 type FarmerRepo interface {
 	GetUsersByIDFromCache(ctx context.Context, id string) (farmer models.Users, _ error)
 	UpdateUser(ctx context.Context, users *models.UpdateUsers) (models.Users, error)
+	ListLogins(ctx context.Context, userID string, limit int) ([]models.Login, error)
 }
 
 type farmerRepo struct {
@@ -27,6 +28,7 @@ type farmerRepo struct {
 type farmerDB struct {
 	db             pkg.PostgresDatabase
 	updateUserStmt pkg.Stmt
+	listLoginsStmt pkg.Stmt
 }
 
 func send(
@@ -52,7 +54,7 @@ func initPostgresDB(
 		defer close(out)
 		var res concurent.Result[pkg.PostgresDatabase]
 
-		db, err := pkg.OpenPostgres(addr, pgi)
+		db, err := pkg.OpenPostgres(addr, pgi, pkg.WithTelemetry("farmer"))
 		if err != nil {
 			res.Error = err
 			send(ctx, out, res)
@@ -97,9 +99,18 @@ func prepareFarmerStmt(ctx context.Context, dbChan <-chan any) <-chan any {
 			return
 		}
 
+		// lls = listLoginsStmt
+		lls, err := dbres.Value.Prepare(constants.LoginAuditQueryByUser)
+		if err != nil {
+			res.Error = err
+			send(ctx, out, res)
+			return
+		}
+
 		res.Value = farmerDB{
 			db:             dbres.Value,
 			updateUserStmt: uus,
+			listLoginsStmt: lls,
 		}
 
 		send(ctx, out, res)
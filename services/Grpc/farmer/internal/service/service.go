@@ -39,7 +39,7 @@ func (fss farmerServiceServer) FarmerProfile(
 	}
 
 	if err == redis.RedisNil {
-		return nil, status.Error(codes.NotFound, fmt.Sprintf("user with id %s is not exist", in.GetId()))
+		return nil, errorDomain.WithReason(codes.NotFound, reasonFarmerNotFound, fmt.Sprintf("user with id %s is not exist", in.GetId()))
 	}
 
 	return nil, err
@@ -66,3 +66,14 @@ func (fss farmerServiceServer) UpdateFarmerProfile(
 
 	return res, nil
 }
+
+func (fss farmerServiceServer) ListLogins(
+	ctx context.Context, in *pbgen.ListLoginsRequest,
+) (*pbgen.ListLoginsResponse, error) {
+	res, err := fss.farmerUsecase.ListLogins(ctx, in.GetId(), in.GetLimit())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
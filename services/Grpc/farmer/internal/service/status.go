@@ -0,0 +1,8 @@
+package service
+
+import "github.com/sony-nurdianto/farm/shared_lib/Go/grpcstatus"
+
+// errorDomain is the ErrorInfo domain of the errors raised by this service.
+const errorDomain grpcstatus.Domain = "farmer"
+
+const reasonFarmerNotFound = "FARMER_NOT_FOUND"
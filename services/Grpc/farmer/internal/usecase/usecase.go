@@ -13,8 +13,14 @@ import (
 type FarmerUsecase interface {
 	GetUserByID(ctx context.Context, id string) (*pbgen.FarmerProfileResponse, error)
 	UpdateUser(ctx context.Context, user *models.UpdateUsers) (models.Users, error)
+	ListLogins(ctx context.Context, id string, limit int32) (*pbgen.ListLoginsResponse, error)
 }
 
+const (
+	defaultLoginsLimit = 20
+	maxLoginsLimit     = 100
+)
+
 type farmerUsecase struct {
 	repo repo.FarmerRepo
 }
@@ -69,3 +75,31 @@ func (fu farmerUsecase) UpdateUser(ctx context.Context, user *models.UpdateUsers
 
 	return res, nil
 }
+
+func (fu farmerUsecase) ListLogins(ctx context.Context, id string, limit int32) (*pbgen.ListLoginsResponse, error) {
+	uCtx, done := context.WithTimeout(ctx, time.Second*15)
+	defer done()
+
+	if limit <= 0 {
+		limit = defaultLoginsLimit
+	}
+	limit = min(limit, maxLoginsLimit)
+
+	data, err := fu.repo.ListLogins(uCtx, id, int(limit))
+	if err != nil {
+		return nil, err
+	}
+
+	logins := make([]*pbgen.Login, 0, len(data))
+	for _, l := range data {
+		logins = append(logins, &pbgen.Login{
+			Id:        l.ID,
+			IpAddress: l.IpAddress,
+			UserAgent: l.UserAgent,
+			Outcome:   l.Outcome,
+			LoggedAt:  timestamppb.New(l.LoggedAt),
+		})
+	}
+
+	return &pbgen.ListLoginsResponse{Logins: logins}, nil
+}
@@ -2,24 +2,32 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
-	"os/signal"
-	"sync"
-	"syscall"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/joho/godotenv"
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/concurrent"
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/api"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/health"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/idempotency"
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/middleware"
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/pbgen"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/problem"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/ratelimit"
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/routes"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/tokverify"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/validation"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
 
+	"github.com/sony-nurdianto/farm/shared_lib/Go/database/redis"
 	"github.com/sony-nurdianto/farm/shared_lib/Go/observability"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/runtime"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/otel"
 )
@@ -65,10 +73,46 @@ func initGrpcClientConnection(
 	return out
 }
 
+// failoverOptions reads the sentinels of a redis from the env vars of
+// prefix, e.g. GATEWAY_REDIS_MASTER_NAME and SENTINEL_GATEWAY_REDIS_ADDR.
+func failoverOptions(prefix string) *redis.FailoverOptions {
+	return &redis.FailoverOptions{
+		MasterName: os.Getenv(prefix + "_MASTER_NAME"),
+		SentinelAddrs: []string{
+			os.Getenv("SENTINEL_" + prefix + "_ADDR"),
+			os.Getenv("SENTINEL_" + prefix + "_ADDR_2"),
+		},
+		Username: os.Getenv(prefix + "_MASTER_USER_NAME"),
+		Password: os.Getenv(prefix + "_MASTER_PASSWORD"),
+		DB:       0,
+	}
+}
+
+func redisClientConn(ctx context.Context, rdi redis.RedisInstance, opts *redis.FailoverOptions) (redis.RedisClient, error) {
+	count := 0
+	rdb := redis.NewRedisDB(rdi)
+	var errConn error
+
+	for range 5 {
+
+		count++
+		rdc, err := rdb.InitRedisClient(ctx, opts)
+
+		if err == nil {
+			return rdc, nil
+		}
+
+		errConn = err
+		time.Sleep(time.Second * 2)
+	}
+
+	return nil, fmt.Errorf("connection failed after %d attempt: %w", count, errConn)
+}
+
 func main() {
 	godotenv.Load()
 
-	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	ctx, stop := runtime.SignalContext()
 	defer stop()
 
 	serviceObsName := "farm-gateway"
@@ -90,10 +134,6 @@ func main() {
 		log.Fatalln(err)
 	}
 
-	defer tp.Shutdown(ctx)
-	defer mp.Shutdown(ctx)
-	defer lp.Shutdown(ctx)
-
 	statHandler := grpc.WithStatsHandler(
 		otelgrpc.NewClientHandler(
 			otelgrpc.WithTracerProvider(tp),
@@ -118,7 +158,10 @@ func main() {
 			AuthServiceConnectionTag,
 			os.Getenv("GRPC_AUTH_SERVICE"),
 			cred, statHandler,
-			grpc.WithUnaryInterceptor(clientIntercpt.UnaryAuthClientIntercept),
+			grpc.WithChainUnaryInterceptor(
+				clientIntercpt.UnaryAuthClientIntercept,
+				validation.UnaryClientInterceptor,
+			),
 		),
 		initGrpcClientConnection(
 			ctx,
@@ -126,7 +169,10 @@ func main() {
 			os.Getenv("GRPC_FARMER_SERVICE"),
 			cred,
 			statHandler,
-			grpc.WithUnaryInterceptor(clientIntercpt.UnaryAuthClientIntercept),
+			grpc.WithChainUnaryInterceptor(
+				clientIntercpt.UnaryAuthClientIntercept,
+				validation.UnaryClientInterceptor,
+			),
 		),
 		initGrpcClientConnection(
 			ctx,
@@ -134,7 +180,10 @@ func main() {
 			os.Getenv("GRPC_FARM_SERVICE"),
 			cred,
 			statHandler,
-			grpc.WithUnaryInterceptor(clientIntercpt.UnaryAuthClientIntercept),
+			grpc.WithChainUnaryInterceptor(
+				clientIntercpt.UnaryAuthClientIntercept,
+				validation.UnaryClientInterceptor,
+			),
 		),
 	}
 
@@ -159,36 +208,91 @@ func main() {
 
 	}
 
-	defer authConnSvc.Close()
-	defer farmerConnSvc.Close()
-
 	authSvc := api.NewGrpcService(pbgen.NewAuthServiceClient(authConnSvc))
 	farmerSvc := api.NewGrpcFarmerService(pbgen.NewFarmerServiceClient(farmerConnSvc))
 	farmSvc := api.NewGrpcFarmService(pbgen.NewFarmServiceClient(farmConnSvc))
+	// the revocation list of the auth service, every token is checked
+	// against it
+	if os.Getenv("GATEWAY_SESSION_REDIS_MASTER_NAME") == "" {
+		log.Fatalln("GATEWAY_SESSION_REDIS_MASTER_NAME is not set, revoked sessions can not be rejected")
+	}
+	sessionRdc, err := redisClientConn(ctx, redis.NewRedisInstance(), failoverOptions("GATEWAY_SESSION_REDIS"))
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	keys := tokverify.NewVerifier(authSvc)
+	verifier := tokverify.WithSessionCheck(keys, tokverify.NewRedisRevocationList(sessionRdc))
+
 	obsm := middleware.NewObservabilityMiddleware(tp, mp)
 
-	app := fiber.New()
-	app.Use(obsm.Trace)
-	app.Use(obsm.Metric)
-	appRoutes := routes.NewRoutes(app, authSvc, farmerSvc, farmSvc)
-	appRoutes.Build()
+	policy := routes.PolicyFromEnv()
 
-	go func() {
-		if err := app.Listen("0.0.0.0:3000"); err != nil {
+	// the Idempotency-Key header is ignored and no request is rate limited
+	// when no redis is configured
+	var idemStore idempotency.Store
+	var limiter *ratelimit.Limiter
+	var rdc redis.RedisClient
+	if os.Getenv("GATEWAY_REDIS_MASTER_NAME") != "" {
+		rdc, err = redisClientConn(ctx, redis.NewRedisInstance(), failoverOptions("GATEWAY_REDIS"))
+		if err != nil {
 			log.Fatalln(err)
 		}
-	}()
+		idemStore = idempotency.NewRedisStore(rdc)
+		limiter = ratelimit.NewLimiter(ratelimit.NewRedisStore(rdc), obsm)
+		log.Printf("rate limits fail open while redis is down: %t", policy.RateLimitFailOpen)
+	} else {
+		log.Println("WARNING: GATEWAY_REDIS_MASTER_NAME is not set, idempotency keys are ignored and no request is rate limited")
+	}
 
-	var once sync.Once
+	app := fiber.New(fiber.Config{ErrorHandler: problem.ErrorHandler})
+	app.Use(obsm.Trace)
+	app.Use(obsm.Metric)
+	readiness := health.NewReadiness(
+		health.DefaultTimeout,
+		health.Downstream{Name: "auth", Client: grpc_health_v1.NewHealthClient(authConnSvc)},
+		health.Downstream{Name: "farmer", Client: grpc_health_v1.NewHealthClient(farmerConnSvc)},
+		health.Downstream{Name: "farm", Client: grpc_health_v1.NewHealthClient(farmConnSvc)},
+	)
+	appRoutes := routes.NewRoutes(app, authSvc, farmerSvc, farmSvc, verifier, idemStore, limiter, readiness, policy)
+	appRoutes.Build()
 
-	for {
-		select {
-		case <-ctx.Done():
-			fmt.Println("Server Stoping, Gracefully Stop ...")
-			fmt.Println("Application Quit.")
-			return
-		default:
-			once.Do(func() { fmt.Println("Server Running at 0.0.0.0:3000") })
-		}
-	}
+	// the server stops first, so the requests in flight drain while their
+	// clients are still open
+	runner := runtime.NewRunner()
+	runner.Register(
+		runtime.Hook{
+			Name: "observability",
+			Stop: func(ctx context.Context) error {
+				return errors.Join(tp.Shutdown(ctx), mp.Shutdown(ctx), lp.Shutdown(ctx))
+			},
+		},
+		runtime.Hook{
+			Name:      "grpc-clients",
+			DependsOn: []string{"observability"},
+			Stop: func(context.Context) error {
+				return errors.Join(authConnSvc.Close(), farmerConnSvc.Close(), farmConnSvc.Close())
+			},
+		},
+		runtime.Hook{
+			Name: "redis",
+			Stop: func(context.Context) error {
+				if rdc == nil {
+					return sessionRdc.Close()
+				}
+				return errors.Join(rdc.Close(), sessionRdc.Close())
+			},
+		},
+		runtime.Hook{
+			Name:      "token-verifier",
+			DependsOn: []string{"grpc-clients"},
+			Run: func(ctx context.Context) error {
+				keys.Run(ctx, tokverify.DefaultRefreshInterval)
+				return nil
+			},
+		},
+		runtime.HTTPHook("http-server", "0.0.0.0:3000", app, "grpc-clients", "redis", "token-verifier"),
+	)
+
+	runner.Main(ctx)
 }
@@ -8,38 +8,47 @@ import (
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/concurrent"
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/models"
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/pbgen"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/validation"
 	"google.golang.org/grpc"
 )
 
+func createFarmRequests(dataRequest []models.CreateFarm) []*pbgen.CreateFarmRequest {
+	msgs := make([]*pbgen.CreateFarmRequest, 0, len(dataRequest))
+	for _, req := range dataRequest {
+		msgs = append(msgs, &pbgen.CreateFarmRequest{
+			Farm: &pbgen.CreateFarm{
+				FarmerId:    req.FarmerID,
+				FarmName:    req.FarmName,
+				FarmType:    req.FarmType,
+				FarmSize:    req.FarmSize,
+				FarmStatus:  req.FarmStatus,
+				Description: req.Description,
+			},
+			Address: &pbgen.CreateFarmAddress{
+				Street:      req.Address.Street,
+				Village:     req.Address.Village,
+				SubDistrict: req.Address.SubDistrict,
+				City:        req.Address.City,
+				Province:    req.Address.Province,
+				PostalCode:  req.Address.PostalCode,
+			},
+			ClientRequestId: req.ClientRequestID,
+		})
+	}
+
+	return msgs
+}
+
 func createFarmSendMsg(
 	ctx context.Context,
 	stream grpc.BidiStreamingClient[pbgen.CreateFarmRequest, pbgen.CreateFarmResponse],
-	dataRequest []models.CreateFarm,
+	msgs []*pbgen.CreateFarmRequest,
 ) <-chan any {
 	out := make(chan any, 1)
 	go func() {
 		defer close(out)
 		var res concurrent.Result[struct{}]
-		for _, req := range dataRequest {
-			msg := &pbgen.CreateFarmRequest{
-				Farm: &pbgen.CreateFarm{
-					FarmerId:    req.FarmerID,
-					FarmName:    req.FarmName,
-					FarmType:    req.FarmType,
-					FarmSize:    req.FarmSize,
-					FarmStatus:  req.FarmStatus,
-					Description: req.Description,
-				},
-				Address: &pbgen.CreateFarmAddress{
-					Street:      req.Address.Street,
-					Village:     req.Address.Village,
-					SubDistrict: req.Address.SubDistrict,
-					City:        req.Address.City,
-					Province:    req.Address.Province,
-					PostalCode:  req.Address.PostalCode,
-				},
-			}
-
+		for _, msg := range msgs {
 			if err := stream.Send(msg); err != nil {
 				res.Error = err
 				concurrent.SendResult(ctx, out, res)
@@ -96,13 +105,18 @@ func (s grpcFarmService) CreateFarm(
 ) ([]*pbgen.CreateFarmResponse, error) {
 	var results []*pbgen.CreateFarmResponse
 
+	msgs := createFarmRequests(dataRequest)
+	if err := validation.Items("data", msgs...); err != nil {
+		return results, err
+	}
+
 	stream, err := s.farmSvc.CreateFarm(ctx)
 	if err != nil {
 		return results, err
 	}
 
 	chs := []<-chan any{
-		createFarmSendMsg(ctx, stream, dataRequest),
+		createFarmSendMsg(ctx, stream, msgs),
 		createFarmRecvMsg(ctx, stream),
 	}
 
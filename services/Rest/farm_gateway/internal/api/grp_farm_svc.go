@@ -7,11 +7,22 @@ import (
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/pbgen"
 )
 
+//go:generate mockgen -source=grp_farm_svc.go -destination=../../test/mocks/mock_grpc_farm_svc.go -package=mocks
 type GrpcFarmService interface {
 	CreateFarm(ctx context.Context, dataRequest []models.CreateFarm) ([]*pbgen.CreateFarmResponse, error)
-	UpdateFarmOrAddress(ctx context.Context, data []models.UpdateFarmWithAddr) ([]*pbgen.UpdateFarmsResponse, error)
+	UpdateFarmOrAddress(ctx context.Context, farmerID string, data []models.UpdateFarmWithAddr) ([]*pbgen.UpdateFarmsResponse, error)
 	GetFarms(ctx context.Context, farmerID string, dataRequest models.GetFarmsRequest) (res models.GetFarmsResponse, _ error)
+	StreamFarms(ctx context.Context, farmerID string, dataRequest models.GetFarmsRequest) (FarmListStream, error)
 	GetFarmByID(ctx context.Context, farmID string, farmerID string) (res models.Farm, _ error)
+	DeleteFarm(ctx context.Context, farmID string, farmerID string) (*pbgen.DeleteFarmResponse, error)
+	SearchFarms(ctx context.Context, farmerID string, dataRequest models.SearchFarmsRequest) (res models.SearchFarmsResponse, _ error)
+}
+
+// FarmListStream reads a farm list as the farm service sends it. Recv
+// returns io.EOF after the last farm, cancel the context given to
+// StreamFarms to stop it early.
+type FarmListStream interface {
+	Recv() (models.FarmListMessage, error)
 }
 
 type grpcFarmService struct {
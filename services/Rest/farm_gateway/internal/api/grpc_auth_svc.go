@@ -13,6 +13,20 @@ type GrpcAuthService interface {
 	AuthUserRegister(ctx context.Context, req *pbgen.RegisterUserRequest) (*pbgen.RegisterUserResponse, error)
 	AuthUserSignIn(ctx context.Context, req *pbgen.AuthenticateUserRequest) (*pbgen.AuthenticateUserResponse, error)
 	AuthTokenValidate(ctx context.Context, req *pbgen.TokenValidateRequest) (*pbgen.TokenValidateResponse, error)
+	AuthVerificationKeys(ctx context.Context, req *pbgen.GetVerificationKeysRequest) (*pbgen.GetVerificationKeysResponse, error)
+	AuthRefreshToken(ctx context.Context, req *pbgen.RefreshTokenRequest) (*pbgen.RefreshTokenResponse, error)
+	AuthLogout(ctx context.Context, req *pbgen.LogoutRequest) (*pbgen.LogoutResponse, error)
+	AuthListSessions(ctx context.Context, req *pbgen.ListSessionsRequest) (*pbgen.ListSessionsResponse, error)
+	AuthRevokeSession(ctx context.Context, req *pbgen.RevokeSessionRequest) (*pbgen.RevokeSessionResponse, error)
+	AuthVerifyEmail(ctx context.Context, req *pbgen.VerifyEmailRequest) (*pbgen.VerifyEmailResponse, error)
+	AuthRequestPasswordReset(ctx context.Context, req *pbgen.RequestPasswordResetRequest) (*pbgen.RequestPasswordResetResponse, error)
+	AuthResetPassword(ctx context.Context, req *pbgen.ResetPasswordRequest) (*pbgen.ResetPasswordResponse, error)
+	AuthChangePassword(ctx context.Context, req *pbgen.ChangePasswordRequest) (*pbgen.ChangePasswordResponse, error)
+	AuthEnrollTOTP(ctx context.Context, req *pbgen.EnrollTOTPRequest) (*pbgen.EnrollTOTPResponse, error)
+	AuthConfirmTOTP(ctx context.Context, req *pbgen.ConfirmTOTPRequest) (*pbgen.ConfirmTOTPResponse, error)
+	AuthDisableTOTP(ctx context.Context, req *pbgen.DisableTOTPRequest) (*pbgen.DisableTOTPResponse, error)
+	AuthVerifySecondFactor(ctx context.Context, req *pbgen.VerifySecondFactorRequest) (*pbgen.AuthenticateUserResponse, error)
+	AuthRegistrationStatus(ctx context.Context, req *pbgen.GetRegistrationStatusRequest) (*pbgen.GetRegistrationStatusResponse, error)
 }
 
 type grpcAuthService struct {
@@ -49,3 +63,129 @@ func (s grpcAuthService) AuthTokenValidate(ctx context.Context, req *pbgen.Token
 
 	return res, nil
 }
+
+func (s grpcAuthService) AuthVerificationKeys(ctx context.Context, req *pbgen.GetVerificationKeysRequest) (*pbgen.GetVerificationKeysResponse, error) {
+	res, err := s.authSvc.GetVerificationKeys(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s grpcAuthService) AuthRefreshToken(ctx context.Context, req *pbgen.RefreshTokenRequest) (*pbgen.RefreshTokenResponse, error) {
+	res, err := s.authSvc.RefreshToken(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s grpcAuthService) AuthLogout(ctx context.Context, req *pbgen.LogoutRequest) (*pbgen.LogoutResponse, error) {
+	res, err := s.authSvc.Logout(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s grpcAuthService) AuthListSessions(ctx context.Context, req *pbgen.ListSessionsRequest) (*pbgen.ListSessionsResponse, error) {
+	res, err := s.authSvc.ListSessions(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s grpcAuthService) AuthRevokeSession(ctx context.Context, req *pbgen.RevokeSessionRequest) (*pbgen.RevokeSessionResponse, error) {
+	res, err := s.authSvc.RevokeSession(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s grpcAuthService) AuthVerifyEmail(ctx context.Context, req *pbgen.VerifyEmailRequest) (*pbgen.VerifyEmailResponse, error) {
+	res, err := s.authSvc.VerifyEmail(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s grpcAuthService) AuthRequestPasswordReset(ctx context.Context, req *pbgen.RequestPasswordResetRequest) (*pbgen.RequestPasswordResetResponse, error) {
+	res, err := s.authSvc.RequestPasswordReset(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s grpcAuthService) AuthResetPassword(ctx context.Context, req *pbgen.ResetPasswordRequest) (*pbgen.ResetPasswordResponse, error) {
+	res, err := s.authSvc.ResetPassword(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s grpcAuthService) AuthChangePassword(ctx context.Context, req *pbgen.ChangePasswordRequest) (*pbgen.ChangePasswordResponse, error) {
+	res, err := s.authSvc.ChangePassword(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s grpcAuthService) AuthEnrollTOTP(ctx context.Context, req *pbgen.EnrollTOTPRequest) (*pbgen.EnrollTOTPResponse, error) {
+	res, err := s.authSvc.EnrollTOTP(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s grpcAuthService) AuthConfirmTOTP(ctx context.Context, req *pbgen.ConfirmTOTPRequest) (*pbgen.ConfirmTOTPResponse, error) {
+	res, err := s.authSvc.ConfirmTOTP(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s grpcAuthService) AuthDisableTOTP(ctx context.Context, req *pbgen.DisableTOTPRequest) (*pbgen.DisableTOTPResponse, error) {
+	res, err := s.authSvc.DisableTOTP(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s grpcAuthService) AuthVerifySecondFactor(ctx context.Context, req *pbgen.VerifySecondFactorRequest) (*pbgen.AuthenticateUserResponse, error) {
+	res, err := s.authSvc.VerifySecondFactor(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s grpcAuthService) AuthRegistrationStatus(ctx context.Context, req *pbgen.GetRegistrationStatusRequest) (*pbgen.GetRegistrationStatusResponse, error) {
+	res, err := s.authSvc.GetRegistrationStatus(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
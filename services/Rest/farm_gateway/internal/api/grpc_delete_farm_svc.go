@@ -0,0 +1,25 @@
+package api
+
+import (
+	"context"
+
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/pbgen"
+)
+
+func (s grpcFarmService) DeleteFarm(
+	ctx context.Context,
+	farmID string,
+	farmerID string,
+) (*pbgen.DeleteFarmResponse, error) {
+	req := &pbgen.DeleteFarmRequest{
+		Id:       farmID,
+		FarmerId: farmerID,
+	}
+
+	res, err := s.farmSvc.DeleteFarm(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -9,6 +9,7 @@ import (
 type GrpcFarmerService interface {
 	FarmerProfile(ctx context.Context, req *pbgen.FarmerProfileRequest) (*pbgen.FarmerProfileResponse, error)
 	ProfileFarmerUpdate(ctx context.Context, req *pbgen.UpdateFarmerProfileRequest) (*pbgen.UpdateFarmerProfileResponse, error)
+	ListLogins(ctx context.Context, req *pbgen.ListLoginsRequest) (*pbgen.ListLoginsResponse, error)
 }
 
 type grpcFarmerService struct {
@@ -36,3 +37,12 @@ func (s grpcFarmerService) ProfileFarmerUpdate(ctx context.Context, req *pbgen.U
 
 	return res, nil
 }
+
+func (s grpcFarmerService) ListLogins(ctx context.Context, req *pbgen.ListLoginsRequest) (*pbgen.ListLoginsResponse, error) {
+	res, err := s.farmerSvc.ListLogins(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
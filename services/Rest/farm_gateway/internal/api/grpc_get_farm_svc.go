@@ -3,9 +3,12 @@ package api
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/models"
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/pbgen"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (s grpcFarmService) GetFarmByID(
@@ -22,28 +25,8 @@ func (s grpcFarmService) GetFarmByID(
 	if err != nil {
 		return res, err
 	}
-	res = models.Farm{
-		ID:          farm.Farm.Id,
-		FarmerID:    farm.Farm.FarmerId,
-		FarmName:    farm.Farm.FarmName,
-		FarmType:    farm.Farm.FarmType,
-		FarmSize:    farm.Farm.FarmSize,
-		FarmStatus:  farm.Farm.FarmStatus,
-		Description: farm.Farm.Description,
-		Addresses: models.FarmAddress{
-			ID:          farm.Farm.Address.Id,
-			Street:      farm.Farm.Address.Street,
-			Village:     farm.Farm.Address.Village,
-			SubDistrict: farm.Farm.Address.SubDistrict,
-			City:        farm.Farm.Address.City,
-			Province:    farm.Farm.Address.Province,
-			PostalCode:  farm.Farm.Address.PostalCode,
-		},
-		CreatedAt: farm.Farm.CreatedAt.AsTime().UTC(),
-		UpdatedAt: farm.Farm.UpdatedAt.AsTime().UTC(),
-	}
 
-	return res, nil
+	return farmFromProto(farm.Farm), nil
 }
 
 func (s grpcFarmService) GetFarms(
@@ -51,15 +34,7 @@ func (s grpcFarmService) GetFarms(
 	farmerID string,
 	dataRequest models.GetFarmsRequest,
 ) (res models.GetFarmsResponse, _ error) {
-	req := &pbgen.GetFarmListRequest{
-		FarmerId:   farmerID,
-		SearchName: dataRequest.SearchName,
-		SortOrder:  dataRequest.SortOrder.ProtoSortOrder(),
-		Limit:      int32(dataRequest.Limit),
-		Offset:     int32(dataRequest.Offset),
-	}
-
-	stream, err := s.farmSvc.GetFarmList(ctx, req)
+	stream, err := s.farmSvc.GetFarmList(ctx, farmListRequest(farmerID, dataRequest))
 	if err != nil {
 		return res, err
 	}
@@ -85,29 +60,111 @@ func (s grpcFarmService) GetFarms(
 			}
 
 			if msg.Farms != nil {
-				farm := models.Farm{
-					ID:          msg.Farms.Id,
-					FarmerID:    msg.Farms.FarmerId,
-					FarmName:    msg.Farms.FarmName,
-					FarmType:    msg.Farms.FarmType,
-					FarmSize:    msg.Farms.FarmSize,
-					FarmStatus:  msg.Farms.FarmStatus,
-					Description: msg.Farms.Description,
-					Addresses: models.FarmAddress{
-						ID:          msg.Farms.Address.Id,
-						Street:      msg.Farms.Address.Street,
-						Village:     msg.Farms.Address.Village,
-						SubDistrict: msg.Farms.Address.SubDistrict,
-						City:        msg.Farms.Address.City,
-						Province:    msg.Farms.Address.Province,
-						PostalCode:  msg.Farms.Address.PostalCode,
-					},
-					CreatedAt: msg.Farms.CreatedAt.AsTime().UTC(),
-					UpdatedAt: msg.Farms.UpdatedAt.AsTime().UTC(),
-				}
-
-				res.Data = append(res.Data, farm)
+				res.Data = append(res.Data, farmFromProto(msg.Farms))
+			}
+
+			if msg.NextPageToken != "" {
+				res.NextPageToken = msg.NextPageToken
 			}
 		}
 	}
 }
+
+func (s grpcFarmService) StreamFarms(
+	ctx context.Context,
+	farmerID string,
+	dataRequest models.GetFarmsRequest,
+) (FarmListStream, error) {
+	stream, err := s.farmSvc.GetFarmList(ctx, farmListRequest(farmerID, dataRequest))
+	if err != nil {
+		return nil, err
+	}
+
+	return farmListStream{stream: stream}, nil
+}
+
+type farmListStream struct {
+	stream grpc.ServerStreamingClient[pbgen.GetFarmListResponse]
+}
+
+func (s farmListStream) Recv() (res models.FarmListMessage, _ error) {
+	msg, err := s.stream.Recv()
+	if err != nil {
+		return res, err
+	}
+
+	if msg.Total != nil {
+		total := int(msg.GetTotal())
+		res.Total = &total
+	}
+
+	if msg.Farms != nil {
+		farm := farmFromProto(msg.Farms)
+		res.Farm = &farm
+	}
+
+	res.NextPageToken = msg.NextPageToken
+
+	return res, nil
+}
+
+func farmListRequest(farmerID string, dataRequest models.GetFarmsRequest) *pbgen.GetFarmListRequest {
+	return &pbgen.GetFarmListRequest{
+		FarmerId:     farmerID,
+		SearchName:   dataRequest.SearchName,
+		SortOrder:    dataRequest.SortOrder.ProtoSortOrder(),
+		Limit:        int32(dataRequest.Limit),
+		Offset:       int32(dataRequest.Offset),
+		PageToken:    dataRequest.PageToken,
+		IncludeTotal: dataRequest.IncludeTotal,
+		SortBy:       dataRequest.SortBy.ProtoSortField(),
+		Filter:       farmListFilter(dataRequest.Filter),
+	}
+}
+
+func farmListFilter(filter models.FarmListFilter) *pbgen.FarmListFilter {
+	return &pbgen.FarmListFilter{
+		FarmTypes:    filter.FarmTypes,
+		FarmStatuses: filter.FarmStatuses,
+		Province:     filter.Province,
+		City:         filter.City,
+		PostalCode:   filter.PostalCode,
+		MinFarmSize:  filter.MinFarmSize,
+		MaxFarmSize:  filter.MaxFarmSize,
+		CreatedFrom:  timestamp(filter.CreatedFrom),
+		CreatedTo:    timestamp(filter.CreatedTo),
+		UpdatedFrom:  timestamp(filter.UpdatedFrom),
+		UpdatedTo:    timestamp(filter.UpdatedTo),
+	}
+}
+
+func timestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
+
+func farmFromProto(farm *pbgen.Farm) models.Farm {
+	return models.Farm{
+		ID:          farm.Id,
+		FarmerID:    farm.FarmerId,
+		FarmName:    farm.FarmName,
+		FarmType:    farm.FarmType,
+		FarmSize:    farm.FarmSize,
+		FarmStatus:  farm.FarmStatus,
+		Description: farm.Description,
+		Addresses: models.FarmAddress{
+			ID:          farm.Address.Id,
+			Street:      farm.Address.Street,
+			Village:     farm.Address.Village,
+			SubDistrict: farm.Address.SubDistrict,
+			City:        farm.Address.City,
+			Province:    farm.Address.Province,
+			PostalCode:  farm.Address.PostalCode,
+		},
+		CreatedAt: farm.CreatedAt.AsTime().UTC(),
+		UpdatedAt: farm.UpdatedAt.AsTime().UTC(),
+	}
+}
@@ -0,0 +1,52 @@
+package api
+
+import (
+	"context"
+
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/models"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/pbgen"
+)
+
+func facetsFromProto(facets []*pbgen.FacetCount) []models.FacetCount {
+	res := make([]models.FacetCount, 0, len(facets))
+	for _, f := range facets {
+		res = append(res, models.FacetCount{Value: f.GetValue(), Count: int(f.GetCount())})
+	}
+
+	return res
+}
+
+func (s grpcFarmService) SearchFarms(
+	ctx context.Context,
+	farmerID string,
+	dataRequest models.SearchFarmsRequest,
+) (res models.SearchFarmsResponse, _ error) {
+	req := &pbgen.SearchFarmsRequest{
+		FarmerId:     farmerID,
+		Query:        dataRequest.Query,
+		FarmTypes:    dataRequest.FarmTypes,
+		FarmStatuses: dataRequest.FarmStatuses,
+		Provinces:    dataRequest.Provinces,
+		Limit:        int32(dataRequest.Limit),
+		Offset:       int32(dataRequest.Offset),
+	}
+
+	found, err := s.farmSvc.SearchFarms(ctx, req)
+	if err != nil {
+		return res, err
+	}
+
+	res.Data = make([]models.Farm, 0, len(found.GetFarms()))
+	for _, farm := range found.GetFarms() {
+		res.Data = append(res.Data, farmFromProto(farm))
+	}
+
+	res.Total = int(found.GetTotal())
+	res.Facets = models.FarmFacets{
+		FarmTypes:    facetsFromProto(found.GetFarmTypes()),
+		FarmStatuses: facetsFromProto(found.GetFarmStatuses()),
+		Provinces:    facetsFromProto(found.GetProvinces()),
+	}
+
+	return res, nil
+}
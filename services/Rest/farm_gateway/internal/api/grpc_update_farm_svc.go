@@ -8,52 +8,62 @@ import (
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/concurrent"
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/models"
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/pbgen"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/validation"
 	"google.golang.org/grpc"
 )
 
+func updateFarmRequests(farmerID string, dataRequest []models.UpdateFarmWithAddr) []*pbgen.UpdateFarmsRequest {
+	msgs := make([]*pbgen.UpdateFarmsRequest, 0, len(dataRequest))
+	for _, req := range dataRequest {
+
+		var farm *pbgen.UpdateFarmData
+		var address *pbgen.UpdateFarmAddressData
+
+		if req.Farm != nil {
+			farm = &pbgen.UpdateFarmData{
+				Id:          req.Farm.ID,
+				FarmerId:    farmerID,
+				FarmName:    req.Farm.FarmName,
+				FarmType:    req.Farm.FarmType,
+				FarmStatus:  req.Farm.FarmStatus,
+				FarmSize:    req.Farm.FarmSize,
+				Description: req.Farm.Description,
+			}
+		}
+
+		if req.Address != nil {
+			address = &pbgen.UpdateFarmAddressData{
+				Id:          req.Address.ID,
+				FarmerId:    farmerID,
+				Street:      req.Address.Street,
+				Village:     req.Address.Village,
+				SubDistrict: req.Address.SubDistrict,
+				City:        req.Address.City,
+				Province:    req.Address.Province,
+				PostalCode:  req.Address.PostalCode,
+			}
+		}
+
+		msgs = append(msgs, &pbgen.UpdateFarmsRequest{
+			Farm:    farm,
+			Address: address,
+		})
+	}
+
+	return msgs
+}
+
 func updateFarmSendMsg(
 	ctx context.Context,
 	stream grpc.BidiStreamingClient[pbgen.UpdateFarmsRequest, pbgen.UpdateFarmsResponse],
-	dataRequest []models.UpdateFarmWithAddr,
+	msgs []*pbgen.UpdateFarmsRequest,
 ) <-chan any {
 	out := make(chan any, 1)
 	go func() {
 		defer close(out)
 
 		var res concurrent.Result[struct{}]
-		for _, req := range dataRequest {
-
-			var farm *pbgen.UpdateFarmData
-			var address *pbgen.UpdateFarmAddressData
-
-			if req.Farm != nil {
-				farm = &pbgen.UpdateFarmData{
-					Id:          req.Farm.ID,
-					FarmName:    req.Farm.FarmName,
-					FarmType:    req.Farm.FarmType,
-					FarmStatus:  req.Farm.FarmStatus,
-					FarmSize:    req.Farm.FarmSize,
-					Description: req.Farm.Description,
-				}
-			}
-
-			if req.Address != nil {
-				address = &pbgen.UpdateFarmAddressData{
-					Id:          req.Address.ID,
-					Street:      req.Address.Street,
-					Village:     req.Address.Village,
-					SubDistrict: req.Address.SubDistrict,
-					City:        req.Address.City,
-					Province:    req.Address.Province,
-					PostalCode:  req.Address.PostalCode,
-				}
-			}
-
-			msg := &pbgen.UpdateFarmsRequest{
-				Farm:    farm,
-				Address: address,
-			}
-
+		for _, msg := range msgs {
 			if err := stream.Send(msg); err != nil {
 				res.Error = err
 				concurrent.SendResult(ctx, out, res)
@@ -106,16 +116,23 @@ func updateFarmRecvMsg(
 
 func (s grpcFarmService) UpdateFarmOrAddress(
 	ctx context.Context,
+	farmerID string,
 	data []models.UpdateFarmWithAddr,
 ) ([]*pbgen.UpdateFarmsResponse, error) {
 	var results []*pbgen.UpdateFarmsResponse
+
+	msgs := updateFarmRequests(farmerID, data)
+	if err := validation.Items("data", msgs...); err != nil {
+		return nil, err
+	}
+
 	stream, err := s.farmSvc.UpdateFarms(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	chs := []<-chan any{
-		updateFarmSendMsg(ctx, stream, data),
+		updateFarmSendMsg(ctx, stream, msgs),
 		updateFarmRecvMsg(ctx, stream),
 	}
 
@@ -1,13 +1,18 @@
 package authh
 
-import "github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/api"
+import (
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/api"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/tokverify"
+)
 
 type authHandler struct {
 	grpcAuthSvc api.GrpcAuthService
+	verifier    tokverify.Verifier
 }
 
-func NewAuthHandler(grpcSvc api.GrpcAuthService) authHandler {
+func NewAuthHandler(grpcSvc api.GrpcAuthService, verifier tokverify.Verifier) authHandler {
 	return authHandler{
 		grpcAuthSvc: grpcSvc,
+		verifier:    verifier,
 	}
 }
@@ -0,0 +1,28 @@
+package authh
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/pbgen"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/problem"
+)
+
+func (h authHandler) Logout(c *fiber.Ctx) error {
+	token, err := bearerToken(c)
+	if err != nil {
+		return problem.Respond(c, fiber.StatusUnauthorized, err.Error())
+	}
+
+	res, err := h.grpcAuthSvc.AuthLogout(c.UserContext(), &pbgen.LogoutRequest{Token: token})
+	if err != nil {
+		return problem.GRPC(c, err)
+	}
+
+	c.ClearCookie("auth_token", refreshCookieName)
+
+	return c.JSON(
+		fiber.Map{
+			"status": res.Status,
+			"msg":    res.Msg,
+		},
+	)
+}
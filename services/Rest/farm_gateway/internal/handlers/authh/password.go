@@ -0,0 +1,88 @@
+package authh
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/models"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/pbgen"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/problem"
+)
+
+func (h authHandler) ForgotPassword(c *fiber.Ctx) error {
+	var body models.UserForgotPassword
+	if err := c.BodyParser(&body); err != nil {
+		return problem.Respond(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	res, err := h.grpcAuthSvc.AuthRequestPasswordReset(
+		c.UserContext(),
+		&pbgen.RequestPasswordResetRequest{Email: body.Email},
+	)
+	if err != nil {
+		return problem.GRPC(c, err)
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(
+		fiber.Map{
+			"status": res.Status,
+			"msg":    res.Msg,
+		},
+	)
+}
+
+func (h authHandler) ResetPassword(c *fiber.Ctx) error {
+	var body models.UserResetPassword
+	if err := c.BodyParser(&body); err != nil {
+		return problem.Respond(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	res, err := h.grpcAuthSvc.AuthResetPassword(
+		c.UserContext(),
+		&pbgen.ResetPasswordRequest{
+			Token:       body.Token,
+			NewPassword: body.NewPassword,
+		},
+	)
+	if err != nil {
+		return problem.GRPC(c, err)
+	}
+
+	c.ClearCookie("auth_token", refreshCookieName)
+
+	return c.JSON(
+		fiber.Map{
+			"status": res.Status,
+			"msg":    res.Msg,
+		},
+	)
+}
+
+func (h authHandler) ChangePassword(c *fiber.Ctx) error {
+	token, err := bearerToken(c)
+	if err != nil {
+		return problem.Respond(c, fiber.StatusUnauthorized, err.Error())
+	}
+
+	var body models.UserChangePassword
+	if err := c.BodyParser(&body); err != nil {
+		return problem.Respond(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	res, err := h.grpcAuthSvc.AuthChangePassword(
+		c.UserContext(),
+		&pbgen.ChangePasswordRequest{
+			Token:           token,
+			CurrentPassword: body.CurrentPassword,
+			NewPassword:     body.NewPassword,
+		},
+	)
+	if err != nil {
+		return problem.GRPC(c, err)
+	}
+
+	return c.JSON(
+		fiber.Map{
+			"status": res.Status,
+			"msg":    res.Msg,
+		},
+	)
+}
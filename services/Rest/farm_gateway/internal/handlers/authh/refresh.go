@@ -0,0 +1,78 @@
+package authh
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/models"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/pbgen"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/problem"
+)
+
+const refreshCookieName = "refresh_token"
+
+func setRefreshCookie(c *fiber.Ctx, token string, expires time.Time) {
+	c.Cookie(&fiber.Cookie{
+		Name:     refreshCookieName,
+		Value:    token,
+		Expires:  expires,
+		HTTPOnly: true,
+		Secure:   true,
+		SameSite: "Strict",
+		Path:     "/auth",
+	})
+}
+
+func (h authHandler) Refresh(c *fiber.Ctx) error {
+	var body models.UserRefreshToken
+
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&body); err != nil {
+			return problem.Respond(c, fiber.StatusBadRequest, err.Error())
+		}
+	}
+
+	// mobile clients send the token in the body, browsers through the cookie
+	refreshToken := body.RefreshToken
+	if refreshToken == "" {
+		refreshToken = c.Cookies(refreshCookieName)
+	}
+
+	if refreshToken == "" {
+		return problem.Respond(c, fiber.StatusBadRequest, "refresh token is required")
+	}
+
+	req := &pbgen.RefreshTokenRequest{
+		RefreshToken: refreshToken,
+	}
+
+	res, err := h.grpcAuthSvc.AuthRefreshToken(c.UserContext(), req)
+	if err != nil {
+		return problem.GRPC(c, err)
+	}
+
+	c.Cookie(&fiber.Cookie{
+		Name:     "auth_token",
+		Value:    res.Token,
+		Expires:  res.ExpiresAt.AsTime(),
+		HTTPOnly: true,
+		Secure:   true,
+		SameSite: "Lax",
+		Path:     "/",
+	})
+
+	setRefreshCookie(c, res.RefreshToken, res.RefreshExpiresAt.AsTime())
+
+	return c.JSON(
+		fiber.Map{
+			"data": fiber.Map{
+				"token":              res.Token,
+				"issued_at":          res.IssuedAt.AsTime().Format(time.RFC3339),
+				"expires_at":         res.ExpiresAt.AsTime().Format(time.RFC3339),
+				"refresh_token":      res.RefreshToken,
+				"refresh_expires_at": res.RefreshExpiresAt.AsTime().Format(time.RFC3339),
+				"session_id":         res.SessionId,
+			},
+		},
+	)
+}
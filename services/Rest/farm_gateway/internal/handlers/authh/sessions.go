@@ -0,0 +1,72 @@
+package authh
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/pbgen"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/problem"
+)
+
+func (h authHandler) ListSessions(c *fiber.Ctx) error {
+	accountID, ok := c.Locals("user_subject").(string)
+	if !ok {
+		return problem.Respond(c, fiber.StatusInternalServerError, "id is not string")
+	}
+
+	sessionID, _ := c.Locals("user_session").(string)
+
+	req := &pbgen.ListSessionsRequest{
+		AccountId:        accountID,
+		CurrentSessionId: sessionID,
+	}
+
+	res, err := h.grpcAuthSvc.AuthListSessions(c.UserContext(), req)
+	if err != nil {
+		return problem.GRPC(c, err)
+	}
+
+	sessions := make([]fiber.Map, 0, len(res.Sessions))
+	for _, s := range res.Sessions {
+		sessions = append(sessions, fiber.Map{
+			"id":           s.Id,
+			"user_agent":   s.UserAgent,
+			"ip_address":   s.IpAddress,
+			"created_at":   s.CreatedAt.AsTime().Format(time.RFC3339),
+			"last_used_at": s.LastUsedAt.AsTime().Format(time.RFC3339),
+			"expires_at":   s.ExpiresAt.AsTime().Format(time.RFC3339),
+			"current":      s.Current,
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"data": sessions,
+	})
+}
+
+func (h authHandler) RevokeSession(c *fiber.Ctx) error {
+	accountID, ok := c.Locals("user_subject").(string)
+	if !ok {
+		return problem.Respond(c, fiber.StatusInternalServerError, "id is not string")
+	}
+
+	sessionID := c.Params("id")
+	if sessionID == "" {
+		return problem.Respond(c, fiber.StatusBadRequest, "session id is required")
+	}
+
+	req := &pbgen.RevokeSessionRequest{
+		AccountId: accountID,
+		SessionId: sessionID,
+	}
+
+	res, err := h.grpcAuthSvc.AuthRevokeSession(c.UserContext(), req)
+	if err != nil {
+		return problem.GRPC(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"status": res.Status,
+		"msg":    res.Msg,
+	})
+}
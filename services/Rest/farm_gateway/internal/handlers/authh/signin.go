@@ -6,28 +6,51 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/models"
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/pbgen"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/problem"
+	"google.golang.org/grpc/metadata"
 )
 
+// clientIPMetadata forwards the caller's IP to the auth service, which only
+// sees the gateway as its peer.
+const clientIPMetadata = "x-client-ip"
+
 func (h authHandler) SignIn(c *fiber.Ctx) error {
 	var user models.UserSignIn
 
 	err := c.BodyParser(&user)
 	if err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": err.Error(),
-		})
+		return problem.Respond(c, fiber.StatusBadRequest, err.Error())
 	}
 
 	req := &pbgen.AuthenticateUserRequest{
-		Email:    user.Email,
-		Password: user.Password,
+		Email:     user.Email,
+		Password:  user.Password,
+		UserAgent: c.Get(fiber.HeaderUserAgent),
+		IpAddress: c.IP(),
 	}
 
-	res, err := h.grpcAuthSvc.AuthUserSignIn(c.UserContext(), req)
+	ctx := metadata.AppendToOutgoingContext(c.UserContext(), clientIPMetadata, c.IP())
+	res, err := h.grpcAuthSvc.AuthUserSignIn(ctx, req)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(
+		return problem.GRPC(c, err)
+	}
+
+	return signInResponse(c, res)
+}
+
+// signInResponse sets the session cookies, or only returns the challenge
+// token when the account still has to pass its second factor.
+func signInResponse(c *fiber.Ctx, res *pbgen.AuthenticateUserResponse) error {
+	if res.SecondFactorRequired {
+		return c.JSON(
 			fiber.Map{
-				"error": err.Error(),
+				"data": fiber.Map{
+					"status":                 res.Status,
+					"message":                res.Msg,
+					"second_factor_required": true,
+					"challenge_token":        res.ChallengeToken,
+					"challenge_expires_at":   res.ChallengeExpiresAt.AsTime().Format(time.RFC3339),
+				},
 			},
 		)
 	}
@@ -42,12 +65,19 @@ func (h authHandler) SignIn(c *fiber.Ctx) error {
 		Path:     "/",
 	})
 
+	setRefreshCookie(c, res.RefreshToken, res.RefreshExpiresAt.AsTime())
+
 	return c.JSON(
 		fiber.Map{
 			"data": fiber.Map{
-				"status":    res.Status,
-				"message":   res.Msg,
-				"issued_at": res.IssuedAt.AsTime().Format(time.RFC3339),
+				"status":             res.Status,
+				"message":            res.Msg,
+				"issued_at":          res.IssuedAt.AsTime().Format(time.RFC3339),
+				"token":              res.Token,
+				"expires_at":         res.ExpiresAt.AsTime().Format(time.RFC3339),
+				"refresh_token":      res.RefreshToken,
+				"refresh_expires_at": res.RefreshExpiresAt.AsTime().Format(time.RFC3339),
+				"session_id":         res.SessionId,
 			},
 		},
 	)
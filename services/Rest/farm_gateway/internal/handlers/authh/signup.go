@@ -4,6 +4,7 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/models"
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/pbgen"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/problem"
 )
 
 func (h authHandler) SignUp(c *fiber.Ctx) error {
@@ -11,9 +12,7 @@ func (h authHandler) SignUp(c *fiber.Ctx) error {
 
 	err := c.BodyParser(&user)
 	if err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": err.Error(),
-		})
+		return problem.Respond(c, fiber.StatusBadRequest, err.Error())
 	}
 
 	req := &pbgen.RegisterUserRequest{
@@ -25,17 +24,36 @@ func (h authHandler) SignUp(c *fiber.Ctx) error {
 
 	res, err := h.grpcAuthSvc.AuthUserRegister(c.UserContext(), req)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(
-			fiber.Map{
-				"error": err.Error(),
-			},
-		)
+		return problem.GRPC(c, err)
 	}
 
-	return c.JSON(
+	// the account is created asynchronously, GET /v1/auth/signup/:id reports
+	// when it can be used
+	c.Location("/v1/auth/signup/" + res.UserId)
+	return c.Status(fiber.StatusAccepted).JSON(
 		fiber.Map{
-			"status": res.Status,
-			"msg":    res.Msg,
+			"status":              res.Status,
+			"msg":                 res.Msg,
+			"user_id":             res.UserId,
+			"registration_status": res.RegistrationStatus,
 		},
 	)
 }
+
+func (h authHandler) SignUpStatus(c *fiber.Ctx) error {
+	res, err := h.grpcAuthSvc.AuthRegistrationStatus(
+		c.UserContext(),
+		&pbgen.GetRegistrationStatusRequest{Id: c.Params("id")},
+	)
+	if err != nil {
+		return problem.GRPC(c, err)
+	}
+
+	return c.JSON(models.RegistrationStatus{
+		UserId:        res.GetUserId(),
+		Status:        res.GetStatus(),
+		FailureReason: res.GetFailureReason(),
+		CreatedAt:     res.GetCreatedAt().AsTime(),
+		UpdatedAt:     res.GetUpdatedAt().AsTime(),
+	})
+}
@@ -0,0 +1,119 @@
+package authh
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/models"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/pbgen"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/problem"
+	"google.golang.org/grpc/metadata"
+)
+
+func (h authHandler) EnrollTOTP(c *fiber.Ctx) error {
+	token, err := bearerToken(c)
+	if err != nil {
+		return problem.Respond(c, fiber.StatusUnauthorized, err.Error())
+	}
+
+	res, err := h.grpcAuthSvc.AuthEnrollTOTP(
+		c.UserContext(),
+		&pbgen.EnrollTOTPRequest{Token: token},
+	)
+	if err != nil {
+		return problem.GRPC(c, err)
+	}
+
+	return c.JSON(
+		fiber.Map{
+			"data": fiber.Map{
+				"otpauth_uri":    res.OtpauthUri,
+				"secret":         res.Secret,
+				"recovery_codes": res.RecoveryCodes,
+			},
+		},
+	)
+}
+
+func (h authHandler) ConfirmTOTP(c *fiber.Ctx) error {
+	token, err := bearerToken(c)
+	if err != nil {
+		return problem.Respond(c, fiber.StatusUnauthorized, err.Error())
+	}
+
+	var body models.UserConfirmTOTP
+	if err := c.BodyParser(&body); err != nil {
+		return problem.Respond(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	res, err := h.grpcAuthSvc.AuthConfirmTOTP(
+		c.UserContext(),
+		&pbgen.ConfirmTOTPRequest{
+			Token: token,
+			Code:  body.Code,
+		},
+	)
+	if err != nil {
+		return problem.GRPC(c, err)
+	}
+
+	return c.JSON(
+		fiber.Map{
+			"status": res.Status,
+			"msg":    res.Msg,
+		},
+	)
+}
+
+func (h authHandler) DisableTOTP(c *fiber.Ctx) error {
+	token, err := bearerToken(c)
+	if err != nil {
+		return problem.Respond(c, fiber.StatusUnauthorized, err.Error())
+	}
+
+	var body models.UserDisableTOTP
+	if err := c.BodyParser(&body); err != nil {
+		return problem.Respond(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	res, err := h.grpcAuthSvc.AuthDisableTOTP(
+		c.UserContext(),
+		&pbgen.DisableTOTPRequest{
+			Token:    token,
+			Password: body.Password,
+			Code:     body.Code,
+		},
+	)
+	if err != nil {
+		return problem.GRPC(c, err)
+	}
+
+	return c.JSON(
+		fiber.Map{
+			"status": res.Status,
+			"msg":    res.Msg,
+		},
+	)
+}
+
+// VerifySecondFactor exchanges the challenge token of a sign-in for the
+// session, the same way SignIn does for accounts without a second factor.
+func (h authHandler) VerifySecondFactor(c *fiber.Ctx) error {
+	var body models.UserVerifySecondFactor
+	if err := c.BodyParser(&body); err != nil {
+		return problem.Respond(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	req := &pbgen.VerifySecondFactorRequest{
+		ChallengeToken: body.ChallengeToken,
+		Code:           body.Code,
+		UserAgent:      c.Get(fiber.HeaderUserAgent),
+		IpAddress:      c.IP(),
+	}
+
+	ctx := metadata.AppendToOutgoingContext(c.UserContext(), clientIPMetadata, c.IP())
+	res, err := h.grpcAuthSvc.AuthVerifySecondFactor(ctx, req)
+	if err != nil {
+		return problem.GRPC(c, err)
+	}
+
+	return signInResponse(c, res)
+}
@@ -1,54 +1,65 @@
 package authh
 
 import (
+	"errors"
+	"log"
 	"strings"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
-	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/pbgen"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/problem"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/tokverify"
 )
 
-func (h authHandler) AuthTokenBaseValidate(c *fiber.Ctx) error {
+func bearerToken(c *fiber.Ctx) (string, error) {
 	authHeader := c.Get("Authorization")
 	if authHeader == "" {
-		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-			"error": "Authorization header is missing",
-		})
+		return "", errors.New("Authorization header is missing")
 	}
 
 	parts := strings.SplitN(authHeader, " ", 2)
 	if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
-		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-			"error": "Invalid Authorization header format",
-		})
+		return "", errors.New("Invalid Authorization header format")
 	}
 
-	token := parts[1]
+	return parts[1], nil
+}
 
-	req := &pbgen.TokenValidateRequest{
-		Token: token,
+func (h authHandler) AuthTokenBaseValidate(c *fiber.Ctx) error {
+	token, err := bearerToken(c)
+	if err != nil {
+		return problem.Respond(c, fiber.StatusUnauthorized, err.Error())
 	}
 
-	res, err := h.grpcAuthSvc.AuthTokenValidate(c.UserContext(), req)
+	res, err := h.verifier.Verify(c.UserContext(), token)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(
-			fiber.Map{
-				"error": err.Error(),
-			},
-		)
-	}
+		if !errors.Is(err, tokverify.ErrTokenInvalid) &&
+			!errors.Is(err, tokverify.ErrTokenExperied) &&
+			!errors.Is(err, tokverify.ErrUnknownKeyID) &&
+			!errors.Is(err, tokverify.ErrSessionRevoked) {
+			// the keys or the revocation list could not be read
+			log.Printf("verify token: %v", err)
+			return problem.Respond(c, fiber.StatusInternalServerError, "token could not be verified")
+		}
 
-	if !res.Valid {
-		return c.Status(fiber.StatusUnauthorized).JSON(
-			fiber.Map{
-				"error": res.Msg,
-			},
-		)
+		return problem.Respond(c, fiber.StatusUnauthorized, err.Error())
 	}
 
-	c.Locals("user_subject", *res.Subject)
-	c.Locals("user_isuer", *res.Isuer)
-	c.Locals("user_experied", res.ExpiresAt.AsTime().Format(time.RFC3339))
+	c.Locals("user_subject", res.Subject)
+	c.Locals("user_isuer", res.Issuer)
+	c.Locals("user_experied", res.Expiration.Format(time.RFC3339))
+	c.Locals("user_session", res.Jti)
+	c.Locals("user_verified", res.Get("verified") == "true")
+
+	return c.Next()
+}
+
+// RequireVerified must run after AuthTokenBaseValidate.
+func (h authHandler) RequireVerified(c *fiber.Ctx) error {
+	verified, _ := c.Locals("user_verified").(bool)
+	if !verified {
+		return problem.Respond(c, fiber.StatusForbidden, "email is not verified")
+	}
 
 	return c.Next()
 }
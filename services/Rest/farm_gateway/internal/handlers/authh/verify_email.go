@@ -0,0 +1,24 @@
+package authh
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/pbgen"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/problem"
+)
+
+func (h authHandler) VerifyEmail(c *fiber.Ctx) error {
+	token := c.Query("token")
+	if token == "" {
+		return problem.Respond(c, fiber.StatusBadRequest, "verification token is required")
+	}
+
+	res, err := h.grpcAuthSvc.AuthVerifyEmail(c.UserContext(), &pbgen.VerifyEmailRequest{Token: token})
+	if err != nil {
+		return problem.GRPC(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"status": res.Status,
+		"msg":    res.Msg,
+	})
+}
@@ -0,0 +1,51 @@
+package farmerh
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/models"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/pbgen"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/problem"
+)
+
+// GetLogins lists the most recent sign-ins of the calling farmer. The
+// optional limit query parameter is capped by the farmer service.
+func (h farmerHandler) GetLogins(c *fiber.Ctx) error {
+	localID := c.Locals("user_subject")
+
+	id, ok := localID.(string)
+	if !ok {
+		return problem.Respond(c, fiber.StatusInternalServerError, "id is not string")
+	}
+
+	limit := c.QueryInt("limit", 0)
+	if limit < 0 {
+		return problem.Respond(c, fiber.StatusBadRequest, "limit must not be negative")
+	}
+
+	req := &pbgen.ListLoginsRequest{
+		Id:    id,
+		Limit: int32(limit),
+	}
+
+	res, err := h.grpcFarmerSvc.ListLogins(c.UserContext(), req)
+	if err != nil {
+		return problem.GRPC(c, err)
+	}
+
+	logins := make([]models.UserLogin, 0, len(res.Logins))
+	for _, l := range res.Logins {
+		logins = append(logins, models.UserLogin{
+			ID:        l.Id,
+			IpAddress: l.IpAddress,
+			UserAgent: l.UserAgent,
+			Outcome:   l.Outcome,
+			LoggedAt:  l.LoggedAt.AsTime().Format(time.RFC3339),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"logins": logins,
+	})
+}
@@ -1,12 +1,12 @@
 package farmerh
 
 import (
-	"errors"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/models"
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/pbgen"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/problem"
 )
 
 func (h farmerHandler) GetFarmerProfile(c *fiber.Ctx) error {
@@ -14,11 +14,7 @@ func (h farmerHandler) GetFarmerProfile(c *fiber.Ctx) error {
 
 	id, ok := localID.(string)
 	if !ok {
-		return c.Status(fiber.StatusInternalServerError).JSON(
-			fiber.Map{
-				"error": errors.New("id is not string"),
-			},
-		)
+		return problem.Respond(c, fiber.StatusInternalServerError, "id is not string")
 	}
 
 	req := &pbgen.FarmerProfileRequest{
@@ -27,11 +23,7 @@ func (h farmerHandler) GetFarmerProfile(c *fiber.Ctx) error {
 
 	res, err := h.grpcFarmerSvc.FarmerProfile(c.UserContext(), req)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(
-			fiber.Map{
-				"error": err.Error(),
-			},
-		)
+		return problem.GRPC(c, err)
 	}
 
 	user := models.Users{
@@ -1,11 +1,10 @@
 package farmerh
 
 import (
-	"errors"
-
 	"github.com/gofiber/fiber/v2"
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/models"
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/pbgen"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/problem"
 )
 
 func (h farmerHandler) UpdateUsers(c *fiber.Ctx) error {
@@ -14,20 +13,12 @@ func (h farmerHandler) UpdateUsers(c *fiber.Ctx) error {
 	localID := c.Locals("user_subject")
 	id, ok := localID.(string)
 	if !ok {
-		return c.Status(fiber.StatusInternalServerError).JSON(
-			fiber.Map{
-				"error": errors.New("id is not string"),
-			},
-		)
+		return problem.Respond(c, fiber.StatusInternalServerError, "id is not string")
 	}
 
 	err := c.BodyParser(&user)
 	if err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(
-			fiber.Map{
-				"error": err.Error(),
-			},
-		)
+		return problem.Respond(c, fiber.StatusBadRequest, err.Error())
 	}
 
 	req := &pbgen.UpdateFarmerProfileRequest{
@@ -39,11 +30,7 @@ func (h farmerHandler) UpdateUsers(c *fiber.Ctx) error {
 
 	res, err := h.grpcFarmerSvc.ProfileFarmerUpdate(c.UserContext(), req)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(
-			fiber.Map{
-				"error": err.Error(),
-			},
-		)
+		return problem.GRPC(c, err)
 	}
 
 	return c.JSON(fiber.Map{
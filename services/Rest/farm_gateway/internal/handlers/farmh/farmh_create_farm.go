@@ -1,21 +1,16 @@
 package farmh
 
 import (
-	"errors"
-
 	"github.com/gofiber/fiber/v2"
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/models"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/problem"
 )
 
 func (fh farmHandler) CreateFarm(c *fiber.Ctx) error {
 	farmerID := c.Locals("user_subject")
 	id, ok := farmerID.(string)
 	if !ok {
-		return c.Status(fiber.StatusInternalServerError).JSON(
-			fiber.Map{
-				"error": errors.New("id is not string"),
-			},
-		)
+		return problem.Respond(c, fiber.StatusInternalServerError, "id is not string")
 	}
 
 	farm := struct {
@@ -23,11 +18,7 @@ func (fh farmHandler) CreateFarm(c *fiber.Ctx) error {
 	}{}
 
 	if err := c.BodyParser(&farm); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(
-			fiber.Map{
-				"error": err.Error(),
-			},
-		)
+		return problem.Respond(c, fiber.StatusBadRequest, err.Error())
 	}
 
 	for i := range farm.Data {
@@ -36,11 +27,7 @@ func (fh farmHandler) CreateFarm(c *fiber.Ctx) error {
 
 	res, err := fh.grpcFarmSvc.CreateFarm(c.UserContext(), farm.Data)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(
-			fiber.Map{
-				"error": err.Error(),
-			},
-		)
+		return problem.GRPC(c, err)
 	}
 
 	return c.JSON(fiber.Map{
@@ -0,0 +1,30 @@
+package farmh
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/problem"
+)
+
+func (fh farmHandler) DeleteFarm(c *fiber.Ctx) error {
+	farmerID := c.Locals("user_subject")
+	id, ok := farmerID.(string)
+	if !ok {
+		return problem.Respond(c, fiber.StatusInternalServerError, "id is not string")
+	}
+
+	farmID := c.Params("id")
+	if farmID == "" {
+		return problem.Respond(c, fiber.StatusBadRequest, "farm id is required")
+	}
+
+	res, err := fh.grpcFarmSvc.DeleteFarm(c.UserContext(), farmID, id)
+	if err != nil {
+		return problem.GRPC(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"data":   res,
+		"status": "Success",
+		"msg":    "Delete Farm Done",
+	})
+}
@@ -1,21 +1,16 @@
 package farmh
 
 import (
-	"errors"
-
 	"github.com/gofiber/fiber/v2"
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/models"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/problem"
 )
 
 func (fh farmHandler) GetFarmByID(c *fiber.Ctx) error {
 	farmerID := c.Locals("user_subject")
 	id, ok := farmerID.(string)
 	if !ok {
-		return c.Status(fiber.StatusInternalServerError).JSON(
-			fiber.Map{
-				"error": errors.New("id is not string"),
-			},
-		)
+		return problem.Respond(c, fiber.StatusInternalServerError, "id is not string")
 	}
 
 	req := struct {
@@ -23,20 +18,12 @@ func (fh farmHandler) GetFarmByID(c *fiber.Ctx) error {
 	}{}
 
 	if err := c.BodyParser(&req); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(
-			fiber.Map{
-				"error": err.Error(),
-			},
-		)
+		return problem.Respond(c, fiber.StatusBadRequest, err.Error())
 	}
 
 	res, err := fh.grpcFarmSvc.GetFarmByID(c.UserContext(), req.ID, id)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(
-			fiber.Map{
-				"error": errors.New("id is not string"),
-			},
-		)
+		return problem.GRPC(c, err)
 	}
 
 	return c.JSON(res)
@@ -46,30 +33,18 @@ func (fh farmHandler) GetFarms(c *fiber.Ctx) error {
 	farmerID := c.Locals("user_subject")
 	id, ok := farmerID.(string)
 	if !ok {
-		return c.Status(fiber.StatusInternalServerError).JSON(
-			fiber.Map{
-				"error": errors.New("id is not string"),
-			},
-		)
+		return problem.Respond(c, fiber.StatusInternalServerError, "id is not string")
 	}
 
 	var req models.GetFarmsRequest
 
 	if err := c.BodyParser(&req); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(
-			fiber.Map{
-				"error": err.Error(),
-			},
-		)
+		return problem.Respond(c, fiber.StatusBadRequest, err.Error())
 	}
 
 	res, err := fh.grpcFarmSvc.GetFarms(c.UserContext(), id, req)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(
-			fiber.Map{
-				"error": err.Error(),
-			},
-		)
+		return problem.GRPC(c, err)
 	}
 
 	return c.JSON(res)
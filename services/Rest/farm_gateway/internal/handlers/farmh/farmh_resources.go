@@ -0,0 +1,238 @@
+package farmh
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/models"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/problem"
+)
+
+// the handlers below serve the /v1/farms resource routes, the farm id comes
+// from the path and list options from the query string
+
+func subjectErrorResponse(c *fiber.Ctx) error {
+	return problem.Respond(c, fiber.StatusInternalServerError, "id is not string")
+}
+
+func (fh farmHandler) ListFarms(c *fiber.Ctx) error {
+	id, ok := c.Locals("user_subject").(string)
+	if !ok {
+		return subjectErrorResponse(c)
+	}
+
+	limit := c.QueryInt("limit", 0)
+	offset := c.QueryInt("offset", 0)
+	if limit < 0 || offset < 0 {
+		return problem.Respond(c, fiber.StatusBadRequest, "limit and offset must not be negative")
+	}
+
+	sortBy, ok := models.ParseFarmSortField(c.Query("sort_by"))
+	if !ok {
+		return problem.Respond(c, fiber.StatusBadRequest, "sort_by must be created_at, name, size or updated_at")
+	}
+
+	filter, err := farmListFilter(c)
+	if err != nil {
+		return problem.Respond(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	req := models.GetFarmsRequest{
+		SearchName:   c.Query("search_name"),
+		SortOrder:    models.SortOrderUnknown.StringToSortOrder(c.Query("sort_order")),
+		Limit:        limit,
+		Offset:       offset,
+		PageToken:    c.Query("page_token"),
+		IncludeTotal: c.QueryBool("include_total"),
+		SortBy:       sortBy,
+		Filter:       filter,
+	}
+
+	if format := c.Query("stream"); format != "" {
+		return fh.streamFarms(c, id, req, format)
+	}
+
+	res, err := fh.grpcFarmSvc.GetFarms(c.UserContext(), id, req)
+	if err != nil {
+		return problem.GRPC(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+// farmListFilter reads the list filters of the query string, the types and
+// statuses are comma separated and the dates are RFC 3339.
+func farmListFilter(c *fiber.Ctx) (filter models.FarmListFilter, _ error) {
+	filter.FarmTypes = queryList(c, "farm_type")
+	filter.FarmStatuses = queryList(c, "farm_status")
+	filter.Province = c.Query("province")
+	filter.City = c.Query("city")
+	filter.PostalCode = c.Query("postal_code")
+
+	sizes := []struct {
+		key string
+		dst **float64
+	}{
+		{"min_farm_size", &filter.MinFarmSize},
+		{"max_farm_size", &filter.MaxFarmSize},
+	}
+	for _, size := range sizes {
+		key, dst := size.key, size.dst
+		raw := c.Query(key)
+		if raw == "" {
+			continue
+		}
+
+		v, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return filter, fmt.Errorf("%s must be a number", key)
+		}
+		*dst = &v
+	}
+
+	dates := []struct {
+		key string
+		dst **time.Time
+	}{
+		{"created_from", &filter.CreatedFrom},
+		{"created_to", &filter.CreatedTo},
+		{"updated_from", &filter.UpdatedFrom},
+		{"updated_to", &filter.UpdatedTo},
+	}
+	for _, date := range dates {
+		key, dst := date.key, date.dst
+		raw := c.Query(key)
+		if raw == "" {
+			continue
+		}
+
+		v, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return filter, fmt.Errorf("%s must be an RFC 3339 date time", key)
+		}
+		*dst = &v
+	}
+
+	return filter, nil
+}
+
+// queryList reads a comma separated list of enum values, upper-cased.
+func queryList(c *fiber.Ctx, key string) []string {
+	vals := queryValues(c, key)
+	for i, v := range vals {
+		vals[i] = strings.ToUpper(v)
+	}
+
+	return vals
+}
+
+func queryValues(c *fiber.Ctx, key string) []string {
+	raw := c.Query(key)
+	if raw == "" {
+		return nil
+	}
+
+	var vals []string
+	for _, v := range strings.Split(raw, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			vals = append(vals, v)
+		}
+	}
+
+	return vals
+}
+
+func (fh farmHandler) GetFarm(c *fiber.Ctx) error {
+	id, ok := c.Locals("user_subject").(string)
+	if !ok {
+		return subjectErrorResponse(c)
+	}
+
+	res, err := fh.grpcFarmSvc.GetFarmByID(c.UserContext(), c.Params("id"), id)
+	if err != nil {
+		return problem.GRPC(c, err)
+	}
+
+	return c.JSON(res)
+}
+
+func (fh farmHandler) PostFarm(c *fiber.Ctx) error {
+	id, ok := c.Locals("user_subject").(string)
+	if !ok {
+		return subjectErrorResponse(c)
+	}
+
+	var farm models.CreateFarm
+	if err := c.BodyParser(&farm); err != nil {
+		return problem.Respond(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	farm.FarmerID = id
+
+	res, err := fh.grpcFarmSvc.CreateFarm(c.UserContext(), []models.CreateFarm{farm})
+	if err != nil {
+		return problem.GRPC(c, err)
+	}
+
+	if len(res) == 0 {
+		return problem.Respond(c, fiber.StatusInternalServerError, "farm service returned no result")
+	}
+
+	c.Location("/v1/farms/" + res[0].GetFarmId())
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"data":   res[0],
+		"status": "Success",
+		"msg":    "Create Farm Done",
+	})
+}
+
+func (fh farmHandler) PatchFarm(c *fiber.Ctx) error {
+	id, ok := c.Locals("user_subject").(string)
+	if !ok {
+		return subjectErrorResponse(c)
+	}
+
+	var patch struct {
+		Farm    *models.UpdateFarm     `json:"farm"`
+		Address *models.UpdateFarmAddr `json:"address"`
+	}
+
+	if err := c.BodyParser(&patch); err != nil {
+		return problem.Respond(c, fiber.StatusBadRequest, err.Error())
+	}
+
+	if patch.Farm == nil && patch.Address == nil {
+		return problem.Respond(c, fiber.StatusBadRequest, "farm or address is required")
+	}
+
+	farmID := c.Params("id")
+	if patch.Farm != nil {
+		patch.Farm.ID = farmID
+	}
+
+	// the address is addressed through its farm, look its id up when the
+	// client did not send it
+	if patch.Address != nil && patch.Address.ID == "" {
+		farm, err := fh.grpcFarmSvc.GetFarmByID(c.UserContext(), farmID, id)
+		if err != nil {
+			return problem.GRPC(c, err)
+		}
+		patch.Address.ID = farm.Addresses.ID
+	}
+
+	res, err := fh.grpcFarmSvc.UpdateFarmOrAddress(c.UserContext(), id, []models.UpdateFarmWithAddr{
+		{Farm: patch.Farm, Address: patch.Address},
+	})
+	if err != nil {
+		return problem.GRPC(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"data":   res,
+		"status": "Success",
+		"msg":    "Update Farm Done",
+	})
+}
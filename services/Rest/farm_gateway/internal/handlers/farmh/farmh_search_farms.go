@@ -0,0 +1,38 @@
+package farmh
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/models"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/problem"
+)
+
+// SearchFarms serves GET /v1/farms/search, q matches the farm name,
+// description and address with prefixes and typos tolerated.
+func (fh farmHandler) SearchFarms(c *fiber.Ctx) error {
+	id, ok := c.Locals("user_subject").(string)
+	if !ok {
+		return subjectErrorResponse(c)
+	}
+
+	limit := c.QueryInt("limit", 0)
+	offset := c.QueryInt("offset", 0)
+	if limit < 0 || offset < 0 {
+		return problem.Respond(c, fiber.StatusBadRequest, "limit and offset must not be negative")
+	}
+
+	req := models.SearchFarmsRequest{
+		Query:        c.Query("q"),
+		FarmTypes:    queryList(c, "farm_type"),
+		FarmStatuses: queryList(c, "farm_status"),
+		Provinces:    queryValues(c, "province"),
+		Limit:        limit,
+		Offset:       offset,
+	}
+
+	res, err := fh.grpcFarmSvc.SearchFarms(c.UserContext(), id, req)
+	if err != nil {
+		return problem.GRPC(c, err)
+	}
+
+	return c.JSON(res)
+}
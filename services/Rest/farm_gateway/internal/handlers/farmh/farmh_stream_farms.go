@@ -0,0 +1,130 @@
+package farmh
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/models"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/problem"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	streamNDJSON = "ndjson"
+	streamSSE    = "sse"
+
+	mimeApplicationNDJSON = "application/x-ndjson"
+	mimeTextEventStream   = "text/event-stream"
+)
+
+// farmListWriter writes one message of the streamed list, event names the
+// kind of message: total, farm, page or error.
+type farmListWriter func(w *bufio.Writer, event string, v any) error
+
+func writeNDJSON(w *bufio.Writer, event string, v any) error {
+	if event == "error" {
+		v = fiber.Map{"error": v}
+	}
+
+	line, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	line = append(line, '\n')
+	_, err = w.Write(line)
+	return err
+}
+
+func writeSSE(w *bufio.Writer, event string, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data)
+	return err
+}
+
+// streamFarms pipes the farm list to the client while the farm service is
+// still sending it, flushing after every farm.
+func (fh farmHandler) streamFarms(
+	c *fiber.Ctx,
+	farmerID string,
+	req models.GetFarmsRequest,
+	format string,
+) error {
+	var write farmListWriter
+	var contentType string
+	switch format {
+	case streamNDJSON:
+		write, contentType = writeNDJSON, mimeApplicationNDJSON
+	case streamSSE:
+		write, contentType = writeSSE, mimeTextEventStream
+	default:
+		return problem.Respond(c, fiber.StatusBadRequest, "stream must be ndjson or sse")
+	}
+
+	// the body is written after the handler returned, so the upstream stream
+	// gets its own context which is canceled once the client is gone
+	ctx, cancel := context.WithCancel(c.UserContext())
+
+	stream, err := fh.grpcFarmSvc.StreamFarms(ctx, farmerID, req)
+	if err != nil {
+		cancel()
+		return problem.GRPC(c, err)
+	}
+
+	// a rejected request fails on the first message, which can still be
+	// answered with a problem
+	first, firstErr := stream.Recv()
+	if firstErr != nil && firstErr != io.EOF {
+		cancel()
+		return problem.GRPC(c, firstErr)
+	}
+
+	c.Set(fiber.HeaderContentType, contentType)
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	// keep proxies such as nginx from buffering the stream
+	c.Set("X-Accel-Buffering", "no")
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer cancel()
+
+		msg, err := first, firstErr
+		for ; err == nil; msg, err = stream.Recv() {
+			event := "farm"
+			switch {
+			case msg.Total != nil:
+				event = "total"
+			case msg.NextPageToken != "":
+				event = "page"
+			}
+
+			if err := write(w, event, msg); err != nil {
+				return
+			}
+
+			// a failed flush means the client disconnected
+			if err := w.Flush(); err != nil {
+				return
+			}
+		}
+
+		if err == io.EOF || ctx.Err() != nil {
+			return
+		}
+
+		// the status line is sent already, the error becomes the last message
+		p := problem.FromStatus(status.Convert(err))
+		if write(w, "error", p) == nil {
+			w.Flush()
+		}
+	})
+
+	return nil
+}
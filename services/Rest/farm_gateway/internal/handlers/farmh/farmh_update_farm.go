@@ -3,28 +3,27 @@ package farmh
 import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/models"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/problem"
 )
 
 func (fh farmHandler) UpdateFarm(c *fiber.Ctx) error {
+	farmerID := c.Locals("user_subject")
+	id, ok := farmerID.(string)
+	if !ok {
+		return problem.Respond(c, fiber.StatusInternalServerError, "id is not string")
+	}
+
 	farmWithAddr := struct {
 		Data []models.UpdateFarmWithAddr `json:"data"`
 	}{}
 
 	if err := c.BodyParser(&farmWithAddr); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(
-			fiber.Map{
-				"error": err.Error(),
-			},
-		)
+		return problem.Respond(c, fiber.StatusBadRequest, err.Error())
 	}
 
-	res, err := fh.grpcFarmSvc.UpdateFarmOrAddress(c.UserContext(), farmWithAddr.Data)
+	res, err := fh.grpcFarmSvc.UpdateFarmOrAddress(c.UserContext(), id, farmWithAddr.Data)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(
-			fiber.Map{
-				"error": err.Error(),
-			},
-		)
+		return problem.GRPC(c, err)
 	}
 
 	return c.JSON(fiber.Map{
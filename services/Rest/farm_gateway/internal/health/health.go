@@ -0,0 +1,103 @@
+// Package health answers the liveness and readiness probes of the gateway,
+// the gateway is ready while the gRPC services behind it report serving.
+package health
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/problem"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+const DefaultTimeout = 2 * time.Second
+
+// Downstream is a gRPC service the routes of the gateway depend on.
+type Downstream struct {
+	Name   string
+	Client grpc_health_v1.HealthClient
+}
+
+type Readiness struct {
+	downstreams []Downstream
+	timeout     time.Duration
+}
+
+// NewReadiness checks every downstream within timeout, DefaultTimeout when
+// it is not positive.
+func NewReadiness(timeout time.Duration, downstreams ...Downstream) *Readiness {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	return &Readiness{downstreams: downstreams, timeout: timeout}
+}
+
+// Check asks every downstream for the status of the server as a whole and
+// answers the status per downstream. A downstream that cannot be reached
+// answers with the code of the failed call, e.g. Unavailable.
+func (r *Readiness) Check(ctx context.Context) (statuses map[string]string, ready bool) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	statuses = make(map[string]string, len(r.downstreams))
+	ready = true
+	for _, d := range r.downstreams {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			res, err := d.Client.Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+
+			servingStatus := res.GetStatus().String()
+			if err != nil {
+				servingStatus = status.Code(err).String()
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			statuses[d.Name] = servingStatus
+			if err != nil || res.GetStatus() != grpc_health_v1.HealthCheckResponse_SERVING {
+				ready = false
+			}
+		}()
+	}
+	wg.Wait()
+
+	return statuses, ready
+}
+
+// Live answers the liveness probe, the gateway is alive while it answers.
+func Live(c *fiber.Ctx) error {
+	return c.JSON(fiber.Map{"status": "ok"})
+}
+
+// Ready answers the readiness probe, a problem listing the status of every
+// downstream when one of them is not serving.
+func (r *Readiness) Ready(c *fiber.Ctx) error {
+	statuses, ready := r.Check(c.UserContext())
+	if ready {
+		return c.JSON(fiber.Map{"status": "ready", "services": statuses})
+	}
+
+	var down []string
+	for name, st := range statuses {
+		if st != grpc_health_v1.HealthCheckResponse_SERVING.String() {
+			down = append(down, name)
+		}
+	}
+	sort.Strings(down)
+
+	p := problem.New(http.StatusServiceUnavailable, "not serving: "+strings.Join(down, ", "))
+	p.Metadata = statuses
+	return problem.Send(c, p)
+}
@@ -0,0 +1,120 @@
+// Package idempotency lets clients retry mutating requests safely. A request
+// carrying an Idempotency-Key is processed once, retries with the same key
+// replay the stored response until the key expires.
+package idempotency
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/problem"
+)
+
+const (
+	Header         = "Idempotency-Key"
+	ReplayedHeader = "Idempotent-Replayed"
+
+	maxKeyLength = 255
+)
+
+// Middleware must run after the token validation, keys are scoped to the
+// caller so two accounts can not read each other's responses. A key is
+// locked for lockTTL while its request is processed, so a gateway that dies
+// before the response is stored blocks the retries only that long. The
+// response is kept for ttl.
+func Middleware(store Store, lockTTL time.Duration, ttl time.Duration) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		key := c.Get(Header)
+		if key == "" {
+			return c.Next()
+		}
+
+		if len(key) > maxKeyLength {
+			return problem.Respond(c, fiber.StatusBadRequest, "Idempotency-Key must be at most 255 characters")
+		}
+
+		subject, _ := c.Locals("user_subject").(string)
+
+		ctx := c.UserContext()
+		scopedKey := subject + ":" + key
+		hash := requestHash(c)
+
+		stored, reserved, err := store.Reserve(ctx, scopedKey, Record{Hash: hash}, lockTTL)
+		if err != nil {
+			log.Printf("idempotency: reserve key of %s: %v", c.Path(), err)
+			return problem.Respond(c, fiber.StatusServiceUnavailable, "idempotency store unavailable")
+		}
+
+		if !reserved {
+			return replay(c, hash, stored)
+		}
+
+		if err := c.Next(); err != nil {
+			release(c, store, scopedKey)
+			return err
+		}
+
+		res := c.Response()
+		if !storable(res.StatusCode()) {
+			release(c, store, scopedKey)
+			return nil
+		}
+
+		rec := Record{
+			Hash:        hash,
+			Done:        true,
+			Status:      res.StatusCode(),
+			ContentType: string(res.Header.ContentType()),
+			Body:        append([]byte(nil), res.Body()...),
+		}
+		if err := store.Complete(ctx, scopedKey, rec, ttl); err != nil {
+			log.Printf("idempotency: store response of %s: %v", c.Path(), err)
+		}
+
+		return nil
+	}
+}
+
+func replay(c *fiber.Ctx, hash string, stored Record) error {
+	if stored.Hash != hash {
+		return problem.Respond(c, fiber.StatusUnprocessableEntity, "Idempotency-Key was already used for a different request")
+	}
+
+	if !stored.Done {
+		return problem.Respond(c, fiber.StatusConflict, "a request with this Idempotency-Key is still being processed")
+	}
+
+	c.Set(ReplayedHeader, "true")
+	c.Set(fiber.HeaderContentType, stored.ContentType)
+	return c.Status(stored.Status).Send(stored.Body)
+}
+
+func release(c *fiber.Ctx, store Store, key string) {
+	if err := store.Release(c.UserContext(), key); err != nil {
+		log.Printf("idempotency: release key of %s: %v", c.Path(), err)
+	}
+}
+
+// storable leaves out the responses a retry may change: server errors and
+// throttling.
+func storable(status int) bool {
+	return status < http.StatusInternalServerError && status != http.StatusTooManyRequests
+}
+
+// requestHash tells a retry from another request sent with the same key, the
+// query string is part of the request, e.g. ?dry_run=true.
+func requestHash(c *fiber.Ctx) string {
+	h := sha256.New()
+	h.Write([]byte(c.Method()))
+	h.Write([]byte{0})
+	h.Write([]byte(c.Path()))
+	h.Write([]byte{0})
+	h.Write(c.Request().URI().QueryString())
+	h.Write([]byte{0})
+	h.Write(c.Body())
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,84 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/sony-nurdianto/farm/shared_lib/Go/database/redis"
+)
+
+const keyPrefix = "idempotency:"
+
+// Record is what is kept for an Idempotency-Key. It is pending from the
+// first request until its response is stored.
+type Record struct {
+	Hash        string `json:"hash"`
+	Done        bool   `json:"done"`
+	Status      int    `json:"status,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        []byte `json:"body,omitempty"`
+}
+
+//go:generate mockgen -source=store.go -destination=../../test/mocks/mock_idempotency_store.go -package=mocks
+type Store interface {
+	// Reserve stores rec for ttl unless key is taken, in which case the
+	// record already stored is returned and reserved is false.
+	Reserve(ctx context.Context, key string, rec Record, ttl time.Duration) (stored Record, reserved bool, _ error)
+	// Complete replaces the pending record with the final response, which
+	// is kept for ttl.
+	Complete(ctx context.Context, key string, rec Record, ttl time.Duration) error
+	// Release forgets key so the request can be retried.
+	Release(ctx context.Context, key string) error
+}
+
+type redisStore struct {
+	rdb redis.RedisClient
+}
+
+func NewRedisStore(rdb redis.RedisClient) redisStore {
+	return redisStore{rdb: rdb}
+}
+
+func (s redisStore) Reserve(
+	ctx context.Context, key string, rec Record, ttl time.Duration,
+) (stored Record, reserved bool, _ error) {
+	value, err := json.Marshal(rec)
+	if err != nil {
+		return stored, false, err
+	}
+
+	// the stored record can expire between SetNX and Get, so try once more
+	for range 2 {
+		reserved, err = s.rdb.SetNX(ctx, keyPrefix+key, value, ttl).Result()
+		if err != nil || reserved {
+			return stored, reserved, err
+		}
+
+		raw, err := s.rdb.Get(ctx, keyPrefix+key).Bytes()
+		if errors.Is(err, redis.RedisNil) {
+			continue
+		}
+		if err != nil {
+			return stored, false, err
+		}
+
+		return stored, false, json.Unmarshal(raw, &stored)
+	}
+
+	return stored, false, errors.New("idempotency key expired while it was read")
+}
+
+func (s redisStore) Complete(ctx context.Context, key string, rec Record, ttl time.Duration) error {
+	value, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+
+	return s.rdb.SetXX(ctx, keyPrefix+key, value, ttl).Err()
+}
+
+func (s redisStore) Release(ctx context.Context, key string) error {
+	return s.rdb.Del(ctx, keyPrefix+key).Err()
+}
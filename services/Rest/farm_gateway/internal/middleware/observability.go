@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"time"
@@ -28,6 +29,8 @@ type observabilityMiddleware struct {
 
 	concurrentRequests metric.Int64UpDownCounter
 	requestRate        metric.Float64Counter
+
+	rateLimitCount metric.Int64Counter
 }
 
 func NewObservabilityMiddleware(
@@ -51,6 +54,10 @@ func NewObservabilityMiddleware(
 		"http_server_request_rate",
 		metric.WithDescription("HTTP request rate per second"),
 	)
+	rateLimitCount, _ := meter.Int64Counter(
+		"http_server_rate_limit_total",
+		metric.WithDescription("Rate limited HTTP requests by route group and outcome"),
+	)
 
 	return observabilityMiddleware{
 		traceProvider: tp,
@@ -66,6 +73,8 @@ func NewObservabilityMiddleware(
 
 		concurrentRequests: concurrentRequests,
 		requestRate:        requestRate,
+
+		rateLimitCount: rateLimitCount,
 	}
 }
 
@@ -176,3 +185,12 @@ func getErrorType(statusCode int) string {
 		return "unknown"
 	}
 }
+
+// RecordRateLimit counts a request that went through the rate limiter of
+// group, outcome is allowed, limited or error.
+func (om observabilityMiddleware) RecordRateLimit(ctx context.Context, group string, outcome string) {
+	om.rateLimitCount.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("ratelimit.group", group),
+		attribute.String("ratelimit.outcome", outcome),
+	))
+}
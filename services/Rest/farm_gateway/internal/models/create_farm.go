@@ -17,4 +17,6 @@ type CreateFarm struct {
 	FarmStatus  string  `json:"farm_status"`
 	Description string  `json:"description"`
 	Address     CreateFarmAddress
+	// ClientRequestID makes retrying this one item safe inside a bulk create.
+	ClientRequestID string `json:"client_request_id"`
 }
@@ -72,6 +72,47 @@ func (so SortOrder) ProtoSortOrderFactory(val pbgen.SortOrder) SortOrder {
 	}
 }
 
+// FarmSortField is the column a farm list is ordered by, the zero value
+// orders by created_at.
+type FarmSortField int
+
+const (
+	FarmSortCreatedAt FarmSortField = iota
+	FarmSortName
+	FarmSortSize
+	FarmSortUpdatedAt
+)
+
+// ParseFarmSortField reads the sort_by query value, ok is false for an
+// unknown field.
+func ParseFarmSortField(val string) (_ FarmSortField, ok bool) {
+	switch strings.ToLower(val) {
+	case "", "created_at":
+		return FarmSortCreatedAt, true
+	case "name", "farm_name":
+		return FarmSortName, true
+	case "size", "farm_size":
+		return FarmSortSize, true
+	case "updated_at":
+		return FarmSortUpdatedAt, true
+	default:
+		return FarmSortCreatedAt, false
+	}
+}
+
+func (sf FarmSortField) ProtoSortField() pbgen.FarmSortField {
+	switch sf {
+	case FarmSortName:
+		return pbgen.FarmSortField_FARM_SORT_FIELD_NAME
+	case FarmSortSize:
+		return pbgen.FarmSortField_FARM_SORT_FIELD_SIZE
+	case FarmSortUpdatedAt:
+		return pbgen.FarmSortField_FARM_SORT_FIELD_UPDATED_AT
+	default:
+		return pbgen.FarmSortField_FARM_SORT_FIELD_CREATED_AT
+	}
+}
+
 type FarmAddress struct {
 	ID          string `json:"id"`
 	Street      string `json:"street"`
@@ -100,9 +141,40 @@ type GetFarmsRequest struct {
 	SortOrder  SortOrder `json:"sort_order"`
 	Limit      int       `json:"limit"`
 	Offset     int       `json:"offset"`
+	// PageToken continues after a previous page, Offset is ignored with it.
+	PageToken    string         `json:"page_token"`
+	IncludeTotal bool           `json:"include_total"`
+	SortBy       FarmSortField  `json:"sort_by"`
+	Filter       FarmListFilter `json:"filter"`
+}
+
+// FarmListFilter narrows a farm list, the filters that are set must all
+// match and the ranges include their bounds.
+type FarmListFilter struct {
+	FarmTypes    []string   `json:"farm_types"`
+	FarmStatuses []string   `json:"farm_statuses"`
+	Province     string     `json:"province"`
+	City         string     `json:"city"`
+	PostalCode   string     `json:"postal_code"`
+	MinFarmSize  *float64   `json:"min_farm_size"`
+	MaxFarmSize  *float64   `json:"max_farm_size"`
+	CreatedFrom  *time.Time `json:"created_from"`
+	CreatedTo    *time.Time `json:"created_to"`
+	UpdatedFrom  *time.Time `json:"updated_from"`
+	UpdatedTo    *time.Time `json:"updated_to"`
 }
 
 type GetFarmsResponse struct {
-	Data  []Farm
-	Total int `json:"total"`
+	Data          []Farm
+	Total         int    `json:"total"`
+	NextPageToken string `json:"next_page_token,omitempty"`
+}
+
+// FarmListMessage is one message of a streamed farm list, the total comes
+// first and every message after it carries one farm. A full page ends with
+// the token of the next one.
+type FarmListMessage struct {
+	Total         *int   `json:"total,omitempty"`
+	Farm          *Farm  `json:"farm,omitempty"`
+	NextPageToken string `json:"next_page_token,omitempty"`
 }
@@ -0,0 +1,31 @@
+package models
+
+// SearchFarmsRequest searches the farms of the caller by name, description
+// and address, the filters narrow the matches and the facets alike.
+type SearchFarmsRequest struct {
+	Query        string   `json:"q"`
+	FarmTypes    []string `json:"farm_types"`
+	FarmStatuses []string `json:"farm_statuses"`
+	Provinces    []string `json:"provinces"`
+	Limit        int      `json:"limit"`
+	Offset       int      `json:"offset"`
+}
+
+type FacetCount struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+type FarmFacets struct {
+	FarmTypes    []FacetCount `json:"farm_types"`
+	FarmStatuses []FacetCount `json:"farm_statuses"`
+	Provinces    []FacetCount `json:"provinces"`
+}
+
+// SearchFarmsResponse holds a page of the matching farms, Total and the
+// facets count every match.
+type SearchFarmsResponse struct {
+	Data   []Farm     `json:"data"`
+	Total  int        `json:"total"`
+	Facets FarmFacets `json:"facets"`
+}
@@ -0,0 +1,9 @@
+package models
+
+type UserLogin struct {
+	ID        string `json:"id"`
+	IpAddress string `json:"ip_address"`
+	UserAgent string `json:"user_agent"`
+	Outcome   string `json:"outcome"`
+	LoggedAt  string `json:"logged_at"`
+}
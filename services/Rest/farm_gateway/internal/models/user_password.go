@@ -0,0 +1,15 @@
+package models
+
+type UserForgotPassword struct {
+	Email string `json:"email"`
+}
+
+type UserResetPassword struct {
+	Token       string `json:"token"`
+	NewPassword string `json:"new_password"`
+}
+
+type UserChangePassword struct {
+	CurrentPassword string `json:"current_password"`
+	NewPassword     string `json:"new_password"`
+}
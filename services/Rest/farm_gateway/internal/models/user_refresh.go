@@ -0,0 +1,5 @@
+package models
+
+type UserRefreshToken struct {
+	RefreshToken string `json:"refresh_token"`
+}
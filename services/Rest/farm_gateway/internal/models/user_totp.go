@@ -0,0 +1,15 @@
+package models
+
+type UserConfirmTOTP struct {
+	Code string `json:"code"`
+}
+
+type UserDisableTOTP struct {
+	Password string `json:"password"`
+	Code     string `json:"code"`
+}
+
+type UserVerifySecondFactor struct {
+	ChallengeToken string `json:"challenge_token"`
+	Code           string `json:"code"`
+}
@@ -1,8 +1,18 @@
 package models
 
+import "time"
+
 type UserRegister struct {
 	FullName    string `json:"full_name"`
 	PhoneNumber string `json:"phone_number"`
 	Email       string `json:"email"`
 	Password    string `json:"password"`
 }
+
+type RegistrationStatus struct {
+	UserId        string    `json:"user_id"`
+	Status        string    `json:"status"`
+	FailureReason string    `json:"failure_reason,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
@@ -0,0 +1,203 @@
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/idempotency"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/problem"
+)
+
+const BearerAuth = "bearerAuth"
+
+// Doc describes one route. Request and Response are sample values, a struct
+// documents its type and a map documents its entries. The zero Doc still
+// produces an operation, so every registered route shows up in the spec.
+type Doc struct {
+	Summary string
+	// Secured routes require the access token as a bearer token.
+	Secured  bool
+	Request  any
+	Query    []Parameter
+	Status   int
+	Response any
+	// Errors lists the error statuses the route answers with besides 401 for
+	// secured routes and 500.
+	Errors []int
+	// Idempotent routes accept an Idempotency-Key header.
+	Idempotent bool
+}
+
+// Route is a registered route as the router built it.
+type Route struct {
+	Method     string
+	Path       string
+	Tag        string
+	Deprecated bool
+	// RateLimited routes answer 429 once the client's bucket is empty.
+	RateLimited bool
+	Doc         Doc
+}
+
+var pathParam = regexp.MustCompile(`:([A-Za-z0-9_]+)`)
+
+// Path turns a fiber path like /v1/farms/:id into /v1/farms/{id}.
+func Path(fiberPath string) string {
+	return pathParam.ReplaceAllString(fiberPath, "{$1}")
+}
+
+func Build(info Info, routes []Route) Document {
+	s := newSchemas()
+	s.components["Problem"] = s.object(reflect.TypeOf(problem.Problem{}))
+
+	doc := Document{
+		OpenAPI: "3.1.0",
+		Info:    info,
+		Paths:   map[string]PathItem{},
+		Components: Components{
+			Schemas: s.components,
+			SecuritySchemes: map[string]SecurityScheme{
+				BearerAuth: {
+					Type:         "http",
+					Scheme:       "bearer",
+					BearerFormat: "JWT",
+					Description:  "access token from /v1/auth/signin",
+				},
+			},
+		},
+	}
+
+	for _, rt := range routes {
+		path := Path(rt.Path)
+		item, ok := doc.Paths[path]
+		if !ok {
+			item = PathItem{}
+			doc.Paths[path] = item
+		}
+
+		item[strings.ToLower(rt.Method)] = s.operation(rt, path)
+	}
+
+	return doc
+}
+
+func (s *schemas) operation(rt Route, path string) *Operation {
+	op := &Operation{
+		OperationID: operationID(rt.Method, path),
+		Summary:     rt.Doc.Summary,
+		Deprecated:  rt.Deprecated,
+		Responses:   map[string]Response{},
+	}
+
+	if rt.Tag != "" {
+		op.Tags = []string{rt.Tag}
+	}
+
+	for _, m := range pathParam.FindAllStringSubmatch(rt.Path, -1) {
+		op.Parameters = append(op.Parameters, Parameter{
+			Name:     m[1],
+			In:       "path",
+			Required: true,
+			Schema:   &Schema{Type: "string"},
+		})
+	}
+
+	op.Parameters = append(op.Parameters, rt.Doc.Query...)
+
+	if rt.Doc.Idempotent {
+		op.Parameters = append(op.Parameters, Parameter{
+			Name:        idempotency.Header,
+			In:          "header",
+			Description: "retries with the same key replay the first response instead of repeating the request",
+			Schema:      &Schema{Type: "string"},
+		})
+	}
+
+	if rt.Doc.Request != nil {
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content: map[string]MediaType{
+				"application/json": {Schema: s.of(rt.Doc.Request)},
+			},
+		}
+	}
+
+	if rt.Doc.Secured {
+		op.Security = []map[string][]string{{BearerAuth: {}}}
+	}
+
+	status := rt.Doc.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+
+	success := Response{Description: http.StatusText(status)}
+	if rt.Doc.Response != nil {
+		success.Content = map[string]MediaType{
+			"application/json": {Schema: s.of(rt.Doc.Response)},
+		}
+	}
+
+	if rt.Deprecated {
+		success.Headers = map[string]Header{
+			"Deprecation": {Description: "when the path was deprecated", Schema: &Schema{Type: "string"}},
+			"Sunset":      {Description: "when the path stops being served", Schema: &Schema{Type: "string"}},
+			"Link":        {Description: "the successor-version of the path", Schema: &Schema{Type: "string"}},
+		}
+	}
+
+	op.Responses[strconv.Itoa(status)] = success
+
+	errorStatuses := append([]int{}, rt.Doc.Errors...)
+	if rt.Doc.Secured {
+		errorStatuses = append(errorStatuses, http.StatusUnauthorized)
+	}
+	if rt.Doc.Idempotent {
+		errorStatuses = append(errorStatuses, http.StatusConflict, http.StatusUnprocessableEntity, http.StatusServiceUnavailable)
+	}
+	if rt.RateLimited {
+		errorStatuses = append(errorStatuses, http.StatusTooManyRequests)
+	}
+	errorStatuses = append(errorStatuses, http.StatusInternalServerError)
+
+	for _, code := range errorStatuses {
+		op.Responses[strconv.Itoa(code)] = Response{
+			Description: http.StatusText(code),
+			Content: map[string]MediaType{
+				problem.ContentType: {Schema: &Schema{Ref: "#/components/schemas/Problem"}},
+			},
+		}
+	}
+
+	return op
+}
+
+// operationID is derived from the method and path, e.g. GET /v1/farms/{id}
+// becomes get_v1_farms_id.
+func operationID(method, path string) string {
+	var b strings.Builder
+	b.WriteString(strings.ToLower(method))
+	for _, part := range strings.Split(path, "/") {
+		part = strings.Trim(part, "{}")
+		if part == "" {
+			continue
+		}
+		b.WriteString("_")
+		b.WriteString(strings.ReplaceAll(part, "-", "_"))
+	}
+
+	return b.String()
+}
+
+// QueryParam documents a query string parameter of the given JSON schema type.
+func QueryParam(name, typ, description string) Parameter {
+	return Parameter{
+		Name:        name,
+		In:          "query",
+		Description: description,
+		Schema:      &Schema{Type: typ},
+	}
+}
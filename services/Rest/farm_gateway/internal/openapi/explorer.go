@@ -0,0 +1,8 @@
+package openapi
+
+import _ "embed"
+
+// Explorer is the API explorer page, it loads the spec from /openapi.json.
+//
+//go:embed explorer.html
+var Explorer []byte
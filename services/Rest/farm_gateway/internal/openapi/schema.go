@@ -0,0 +1,162 @@
+package openapi
+
+import (
+	"reflect"
+	"strings"
+	"time"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// schemas turns go values into JSON schemas the way encoding/json would
+// marshal them. Named structs become components and are referenced by name.
+type schemas struct {
+	components map[string]*Schema
+}
+
+func newSchemas() *schemas {
+	return &schemas{components: map[string]*Schema{}}
+}
+
+// of describes v. Maps are described by their entries, so a sample like
+// fiber.Map{"status": "", "msg": ""} documents an ad hoc response body.
+func (s *schemas) of(v any) *Schema {
+	if v == nil {
+		return &Schema{}
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Map && rv.Len() > 0 {
+		return s.sample(rv)
+	}
+
+	if rv.Kind() == reflect.Slice && rv.Len() > 0 {
+		return &Schema{Type: "array", Items: s.of(rv.Index(0).Interface())}
+	}
+
+	return s.typeOf(rv.Type())
+}
+
+func (s *schemas) sample(rv reflect.Value) *Schema {
+	out := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	for _, key := range rv.MapKeys() {
+		out.Properties[key.String()] = s.of(rv.MapIndex(key).Interface())
+	}
+
+	return out
+}
+
+func (s *schemas) typeOf(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t == timeType {
+		return &Schema{Type: "string", Format: "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: s.typeOf(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: s.typeOf(t.Elem())}
+	case reflect.Struct:
+		return s.component(t)
+	default:
+		return &Schema{}
+	}
+}
+
+func (s *schemas) component(t reflect.Type) *Schema {
+	if t.Name() == "" {
+		return s.object(t)
+	}
+
+	name := componentName(t)
+	ref := &Schema{Ref: "#/components/schemas/" + name}
+	if _, ok := s.components[name]; ok {
+		return ref
+	}
+
+	// placeholder first so self referencing types terminate
+	s.components[name] = &Schema{}
+	*s.components[name] = *s.object(t)
+	return ref
+}
+
+func (s *schemas) object(t reflect.Type) *Schema {
+	out := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, skip := jsonName(field)
+		if skip {
+			continue
+		}
+
+		if field.Anonymous && name == "" {
+			embedded := s.object(indirect(field.Type))
+			for k, v := range embedded.Properties {
+				out.Properties[k] = v
+			}
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		out.Properties[name] = s.typeOf(field.Type)
+	}
+
+	return out
+}
+
+func jsonName(field reflect.StructField) (string, bool) {
+	tag, ok := field.Tag.Lookup("json")
+	if !ok {
+		return "", false
+	}
+
+	name, _, _ := strings.Cut(tag, ",")
+	return name, name == "-"
+}
+
+func indirect(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+// componentName keeps generated proto messages apart from the gateway models
+// that share their names.
+func componentName(t reflect.Type) string {
+	pkg := t.PkgPath()
+	if i := strings.LastIndex(pkg, "/"); i >= 0 {
+		pkg = pkg[i+1:]
+	}
+
+	if pkg == "models" || pkg == "" {
+		return t.Name()
+	}
+
+	return pkg + "." + t.Name()
+}
@@ -24,6 +24,7 @@ const (
 	FarmService_GetFarmList_FullMethodName = "/farm.v1.FarmService/GetFarmList"
 	FarmService_UpdateFarms_FullMethodName = "/farm.v1.FarmService/UpdateFarms"
 	FarmService_DeleteFarm_FullMethodName  = "/farm.v1.FarmService/DeleteFarm"
+	FarmService_SearchFarms_FullMethodName = "/farm.v1.FarmService/SearchFarms"
 )
 
 // FarmServiceClient is the client API for FarmService service.
@@ -35,6 +36,7 @@ type FarmServiceClient interface {
 	GetFarmList(ctx context.Context, in *GetFarmListRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetFarmListResponse], error)
 	UpdateFarms(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[UpdateFarmsRequest, UpdateFarmsResponse], error)
 	DeleteFarm(ctx context.Context, in *DeleteFarmRequest, opts ...grpc.CallOption) (*DeleteFarmResponse, error)
+	SearchFarms(ctx context.Context, in *SearchFarmsRequest, opts ...grpc.CallOption) (*SearchFarmsResponse, error)
 }
 
 type farmServiceClient struct {
@@ -110,6 +112,16 @@ func (c *farmServiceClient) DeleteFarm(ctx context.Context, in *DeleteFarmReques
 	return out, nil
 }
 
+func (c *farmServiceClient) SearchFarms(ctx context.Context, in *SearchFarmsRequest, opts ...grpc.CallOption) (*SearchFarmsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchFarmsResponse)
+	err := c.cc.Invoke(ctx, FarmService_SearchFarms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FarmServiceServer is the server API for FarmService service.
 // All implementations must embed UnimplementedFarmServiceServer
 // for forward compatibility.
@@ -119,6 +131,7 @@ type FarmServiceServer interface {
 	GetFarmList(*GetFarmListRequest, grpc.ServerStreamingServer[GetFarmListResponse]) error
 	UpdateFarms(grpc.BidiStreamingServer[UpdateFarmsRequest, UpdateFarmsResponse]) error
 	DeleteFarm(context.Context, *DeleteFarmRequest) (*DeleteFarmResponse, error)
+	SearchFarms(context.Context, *SearchFarmsRequest) (*SearchFarmsResponse, error)
 	mustEmbedUnimplementedFarmServiceServer()
 }
 
@@ -144,6 +157,9 @@ func (UnimplementedFarmServiceServer) UpdateFarms(grpc.BidiStreamingServer[Updat
 func (UnimplementedFarmServiceServer) DeleteFarm(context.Context, *DeleteFarmRequest) (*DeleteFarmResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteFarm not implemented")
 }
+func (UnimplementedFarmServiceServer) SearchFarms(context.Context, *SearchFarmsRequest) (*SearchFarmsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchFarms not implemented")
+}
 func (UnimplementedFarmServiceServer) mustEmbedUnimplementedFarmServiceServer() {}
 func (UnimplementedFarmServiceServer) testEmbeddedByValue()                     {}
 
@@ -226,6 +242,24 @@ func _FarmService_DeleteFarm_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FarmService_SearchFarms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchFarmsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FarmServiceServer).SearchFarms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FarmService_SearchFarms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FarmServiceServer).SearchFarms(ctx, req.(*SearchFarmsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FarmService_ServiceDesc is the grpc.ServiceDesc for FarmService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -241,6 +275,10 @@ var FarmService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteFarm",
 			Handler:    _FarmService_DeleteFarm_Handler,
 		},
+		{
+			MethodName: "SearchFarms",
+			Handler:    _FarmService_SearchFarms_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
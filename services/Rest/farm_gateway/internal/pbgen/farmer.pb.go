@@ -7,6 +7,7 @@
 package pbgen
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -322,11 +323,183 @@ func (x *UpdateFarmerProfileResponse) GetMsg() string {
 	return ""
 }
 
+type Login struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,2,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,3,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	Outcome       string                 `protobuf:"bytes,4,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	LoggedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=logged_at,json=loggedAt,proto3" json:"logged_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Login) Reset() {
+	*x = Login{}
+	mi := &file_farmer_v1_farmer_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Login) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Login) ProtoMessage() {}
+
+func (x *Login) ProtoReflect() protoreflect.Message {
+	mi := &file_farmer_v1_farmer_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Login.ProtoReflect.Descriptor instead.
+func (*Login) Descriptor() ([]byte, []int) {
+	return file_farmer_v1_farmer_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Login) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Login) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *Login) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *Login) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *Login) GetLoggedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LoggedAt
+	}
+	return nil
+}
+
+type ListLoginsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLoginsRequest) Reset() {
+	*x = ListLoginsRequest{}
+	mi := &file_farmer_v1_farmer_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLoginsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoginsRequest) ProtoMessage() {}
+
+func (x *ListLoginsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_farmer_v1_farmer_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoginsRequest.ProtoReflect.Descriptor instead.
+func (*ListLoginsRequest) Descriptor() ([]byte, []int) {
+	return file_farmer_v1_farmer_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListLoginsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListLoginsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListLoginsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Logins        []*Login               `protobuf:"bytes,1,rep,name=logins,proto3" json:"logins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLoginsResponse) Reset() {
+	*x = ListLoginsResponse{}
+	mi := &file_farmer_v1_farmer_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLoginsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoginsResponse) ProtoMessage() {}
+
+func (x *ListLoginsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_farmer_v1_farmer_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoginsResponse.ProtoReflect.Descriptor instead.
+func (*ListLoginsResponse) Descriptor() ([]byte, []int) {
+	return file_farmer_v1_farmer_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListLoginsResponse) GetLogins() []*Login {
+	if x != nil {
+		return x.Logins
+	}
+	return nil
+}
+
 var File_farmer_v1_farmer_proto protoreflect.FileDescriptor
 
 const file_farmer_v1_farmer_proto_rawDesc = "" +
 	"\n" +
-	"\x16farmer/v1/farmer.proto\x12\tfarmer.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf9\x01\n" +
+	"\x16farmer/v1/farmer.proto\x12\tfarmer.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf9\x01\n" +
 	"\x06Farmer\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tfull_name\x18\x02 \x01(\tR\bfullName\x12\x14\n" +
@@ -335,26 +508,41 @@ const file_farmer_v1_farmer_proto_rawDesc = "" +
 	"\rregistered_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\fregisteredAt\x12\x1a\n" +
 	"\bverified\x18\x06 \x01(\bR\bverified\x129\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"&\n" +
-	"\x14FarmerProfileRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"B\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"0\n" +
+	"\x14FarmerProfileRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"B\n" +
 	"\x15FarmerProfileResponse\x12)\n" +
-	"\x06farmer\x18\x01 \x01(\v2\x11.farmer.v1.FarmerR\x06farmer\"\xa6\x01\n" +
-	"\x1aUpdateFarmerProfileRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
-	"\tfull_name\x18\x02 \x01(\tH\x00R\bfullName\x88\x01\x01\x12\x19\n" +
-	"\x05email\x18\x03 \x01(\tH\x01R\x05email\x88\x01\x01\x12\x19\n" +
-	"\x05phone\x18\x04 \x01(\tH\x02R\x05phone\x88\x01\x01B\f\n" +
+	"\x06farmer\x18\x01 \x01(\v2\x11.farmer.v1.FarmerR\x06farmer\"\x8b\x02\n" +
+	"\x1aUpdateFarmerProfileRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12)\n" +
+	"\tfull_name\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x00R\bfullName\x88\x01\x01\x12T\n" +
+	"\x05email\x18\x03 \x01(\tB9\xbaH6r422^[a-zA-Z0-9._%+\\-]+@[a-zA-Z0-9.\\-]+\\.[a-zA-Z]{2,}$H\x01R\x05email\x88\x01\x01\x120\n" +
+	"\x05phone\x18\x04 \x01(\tB\x15\xbaH\x12r\x102\x0e^\\+?\\d{10,15}$H\x02R\x05phone\x88\x01\x01B\f\n" +
 	"\n" +
 	"_full_nameB\b\n" +
 	"\x06_emailB\b\n" +
 	"\x06_phone\"G\n" +
 	"\x1bUpdateFarmerProfileResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x10\n" +
-	"\x03msg\x18\x02 \x01(\tR\x03msg2\xc9\x01\n" +
+	"\x03msg\x18\x02 \x01(\tR\x03msg\"\xa8\x01\n" +
+	"\x05Login\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x02 \x01(\tR\tipAddress\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x03 \x01(\tR\tuserAgent\x12\x18\n" +
+	"\aoutcome\x18\x04 \x01(\tR\aoutcome\x127\n" +
+	"\tlogged_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bloggedAt\"L\n" +
+	"\x11ListLoginsRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1d\n" +
+	"\x05limit\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x05limit\">\n" +
+	"\x12ListLoginsResponse\x12(\n" +
+	"\x06logins\x18\x01 \x03(\v2\x10.farmer.v1.LoginR\x06logins2\x94\x02\n" +
 	"\rFarmerService\x12R\n" +
 	"\rFarmerProfile\x12\x1f.farmer.v1.FarmerProfileRequest\x1a .farmer.v1.FarmerProfileResponse\x12d\n" +
-	"\x13UpdateFarmerProfile\x12%.farmer.v1.UpdateFarmerProfileRequest\x1a&.farmer.v1.UpdateFarmerProfileResponseb\x06proto3"
+	"\x13UpdateFarmerProfile\x12%.farmer.v1.UpdateFarmerProfileRequest\x1a&.farmer.v1.UpdateFarmerProfileResponse\x12I\n" +
+	"\n" +
+	"ListLogins\x12\x1c.farmer.v1.ListLoginsRequest\x1a\x1d.farmer.v1.ListLoginsResponseb\x06proto3"
 
 var (
 	file_farmer_v1_farmer_proto_rawDescOnce sync.Once
@@ -368,28 +556,35 @@ func file_farmer_v1_farmer_proto_rawDescGZIP() []byte {
 	return file_farmer_v1_farmer_proto_rawDescData
 }
 
-var file_farmer_v1_farmer_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_farmer_v1_farmer_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_farmer_v1_farmer_proto_goTypes = []any{
 	(*Farmer)(nil),                      // 0: farmer.v1.Farmer
 	(*FarmerProfileRequest)(nil),        // 1: farmer.v1.FarmerProfileRequest
 	(*FarmerProfileResponse)(nil),       // 2: farmer.v1.FarmerProfileResponse
 	(*UpdateFarmerProfileRequest)(nil),  // 3: farmer.v1.UpdateFarmerProfileRequest
 	(*UpdateFarmerProfileResponse)(nil), // 4: farmer.v1.UpdateFarmerProfileResponse
-	(*timestamppb.Timestamp)(nil),       // 5: google.protobuf.Timestamp
+	(*Login)(nil),                       // 5: farmer.v1.Login
+	(*ListLoginsRequest)(nil),           // 6: farmer.v1.ListLoginsRequest
+	(*ListLoginsResponse)(nil),          // 7: farmer.v1.ListLoginsResponse
+	(*timestamppb.Timestamp)(nil),       // 8: google.protobuf.Timestamp
 }
 var file_farmer_v1_farmer_proto_depIdxs = []int32{
-	5, // 0: farmer.v1.Farmer.registered_at:type_name -> google.protobuf.Timestamp
-	5, // 1: farmer.v1.Farmer.updated_at:type_name -> google.protobuf.Timestamp
+	8, // 0: farmer.v1.Farmer.registered_at:type_name -> google.protobuf.Timestamp
+	8, // 1: farmer.v1.Farmer.updated_at:type_name -> google.protobuf.Timestamp
 	0, // 2: farmer.v1.FarmerProfileResponse.farmer:type_name -> farmer.v1.Farmer
-	1, // 3: farmer.v1.FarmerService.FarmerProfile:input_type -> farmer.v1.FarmerProfileRequest
-	3, // 4: farmer.v1.FarmerService.UpdateFarmerProfile:input_type -> farmer.v1.UpdateFarmerProfileRequest
-	2, // 5: farmer.v1.FarmerService.FarmerProfile:output_type -> farmer.v1.FarmerProfileResponse
-	4, // 6: farmer.v1.FarmerService.UpdateFarmerProfile:output_type -> farmer.v1.UpdateFarmerProfileResponse
-	5, // [5:7] is the sub-list for method output_type
-	3, // [3:5] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	8, // 3: farmer.v1.Login.logged_at:type_name -> google.protobuf.Timestamp
+	5, // 4: farmer.v1.ListLoginsResponse.logins:type_name -> farmer.v1.Login
+	1, // 5: farmer.v1.FarmerService.FarmerProfile:input_type -> farmer.v1.FarmerProfileRequest
+	3, // 6: farmer.v1.FarmerService.UpdateFarmerProfile:input_type -> farmer.v1.UpdateFarmerProfileRequest
+	6, // 7: farmer.v1.FarmerService.ListLogins:input_type -> farmer.v1.ListLoginsRequest
+	2, // 8: farmer.v1.FarmerService.FarmerProfile:output_type -> farmer.v1.FarmerProfileResponse
+	4, // 9: farmer.v1.FarmerService.UpdateFarmerProfile:output_type -> farmer.v1.UpdateFarmerProfileResponse
+	7, // 10: farmer.v1.FarmerService.ListLogins:output_type -> farmer.v1.ListLoginsResponse
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_farmer_v1_farmer_proto_init() }
@@ -404,7 +599,7 @@ func file_farmer_v1_farmer_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_farmer_v1_farmer_proto_rawDesc), len(file_farmer_v1_farmer_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	FarmerService_FarmerProfile_FullMethodName       = "/farmer.v1.FarmerService/FarmerProfile"
 	FarmerService_UpdateFarmerProfile_FullMethodName = "/farmer.v1.FarmerService/UpdateFarmerProfile"
+	FarmerService_ListLogins_FullMethodName          = "/farmer.v1.FarmerService/ListLogins"
 )
 
 // FarmerServiceClient is the client API for FarmerService service.
@@ -29,6 +30,7 @@ const (
 type FarmerServiceClient interface {
 	FarmerProfile(ctx context.Context, in *FarmerProfileRequest, opts ...grpc.CallOption) (*FarmerProfileResponse, error)
 	UpdateFarmerProfile(ctx context.Context, in *UpdateFarmerProfileRequest, opts ...grpc.CallOption) (*UpdateFarmerProfileResponse, error)
+	ListLogins(ctx context.Context, in *ListLoginsRequest, opts ...grpc.CallOption) (*ListLoginsResponse, error)
 }
 
 type farmerServiceClient struct {
@@ -59,12 +61,23 @@ func (c *farmerServiceClient) UpdateFarmerProfile(ctx context.Context, in *Updat
 	return out, nil
 }
 
+func (c *farmerServiceClient) ListLogins(ctx context.Context, in *ListLoginsRequest, opts ...grpc.CallOption) (*ListLoginsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLoginsResponse)
+	err := c.cc.Invoke(ctx, FarmerService_ListLogins_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FarmerServiceServer is the server API for FarmerService service.
 // All implementations must embed UnimplementedFarmerServiceServer
 // for forward compatibility.
 type FarmerServiceServer interface {
 	FarmerProfile(context.Context, *FarmerProfileRequest) (*FarmerProfileResponse, error)
 	UpdateFarmerProfile(context.Context, *UpdateFarmerProfileRequest) (*UpdateFarmerProfileResponse, error)
+	ListLogins(context.Context, *ListLoginsRequest) (*ListLoginsResponse, error)
 	mustEmbedUnimplementedFarmerServiceServer()
 }
 
@@ -81,6 +94,9 @@ func (UnimplementedFarmerServiceServer) FarmerProfile(context.Context, *FarmerPr
 func (UnimplementedFarmerServiceServer) UpdateFarmerProfile(context.Context, *UpdateFarmerProfileRequest) (*UpdateFarmerProfileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateFarmerProfile not implemented")
 }
+func (UnimplementedFarmerServiceServer) ListLogins(context.Context, *ListLoginsRequest) (*ListLoginsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLogins not implemented")
+}
 func (UnimplementedFarmerServiceServer) mustEmbedUnimplementedFarmerServiceServer() {}
 func (UnimplementedFarmerServiceServer) testEmbeddedByValue()                       {}
 
@@ -138,6 +154,24 @@ func _FarmerService_UpdateFarmerProfile_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FarmerService_ListLogins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoginsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FarmerServiceServer).ListLogins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FarmerService_ListLogins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FarmerServiceServer).ListLogins(ctx, req.(*ListLoginsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FarmerService_ServiceDesc is the grpc.ServiceDesc for FarmerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +187,10 @@ var FarmerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateFarmerProfile",
 			Handler:    _FarmerService_UpdateFarmerProfile_Handler,
 		},
+		{
+			MethodName: "ListLogins",
+			Handler:    _FarmerService_ListLogins_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "farmer/v1/farmer.proto",
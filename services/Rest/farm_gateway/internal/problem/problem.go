@@ -0,0 +1,154 @@
+package problem
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ContentType is the media type of an RFC 7807 problem body.
+const ContentType = "application/problem+json"
+
+// InvalidParam is one field the request got wrong, from errdetails.BadRequest.
+type InvalidParam struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// Problem is the RFC 7807 body every gateway error answers with. Reason,
+// Domain and Metadata come from errdetails.ErrorInfo.
+type Problem struct {
+	Type          string            `json:"type"`
+	Title         string            `json:"title"`
+	Status        int               `json:"status"`
+	Detail        string            `json:"detail,omitempty"`
+	Instance      string            `json:"instance,omitempty"`
+	Code          string            `json:"code,omitempty"`
+	Reason        string            `json:"reason,omitempty"`
+	Domain        string            `json:"domain,omitempty"`
+	Metadata      map[string]string `json:"metadata,omitempty"`
+	InvalidParams []InvalidParam    `json:"invalid_params,omitempty"`
+	// Error repeats Detail for clients of the former {"error": ...} body.
+	Error string `json:"error"`
+
+	retryAfter int
+}
+
+// HTTPStatus maps a gRPC code to the status the gateway answers with.
+func HTTPStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted, codes.FailedPrecondition:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Canceled:
+		return 499
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// New is a problem raised by the gateway itself, e.g. a body it cannot parse.
+func New(httpStatus int, detail string) Problem {
+	title := http.StatusText(httpStatus)
+	if title == "" {
+		title = "Client Closed Request"
+	}
+
+	return Problem{
+		Type:   "about:blank",
+		Title:  title,
+		Status: httpStatus,
+		Detail: detail,
+		Error:  detail,
+	}
+}
+
+// FromStatus translates a gRPC status and its details.
+func FromStatus(st *status.Status) Problem {
+	p := New(HTTPStatus(st.Code()), st.Message())
+	p.Code = st.Code().String()
+
+	for _, d := range st.Details() {
+		switch detail := d.(type) {
+		case *errdetails.BadRequest:
+			for _, v := range detail.GetFieldViolations() {
+				p.InvalidParams = append(p.InvalidParams, InvalidParam{
+					Name:   v.GetField(),
+					Reason: v.GetDescription(),
+				})
+			}
+		case *errdetails.ErrorInfo:
+			p.Reason = detail.GetReason()
+			p.Domain = detail.GetDomain()
+			p.Metadata = detail.GetMetadata()
+		case *errdetails.RetryInfo:
+			p.retryAfter = int(math.Ceil(detail.GetRetryDelay().AsDuration().Seconds()))
+		}
+	}
+
+	return p
+}
+
+// RetryAfter is the delay in seconds a throttled caller has to wait, if the
+// service told one.
+func (p Problem) RetryAfter() (int, bool) {
+	return p.retryAfter, p.retryAfter > 0
+}
+
+// Send writes p as the response.
+func Send(c *fiber.Ctx, p Problem) error {
+	if p.Instance == "" {
+		p.Instance = c.Path()
+	}
+
+	if secs, ok := p.RetryAfter(); ok {
+		c.Set(fiber.HeaderRetryAfter, strconv.Itoa(secs))
+	}
+
+	c.Status(p.Status)
+	return c.JSON(p, ContentType)
+}
+
+// Respond answers with a problem raised by the gateway itself.
+func Respond(c *fiber.Ctx, httpStatus int, detail string) error {
+	return Send(c, New(httpStatus, detail))
+}
+
+// GRPC answers with the translation of an error returned by a gRPC client.
+func GRPC(c *fiber.Ctx, err error) error {
+	return Send(c, FromStatus(status.Convert(err)))
+}
+
+// ErrorHandler is the fiber.Config ErrorHandler, so errors fiber raises on
+// its own, e.g. an unknown route, answer with a problem too.
+func ErrorHandler(c *fiber.Ctx, err error) error {
+	var fe *fiber.Error
+	if errors.As(err, &fe) {
+		return Respond(c, fe.Code, fe.Message)
+	}
+
+	return Respond(c, fiber.StatusInternalServerError, err.Error())
+}
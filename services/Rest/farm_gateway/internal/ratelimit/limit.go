@@ -0,0 +1,41 @@
+package ratelimit
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Limit is a token bucket of Requests tokens that refills completely over
+// Window, so a client may burst Requests at once and then sustain
+// Requests per Window.
+type Limit struct {
+	Requests int
+	Window   time.Duration
+}
+
+// ParseLimit reads a limit written as requests/window, e.g. 10/1m.
+func ParseLimit(val string) (l Limit, _ error) {
+	rawRequests, rawWindow, ok := strings.Cut(val, "/")
+	if !ok {
+		return l, fmt.Errorf("rate limit %q is not requests/window", val)
+	}
+
+	requests, err := strconv.Atoi(rawRequests)
+	if err != nil || requests <= 0 {
+		return l, fmt.Errorf("rate limit %q must allow a positive number of requests", val)
+	}
+
+	window, err := time.ParseDuration(rawWindow)
+	if err != nil || window <= 0 {
+		return l, fmt.Errorf("rate limit %q must have a positive window", val)
+	}
+
+	return Limit{Requests: requests, Window: window}, nil
+}
+
+// Policy is the RateLimit-Policy value of the limit.
+func (l Limit) Policy() string {
+	return fmt.Sprintf("%d;w=%d", l.Requests, int(l.Window.Seconds()))
+}
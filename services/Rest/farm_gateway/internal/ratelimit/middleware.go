@@ -0,0 +1,113 @@
+// Package ratelimit keeps abusive clients from fanning requests out to the
+// services behind the gateway. Every client has a token bucket per route
+// group in redis, keyed by its subject once authenticated and by its IP
+// otherwise.
+package ratelimit
+
+import (
+	"context"
+	"log"
+	"math"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/problem"
+)
+
+const (
+	HeaderLimit     = "RateLimit-Limit"
+	HeaderRemaining = "RateLimit-Remaining"
+	HeaderReset     = "RateLimit-Reset"
+	HeaderPolicy    = "RateLimit-Policy"
+)
+
+const (
+	OutcomeAllowed = "allowed"
+	OutcomeLimited = "limited"
+	OutcomeError   = "error"
+)
+
+// Recorder counts the outcome of every rate limited request.
+type Recorder interface {
+	RecordRateLimit(ctx context.Context, group string, outcome string)
+}
+
+type Limiter struct {
+	store    Store
+	recorder Recorder
+
+	// storeDown is set while the store fails, so an outage is logged once
+	// and not on every request. The error outcome counts every request.
+	storeDown atomic.Bool
+}
+
+type nopRecorder struct{}
+
+func (nopRecorder) RecordRateLimit(context.Context, string, string) {}
+
+// NewLimiter counts nothing when recorder is nil.
+func NewLimiter(store Store, recorder Recorder) *Limiter {
+	if recorder == nil {
+		recorder = nopRecorder{}
+	}
+
+	return &Limiter{
+		store:    store,
+		recorder: recorder,
+	}
+}
+
+// Middleware limits the requests of a route group. It must run after the
+// token validation to limit authenticated clients by their subject. When
+// the store fails the request is let through with failOpen and answered
+// 503 without it.
+func (l *Limiter) Middleware(group string, limit Limit, failOpen bool) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ctx := c.UserContext()
+
+		client := "ip:" + c.IP()
+		if subject, ok := c.Locals("user_subject").(string); ok && subject != "" {
+			client = "sub:" + subject
+		}
+
+		res, err := l.store.Take(ctx, group+":"+client, limit)
+		if err != nil {
+			if l.storeDown.CompareAndSwap(false, true) {
+				log.Println("rate limit store unavailable:", err)
+			}
+			l.recorder.RecordRateLimit(ctx, group, OutcomeError)
+
+			if !failOpen {
+				return problem.Respond(c, fiber.StatusServiceUnavailable, "rate limit unavailable")
+			}
+			return c.Next()
+		}
+
+		if l.storeDown.CompareAndSwap(true, false) {
+			log.Println("rate limit store recovered")
+		}
+
+		c.Set(HeaderLimit, strconv.Itoa(limit.Requests))
+		c.Set(HeaderRemaining, strconv.Itoa(res.Remaining))
+		c.Set(HeaderReset, strconv.Itoa(seconds(res.Reset)))
+		c.Set(HeaderPolicy, limit.Policy())
+
+		if !res.Allowed {
+			l.recorder.RecordRateLimit(ctx, group, OutcomeLimited)
+
+			retryAfter := strconv.Itoa(seconds(res.RetryAfter))
+			c.Set(fiber.HeaderRetryAfter, retryAfter)
+			return problem.Respond(c, fiber.StatusTooManyRequests, "rate limit exceeded, retry in "+retryAfter+" seconds")
+		}
+
+		l.recorder.RecordRateLimit(ctx, group, OutcomeAllowed)
+		return c.Next()
+	}
+}
+
+// seconds rounds up, a client waiting the advertised time must find a token.
+func seconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
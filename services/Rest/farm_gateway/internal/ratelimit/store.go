@@ -0,0 +1,90 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sony-nurdianto/farm/shared_lib/Go/database/redis"
+)
+
+const keyPrefix = "ratelimit:"
+
+// Result is the state of a bucket after a request took from it.
+type Result struct {
+	Allowed   bool
+	Remaining int
+	// Reset is how long the bucket takes to fill up again.
+	Reset time.Duration
+	// RetryAfter is how long a rejected client has to wait for a token.
+	RetryAfter time.Duration
+}
+
+//go:generate mockgen -source=store.go -destination=../../test/mocks/mock_ratelimit_store.go -package=mocks -mock_names=Store=MockRateLimitStore
+type Store interface {
+	// Take removes a token from the bucket of key, the request is allowed
+	// when there was one.
+	Take(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+// tokenBucket refills the bucket for the time since it was last taken from
+// and takes a token. The clock is redis', so every gateway replica agrees
+// on it, and the bucket expires once it would be full again.
+const tokenBucket = `
+local capacity = tonumber(ARGV[1])
+local rate = tonumber(ARGV[2])
+
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+local bucket = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(bucket[1]) or capacity
+local ts = tonumber(bucket[2]) or now
+
+tokens = math.min(capacity, tokens + math.max(0, now - ts) * rate)
+
+local allowed = 0
+local retry = 0
+if tokens >= 1 then
+  tokens = tokens - 1
+  allowed = 1
+else
+  retry = math.ceil((1 - tokens) / rate)
+end
+
+local reset = math.ceil((capacity - tokens) / rate)
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', now)
+redis.call('PEXPIRE', KEYS[1], math.max(reset, 1))
+
+return {allowed, math.floor(tokens), reset, retry}
+`
+
+type redisStore struct {
+	rdb redis.RedisClient
+}
+
+func NewRedisStore(rdb redis.RedisClient) redisStore {
+	return redisStore{rdb: rdb}
+}
+
+func (s redisStore) Take(ctx context.Context, key string, limit Limit) (res Result, _ error) {
+	// tokens per millisecond
+	rate := float64(limit.Requests) / float64(limit.Window.Milliseconds())
+
+	reply, err := s.rdb.Do(ctx, "EVAL", tokenBucket, 1, keyPrefix+key, limit.Requests, rate).Int64Slice()
+	if err != nil {
+		return res, err
+	}
+
+	if len(reply) != 4 {
+		return res, fmt.Errorf("rate limit bucket: unexpected reply %v", reply)
+	}
+
+	res.Allowed = reply[0] == 1
+	res.Remaining = int(reply[1])
+	res.Reset = time.Duration(reply[2]) * time.Millisecond
+	res.RetryAfter = time.Duration(reply[3]) * time.Millisecond
+
+	return res, nil
+}
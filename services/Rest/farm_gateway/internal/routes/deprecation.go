@@ -0,0 +1,34 @@
+package routes
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// legacyDeprecatedAt is when the unversioned paths were superseded by /v1.
+var legacyDeprecatedAt = time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC)
+
+// Deprecated marks every response of a route as deprecated (RFC 9745) and
+// points clients to its successor. The Sunset header (RFC 8594) is only sent
+// when a sunset date is set.
+func Deprecated(deprecatedAt, sunset time.Time, successor string) fiber.Handler {
+	deprecation := fmt.Sprintf("@%d", deprecatedAt.Unix())
+	link := fmt.Sprintf(`<%s>; rel="successor-version"`, successor)
+
+	var sunsetValue string
+	if !sunset.IsZero() {
+		sunsetValue = sunset.UTC().Format(http.TimeFormat)
+	}
+
+	return func(c *fiber.Ctx) error {
+		c.Set("Deprecation", deprecation)
+		if sunsetValue != "" {
+			c.Set("Sunset", sunsetValue)
+		}
+		c.Append(fiber.HeaderLink, link)
+		return c.Next()
+	}
+}
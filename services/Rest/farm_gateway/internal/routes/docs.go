@@ -0,0 +1,331 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/models"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/openapi"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/pbgen"
+)
+
+// response samples for handlers that answer with a fiber.Map
+
+var statusMsgBody = fiber.Map{"status": "", "msg": ""}
+
+var tokenBody = fiber.Map{
+	"data": fiber.Map{
+		"status":                 "",
+		"message":                "",
+		"issued_at":              "",
+		"token":                  "",
+		"expires_at":             "",
+		"refresh_token":          "",
+		"refresh_expires_at":     "",
+		"session_id":             "",
+		"second_factor_required": false,
+		"challenge_token":        "",
+		"challenge_expires_at":   "",
+	},
+}
+
+var sessionsBody = fiber.Map{
+	"data": []fiber.Map{{
+		"id":           "",
+		"user_agent":   "",
+		"ip_address":   "",
+		"created_at":   "",
+		"last_used_at": "",
+		"expires_at":   "",
+		"current":      false,
+	}},
+}
+
+var listQuery = []openapi.Parameter{
+	openapi.QueryParam("search_name", "string", "only farms whose name contains the value"),
+	openapi.QueryParam("sort_order", "string", "asc or desc"),
+	openapi.QueryParam("sort_by", "string", "created_at, name, size or updated_at, defaults to created_at"),
+	openapi.QueryParam("farm_type", "string", "comma separated farm types"),
+	openapi.QueryParam("farm_status", "string", "comma separated farm statuses"),
+	openapi.QueryParam("province", "string", "only farms in the province"),
+	openapi.QueryParam("city", "string", "only farms in the city"),
+	openapi.QueryParam("postal_code", "string", "only farms with the postal code"),
+	openapi.QueryParam("min_farm_size", "number", "smallest farm size"),
+	openapi.QueryParam("max_farm_size", "number", "largest farm size"),
+	openapi.QueryParam("created_from", "string", "RFC 3339, only farms created at or after it"),
+	openapi.QueryParam("created_to", "string", "RFC 3339, only farms created at or before it"),
+	openapi.QueryParam("updated_from", "string", "RFC 3339, only farms updated at or after it"),
+	openapi.QueryParam("updated_to", "string", "RFC 3339, only farms updated at or before it"),
+	openapi.QueryParam("limit", "integer", "page size"),
+	openapi.QueryParam("offset", "integer", "number of farms to skip, ignored with a page_token"),
+	openapi.QueryParam("page_token", "string", "next_page_token of the previous page, sent with the same filters"),
+	openapi.QueryParam("include_total", "boolean", "count the matching farms on a page_token request too"),
+	openapi.QueryParam("stream", "string", "ndjson or sse streams the farms one per line or event, the total and the page token follow them"),
+}
+
+var searchQuery = []openapi.Parameter{
+	openapi.QueryParam("q", "string", "words matched against the name, description and address, prefixes and single typos match too"),
+	openapi.QueryParam("farm_type", "string", "comma separated farm types"),
+	openapi.QueryParam("farm_status", "string", "comma separated farm statuses"),
+	openapi.QueryParam("province", "string", "comma separated provinces"),
+	openapi.QueryParam("limit", "integer", "page size, at most 100, defaults to 10"),
+	openapi.QueryParam("offset", "integer", "number of farms to skip"),
+}
+
+var (
+	signUpDoc = openapi.Doc{
+		Summary: "Register a farmer, the account is created asynchronously",
+		Request: models.UserRegister{},
+		Status:  http.StatusAccepted,
+		Response: fiber.Map{
+			"status":              "",
+			"msg":                 "",
+			"user_id":             "",
+			"registration_status": "",
+		},
+		Errors: []int{http.StatusBadRequest, http.StatusConflict},
+	}
+
+	signUpStatusDoc = openapi.Doc{
+		Summary:  "Poll the registration started by a signup",
+		Response: models.RegistrationStatus{},
+		Errors:   []int{http.StatusBadRequest, http.StatusNotFound},
+	}
+
+	signInDoc = openapi.Doc{
+		Summary:  "Sign in, answers with a second factor challenge when TOTP is enabled",
+		Request:  models.UserSignIn{},
+		Response: tokenBody,
+		Errors:   []int{http.StatusBadRequest, http.StatusUnauthorized, http.StatusTooManyRequests},
+	}
+
+	refreshDoc = openapi.Doc{
+		Summary:  "Rotate the refresh token from the body or the refresh_token cookie",
+		Request:  models.UserRefreshToken{},
+		Response: tokenBody,
+		Errors:   []int{http.StatusBadRequest, http.StatusUnauthorized},
+	}
+
+	logoutDoc = openapi.Doc{
+		Summary:  "Revoke the current session",
+		Secured:  true,
+		Response: statusMsgBody,
+	}
+
+	listSessionsDoc = openapi.Doc{
+		Summary:  "List the active sessions of the caller",
+		Secured:  true,
+		Response: sessionsBody,
+	}
+
+	revokeSessionDoc = openapi.Doc{
+		Summary:  "Revoke one session of the caller",
+		Secured:  true,
+		Response: statusMsgBody,
+		Errors:   []int{http.StatusBadRequest, http.StatusNotFound},
+	}
+
+	verifyEmailDoc = openapi.Doc{
+		Summary:  "Verify an email address with the emailed token",
+		Query:    []openapi.Parameter{openapi.QueryParam("token", "string", "verification token")},
+		Response: statusMsgBody,
+		Errors:   []int{http.StatusBadRequest},
+	}
+
+	forgotPasswordDoc = openapi.Doc{
+		Summary:  "Email a password reset link",
+		Request:  models.UserForgotPassword{},
+		Status:   http.StatusAccepted,
+		Response: statusMsgBody,
+		Errors:   []int{http.StatusBadRequest},
+	}
+
+	resetPasswordDoc = openapi.Doc{
+		Summary:  "Set a new password with a reset token",
+		Request:  models.UserResetPassword{},
+		Response: statusMsgBody,
+		Errors:   []int{http.StatusBadRequest},
+	}
+
+	changePasswordDoc = openapi.Doc{
+		Summary:  "Change the password of the caller",
+		Secured:  true,
+		Request:  models.UserChangePassword{},
+		Response: statusMsgBody,
+		Errors:   []int{http.StatusBadRequest},
+	}
+
+	enrollTOTPDoc = openapi.Doc{
+		Summary: "Start a TOTP enrollment",
+		Secured: true,
+		Response: fiber.Map{
+			"data": fiber.Map{
+				"otpauth_uri":    "",
+				"secret":         "",
+				"recovery_codes": []string{},
+			},
+		},
+		Errors: []int{http.StatusConflict},
+	}
+
+	confirmTOTPDoc = openapi.Doc{
+		Summary:  "Confirm a TOTP enrollment with a first code",
+		Secured:  true,
+		Request:  models.UserConfirmTOTP{},
+		Response: statusMsgBody,
+		Errors:   []int{http.StatusBadRequest, http.StatusConflict},
+	}
+
+	disableTOTPDoc = openapi.Doc{
+		Summary:  "Disable TOTP",
+		Secured:  true,
+		Request:  models.UserDisableTOTP{},
+		Response: statusMsgBody,
+		Errors:   []int{http.StatusBadRequest},
+	}
+
+	verifySecondFactorDoc = openapi.Doc{
+		Summary:  "Complete a sign-in challenge with a TOTP or recovery code",
+		Request:  models.UserVerifySecondFactor{},
+		Response: tokenBody,
+		Errors:   []int{http.StatusBadRequest, http.StatusUnauthorized, http.StatusTooManyRequests},
+	}
+
+	profileDoc = openapi.Doc{
+		Summary:  "Get the profile of the caller",
+		Secured:  true,
+		Response: models.Users{},
+	}
+
+	updateProfileDoc = openapi.Doc{
+		Summary:    "Update the profile of the caller",
+		Secured:    true,
+		Request:    models.UpdateUsers{},
+		Response:   statusMsgBody,
+		Errors:     []int{http.StatusBadRequest, http.StatusForbidden},
+		Idempotent: true,
+	}
+
+	loginsDoc = openapi.Doc{
+		Summary:  "List the recent sign-ins of the caller",
+		Secured:  true,
+		Query:    []openapi.Parameter{openapi.QueryParam("limit", "integer", "number of sign-ins")},
+		Response: fiber.Map{"logins": []models.UserLogin{}},
+		Errors:   []int{http.StatusBadRequest},
+	}
+
+	listFarmsDoc = openapi.Doc{
+		Summary:  "List the farms of the caller",
+		Secured:  true,
+		Query:    listQuery,
+		Response: models.GetFarmsResponse{},
+		Errors:   []int{http.StatusBadRequest},
+	}
+
+	searchFarmsDoc = openapi.Doc{
+		Summary:  "Search the farms of the caller, with counts per type, status and province",
+		Secured:  true,
+		Query:    searchQuery,
+		Response: models.SearchFarmsResponse{},
+		Errors:   []int{http.StatusBadRequest},
+	}
+
+	getFarmDoc = openapi.Doc{
+		Summary:  "Get one farm of the caller",
+		Secured:  true,
+		Response: models.Farm{},
+		Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusForbidden},
+	}
+
+	postFarmDoc = openapi.Doc{
+		Summary:    "Create a farm",
+		Secured:    true,
+		Request:    models.CreateFarm{},
+		Status:     http.StatusCreated,
+		Response:   fiber.Map{"data": &pbgen.CreateFarmResponse{}, "status": "", "msg": ""},
+		Errors:     []int{http.StatusBadRequest, http.StatusForbidden},
+		Idempotent: true,
+	}
+
+	patchFarmDoc = openapi.Doc{
+		Summary: "Update a farm and or its address",
+		Secured: true,
+		Request: fiber.Map{
+			"farm":    models.UpdateFarm{},
+			"address": models.UpdateFarmAddr{},
+		},
+		Response:   fiber.Map{"data": []*pbgen.UpdateFarmsResponse{}, "status": "", "msg": ""},
+		Errors:     []int{http.StatusBadRequest, http.StatusNotFound, http.StatusForbidden},
+		Idempotent: true,
+	}
+
+	deleteFarmDoc = openapi.Doc{
+		Summary:    "Delete a farm",
+		Secured:    true,
+		Response:   fiber.Map{"data": &pbgen.DeleteFarmResponse{}, "status": "", "msg": ""},
+		Errors:     []int{http.StatusBadRequest, http.StatusNotFound, http.StatusForbidden},
+		Idempotent: true,
+	}
+
+	legacyCreateFarmDoc = openapi.Doc{
+		Summary:    "Create farms in bulk",
+		Secured:    true,
+		Request:    fiber.Map{"data": []models.CreateFarm{}},
+		Response:   fiber.Map{"data": []*pbgen.CreateFarmResponse{}, "status": "", "msg": ""},
+		Errors:     []int{http.StatusBadRequest},
+		Idempotent: true,
+	}
+
+	legacyUpdateFarmDoc = openapi.Doc{
+		Summary:    "Update farms and addresses in bulk",
+		Secured:    true,
+		Request:    fiber.Map{"data": []models.UpdateFarmWithAddr{}},
+		Response:   fiber.Map{"data": []*pbgen.UpdateFarmsResponse{}, "status": "", "msg": ""},
+		Errors:     []int{http.StatusBadRequest},
+		Idempotent: true,
+	}
+
+	legacyListFarmsDoc = openapi.Doc{
+		Summary:  "List the farms of the caller",
+		Secured:  true,
+		Request:  models.GetFarmsRequest{},
+		Response: models.GetFarmsResponse{},
+		Errors:   []int{http.StatusBadRequest},
+	}
+
+	legacyGetFarmDoc = openapi.Doc{
+		Summary:  "Get one farm of the caller by the id in the body",
+		Secured:  true,
+		Request:  fiber.Map{"id": ""},
+		Response: models.Farm{},
+		Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusForbidden},
+	}
+
+	openAPIDoc = openapi.Doc{
+		Summary: "This OpenAPI document",
+		Response: fiber.Map{
+			"openapi":    "",
+			"info":       fiber.Map{"title": "", "version": ""},
+			"paths":      fiber.Map{},
+			"components": fiber.Map{},
+		},
+	}
+
+	explorerDoc = openapi.Doc{
+		Summary: "Interactive explorer for this document",
+	}
+
+	healthzDoc = openapi.Doc{
+		Summary:  "Liveness probe, answers while the gateway runs",
+		Response: fiber.Map{"status": ""},
+	}
+
+	readyzDoc = openapi.Doc{
+		Summary: "Readiness probe, the grpc.health.v1 status of every service behind the gateway",
+		Response: fiber.Map{
+			"status":   "",
+			"services": fiber.Map{"auth": "", "farmer": "", "farm": ""},
+		},
+		Errors: []int{http.StatusServiceUnavailable},
+	}
+)
@@ -2,12 +2,17 @@ package routes
 
 import (
 	"github.com/gofiber/fiber/v2"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/openapi"
 )
 
 type RouterHandlers struct {
-	Path    string
-	Method  string
+	Path   string
+	Method string
+	// Auth validates the access token of a secured route, it runs before
+	// Handler and nil leaves the route public.
+	Auth    fiber.Handler
 	Handler []fiber.Handler
+	Doc     openapi.Doc
 }
 
 func NewRouterHandlers(path string, method string, handlers ...fiber.Handler) RouterHandlers {
@@ -17,3 +22,15 @@ func NewRouterHandlers(path string, method string, handlers ...fiber.Handler) Ro
 		Handler: handlers,
 	}
 }
+
+// WithDoc attaches the OpenAPI description of the route.
+func (rh RouterHandlers) WithDoc(doc openapi.Doc) RouterHandlers {
+	rh.Doc = doc
+	return rh
+}
+
+// WithAuth secures the route with auth.
+func (rh RouterHandlers) WithAuth(auth fiber.Handler) RouterHandlers {
+	rh.Auth = auth
+	return rh
+}
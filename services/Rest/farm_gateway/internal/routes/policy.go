@@ -0,0 +1,124 @@
+package routes
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/ratelimit"
+)
+
+// defaultIdempotencyTTL is how long a response is replayed for its
+// Idempotency-Key unless GATEWAY_IDEMPOTENCY_TTL says otherwise.
+const defaultIdempotencyTTL = 24 * time.Hour
+
+// defaultIdempotencyLockTTL is how long an Idempotency-Key stays locked
+// while its request is processed unless GATEWAY_IDEMPOTENCY_LOCK_TTL says
+// otherwise. It must outlast the slowest request.
+const defaultIdempotencyLockTTL = time.Minute
+
+// defaultLegacySunset is when the unversioned paths stop being served unless
+// GATEWAY_LEGACY_SUNSET says otherwise.
+var defaultLegacySunset = time.Date(2027, time.April, 18, 0, 0, 0, 0, time.UTC)
+
+// The route groups with a bucket of their own. The auth group holds the
+// routes that take credentials from an unauthenticated client: sign-up,
+// sign-in, password forgot and reset and the second factor. The read and
+// write groups split the rest of the API by method and the ip group counts
+// the requests of an IP to the secured routes before their token is
+// validated.
+const (
+	RateLimitAuth  = "auth"
+	RateLimitRead  = "read"
+	RateLimitWrite = "write"
+	RateLimitIP    = "ip"
+)
+
+// defaultRateLimits apply to the groups unless GATEWAY_RATE_LIMIT_AUTH,
+// GATEWAY_RATE_LIMIT_READ, GATEWAY_RATE_LIMIT_WRITE or GATEWAY_RATE_LIMIT_IP
+// say otherwise, e.g. 10/1m. The ip bucket is shared by the clients behind
+// one address, it only has to stop a flood of bad tokens.
+var defaultRateLimits = map[string]ratelimit.Limit{
+	RateLimitAuth:  {Requests: 10, Window: time.Minute},
+	RateLimitRead:  {Requests: 300, Window: time.Minute},
+	RateLimitWrite: {Requests: 60, Window: time.Minute},
+	RateLimitIP:    {Requests: 1200, Window: time.Minute},
+}
+
+// defaultRateLimitFailOpen lets the requests through while the rate limit
+// store is down unless GATEWAY_RATE_LIMIT_FAIL_OPEN says otherwise, an
+// outage of redis does not take the API down with it.
+const defaultRateLimitFailOpen = true
+
+type Policy struct {
+	// RequireVerified rejects write routes for accounts whose email is not verified yet.
+	RequireVerified bool
+	// LegacySunset is advertised in the Sunset header of the unversioned paths.
+	LegacySunset time.Time
+	// IdempotencyTTL is how long the response of an Idempotency-Key is kept.
+	IdempotencyTTL time.Duration
+	// IdempotencyLockTTL is how long an Idempotency-Key is locked before
+	// its response is stored.
+	IdempotencyLockTTL time.Duration
+	// RateLimits are the buckets of the route groups.
+	RateLimits map[string]ratelimit.Limit
+	// RateLimitFailOpen lets the requests through while the rate limit store
+	// is down, otherwise they are answered 503.
+	RateLimitFailOpen bool
+}
+
+func PolicyFromEnv() Policy {
+	requireVerified, _ := strconv.ParseBool(os.Getenv("GATEWAY_REQUIRE_VERIFIED"))
+
+	legacySunset, err := time.Parse(time.DateOnly, os.Getenv("GATEWAY_LEGACY_SUNSET"))
+	if err != nil {
+		legacySunset = defaultLegacySunset
+	}
+
+	idempotencyTTL, err := time.ParseDuration(os.Getenv("GATEWAY_IDEMPOTENCY_TTL"))
+	if err != nil || idempotencyTTL <= 0 {
+		idempotencyTTL = defaultIdempotencyTTL
+	}
+
+	idempotencyLockTTL, err := time.ParseDuration(os.Getenv("GATEWAY_IDEMPOTENCY_LOCK_TTL"))
+	if err != nil || idempotencyLockTTL <= 0 {
+		idempotencyLockTTL = defaultIdempotencyLockTTL
+	}
+
+	rateLimitFailOpen := defaultRateLimitFailOpen
+	if raw := os.Getenv("GATEWAY_RATE_LIMIT_FAIL_OPEN"); raw != "" {
+		if rateLimitFailOpen, err = strconv.ParseBool(raw); err != nil {
+			log.Printf("GATEWAY_RATE_LIMIT_FAIL_OPEN: %v, keeping %t", err, defaultRateLimitFailOpen)
+			rateLimitFailOpen = defaultRateLimitFailOpen
+		}
+	}
+
+	rateLimits := make(map[string]ratelimit.Limit, len(defaultRateLimits))
+	for group, limit := range defaultRateLimits {
+		rateLimits[group] = limit
+
+		env := "GATEWAY_RATE_LIMIT_" + strings.ToUpper(group)
+		raw := os.Getenv(env)
+		if raw == "" {
+			continue
+		}
+
+		parsed, err := ratelimit.ParseLimit(raw)
+		if err != nil {
+			log.Printf("%s: %v, keeping %d/%s", env, err, limit.Requests, limit.Window)
+			continue
+		}
+		rateLimits[group] = parsed
+	}
+
+	return Policy{
+		RequireVerified:    requireVerified,
+		LegacySunset:       legacySunset,
+		IdempotencyTTL:     idempotencyTTL,
+		IdempotencyLockTTL: idempotencyLockTTL,
+		RateLimits:         rateLimits,
+		RateLimitFailOpen:  rateLimitFailOpen,
+	}
+}
@@ -5,7 +5,10 @@ import (
 )
 
 type Router struct {
-	handlers []RouterHandlers
+	middleware []fiber.Handler
+	limit      func(method string) fiber.Handler
+	limitIP    fiber.Handler
+	handlers   []RouterHandlers
 }
 
 func NewRouter(handlers ...RouterHandlers) Router {
@@ -14,8 +17,50 @@ func NewRouter(handlers ...RouterHandlers) Router {
 	}
 }
 
-func (r *Router) Builder(router fiber.Router) {
+// Use returns a copy of the router that runs middleware in front of every
+// handler it builds.
+func (r Router) Use(middleware ...fiber.Handler) Router {
+	r.middleware = append(append([]fiber.Handler{}, r.middleware...), middleware...)
+	return r
+}
+
+// RateLimit returns a copy of the router that runs the limiter limit picks
+// for the method in front of every handler. A nil limit leaves the routes
+// unlimited.
+func (r Router) RateLimit(limit func(method string) fiber.Handler) Router {
+	r.limit = limit
+	return r
+}
+
+// RateLimitIP returns a copy of the router that runs limit in front of the
+// token validation of the secured routes, so clients sending bad tokens are
+// limited by their IP before the token is checked. A nil limit leaves the
+// validation unlimited.
+func (r Router) RateLimitIP(limit fiber.Handler) Router {
+	r.limitIP = limit
+	return r
+}
+
+// Builder mounts every route as its middleware, the IP limiter and the token
+// validation when the route is secured, then its limiter and its handlers.
+// The limiter runs after the validation to count the requests of a secured
+// route against the subject.
+func (r Router) Builder(router fiber.Router) {
 	for _, h := range r.handlers {
-		router.Add(h.Method, h.Path, h.Handler...)
+		handlers := append([]fiber.Handler{}, r.middleware...)
+
+		if h.Auth != nil {
+			if r.limitIP != nil {
+				handlers = append(handlers, r.limitIP)
+			}
+			handlers = append(handlers, h.Auth)
+		}
+
+		if r.limit != nil {
+			handlers = append(handlers, r.limit(h.Method))
+		}
+
+		handlers = append(handlers, h.Handler...)
+		router.Add(h.Method, h.Path, handlers...)
 	}
 }
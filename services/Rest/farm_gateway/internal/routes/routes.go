@@ -1,13 +1,21 @@
 package routes
 
 import (
+	"encoding/json"
 	"net/http"
+	"sync"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/api"
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/handlers/authh"
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/handlers/farmerh"
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/handlers/farmh"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/health"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/idempotency"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/openapi"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/problem"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/ratelimit"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/tokverify"
 )
 
 type Routes struct {
@@ -15,6 +23,15 @@ type Routes struct {
 	authSvc   api.GrpcAuthService
 	farmerSvc api.GrpcFarmerService
 	farmSvc   api.GrpcFarmService
+	verifier  tokverify.Verifier
+	idemStore idempotency.Store
+	limiter   *ratelimit.Limiter
+	readiness *health.Readiness
+	policy    Policy
+	registry  []openapi.Route
+	specOnce  sync.Once
+	spec      []byte
+	specErr   error
 }
 
 func NewRoutes(
@@ -22,48 +39,237 @@ func NewRoutes(
 	authSvc api.GrpcAuthService,
 	farmerSvc api.GrpcFarmerService,
 	farmSvc api.GrpcFarmService,
+	verifier tokverify.Verifier,
+	idemStore idempotency.Store,
+	limiter *ratelimit.Limiter,
+	readiness *health.Readiness,
+	policy Policy,
 ) *Routes {
 	return &Routes{
-		app,
-		authSvc,
-		farmerSvc,
-		farmSvc,
+		app:       app,
+		authSvc:   authSvc,
+		farmerSvc: farmerSvc,
+		farmSvc:   farmSvc,
+		verifier:  verifier,
+		idemStore: idemStore,
+		limiter:   limiter,
+		readiness: readiness,
+		policy:    policy,
+	}
+}
+
+// mount routes router under prefix and records its handlers for the spec.
+func (r *Routes) mount(prefix, tag string, router Router) {
+	r.app.Route(prefix, router.Builder)
+	r.record(prefix, tag, false, router)
+}
+
+// mountLegacy mounts a deprecated alias of the successor prefix.
+func (r *Routes) mountLegacy(prefix, tag, successor string, router Router) {
+	deprecated := Deprecated(legacyDeprecatedAt, r.policy.LegacySunset, successor)
+	r.app.Route(prefix, router.Use(deprecated).Builder)
+	r.record(prefix, tag, true, router)
+}
+
+func (r *Routes) record(prefix, tag string, deprecated bool, router Router) {
+	for _, h := range router.handlers {
+		r.registry = append(r.registry, openapi.Route{
+			Method:      h.Method,
+			Path:        prefix + h.Path,
+			Tag:         tag,
+			Deprecated:  deprecated,
+			RateLimited: router.limit != nil,
+			Doc:         h.Doc,
+		})
+	}
+}
+
+// Spec is the OpenAPI document of every route mounted so far.
+func (r *Routes) Spec() openapi.Document {
+	return openapi.Build(openapi.Info{
+		Title:       "Farm Gateway API",
+		Version:     "1.0.0",
+		Description: "REST gateway in front of the auth, farmer and farm services.",
+	}, r.registry)
+}
+
+// limitGroup puts every route in the bucket of group, nil without a limiter.
+func (r *Routes) limitGroup(group string) func(method string) fiber.Handler {
+	if r.limiter == nil {
+		return nil
+	}
+
+	limit := r.limiter.Middleware(group, r.policy.RateLimits[group], r.policy.RateLimitFailOpen)
+	return func(string) fiber.Handler { return limit }
+}
+
+// limitIP limits the requests of a client IP to the secured routes before
+// their token is validated, nil without a limiter.
+func (r *Routes) limitIP() fiber.Handler {
+	if r.limiter == nil {
+		return nil
+	}
+
+	return r.limiter.Middleware(RateLimitIP, r.policy.RateLimits[RateLimitIP], r.policy.RateLimitFailOpen)
+}
+
+// limitByMethod puts the reads in the read bucket and everything else in
+// the write bucket, nil without a limiter.
+func (r *Routes) limitByMethod() func(method string) fiber.Handler {
+	if r.limiter == nil {
+		return nil
+	}
+
+	read := r.limiter.Middleware(RateLimitRead, r.policy.RateLimits[RateLimitRead], r.policy.RateLimitFailOpen)
+	write := r.limiter.Middleware(RateLimitWrite, r.policy.RateLimits[RateLimitWrite], r.policy.RateLimitFailOpen)
+	return func(method string) fiber.Handler {
+		if method == http.MethodGet || method == http.MethodHead {
+			return read
+		}
+		return write
 	}
 }
 
 func (r *Routes) Build() {
-	authHandler := authh.NewAuthHandler(r.authSvc)
+	authHandler := authh.NewAuthHandler(r.authSvc, r.verifier)
+	tokenAuth := authHandler.AuthTokenBaseValidate
 
-	signupHandler := NewRouterHandlers("/signup", http.MethodPost, authHandler.SignUp)
-	signInHandler := NewRouterHandlers("/signin", http.MethodPost, authHandler.SignIn)
-	authRouter := NewRouter(
+	writeGuard := func(h fiber.Handler) []fiber.Handler {
+		var handlers []fiber.Handler
+		if r.policy.RequireVerified {
+			handlers = append(handlers, authHandler.RequireVerified)
+		}
+		// without a store the Idempotency-Key header is ignored
+		if r.idemStore != nil {
+			handlers = append(handlers, idempotency.Middleware(r.idemStore, r.policy.IdempotencyLockTTL, r.policy.IdempotencyTTL))
+		}
+		return append(handlers, h)
+	}
+
+	signupHandler := NewRouterHandlers("/signup", http.MethodPost, authHandler.SignUp).WithDoc(signUpDoc)
+	signupStatusHandler := NewRouterHandlers("/signup/:id", http.MethodGet, authHandler.SignUpStatus).WithDoc(signUpStatusDoc)
+	signInHandler := NewRouterHandlers("/signin", http.MethodPost, authHandler.SignIn).WithDoc(signInDoc)
+	refreshHandler := NewRouterHandlers("/refresh", http.MethodPost, authHandler.Refresh).WithDoc(refreshDoc)
+	logoutHandler := NewRouterHandlers("/logout", http.MethodPost, authHandler.Logout).WithAuth(tokenAuth).WithDoc(logoutDoc)
+	listSessionsHandler := NewRouterHandlers("/sessions", http.MethodGet, authHandler.ListSessions).WithAuth(tokenAuth).WithDoc(listSessionsDoc)
+	revokeSessionHandler := NewRouterHandlers("/sessions/:id", http.MethodDelete, authHandler.RevokeSession).WithAuth(tokenAuth).WithDoc(revokeSessionDoc)
+	verifyEmailHandler := NewRouterHandlers("/verify", http.MethodGet, authHandler.VerifyEmail).WithDoc(verifyEmailDoc)
+	forgotPasswordHandler := NewRouterHandlers("/password/forgot", http.MethodPost, authHandler.ForgotPassword).WithDoc(forgotPasswordDoc)
+	resetPasswordHandler := NewRouterHandlers("/password/reset", http.MethodPost, authHandler.ResetPassword).WithDoc(resetPasswordDoc)
+	changePasswordHandler := NewRouterHandlers("/password/change", http.MethodPost, authHandler.ChangePassword).WithAuth(tokenAuth).WithDoc(changePasswordDoc)
+	enrollTOTPHandler := NewRouterHandlers("/2fa/enroll", http.MethodPost, authHandler.EnrollTOTP).WithAuth(tokenAuth).WithDoc(enrollTOTPDoc)
+	confirmTOTPHandler := NewRouterHandlers("/2fa/confirm", http.MethodPost, authHandler.ConfirmTOTP).WithAuth(tokenAuth).WithDoc(confirmTOTPDoc)
+	disableTOTPHandler := NewRouterHandlers("/2fa/disable", http.MethodPost, authHandler.DisableTOTP).WithAuth(tokenAuth).WithDoc(disableTOTPDoc)
+	verifySecondFactorHandler := NewRouterHandlers("/2fa/verify", http.MethodPost, authHandler.VerifySecondFactor).WithDoc(verifySecondFactorDoc)
+	// the routes that take a password, an email or a second factor from an
+	// unauthenticated client get the strict bucket of the auth group
+	credentialsRouter := NewRouter(
 		signupHandler,
 		signInHandler,
-	)
+		forgotPasswordHandler,
+		resetPasswordHandler,
+		verifySecondFactorHandler,
+	).RateLimit(r.limitGroup(RateLimitAuth)).RateLimitIP(r.limitIP())
+	authRouter := NewRouter(
+		signupStatusHandler,
+		refreshHandler,
+		logoutHandler,
+		listSessionsHandler,
+		revokeSessionHandler,
+		verifyEmailHandler,
+		changePasswordHandler,
+		enrollTOTPHandler,
+		confirmTOTPHandler,
+		disableTOTPHandler,
+	).RateLimit(r.limitByMethod()).RateLimitIP(r.limitIP())
 
-	r.app.Route("/auth", authRouter.Builder)
+	r.mount("/v1/auth", "auth", credentialsRouter)
+	r.mount("/v1/auth", "auth", authRouter)
+	// the unversioned paths are kept as deprecated aliases of /v1
+	r.mountLegacy("/auth", "auth", "/v1/auth", credentialsRouter)
+	r.mountLegacy("/auth", "auth", "/v1/auth", authRouter)
 
 	farmerHandler := farmerh.NewFarmerHandler(r.farmerSvc)
-	farmerProfileHandler := NewRouterHandlers("/profile", http.MethodGet, authHandler.AuthTokenBaseValidate, farmerHandler.GetFarmerProfile)
-	updateProfileHandler := NewRouterHandlers("/update_profile", http.MethodPatch, authHandler.AuthTokenBaseValidate, farmerHandler.UpdateUsers)
+	farmerProfileHandler := NewRouterHandlers("/profile", http.MethodGet, farmerHandler.GetFarmerProfile).WithAuth(tokenAuth).WithDoc(profileDoc)
+	updateProfileHandler := NewRouterHandlers("/update_profile", http.MethodPatch, writeGuard(farmerHandler.UpdateUsers)...).WithAuth(tokenAuth).WithDoc(updateProfileDoc)
+	farmerLoginsHandler := NewRouterHandlers("/logins", http.MethodGet, farmerHandler.GetLogins).WithAuth(tokenAuth).WithDoc(loginsDoc)
 	farmerRouter := NewRouter(
 		farmerProfileHandler,
 		updateProfileHandler,
-	)
+		farmerLoginsHandler,
+	).RateLimit(r.limitByMethod()).RateLimitIP(r.limitIP())
 
-	r.app.Route("/farmer", farmerRouter.Builder)
+	r.mountLegacy("/farmer", "farmer", "/v1/me", farmerRouter)
+
+	meRouter := NewRouter(
+		NewRouterHandlers("", http.MethodGet, farmerHandler.GetFarmerProfile).WithAuth(tokenAuth).WithDoc(profileDoc),
+		NewRouterHandlers("", http.MethodPatch, writeGuard(farmerHandler.UpdateUsers)...).WithAuth(tokenAuth).WithDoc(updateProfileDoc),
+		NewRouterHandlers("/logins", http.MethodGet, farmerHandler.GetLogins).WithAuth(tokenAuth).WithDoc(loginsDoc),
+		NewRouterHandlers("/sessions", http.MethodGet, authHandler.ListSessions).WithAuth(tokenAuth).WithDoc(listSessionsDoc),
+		NewRouterHandlers("/sessions/:id", http.MethodDelete, authHandler.RevokeSession).WithAuth(tokenAuth).WithDoc(revokeSessionDoc),
+	).RateLimit(r.limitByMethod()).RateLimitIP(r.limitIP())
+
+	r.mount("/v1/me", "farmer", meRouter)
 
 	farmHandler := farmh.NewFarmHandler(r.farmSvc)
-	farmCreateFarmHandler := NewRouterHandlers("/create", http.MethodPost, authHandler.AuthTokenBaseValidate, farmHandler.CreateFarm)
-	farmUpdateHandler := NewRouterHandlers("/update", http.MethodPatch, authHandler.AuthTokenBaseValidate, farmHandler.UpdateFarm)
-	farmGetFarmsHandler := NewRouterHandlers("/list", http.MethodPost, authHandler.AuthTokenBaseValidate, farmHandler.GetFarms)
-	farmGetByIDHandler := NewRouterHandlers("", http.MethodPost, authHandler.AuthTokenBaseValidate, farmHandler.GetFarmByID)
+	farmCreateFarmHandler := NewRouterHandlers("/create", http.MethodPost, writeGuard(farmHandler.CreateFarm)...).WithAuth(tokenAuth).WithDoc(legacyCreateFarmDoc)
+	farmUpdateHandler := NewRouterHandlers("/update", http.MethodPatch, writeGuard(farmHandler.UpdateFarm)...).WithAuth(tokenAuth).WithDoc(legacyUpdateFarmDoc)
+	farmGetFarmsHandler := NewRouterHandlers("/list", http.MethodPost, farmHandler.GetFarms).WithAuth(tokenAuth).WithDoc(legacyListFarmsDoc)
+	farmGetByIDHandler := NewRouterHandlers("", http.MethodPost, farmHandler.GetFarmByID).WithAuth(tokenAuth).WithDoc(legacyGetFarmDoc)
+	farmDeleteHandler := NewRouterHandlers("/:id", http.MethodDelete, writeGuard(farmHandler.DeleteFarm)...).WithAuth(tokenAuth).WithDoc(deleteFarmDoc)
 	farmRouter := NewRouter(
 		farmCreateFarmHandler,
 		farmUpdateHandler,
 		farmGetFarmsHandler,
 		farmGetByIDHandler,
+		farmDeleteHandler,
+	).RateLimit(r.limitByMethod()).RateLimitIP(r.limitIP())
+
+	r.mountLegacy("/farm", "farm", "/v1/farms", farmRouter)
+
+	farmsRouter := NewRouter(
+		NewRouterHandlers("", http.MethodGet, farmHandler.ListFarms).WithAuth(tokenAuth).WithDoc(listFarmsDoc),
+		NewRouterHandlers("", http.MethodPost, writeGuard(farmHandler.PostFarm)...).WithAuth(tokenAuth).WithDoc(postFarmDoc),
+		NewRouterHandlers("/search", http.MethodGet, farmHandler.SearchFarms).WithAuth(tokenAuth).WithDoc(searchFarmsDoc),
+		NewRouterHandlers("/:id", http.MethodGet, farmHandler.GetFarm).WithAuth(tokenAuth).WithDoc(getFarmDoc),
+		NewRouterHandlers("/:id", http.MethodPatch, writeGuard(farmHandler.PatchFarm)...).WithAuth(tokenAuth).WithDoc(patchFarmDoc),
+		NewRouterHandlers("/:id", http.MethodDelete, writeGuard(farmHandler.DeleteFarm)...).WithAuth(tokenAuth).WithDoc(deleteFarmDoc),
+	).RateLimit(r.limitByMethod()).RateLimitIP(r.limitIP())
+
+	r.mount("/v1/farms", "farm", farmsRouter)
+
+	docsRouter := NewRouter(
+		NewRouterHandlers("/openapi.json", http.MethodGet, r.serveSpec).WithDoc(openAPIDoc),
+		NewRouterHandlers("/docs", http.MethodGet, serveExplorer).WithDoc(explorerDoc),
 	)
 
-	r.app.Route("/farm", farmRouter.Builder)
+	r.mount("", "docs", docsRouter)
+
+	healthHandlers := []RouterHandlers{
+		NewRouterHandlers("/healthz", http.MethodGet, health.Live).WithDoc(healthzDoc),
+	}
+	// without downstreams to ask the gateway has no readiness to report
+	if r.readiness != nil {
+		healthHandlers = append(healthHandlers, NewRouterHandlers("/readyz", http.MethodGet, r.readiness.Ready).WithDoc(readyzDoc))
+	}
+
+	r.mount("", "health", NewRouter(healthHandlers...))
+}
+
+func (r *Routes) serveSpec(c *fiber.Ctx) error {
+	// the registry is complete once the app serves, so the spec is marshaled once
+	r.specOnce.Do(func() {
+		r.spec, r.specErr = json.Marshal(r.Spec())
+	})
+	if r.specErr != nil {
+		return problem.Respond(c, http.StatusInternalServerError, r.specErr.Error())
+	}
+
+	c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSONCharsetUTF8)
+	return c.Send(r.spec)
+}
+
+func serveExplorer(c *fiber.Ctx) error {
+	c.Set(fiber.HeaderContentType, fiber.MIMETextHTMLCharsetUTF8)
+	return c.Send(openapi.Explorer)
 }
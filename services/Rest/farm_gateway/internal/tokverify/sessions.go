@@ -0,0 +1,78 @@
+package tokverify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/o1egl/paseto"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/database/redis"
+)
+
+var ErrSessionRevoked error = errors.New("invalid token session is revoked")
+
+//go:generate mockgen -source=sessions.go -destination=../../test/mocks/mock_revocation_list.go -package=mocks
+type RevocationList interface {
+	IsRevoked(ctx context.Context, sessionID string) (bool, error)
+}
+
+// redisRevocationList reads the revocation list the auth service writes on
+// Logout, RevokeSession and a password change.
+type redisRevocationList struct {
+	rdb redis.RedisClient
+}
+
+func NewRedisRevocationList(rdb redis.RedisClient) RevocationList {
+	return redisRevocationList{rdb: rdb}
+}
+
+// revokedSessionKey is the key of the auth service for a revoked session.
+func revokedSessionKey(sessionID string) string {
+	return fmt.Sprintf("revoked_session:%s", sessionID)
+}
+
+func (rl redisRevocationList) IsRevoked(ctx context.Context, sessionID string) (bool, error) {
+	err := rl.rdb.HGet(ctx, revokedSessionKey(sessionID), "revoked_at").Err()
+	if errors.Is(err, redis.RedisNil) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+type sessionVerifier struct {
+	Verifier
+	revoked RevocationList
+}
+
+// WithSessionCheck makes verifier reject the tokens of a revoked session.
+// The session of every token is looked up in the revocation list, so a
+// session stops working at the gateway as soon as it is revoked.
+func WithSessionCheck(verifier Verifier, revoked RevocationList) Verifier {
+	return sessionVerifier{
+		Verifier: verifier,
+		revoked:  revoked,
+	}
+}
+
+func (sv sessionVerifier) Verify(ctx context.Context, token string) (paseto.JSONToken, error) {
+	jsonToken, err := sv.Verifier.Verify(ctx, token)
+	if err != nil {
+		return jsonToken, err
+	}
+
+	revoked, err := sv.revoked.IsRevoked(ctx, jsonToken.Jti)
+	if err != nil {
+		return jsonToken, fmt.Errorf("check session revocation: %w", err)
+	}
+
+	if revoked {
+		return jsonToken, ErrSessionRevoked
+	}
+
+	return jsonToken, nil
+}
@@ -0,0 +1,171 @@
+package tokverify
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/o1egl/paseto"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/api"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/pbgen"
+	"golang.org/x/sync/singleflight"
+)
+
+var (
+	ErrTokenInvalid  error = errors.New("invalid token failed to verify")
+	ErrTokenExperied error = errors.New("invalid token token is experied")
+	ErrUnknownKeyID  error = errors.New("invalid token unknown key id")
+)
+
+const (
+	DefaultRefreshInterval = 5 * time.Minute
+	// minRefreshGap stops a flood of tokens with unknown kid from turning
+	// into a flood of GetVerificationKeys calls, a failed call counts too.
+	// The calls that overlap share one through refreshes.
+	minRefreshGap = 10 * time.Second
+)
+
+//go:generate mockgen -source=verifier.go -destination=../../test/mocks/mock_verifier.go -package=mocks
+type Verifier interface {
+	Verify(ctx context.Context, token string) (paseto.JSONToken, error)
+	Refresh(ctx context.Context) error
+	Run(ctx context.Context, interval time.Duration)
+}
+
+type tokenFooter struct {
+	KeyID string `json:"kid"`
+}
+
+type keyCache struct {
+	authSvc api.GrpcAuthService
+	v2      *paseto.V2
+
+	refreshes singleflight.Group
+
+	mu          sync.RWMutex
+	keys        map[string]ed25519.PublicKey
+	lastAttempt time.Time
+}
+
+// NewVerifier verifies v2.public tokens in-process with keys published by
+// the auth service. Every published key is accepted so tokens signed before
+// a rotation keep working until they expire.
+func NewVerifier(authSvc api.GrpcAuthService) Verifier {
+	return &keyCache{
+		authSvc: authSvc,
+		v2:      paseto.NewV2(),
+		keys:    make(map[string]ed25519.PublicKey),
+	}
+}
+
+func (kc *keyCache) Refresh(ctx context.Context) error {
+	kc.mu.Lock()
+	kc.lastAttempt = time.Now()
+	kc.mu.Unlock()
+
+	res, err := kc.authSvc.AuthVerificationKeys(ctx, &pbgen.GetVerificationKeysRequest{})
+	if err != nil {
+		return err
+	}
+
+	keys := make(map[string]ed25519.PublicKey, len(res.GetKeys()))
+	for _, k := range res.GetKeys() {
+		if len(k.GetPublicKey()) != ed25519.PublicKeySize {
+			continue
+		}
+		keys[k.GetKid()] = ed25519.PublicKey(k.GetPublicKey())
+	}
+
+	kc.mu.Lock()
+	kc.keys = keys
+	kc.mu.Unlock()
+
+	return nil
+}
+
+func (kc *keyCache) Run(ctx context.Context, interval time.Duration) {
+	if err := kc.Refresh(ctx); err != nil {
+		log.Printf("tokverify: initial key refresh failed: %v\n", err)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := kc.Refresh(ctx); err != nil {
+				log.Printf("tokverify: key refresh failed: %v\n", err)
+			}
+		}
+	}
+}
+
+func (kc *keyCache) publicKey(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+	kc.mu.RLock()
+	pub, ok := kc.keys[kid]
+	kc.mu.RUnlock()
+
+	if ok {
+		return pub, nil
+	}
+
+	// A kid we have not seen yet usually means the auth service rotated
+	// keys since our last refresh. The refresh outlives the request that
+	// started it, the requests waiting on it share its result.
+	kc.refreshes.Do("refresh", func() (any, error) {
+		kc.mu.RLock()
+		due := time.Since(kc.lastAttempt) > minRefreshGap
+		kc.mu.RUnlock()
+
+		if !due {
+			return nil, nil
+		}
+
+		err := kc.Refresh(context.WithoutCancel(ctx))
+		if err != nil {
+			log.Printf("tokverify: key refresh for unknown kid failed: %v\n", err)
+		}
+
+		return nil, err
+	})
+
+	kc.mu.RLock()
+	pub, ok = kc.keys[kid]
+	kc.mu.RUnlock()
+
+	if !ok {
+		return nil, ErrUnknownKeyID
+	}
+
+	return pub, nil
+}
+
+func (kc *keyCache) Verify(ctx context.Context, token string) (paseto.JSONToken, error) {
+	var jsonToken paseto.JSONToken
+	var footer tokenFooter
+
+	if err := paseto.ParseFooter(token, &footer); err != nil {
+		return jsonToken, ErrTokenInvalid
+	}
+
+	pub, err := kc.publicKey(ctx, footer.KeyID)
+	if err != nil {
+		return jsonToken, err
+	}
+
+	if err := kc.v2.Verify(token, pub, &jsonToken, nil); err != nil {
+		return jsonToken, ErrTokenInvalid
+	}
+
+	if jsonToken.Expiration.Before(time.Now()) {
+		return jsonToken, ErrTokenExperied
+	}
+
+	return jsonToken, nil
+}
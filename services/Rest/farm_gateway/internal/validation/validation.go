@@ -0,0 +1,73 @@
+// Package validation mirrors the buf.validate rules of the service protos in
+// the gateway so invalid requests are rejected before they are dispatched.
+// Violations are returned as the same InvalidArgument status with
+// errdetails.BadRequest the services would answer with.
+package validation
+
+import (
+	"context"
+	"fmt"
+
+	"buf.build/go/protovalidate"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/grpcstatus"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Message validates a single request.
+func Message(msg proto.Message) error {
+	return Items("", msg)
+}
+
+// Items validates a batch sent over a client stream. Every violation of the
+// batch is reported, prefixed with the field and index of its item, e.g.
+// "data[2].farm.farm_size".
+func Items[T proto.Message](field string, items ...T) error {
+	badRequest := &errdetails.BadRequest{}
+
+	for i, item := range items {
+		err := protovalidate.Validate(item)
+		if err == nil {
+			continue
+		}
+
+		prefix := field
+		if len(items) > 1 || field != "" {
+			prefix = fmt.Sprintf("%s[%d]", field, i)
+		}
+
+		itemViolations, ok := grpcstatus.Violations(prefix, err)
+		if !ok {
+			return status.Error(codes.Internal, err.Error())
+		}
+		badRequest.FieldViolations = append(badRequest.FieldViolations, itemViolations.GetFieldViolations()...)
+	}
+
+	if len(badRequest.GetFieldViolations()) == 0 {
+		return nil
+	}
+
+	return grpcstatus.InvalidArgument(badRequest)
+}
+
+// UnaryClientInterceptor validates the request of a unary call and fails the
+// call without invoking the service when it breaks the declared rules.
+func UnaryClientInterceptor(
+	ctx context.Context,
+	method string,
+	req, res any,
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	if msg, ok := req.(proto.Message); ok {
+		if err := Message(msg); err != nil {
+			return err
+		}
+	}
+
+	return invoker(ctx, method, req, res, cc, opts...)
+}
@@ -56,6 +56,186 @@ func (mr *MockAuthServiceClientMockRecorder) AuthenticateUser(ctx, in interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateUser", reflect.TypeOf((*MockAuthServiceClient)(nil).AuthenticateUser), varargs...)
 }
 
+// ChangePassword mocks base method.
+func (m *MockAuthServiceClient) ChangePassword(ctx context.Context, in *pbgen.ChangePasswordRequest, opts ...grpc.CallOption) (*pbgen.ChangePasswordResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ChangePassword", varargs...)
+	ret0, _ := ret[0].(*pbgen.ChangePasswordResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockAuthServiceClientMockRecorder) ChangePassword(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockAuthServiceClient)(nil).ChangePassword), varargs...)
+}
+
+// ConfirmTOTP mocks base method.
+func (m *MockAuthServiceClient) ConfirmTOTP(ctx context.Context, in *pbgen.ConfirmTOTPRequest, opts ...grpc.CallOption) (*pbgen.ConfirmTOTPResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ConfirmTOTP", varargs...)
+	ret0, _ := ret[0].(*pbgen.ConfirmTOTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmTOTP indicates an expected call of ConfirmTOTP.
+func (mr *MockAuthServiceClientMockRecorder) ConfirmTOTP(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmTOTP", reflect.TypeOf((*MockAuthServiceClient)(nil).ConfirmTOTP), varargs...)
+}
+
+// DisableTOTP mocks base method.
+func (m *MockAuthServiceClient) DisableTOTP(ctx context.Context, in *pbgen.DisableTOTPRequest, opts ...grpc.CallOption) (*pbgen.DisableTOTPResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DisableTOTP", varargs...)
+	ret0, _ := ret[0].(*pbgen.DisableTOTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableTOTP indicates an expected call of DisableTOTP.
+func (mr *MockAuthServiceClientMockRecorder) DisableTOTP(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTOTP", reflect.TypeOf((*MockAuthServiceClient)(nil).DisableTOTP), varargs...)
+}
+
+// EnrollTOTP mocks base method.
+func (m *MockAuthServiceClient) EnrollTOTP(ctx context.Context, in *pbgen.EnrollTOTPRequest, opts ...grpc.CallOption) (*pbgen.EnrollTOTPResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EnrollTOTP", varargs...)
+	ret0, _ := ret[0].(*pbgen.EnrollTOTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnrollTOTP indicates an expected call of EnrollTOTP.
+func (mr *MockAuthServiceClientMockRecorder) EnrollTOTP(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollTOTP", reflect.TypeOf((*MockAuthServiceClient)(nil).EnrollTOTP), varargs...)
+}
+
+// GetRegistrationStatus mocks base method.
+func (m *MockAuthServiceClient) GetRegistrationStatus(ctx context.Context, in *pbgen.GetRegistrationStatusRequest, opts ...grpc.CallOption) (*pbgen.GetRegistrationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRegistrationStatus", varargs...)
+	ret0, _ := ret[0].(*pbgen.GetRegistrationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegistrationStatus indicates an expected call of GetRegistrationStatus.
+func (mr *MockAuthServiceClientMockRecorder) GetRegistrationStatus(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistrationStatus", reflect.TypeOf((*MockAuthServiceClient)(nil).GetRegistrationStatus), varargs...)
+}
+
+// GetVerificationKeys mocks base method.
+func (m *MockAuthServiceClient) GetVerificationKeys(ctx context.Context, in *pbgen.GetVerificationKeysRequest, opts ...grpc.CallOption) (*pbgen.GetVerificationKeysResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVerificationKeys", varargs...)
+	ret0, _ := ret[0].(*pbgen.GetVerificationKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVerificationKeys indicates an expected call of GetVerificationKeys.
+func (mr *MockAuthServiceClientMockRecorder) GetVerificationKeys(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVerificationKeys", reflect.TypeOf((*MockAuthServiceClient)(nil).GetVerificationKeys), varargs...)
+}
+
+// ListSessions mocks base method.
+func (m *MockAuthServiceClient) ListSessions(ctx context.Context, in *pbgen.ListSessionsRequest, opts ...grpc.CallOption) (*pbgen.ListSessionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSessions", varargs...)
+	ret0, _ := ret[0].(*pbgen.ListSessionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockAuthServiceClientMockRecorder) ListSessions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockAuthServiceClient)(nil).ListSessions), varargs...)
+}
+
+// Logout mocks base method.
+func (m *MockAuthServiceClient) Logout(ctx context.Context, in *pbgen.LogoutRequest, opts ...grpc.CallOption) (*pbgen.LogoutResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Logout", varargs...)
+	ret0, _ := ret[0].(*pbgen.LogoutResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockAuthServiceClientMockRecorder) Logout(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthServiceClient)(nil).Logout), varargs...)
+}
+
+// RefreshToken mocks base method.
+func (m *MockAuthServiceClient) RefreshToken(ctx context.Context, in *pbgen.RefreshTokenRequest, opts ...grpc.CallOption) (*pbgen.RefreshTokenResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RefreshToken", varargs...)
+	ret0, _ := ret[0].(*pbgen.RefreshTokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshToken indicates an expected call of RefreshToken.
+func (mr *MockAuthServiceClientMockRecorder) RefreshToken(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshToken", reflect.TypeOf((*MockAuthServiceClient)(nil).RefreshToken), varargs...)
+}
+
 // RegisterUser mocks base method.
 func (m *MockAuthServiceClient) RegisterUser(ctx context.Context, in *pbgen.RegisterUserRequest, opts ...grpc.CallOption) (*pbgen.RegisterUserResponse, error) {
 	m.ctrl.T.Helper()
@@ -76,6 +256,66 @@ func (mr *MockAuthServiceClientMockRecorder) RegisterUser(ctx, in interface{}, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterUser", reflect.TypeOf((*MockAuthServiceClient)(nil).RegisterUser), varargs...)
 }
 
+// RequestPasswordReset mocks base method.
+func (m *MockAuthServiceClient) RequestPasswordReset(ctx context.Context, in *pbgen.RequestPasswordResetRequest, opts ...grpc.CallOption) (*pbgen.RequestPasswordResetResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RequestPasswordReset", varargs...)
+	ret0, _ := ret[0].(*pbgen.RequestPasswordResetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestPasswordReset indicates an expected call of RequestPasswordReset.
+func (mr *MockAuthServiceClientMockRecorder) RequestPasswordReset(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPasswordReset", reflect.TypeOf((*MockAuthServiceClient)(nil).RequestPasswordReset), varargs...)
+}
+
+// ResetPassword mocks base method.
+func (m *MockAuthServiceClient) ResetPassword(ctx context.Context, in *pbgen.ResetPasswordRequest, opts ...grpc.CallOption) (*pbgen.ResetPasswordResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetPassword", varargs...)
+	ret0, _ := ret[0].(*pbgen.ResetPasswordResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockAuthServiceClientMockRecorder) ResetPassword(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockAuthServiceClient)(nil).ResetPassword), varargs...)
+}
+
+// RevokeSession mocks base method.
+func (m *MockAuthServiceClient) RevokeSession(ctx context.Context, in *pbgen.RevokeSessionRequest, opts ...grpc.CallOption) (*pbgen.RevokeSessionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevokeSession", varargs...)
+	ret0, _ := ret[0].(*pbgen.RevokeSessionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockAuthServiceClientMockRecorder) RevokeSession(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockAuthServiceClient)(nil).RevokeSession), varargs...)
+}
+
 // TokenValidate mocks base method.
 func (m *MockAuthServiceClient) TokenValidate(ctx context.Context, in *pbgen.TokenValidateRequest, opts ...grpc.CallOption) (*pbgen.TokenValidateResponse, error) {
 	m.ctrl.T.Helper()
@@ -96,6 +336,46 @@ func (mr *MockAuthServiceClientMockRecorder) TokenValidate(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TokenValidate", reflect.TypeOf((*MockAuthServiceClient)(nil).TokenValidate), varargs...)
 }
 
+// VerifyEmail mocks base method.
+func (m *MockAuthServiceClient) VerifyEmail(ctx context.Context, in *pbgen.VerifyEmailRequest, opts ...grpc.CallOption) (*pbgen.VerifyEmailResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "VerifyEmail", varargs...)
+	ret0, _ := ret[0].(*pbgen.VerifyEmailResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockAuthServiceClientMockRecorder) VerifyEmail(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockAuthServiceClient)(nil).VerifyEmail), varargs...)
+}
+
+// VerifySecondFactor mocks base method.
+func (m *MockAuthServiceClient) VerifySecondFactor(ctx context.Context, in *pbgen.VerifySecondFactorRequest, opts ...grpc.CallOption) (*pbgen.AuthenticateUserResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "VerifySecondFactor", varargs...)
+	ret0, _ := ret[0].(*pbgen.AuthenticateUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifySecondFactor indicates an expected call of VerifySecondFactor.
+func (mr *MockAuthServiceClientMockRecorder) VerifySecondFactor(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifySecondFactor", reflect.TypeOf((*MockAuthServiceClient)(nil).VerifySecondFactor), varargs...)
+}
+
 // MockAuthServiceServer is a mock of AuthServiceServer interface.
 type MockAuthServiceServer struct {
 	ctrl     *gomock.Controller
@@ -134,6 +414,141 @@ func (mr *MockAuthServiceServerMockRecorder) AuthenticateUser(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateUser", reflect.TypeOf((*MockAuthServiceServer)(nil).AuthenticateUser), arg0, arg1)
 }
 
+// ChangePassword mocks base method.
+func (m *MockAuthServiceServer) ChangePassword(arg0 context.Context, arg1 *pbgen.ChangePasswordRequest) (*pbgen.ChangePasswordResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", arg0, arg1)
+	ret0, _ := ret[0].(*pbgen.ChangePasswordResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockAuthServiceServerMockRecorder) ChangePassword(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockAuthServiceServer)(nil).ChangePassword), arg0, arg1)
+}
+
+// ConfirmTOTP mocks base method.
+func (m *MockAuthServiceServer) ConfirmTOTP(arg0 context.Context, arg1 *pbgen.ConfirmTOTPRequest) (*pbgen.ConfirmTOTPResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmTOTP", arg0, arg1)
+	ret0, _ := ret[0].(*pbgen.ConfirmTOTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmTOTP indicates an expected call of ConfirmTOTP.
+func (mr *MockAuthServiceServerMockRecorder) ConfirmTOTP(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmTOTP", reflect.TypeOf((*MockAuthServiceServer)(nil).ConfirmTOTP), arg0, arg1)
+}
+
+// DisableTOTP mocks base method.
+func (m *MockAuthServiceServer) DisableTOTP(arg0 context.Context, arg1 *pbgen.DisableTOTPRequest) (*pbgen.DisableTOTPResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableTOTP", arg0, arg1)
+	ret0, _ := ret[0].(*pbgen.DisableTOTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableTOTP indicates an expected call of DisableTOTP.
+func (mr *MockAuthServiceServerMockRecorder) DisableTOTP(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTOTP", reflect.TypeOf((*MockAuthServiceServer)(nil).DisableTOTP), arg0, arg1)
+}
+
+// EnrollTOTP mocks base method.
+func (m *MockAuthServiceServer) EnrollTOTP(arg0 context.Context, arg1 *pbgen.EnrollTOTPRequest) (*pbgen.EnrollTOTPResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnrollTOTP", arg0, arg1)
+	ret0, _ := ret[0].(*pbgen.EnrollTOTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnrollTOTP indicates an expected call of EnrollTOTP.
+func (mr *MockAuthServiceServerMockRecorder) EnrollTOTP(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollTOTP", reflect.TypeOf((*MockAuthServiceServer)(nil).EnrollTOTP), arg0, arg1)
+}
+
+// GetRegistrationStatus mocks base method.
+func (m *MockAuthServiceServer) GetRegistrationStatus(arg0 context.Context, arg1 *pbgen.GetRegistrationStatusRequest) (*pbgen.GetRegistrationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegistrationStatus", arg0, arg1)
+	ret0, _ := ret[0].(*pbgen.GetRegistrationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegistrationStatus indicates an expected call of GetRegistrationStatus.
+func (mr *MockAuthServiceServerMockRecorder) GetRegistrationStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistrationStatus", reflect.TypeOf((*MockAuthServiceServer)(nil).GetRegistrationStatus), arg0, arg1)
+}
+
+// GetVerificationKeys mocks base method.
+func (m *MockAuthServiceServer) GetVerificationKeys(arg0 context.Context, arg1 *pbgen.GetVerificationKeysRequest) (*pbgen.GetVerificationKeysResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVerificationKeys", arg0, arg1)
+	ret0, _ := ret[0].(*pbgen.GetVerificationKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVerificationKeys indicates an expected call of GetVerificationKeys.
+func (mr *MockAuthServiceServerMockRecorder) GetVerificationKeys(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVerificationKeys", reflect.TypeOf((*MockAuthServiceServer)(nil).GetVerificationKeys), arg0, arg1)
+}
+
+// ListSessions mocks base method.
+func (m *MockAuthServiceServer) ListSessions(arg0 context.Context, arg1 *pbgen.ListSessionsRequest) (*pbgen.ListSessionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", arg0, arg1)
+	ret0, _ := ret[0].(*pbgen.ListSessionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockAuthServiceServerMockRecorder) ListSessions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockAuthServiceServer)(nil).ListSessions), arg0, arg1)
+}
+
+// Logout mocks base method.
+func (m *MockAuthServiceServer) Logout(arg0 context.Context, arg1 *pbgen.LogoutRequest) (*pbgen.LogoutResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", arg0, arg1)
+	ret0, _ := ret[0].(*pbgen.LogoutResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockAuthServiceServerMockRecorder) Logout(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthServiceServer)(nil).Logout), arg0, arg1)
+}
+
+// RefreshToken mocks base method.
+func (m *MockAuthServiceServer) RefreshToken(arg0 context.Context, arg1 *pbgen.RefreshTokenRequest) (*pbgen.RefreshTokenResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshToken", arg0, arg1)
+	ret0, _ := ret[0].(*pbgen.RefreshTokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshToken indicates an expected call of RefreshToken.
+func (mr *MockAuthServiceServerMockRecorder) RefreshToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshToken", reflect.TypeOf((*MockAuthServiceServer)(nil).RefreshToken), arg0, arg1)
+}
+
 // RegisterUser mocks base method.
 func (m *MockAuthServiceServer) RegisterUser(arg0 context.Context, arg1 *pbgen.RegisterUserRequest) (*pbgen.RegisterUserResponse, error) {
 	m.ctrl.T.Helper()
@@ -149,6 +564,51 @@ func (mr *MockAuthServiceServerMockRecorder) RegisterUser(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterUser", reflect.TypeOf((*MockAuthServiceServer)(nil).RegisterUser), arg0, arg1)
 }
 
+// RequestPasswordReset mocks base method.
+func (m *MockAuthServiceServer) RequestPasswordReset(arg0 context.Context, arg1 *pbgen.RequestPasswordResetRequest) (*pbgen.RequestPasswordResetResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestPasswordReset", arg0, arg1)
+	ret0, _ := ret[0].(*pbgen.RequestPasswordResetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestPasswordReset indicates an expected call of RequestPasswordReset.
+func (mr *MockAuthServiceServerMockRecorder) RequestPasswordReset(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPasswordReset", reflect.TypeOf((*MockAuthServiceServer)(nil).RequestPasswordReset), arg0, arg1)
+}
+
+// ResetPassword mocks base method.
+func (m *MockAuthServiceServer) ResetPassword(arg0 context.Context, arg1 *pbgen.ResetPasswordRequest) (*pbgen.ResetPasswordResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", arg0, arg1)
+	ret0, _ := ret[0].(*pbgen.ResetPasswordResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockAuthServiceServerMockRecorder) ResetPassword(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockAuthServiceServer)(nil).ResetPassword), arg0, arg1)
+}
+
+// RevokeSession mocks base method.
+func (m *MockAuthServiceServer) RevokeSession(arg0 context.Context, arg1 *pbgen.RevokeSessionRequest) (*pbgen.RevokeSessionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", arg0, arg1)
+	ret0, _ := ret[0].(*pbgen.RevokeSessionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockAuthServiceServerMockRecorder) RevokeSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockAuthServiceServer)(nil).RevokeSession), arg0, arg1)
+}
+
 // TokenValidate mocks base method.
 func (m *MockAuthServiceServer) TokenValidate(arg0 context.Context, arg1 *pbgen.TokenValidateRequest) (*pbgen.TokenValidateResponse, error) {
 	m.ctrl.T.Helper()
@@ -164,6 +624,36 @@ func (mr *MockAuthServiceServerMockRecorder) TokenValidate(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TokenValidate", reflect.TypeOf((*MockAuthServiceServer)(nil).TokenValidate), arg0, arg1)
 }
 
+// VerifyEmail mocks base method.
+func (m *MockAuthServiceServer) VerifyEmail(arg0 context.Context, arg1 *pbgen.VerifyEmailRequest) (*pbgen.VerifyEmailResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmail", arg0, arg1)
+	ret0, _ := ret[0].(*pbgen.VerifyEmailResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockAuthServiceServerMockRecorder) VerifyEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockAuthServiceServer)(nil).VerifyEmail), arg0, arg1)
+}
+
+// VerifySecondFactor mocks base method.
+func (m *MockAuthServiceServer) VerifySecondFactor(arg0 context.Context, arg1 *pbgen.VerifySecondFactorRequest) (*pbgen.AuthenticateUserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifySecondFactor", arg0, arg1)
+	ret0, _ := ret[0].(*pbgen.AuthenticateUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifySecondFactor indicates an expected call of VerifySecondFactor.
+func (mr *MockAuthServiceServerMockRecorder) VerifySecondFactor(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifySecondFactor", reflect.TypeOf((*MockAuthServiceServer)(nil).VerifySecondFactor), arg0, arg1)
+}
+
 // mustEmbedUnimplementedAuthServiceServer mocks base method.
 func (m *MockAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {
 	m.ctrl.T.Helper()
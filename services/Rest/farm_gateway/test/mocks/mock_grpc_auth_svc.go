@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -34,47 +35,257 @@ func (m *MockGrpcAuthService) EXPECT() *MockGrpcAuthServiceMockRecorder {
 	return m.recorder
 }
 
+// AuthChangePassword mocks base method.
+func (m *MockGrpcAuthService) AuthChangePassword(ctx context.Context, req *pbgen.ChangePasswordRequest) (*pbgen.ChangePasswordResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthChangePassword", ctx, req)
+	ret0, _ := ret[0].(*pbgen.ChangePasswordResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthChangePassword indicates an expected call of AuthChangePassword.
+func (mr *MockGrpcAuthServiceMockRecorder) AuthChangePassword(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthChangePassword", reflect.TypeOf((*MockGrpcAuthService)(nil).AuthChangePassword), ctx, req)
+}
+
+// AuthConfirmTOTP mocks base method.
+func (m *MockGrpcAuthService) AuthConfirmTOTP(ctx context.Context, req *pbgen.ConfirmTOTPRequest) (*pbgen.ConfirmTOTPResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthConfirmTOTP", ctx, req)
+	ret0, _ := ret[0].(*pbgen.ConfirmTOTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthConfirmTOTP indicates an expected call of AuthConfirmTOTP.
+func (mr *MockGrpcAuthServiceMockRecorder) AuthConfirmTOTP(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthConfirmTOTP", reflect.TypeOf((*MockGrpcAuthService)(nil).AuthConfirmTOTP), ctx, req)
+}
+
+// AuthDisableTOTP mocks base method.
+func (m *MockGrpcAuthService) AuthDisableTOTP(ctx context.Context, req *pbgen.DisableTOTPRequest) (*pbgen.DisableTOTPResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthDisableTOTP", ctx, req)
+	ret0, _ := ret[0].(*pbgen.DisableTOTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthDisableTOTP indicates an expected call of AuthDisableTOTP.
+func (mr *MockGrpcAuthServiceMockRecorder) AuthDisableTOTP(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthDisableTOTP", reflect.TypeOf((*MockGrpcAuthService)(nil).AuthDisableTOTP), ctx, req)
+}
+
+// AuthEnrollTOTP mocks base method.
+func (m *MockGrpcAuthService) AuthEnrollTOTP(ctx context.Context, req *pbgen.EnrollTOTPRequest) (*pbgen.EnrollTOTPResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthEnrollTOTP", ctx, req)
+	ret0, _ := ret[0].(*pbgen.EnrollTOTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthEnrollTOTP indicates an expected call of AuthEnrollTOTP.
+func (mr *MockGrpcAuthServiceMockRecorder) AuthEnrollTOTP(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthEnrollTOTP", reflect.TypeOf((*MockGrpcAuthService)(nil).AuthEnrollTOTP), ctx, req)
+}
+
+// AuthListSessions mocks base method.
+func (m *MockGrpcAuthService) AuthListSessions(ctx context.Context, req *pbgen.ListSessionsRequest) (*pbgen.ListSessionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthListSessions", ctx, req)
+	ret0, _ := ret[0].(*pbgen.ListSessionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthListSessions indicates an expected call of AuthListSessions.
+func (mr *MockGrpcAuthServiceMockRecorder) AuthListSessions(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthListSessions", reflect.TypeOf((*MockGrpcAuthService)(nil).AuthListSessions), ctx, req)
+}
+
+// AuthLogout mocks base method.
+func (m *MockGrpcAuthService) AuthLogout(ctx context.Context, req *pbgen.LogoutRequest) (*pbgen.LogoutResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthLogout", ctx, req)
+	ret0, _ := ret[0].(*pbgen.LogoutResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthLogout indicates an expected call of AuthLogout.
+func (mr *MockGrpcAuthServiceMockRecorder) AuthLogout(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthLogout", reflect.TypeOf((*MockGrpcAuthService)(nil).AuthLogout), ctx, req)
+}
+
+// AuthRefreshToken mocks base method.
+func (m *MockGrpcAuthService) AuthRefreshToken(ctx context.Context, req *pbgen.RefreshTokenRequest) (*pbgen.RefreshTokenResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthRefreshToken", ctx, req)
+	ret0, _ := ret[0].(*pbgen.RefreshTokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthRefreshToken indicates an expected call of AuthRefreshToken.
+func (mr *MockGrpcAuthServiceMockRecorder) AuthRefreshToken(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthRefreshToken", reflect.TypeOf((*MockGrpcAuthService)(nil).AuthRefreshToken), ctx, req)
+}
+
+// AuthRegistrationStatus mocks base method.
+func (m *MockGrpcAuthService) AuthRegistrationStatus(ctx context.Context, req *pbgen.GetRegistrationStatusRequest) (*pbgen.GetRegistrationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthRegistrationStatus", ctx, req)
+	ret0, _ := ret[0].(*pbgen.GetRegistrationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthRegistrationStatus indicates an expected call of AuthRegistrationStatus.
+func (mr *MockGrpcAuthServiceMockRecorder) AuthRegistrationStatus(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthRegistrationStatus", reflect.TypeOf((*MockGrpcAuthService)(nil).AuthRegistrationStatus), ctx, req)
+}
+
+// AuthRequestPasswordReset mocks base method.
+func (m *MockGrpcAuthService) AuthRequestPasswordReset(ctx context.Context, req *pbgen.RequestPasswordResetRequest) (*pbgen.RequestPasswordResetResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthRequestPasswordReset", ctx, req)
+	ret0, _ := ret[0].(*pbgen.RequestPasswordResetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthRequestPasswordReset indicates an expected call of AuthRequestPasswordReset.
+func (mr *MockGrpcAuthServiceMockRecorder) AuthRequestPasswordReset(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthRequestPasswordReset", reflect.TypeOf((*MockGrpcAuthService)(nil).AuthRequestPasswordReset), ctx, req)
+}
+
+// AuthResetPassword mocks base method.
+func (m *MockGrpcAuthService) AuthResetPassword(ctx context.Context, req *pbgen.ResetPasswordRequest) (*pbgen.ResetPasswordResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthResetPassword", ctx, req)
+	ret0, _ := ret[0].(*pbgen.ResetPasswordResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthResetPassword indicates an expected call of AuthResetPassword.
+func (mr *MockGrpcAuthServiceMockRecorder) AuthResetPassword(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthResetPassword", reflect.TypeOf((*MockGrpcAuthService)(nil).AuthResetPassword), ctx, req)
+}
+
+// AuthRevokeSession mocks base method.
+func (m *MockGrpcAuthService) AuthRevokeSession(ctx context.Context, req *pbgen.RevokeSessionRequest) (*pbgen.RevokeSessionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthRevokeSession", ctx, req)
+	ret0, _ := ret[0].(*pbgen.RevokeSessionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthRevokeSession indicates an expected call of AuthRevokeSession.
+func (mr *MockGrpcAuthServiceMockRecorder) AuthRevokeSession(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthRevokeSession", reflect.TypeOf((*MockGrpcAuthService)(nil).AuthRevokeSession), ctx, req)
+}
+
 // AuthTokenValidate mocks base method.
-func (m *MockGrpcAuthService) AuthTokenValidate(req *pbgen.TokenValidateRequest) (*pbgen.TokenValidateResponse, error) {
+func (m *MockGrpcAuthService) AuthTokenValidate(ctx context.Context, req *pbgen.TokenValidateRequest) (*pbgen.TokenValidateResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AuthTokenValidate", req)
+	ret := m.ctrl.Call(m, "AuthTokenValidate", ctx, req)
 	ret0, _ := ret[0].(*pbgen.TokenValidateResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AuthTokenValidate indicates an expected call of AuthTokenValidate.
-func (mr *MockGrpcAuthServiceMockRecorder) AuthTokenValidate(req interface{}) *gomock.Call {
+func (mr *MockGrpcAuthServiceMockRecorder) AuthTokenValidate(ctx, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthTokenValidate", reflect.TypeOf((*MockGrpcAuthService)(nil).AuthTokenValidate), req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthTokenValidate", reflect.TypeOf((*MockGrpcAuthService)(nil).AuthTokenValidate), ctx, req)
 }
 
 // AuthUserRegister mocks base method.
-func (m *MockGrpcAuthService) AuthUserRegister(req *pbgen.RegisterUserRequest) (*pbgen.RegisterUserResponse, error) {
+func (m *MockGrpcAuthService) AuthUserRegister(ctx context.Context, req *pbgen.RegisterUserRequest) (*pbgen.RegisterUserResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AuthUserRegister", req)
+	ret := m.ctrl.Call(m, "AuthUserRegister", ctx, req)
 	ret0, _ := ret[0].(*pbgen.RegisterUserResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AuthUserRegister indicates an expected call of AuthUserRegister.
-func (mr *MockGrpcAuthServiceMockRecorder) AuthUserRegister(req interface{}) *gomock.Call {
+func (mr *MockGrpcAuthServiceMockRecorder) AuthUserRegister(ctx, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthUserRegister", reflect.TypeOf((*MockGrpcAuthService)(nil).AuthUserRegister), req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthUserRegister", reflect.TypeOf((*MockGrpcAuthService)(nil).AuthUserRegister), ctx, req)
 }
 
 // AuthUserSignIn mocks base method.
-func (m *MockGrpcAuthService) AuthUserSignIn(req *pbgen.AuthenticateUserRequest) (*pbgen.AuthenticateUserResponse, error) {
+func (m *MockGrpcAuthService) AuthUserSignIn(ctx context.Context, req *pbgen.AuthenticateUserRequest) (*pbgen.AuthenticateUserResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AuthUserSignIn", req)
+	ret := m.ctrl.Call(m, "AuthUserSignIn", ctx, req)
 	ret0, _ := ret[0].(*pbgen.AuthenticateUserResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AuthUserSignIn indicates an expected call of AuthUserSignIn.
-func (mr *MockGrpcAuthServiceMockRecorder) AuthUserSignIn(req interface{}) *gomock.Call {
+func (mr *MockGrpcAuthServiceMockRecorder) AuthUserSignIn(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthUserSignIn", reflect.TypeOf((*MockGrpcAuthService)(nil).AuthUserSignIn), ctx, req)
+}
+
+// AuthVerificationKeys mocks base method.
+func (m *MockGrpcAuthService) AuthVerificationKeys(ctx context.Context, req *pbgen.GetVerificationKeysRequest) (*pbgen.GetVerificationKeysResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthVerificationKeys", ctx, req)
+	ret0, _ := ret[0].(*pbgen.GetVerificationKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthVerificationKeys indicates an expected call of AuthVerificationKeys.
+func (mr *MockGrpcAuthServiceMockRecorder) AuthVerificationKeys(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthVerificationKeys", reflect.TypeOf((*MockGrpcAuthService)(nil).AuthVerificationKeys), ctx, req)
+}
+
+// AuthVerifyEmail mocks base method.
+func (m *MockGrpcAuthService) AuthVerifyEmail(ctx context.Context, req *pbgen.VerifyEmailRequest) (*pbgen.VerifyEmailResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthVerifyEmail", ctx, req)
+	ret0, _ := ret[0].(*pbgen.VerifyEmailResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthVerifyEmail indicates an expected call of AuthVerifyEmail.
+func (mr *MockGrpcAuthServiceMockRecorder) AuthVerifyEmail(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthVerifyEmail", reflect.TypeOf((*MockGrpcAuthService)(nil).AuthVerifyEmail), ctx, req)
+}
+
+// AuthVerifySecondFactor mocks base method.
+func (m *MockGrpcAuthService) AuthVerifySecondFactor(ctx context.Context, req *pbgen.VerifySecondFactorRequest) (*pbgen.AuthenticateUserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthVerifySecondFactor", ctx, req)
+	ret0, _ := ret[0].(*pbgen.AuthenticateUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthVerifySecondFactor indicates an expected call of AuthVerifySecondFactor.
+func (mr *MockGrpcAuthServiceMockRecorder) AuthVerifySecondFactor(ctx, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthUserSignIn", reflect.TypeOf((*MockGrpcAuthService)(nil).AuthUserSignIn), req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthVerifySecondFactor", reflect.TypeOf((*MockGrpcAuthService)(nil).AuthVerifySecondFactor), ctx, req)
 }
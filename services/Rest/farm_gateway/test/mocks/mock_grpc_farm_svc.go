@@ -0,0 +1,181 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: grp_farm_svc.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	api "github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/api"
+	models "github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/models"
+	pbgen "github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/pbgen"
+)
+
+// MockGrpcFarmService is a mock of GrpcFarmService interface.
+type MockGrpcFarmService struct {
+	ctrl     *gomock.Controller
+	recorder *MockGrpcFarmServiceMockRecorder
+}
+
+// MockGrpcFarmServiceMockRecorder is the mock recorder for MockGrpcFarmService.
+type MockGrpcFarmServiceMockRecorder struct {
+	mock *MockGrpcFarmService
+}
+
+// NewMockGrpcFarmService creates a new mock instance.
+func NewMockGrpcFarmService(ctrl *gomock.Controller) *MockGrpcFarmService {
+	mock := &MockGrpcFarmService{ctrl: ctrl}
+	mock.recorder = &MockGrpcFarmServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGrpcFarmService) EXPECT() *MockGrpcFarmServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateFarm mocks base method.
+func (m *MockGrpcFarmService) CreateFarm(ctx context.Context, dataRequest []models.CreateFarm) ([]*pbgen.CreateFarmResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFarm", ctx, dataRequest)
+	ret0, _ := ret[0].([]*pbgen.CreateFarmResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFarm indicates an expected call of CreateFarm.
+func (mr *MockGrpcFarmServiceMockRecorder) CreateFarm(ctx, dataRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFarm", reflect.TypeOf((*MockGrpcFarmService)(nil).CreateFarm), ctx, dataRequest)
+}
+
+// DeleteFarm mocks base method.
+func (m *MockGrpcFarmService) DeleteFarm(ctx context.Context, farmID, farmerID string) (*pbgen.DeleteFarmResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFarm", ctx, farmID, farmerID)
+	ret0, _ := ret[0].(*pbgen.DeleteFarmResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFarm indicates an expected call of DeleteFarm.
+func (mr *MockGrpcFarmServiceMockRecorder) DeleteFarm(ctx, farmID, farmerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFarm", reflect.TypeOf((*MockGrpcFarmService)(nil).DeleteFarm), ctx, farmID, farmerID)
+}
+
+// GetFarmByID mocks base method.
+func (m *MockGrpcFarmService) GetFarmByID(ctx context.Context, farmID, farmerID string) (models.Farm, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFarmByID", ctx, farmID, farmerID)
+	ret0, _ := ret[0].(models.Farm)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFarmByID indicates an expected call of GetFarmByID.
+func (mr *MockGrpcFarmServiceMockRecorder) GetFarmByID(ctx, farmID, farmerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFarmByID", reflect.TypeOf((*MockGrpcFarmService)(nil).GetFarmByID), ctx, farmID, farmerID)
+}
+
+// GetFarms mocks base method.
+func (m *MockGrpcFarmService) GetFarms(ctx context.Context, farmerID string, dataRequest models.GetFarmsRequest) (models.GetFarmsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFarms", ctx, farmerID, dataRequest)
+	ret0, _ := ret[0].(models.GetFarmsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFarms indicates an expected call of GetFarms.
+func (mr *MockGrpcFarmServiceMockRecorder) GetFarms(ctx, farmerID, dataRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFarms", reflect.TypeOf((*MockGrpcFarmService)(nil).GetFarms), ctx, farmerID, dataRequest)
+}
+
+// SearchFarms mocks base method.
+func (m *MockGrpcFarmService) SearchFarms(ctx context.Context, farmerID string, dataRequest models.SearchFarmsRequest) (models.SearchFarmsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchFarms", ctx, farmerID, dataRequest)
+	ret0, _ := ret[0].(models.SearchFarmsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchFarms indicates an expected call of SearchFarms.
+func (mr *MockGrpcFarmServiceMockRecorder) SearchFarms(ctx, farmerID, dataRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchFarms", reflect.TypeOf((*MockGrpcFarmService)(nil).SearchFarms), ctx, farmerID, dataRequest)
+}
+
+// StreamFarms mocks base method.
+func (m *MockGrpcFarmService) StreamFarms(ctx context.Context, farmerID string, dataRequest models.GetFarmsRequest) (api.FarmListStream, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamFarms", ctx, farmerID, dataRequest)
+	ret0, _ := ret[0].(api.FarmListStream)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamFarms indicates an expected call of StreamFarms.
+func (mr *MockGrpcFarmServiceMockRecorder) StreamFarms(ctx, farmerID, dataRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamFarms", reflect.TypeOf((*MockGrpcFarmService)(nil).StreamFarms), ctx, farmerID, dataRequest)
+}
+
+// UpdateFarmOrAddress mocks base method.
+func (m *MockGrpcFarmService) UpdateFarmOrAddress(ctx context.Context, farmerID string, data []models.UpdateFarmWithAddr) ([]*pbgen.UpdateFarmsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFarmOrAddress", ctx, farmerID, data)
+	ret0, _ := ret[0].([]*pbgen.UpdateFarmsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFarmOrAddress indicates an expected call of UpdateFarmOrAddress.
+func (mr *MockGrpcFarmServiceMockRecorder) UpdateFarmOrAddress(ctx, farmerID, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFarmOrAddress", reflect.TypeOf((*MockGrpcFarmService)(nil).UpdateFarmOrAddress), ctx, farmerID, data)
+}
+
+// MockFarmListStream is a mock of FarmListStream interface.
+type MockFarmListStream struct {
+	ctrl     *gomock.Controller
+	recorder *MockFarmListStreamMockRecorder
+}
+
+// MockFarmListStreamMockRecorder is the mock recorder for MockFarmListStream.
+type MockFarmListStreamMockRecorder struct {
+	mock *MockFarmListStream
+}
+
+// NewMockFarmListStream creates a new mock instance.
+func NewMockFarmListStream(ctrl *gomock.Controller) *MockFarmListStream {
+	mock := &MockFarmListStream{ctrl: ctrl}
+	mock.recorder = &MockFarmListStreamMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFarmListStream) EXPECT() *MockFarmListStreamMockRecorder {
+	return m.recorder
+}
+
+// Recv mocks base method.
+func (m *MockFarmListStream) Recv() (models.FarmListMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(models.FarmListMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockFarmListStreamMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockFarmListStream)(nil).Recv))
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sessions.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRevocationList is a mock of RevocationList interface.
+type MockRevocationList struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevocationListMockRecorder
+}
+
+// MockRevocationListMockRecorder is the mock recorder for MockRevocationList.
+type MockRevocationListMockRecorder struct {
+	mock *MockRevocationList
+}
+
+// NewMockRevocationList creates a new mock instance.
+func NewMockRevocationList(ctrl *gomock.Controller) *MockRevocationList {
+	mock := &MockRevocationList{ctrl: ctrl}
+	mock.recorder = &MockRevocationListMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevocationList) EXPECT() *MockRevocationListMockRecorder {
+	return m.recorder
+}
+
+// IsRevoked mocks base method.
+func (m *MockRevocationList) IsRevoked(ctx context.Context, sessionID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsRevoked", ctx, sessionID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsRevoked indicates an expected call of IsRevoked.
+func (mr *MockRevocationListMockRecorder) IsRevoked(ctx, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRevoked", reflect.TypeOf((*MockRevocationList)(nil).IsRevoked), ctx, sessionID)
+}
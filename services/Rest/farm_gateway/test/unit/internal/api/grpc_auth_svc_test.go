@@ -1,6 +1,7 @@
 package uni_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -30,7 +31,7 @@ func TestGrpcAuthService_AuthUserRegister(t *testing.T) {
 		req := &pbgen.RegisterUserRequest{}
 
 		svc := api.NewGrpcService(mockAuthSvcClient)
-		res, err := svc.AuthUserRegister(req)
+		res, err := svc.AuthUserRegister(context.Background(), req)
 		assert.NoError(t, err)
 		assert.Equal(t, res.Status, "Success Register User")
 		assert.Equal(t, res.Msg, "Success")
@@ -46,7 +47,7 @@ func TestGrpcAuthService_AuthUserRegister(t *testing.T) {
 		req := &pbgen.RegisterUserRequest{}
 
 		svc := api.NewGrpcService(mockAuthSvcClient)
-		res, err := svc.AuthUserRegister(req)
+		res, err := svc.AuthUserRegister(context.Background(), req)
 		assert.Error(t, err)
 		assert.Nil(t, res)
 	})
@@ -79,7 +80,7 @@ func TestAuthUserSignIn(t *testing.T) {
 		}
 
 		svc := api.NewGrpcService(mockAuthSvcClient)
-		res, err := svc.AuthUserSignIn(req)
+		res, err := svc.AuthUserSignIn(context.Background(), req)
 		assert.NoError(t, err)
 		assert.Equal(t, res.Token, "Token")
 		assert.Equal(t, res.ExpiresAt, experiedAt)
@@ -98,7 +99,7 @@ func TestAuthUserSignIn(t *testing.T) {
 		req := &pbgen.AuthenticateUserRequest{}
 
 		svc := api.NewGrpcService(mockAuthSvcClient)
-		res, err := svc.AuthUserSignIn(req)
+		res, err := svc.AuthUserSignIn(context.Background(), req)
 		assert.Error(t, err)
 		assert.Nil(t, res)
 	})
@@ -131,7 +132,7 @@ func TestAuthTokenValidate(t *testing.T) {
 		}
 
 		svc := api.NewGrpcService(mockAuthSvcClient)
-		res, err := svc.AuthTokenValidate(req)
+		res, err := svc.AuthTokenValidate(context.Background(), req)
 		assert.NoError(t, err)
 		assert.True(t, res.Valid)
 		assert.Equal(t, res.ExpiresAt, experiedAt)
@@ -149,7 +150,7 @@ func TestAuthTokenValidate(t *testing.T) {
 		req := &pbgen.TokenValidateRequest{}
 
 		svc := api.NewGrpcService(mockAuthSvcClient)
-		res, err := svc.AuthTokenValidate(req)
+		res, err := svc.AuthTokenValidate(context.Background(), req)
 		assert.Error(t, err)
 		assert.Nil(t, res)
 	})
@@ -0,0 +1,74 @@
+package unit_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/handlers/authh"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/pbgen"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPasswordHandlers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthSvc := mocks.NewMockGrpcAuthService(ctrl)
+	handler := authh.NewAuthHandler(mockAuthSvc, nil)
+
+	app := fiber.New()
+	app.Post("/auth/password/forgot", handler.ForgotPassword)
+	app.Post("/auth/password/reset", handler.ResetPassword)
+	app.Post("/auth/password/change", handler.ChangePassword)
+
+	jsonRequest := func(path, body string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader([]byte(body)))
+		req.Header.Set("Content-Type", "application/json")
+		return req
+	}
+
+	t.Run("forgot password accepted", func(t *testing.T) {
+		mockAuthSvc.EXPECT().
+			AuthRequestPasswordReset(gomock.Any(), &pbgen.RequestPasswordResetRequest{Email: "farmer@example.com"}).
+			Return(&pbgen.RequestPasswordResetResponse{Status: "Success"}, nil)
+
+		res, _ := app.Test(jsonRequest("/auth/password/forgot", `{"email":"farmer@example.com"}`))
+		assert.Equal(t, fiber.StatusAccepted, res.StatusCode)
+	})
+
+	t.Run("reset password invalid token", func(t *testing.T) {
+		mockAuthSvc.EXPECT().
+			AuthResetPassword(gomock.Any(), &pbgen.ResetPasswordRequest{Token: "bad", NewPassword: "Abcdef1!"}).
+			Return(nil, status.Error(codes.InvalidArgument, "invalid"))
+
+		res, _ := app.Test(jsonRequest("/auth/password/reset", `{"token":"bad","new_password":"Abcdef1!"}`))
+		assert.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+	})
+
+	t.Run("change password missing bearer", func(t *testing.T) {
+		res, _ := app.Test(jsonRequest("/auth/password/change", `{"current_password":"a","new_password":"b"}`))
+		assert.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
+	})
+
+	t.Run("change password wrong current password", func(t *testing.T) {
+		mockAuthSvc.EXPECT().
+			AuthChangePassword(gomock.Any(), &pbgen.ChangePasswordRequest{
+				Token:           "access",
+				CurrentPassword: "Wrong1!a",
+				NewPassword:     "Abcdef1!",
+			}).
+			Return(nil, status.Error(codes.PermissionDenied, "invalid password"))
+
+		req := jsonRequest("/auth/password/change", `{"current_password":"Wrong1!a","new_password":"Abcdef1!"}`)
+		req.Header.Set("Authorization", "Bearer access")
+		res, _ := app.Test(req)
+		assert.Equal(t, fiber.StatusForbidden, res.StatusCode)
+	})
+}
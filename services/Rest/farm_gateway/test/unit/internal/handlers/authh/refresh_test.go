@@ -0,0 +1,79 @@
+package unit_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/handlers/authh"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/pbgen"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestRefreshHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthSvc := mocks.NewMockGrpcAuthService(ctrl)
+	handler := authh.NewAuthHandler(mockAuthSvc, nil)
+
+	t.Run("missing refresh token", func(t *testing.T) {
+		app := fiber.New()
+		app.Post("/auth/refresh", handler.Refresh)
+
+		req := httptest.NewRequest(http.MethodPost, "/auth/refresh", nil)
+		res, _ := app.Test(req)
+		assert.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+	})
+
+	t.Run("refresh token reused", func(t *testing.T) {
+		app := fiber.New()
+		app.Post("/auth/refresh", handler.Refresh)
+
+		mockAuthSvc.EXPECT().
+			AuthRefreshToken(gomock.Any(), &pbgen.RefreshTokenRequest{RefreshToken: "old"}).
+			Return(nil, status.Error(codes.Unauthenticated, "reused"))
+
+		req := httptest.NewRequest(http.MethodPost, "/auth/refresh", bytes.NewReader([]byte(`{"refresh_token":"old"}`)))
+		req.Header.Set("Content-Type", "application/json")
+		res, _ := app.Test(req)
+		assert.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
+	})
+
+	t.Run("refresh token from cookie", func(t *testing.T) {
+		app := fiber.New()
+		app.Post("/auth/refresh", handler.Refresh)
+
+		mockAuthSvc.EXPECT().
+			AuthRefreshToken(gomock.Any(), &pbgen.RefreshTokenRequest{RefreshToken: "cookie"}).
+			Return(&pbgen.RefreshTokenResponse{
+				Token:            "access",
+				IssuedAt:         timestamppb.Now(),
+				ExpiresAt:        timestamppb.New(time.Now().Add(15 * time.Minute)),
+				RefreshToken:     "new",
+				RefreshExpiresAt: timestamppb.New(time.Now().Add(24 * time.Hour)),
+				SessionId:        "s1",
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/auth/refresh", nil)
+		req.AddCookie(&http.Cookie{Name: "refresh_token", Value: "cookie"})
+		res, _ := app.Test(req)
+		assert.Equal(t, fiber.StatusOK, res.StatusCode)
+
+		var rotated bool
+		for _, ck := range res.Cookies() {
+			if ck.Name == "refresh_token" && ck.Value == "new" {
+				rotated = true
+			}
+		}
+		assert.True(t, rotated)
+	})
+}
@@ -2,6 +2,7 @@ package unit_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"io"
@@ -17,6 +18,11 @@ import (
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/pbgen"
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/test/mocks"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -28,7 +34,7 @@ func TestSignInHandlerErrorBodyParser(t *testing.T) {
 
 	mockAuthSvc := mocks.NewMockGrpcAuthService(ctrl)
 
-	authHandler := authh.NewAuthHandler(mockAuthSvc)
+	authHandler := authh.NewAuthHandler(mockAuthSvc, nil)
 
 	app.Post("/auth/signin", authHandler.SignIn)
 
@@ -62,12 +68,12 @@ func TestSignInHandlerAuthUserSignInErr(t *testing.T) {
 	mockAuthSvc := mocks.NewMockGrpcAuthService(ctrl)
 
 	mockAuthSvc.EXPECT().
-		AuthUserSignIn(gomock.Any()).
+		AuthUserSignIn(gomock.Any(), gomock.Any()).
 		Return(
 			nil, errors.New("Error something"),
 		)
 
-	authHandler := authh.NewAuthHandler(mockAuthSvc)
+	authHandler := authh.NewAuthHandler(mockAuthSvc, nil)
 
 	app.Post("/auth/signin", authHandler.SignIn)
 
@@ -102,7 +108,7 @@ func TestSignInHandlerSuccess(t *testing.T) {
 	mockAuthSvc := mocks.NewMockGrpcAuthService(ctrl)
 
 	mockAuthSvc.EXPECT().
-		AuthUserSignIn(gomock.Any()).
+		AuthUserSignIn(gomock.Any(), gomock.Any()).
 		Return(
 			&pbgen.AuthenticateUserResponse{
 				Token:     "Token",
@@ -112,7 +118,7 @@ func TestSignInHandlerSuccess(t *testing.T) {
 				Status:    "Success",
 			}, nil)
 
-	authHandler := authh.NewAuthHandler(mockAuthSvc)
+	authHandler := authh.NewAuthHandler(mockAuthSvc, nil)
 
 	app.Post("/auth/signin", authHandler.SignIn)
 
@@ -140,3 +146,38 @@ func TestSignInHandlerSuccess(t *testing.T) {
 	body, _ := io.ReadAll(res.Body)
 	assert.Contains(t, string(body), `"status":"Success"`)
 }
+
+func TestSignInHandlerThrottled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	app := fiber.New()
+
+	mockAuthSvc := mocks.NewMockGrpcAuthService(ctrl)
+	authHandler := authh.NewAuthHandler(mockAuthSvc, nil)
+	app.Post("/auth/signin", authHandler.SignIn)
+
+	st, err := status.New(codes.ResourceExhausted, "too many sign in attempts").
+		WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(90 * time.Second)})
+	assert.NoError(t, err)
+
+	mockAuthSvc.EXPECT().
+		AuthUserSignIn(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, req *pbgen.AuthenticateUserRequest) (*pbgen.AuthenticateUserResponse, error) {
+			md, _ := metadata.FromOutgoingContext(ctx)
+			assert.Equal(t, []string{"0.0.0.0"}, md.Get("x-client-ip"))
+			return nil, st.Err()
+		})
+
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/auth/signin",
+		bytes.NewReader([]byte(`{"email":"sony@gmail.com","password":"secreet"}`)),
+	)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusTooManyRequests, res.StatusCode)
+	assert.Equal(t, "90", res.Header.Get(fiber.HeaderRetryAfter))
+}
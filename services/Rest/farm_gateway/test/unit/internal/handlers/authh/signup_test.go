@@ -16,6 +16,9 @@ import (
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/pbgen"
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/test/mocks"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestSignupHandlerBodyParserError(t *testing.T) {
@@ -25,7 +28,7 @@ func TestSignupHandlerBodyParserError(t *testing.T) {
 	app := fiber.New()
 
 	mockAuthSvc := mocks.NewMockGrpcAuthService(ctrl)
-	authHandler := authh.NewAuthHandler(mockAuthSvc)
+	authHandler := authh.NewAuthHandler(mockAuthSvc, nil)
 
 	app.Post("/auth/signup", authHandler.SignUp)
 
@@ -61,10 +64,10 @@ func TestSignupHandlerAuthRegisterErr(t *testing.T) {
 	mockAuthSvc := mocks.NewMockGrpcAuthService(ctrl)
 
 	mockAuthSvc.EXPECT().
-		AuthUserRegister(gomock.Any()).
+		AuthUserRegister(gomock.Any(), gomock.Any()).
 		Return(nil, errors.New("Something Wrong"))
 
-	authHandler := authh.NewAuthHandler(mockAuthSvc)
+	authHandler := authh.NewAuthHandler(mockAuthSvc, nil)
 
 	app.Post("/auth/signup", authHandler.SignUp)
 
@@ -101,13 +104,15 @@ func TestSignUpHandlerSuccess(t *testing.T) {
 	mockAuthSvc := mocks.NewMockGrpcAuthService(ctrl)
 
 	mockAuthSvc.EXPECT().
-		AuthUserRegister(gomock.Any()).
+		AuthUserRegister(gomock.Any(), gomock.Any()).
 		Return(&pbgen.RegisterUserResponse{
-			Status: "Success",
-			Msg:    "Success Register User",
+			Status:             "Success",
+			Msg:                "Registration Pending",
+			UserId:             "0b7c2a1e-5f3d-4c8a-9e6b-1d2f3a4b5c6d",
+			RegistrationStatus: "pending",
 		}, nil)
 
-	authHandler := authh.NewAuthHandler(mockAuthSvc)
+	authHandler := authh.NewAuthHandler(mockAuthSvc, nil)
 
 	app.Post("/auth/signup", authHandler.SignUp)
 
@@ -132,8 +137,53 @@ func TestSignUpHandlerSuccess(t *testing.T) {
 
 	res, err := app.Test(req)
 	assert.NoError(t, err)
-	assert.Equal(t, fiber.StatusOK, res.StatusCode)
+	assert.Equal(t, fiber.StatusAccepted, res.StatusCode)
+	assert.Equal(t, "/v1/auth/signup/0b7c2a1e-5f3d-4c8a-9e6b-1d2f3a4b5c6d", res.Header.Get("Location"))
 
 	body, _ := io.ReadAll(res.Body)
 	assert.Contains(t, string(body), `"status":"Success"`)
+	assert.Contains(t, string(body), `"registration_status":"pending"`)
+}
+
+func TestSignUpStatusHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthSvc := mocks.NewMockGrpcAuthService(ctrl)
+	authHandler := authh.NewAuthHandler(mockAuthSvc, nil)
+
+	app := fiber.New()
+	app.Get("/auth/signup/:id", authHandler.SignUpStatus)
+
+	t.Run("Not Found", func(t *testing.T) {
+		mockAuthSvc.EXPECT().
+			AuthRegistrationStatus(gomock.Any(), gomock.Any()).
+			Return(nil, status.Error(codes.NotFound, "Registration Is Not Found"))
+
+		req := httptest.NewRequest(http.MethodGet, "/auth/signup/0b7c2a1e-5f3d-4c8a-9e6b-1d2f3a4b5c6d", nil)
+		res, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusNotFound, res.StatusCode)
+	})
+
+	t.Run("Failed", func(t *testing.T) {
+		mockAuthSvc.EXPECT().
+			AuthRegistrationStatus(gomock.Any(), &pbgen.GetRegistrationStatusRequest{Id: "0b7c2a1e-5f3d-4c8a-9e6b-1d2f3a4b5c6d"}).
+			Return(&pbgen.GetRegistrationStatusResponse{
+				UserId:        "0b7c2a1e-5f3d-4c8a-9e6b-1d2f3a4b5c6d",
+				Status:        "failed",
+				FailureReason: "registration timed out",
+				CreatedAt:     timestamppb.Now(),
+				UpdatedAt:     timestamppb.Now(),
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/auth/signup/0b7c2a1e-5f3d-4c8a-9e6b-1d2f3a4b5c6d", nil)
+		res, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, res.StatusCode)
+
+		body, _ := io.ReadAll(res.Body)
+		assert.Contains(t, string(body), `"status":"failed"`)
+		assert.Contains(t, string(body), `"failure_reason":"registration timed out"`)
+	})
 }
@@ -0,0 +1,149 @@
+package unit_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/handlers/authh"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/pbgen"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestSignInHandlerSecondFactorRequired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	app := fiber.New()
+
+	mockAuthSvc := mocks.NewMockGrpcAuthService(ctrl)
+	authHandler := authh.NewAuthHandler(mockAuthSvc, nil)
+	app.Post("/auth/signin", authHandler.SignIn)
+
+	mockAuthSvc.EXPECT().
+		AuthUserSignIn(gomock.Any(), gomock.Any()).
+		Return(&pbgen.AuthenticateUserResponse{
+			Status:               "SecondFactorRequired",
+			SecondFactorRequired: true,
+			ChallengeToken:       "challenge",
+			ChallengeExpiresAt:   timestamppb.New(time.Now().Add(5 * time.Minute)),
+		}, nil)
+
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/auth/signin",
+		bytes.NewReader([]byte(`{"email":"sony@gmail.com","password":"secreet"}`)),
+	)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, res.StatusCode)
+	assert.Empty(t, res.Cookies())
+
+	body, _ := io.ReadAll(res.Body)
+	var payload struct {
+		Data map[string]any `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(body, &payload))
+	assert.Equal(t, true, payload.Data["second_factor_required"])
+	assert.Equal(t, "challenge", payload.Data["challenge_token"])
+	assert.NotContains(t, payload.Data, "token")
+}
+
+func TestVerifySecondFactorHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	app := fiber.New()
+
+	mockAuthSvc := mocks.NewMockGrpcAuthService(ctrl)
+	authHandler := authh.NewAuthHandler(mockAuthSvc, nil)
+	app.Post("/auth/2fa/verify", authHandler.VerifySecondFactor)
+
+	newRequest := func() *http.Request {
+		req := httptest.NewRequest(
+			http.MethodPost,
+			"/auth/2fa/verify",
+			bytes.NewReader([]byte(`{"challenge_token":"challenge","code":"123456"}`)),
+		)
+		req.Header.Set("Content-Type", "application/json")
+		return req
+	}
+
+	t.Run("Success Sets Session Cookies", func(t *testing.T) {
+		now := time.Now()
+		mockAuthSvc.EXPECT().
+			AuthVerifySecondFactor(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, req *pbgen.VerifySecondFactorRequest) (*pbgen.AuthenticateUserResponse, error) {
+				assert.Equal(t, "challenge", req.ChallengeToken)
+				assert.Equal(t, "123456", req.Code)
+				return &pbgen.AuthenticateUserResponse{
+					Token:            "access",
+					Status:           "Success",
+					IssuedAt:         timestamppb.New(now),
+					ExpiresAt:        timestamppb.New(now.Add(15 * time.Minute)),
+					RefreshToken:     "refresh",
+					RefreshExpiresAt: timestamppb.New(now.Add(time.Hour)),
+					SessionId:        "session",
+				}, nil
+			})
+
+		res, err := app.Test(newRequest())
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, res.StatusCode)
+		assert.NotEmpty(t, res.Cookies())
+	})
+
+	t.Run("Wrong Code", func(t *testing.T) {
+		mockAuthSvc.EXPECT().
+			AuthVerifySecondFactor(gomock.Any(), gomock.Any()).
+			Return(nil, status.Error(codes.PermissionDenied, "Second Factor Code Is Invalid"))
+
+		res, err := app.Test(newRequest())
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusForbidden, res.StatusCode)
+	})
+
+	t.Run("Challenge Expired", func(t *testing.T) {
+		mockAuthSvc.EXPECT().
+			AuthVerifySecondFactor(gomock.Any(), gomock.Any()).
+			Return(nil, status.Error(codes.Unauthenticated, "Login Challenge Is Invalid"))
+
+		res, err := app.Test(newRequest())
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
+	})
+}
+
+func TestEnrollTOTPHandlerAlreadyEnabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	app := fiber.New()
+
+	mockAuthSvc := mocks.NewMockGrpcAuthService(ctrl)
+	authHandler := authh.NewAuthHandler(mockAuthSvc, nil)
+	app.Post("/auth/2fa/enroll", authHandler.EnrollTOTP)
+
+	mockAuthSvc.EXPECT().
+		AuthEnrollTOTP(gomock.Any(), &pbgen.EnrollTOTPRequest{Token: "access"}).
+		Return(nil, status.Error(codes.FailedPrecondition, "Two Factor Authentication Is Already Enabled"))
+
+	req := httptest.NewRequest(http.MethodPost, "/auth/2fa/enroll", nil)
+	req.Header.Set("Authorization", "Bearer access")
+
+	res, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusConflict, res.StatusCode)
+}
@@ -2,6 +2,7 @@ package unit_test
 
 import (
 	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -9,11 +10,11 @@ import (
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/golang/mock/gomock"
+	"github.com/o1egl/paseto"
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/handlers/authh"
-	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/pbgen"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/tokverify"
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/test/mocks"
 	"github.com/stretchr/testify/assert"
-	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestAuthTokenBaseValidate(t *testing.T) {
@@ -21,7 +22,8 @@ func TestAuthTokenBaseValidate(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockAuthSvc := mocks.NewMockGrpcAuthService(ctrl)
-	handler := authh.NewAuthHandler(mockAuthSvc)
+	mockVerifier := mocks.NewMockVerifier(ctrl)
+	handler := authh.NewAuthHandler(mockAuthSvc, mockVerifier)
 
 	t.Run("missing header", func(t *testing.T) {
 		app := fiber.New()
@@ -42,11 +44,11 @@ func TestAuthTokenBaseValidate(t *testing.T) {
 		assert.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
 	})
 
-	t.Run("grpc error", func(t *testing.T) {
+	t.Run("key refresh error", func(t *testing.T) {
 		app := fiber.New()
-		mockAuthSvc.EXPECT().
-			AuthTokenValidate(gomock.Any()).
-			Return(nil, errors.New("grpc fail"))
+		mockVerifier.EXPECT().
+			Verify(gomock.Any(), "validtoken").
+			Return(paseto.JSONToken{}, errors.New("grpc fail"))
 
 		app.Get("/", handler.AuthTokenBaseValidate)
 
@@ -54,16 +56,16 @@ func TestAuthTokenBaseValidate(t *testing.T) {
 		req.Header.Set("Authorization", "Bearer validtoken")
 		res, _ := app.Test(req)
 		assert.Equal(t, fiber.StatusInternalServerError, res.StatusCode)
+
+		body, _ := io.ReadAll(res.Body)
+		assert.NotContains(t, string(body), "grpc fail")
 	})
 
 	t.Run("token invalid", func(t *testing.T) {
 		app := fiber.New()
-		mockAuthSvc.EXPECT().
-			AuthTokenValidate(gomock.Any()).
-			Return(&pbgen.TokenValidateResponse{
-				Valid: false,
-				Msg:   "expired",
-			}, nil)
+		mockVerifier.EXPECT().
+			Verify(gomock.Any(), "sometoken").
+			Return(paseto.JSONToken{}, tokverify.ErrTokenExperied)
 
 		app.Get("/", handler.AuthTokenBaseValidate)
 
@@ -73,20 +75,48 @@ func TestAuthTokenBaseValidate(t *testing.T) {
 		assert.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
 	})
 
+	t.Run("session revoked", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		keys := mocks.NewMockVerifier(ctrl)
+		authSvc := mocks.NewMockGrpcAuthService(ctrl)
+		revoked := mocks.NewMockRevocationList(ctrl)
+		keys.EXPECT().
+			Verify(gomock.Any(), "revokedtoken").
+			Return(paseto.JSONToken{
+				Jti:        "session-1",
+				Subject:    "user123",
+				Expiration: time.Now().Add(1 * time.Hour),
+			}, nil)
+		revoked.EXPECT().IsRevoked(gomock.Any(), "session-1").Return(true, nil)
+
+		revocation := authh.NewAuthHandler(authSvc, tokverify.WithSessionCheck(keys, revoked))
+
+		app := fiber.New()
+		app.Get("/", revocation.AuthTokenBaseValidate, func(c *fiber.Ctx) error {
+			return c.SendStatus(fiber.StatusOK)
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("Authorization", "Bearer revokedtoken")
+		res, _ := app.Test(req)
+		assert.Equal(t, fiber.StatusUnauthorized, res.StatusCode)
+	})
+
 	t.Run("token valid", func(t *testing.T) {
 		app := fiber.New()
-		mockAuthSvc.EXPECT().
-			AuthTokenValidate(gomock.Any()).
-			Return(&pbgen.TokenValidateResponse{
-				Valid:     true,
-				Msg:       "ok",
-				Subject:   ptrString("user123"),
-				Isuer:     ptrString("authservice"),
-				ExpiresAt: timestamppb.New(time.Now().Add(1 * time.Hour)),
+		mockVerifier.EXPECT().
+			Verify(gomock.Any(), "validtoken").
+			Return(paseto.JSONToken{
+				Subject:    "user123",
+				Issuer:     "auth",
+				Expiration: time.Now().Add(1 * time.Hour),
 			}, nil)
 
 		// Route pakai Next() biar ada endpoint setelah middleware
 		app.Get("/", handler.AuthTokenBaseValidate, func(c *fiber.Ctx) error {
+			assert.Equal(t, "user123", c.Locals("user_subject"))
 			return c.SendStatus(fiber.StatusOK)
 		})
 
@@ -96,7 +126,3 @@ func TestAuthTokenBaseValidate(t *testing.T) {
 		assert.Equal(t, fiber.StatusOK, res.StatusCode)
 	})
 }
-
-func ptrString(s string) *string {
-	return &s
-}
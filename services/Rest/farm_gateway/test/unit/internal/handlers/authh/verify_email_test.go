@@ -0,0 +1,76 @@
+package unit_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/handlers/authh"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/pbgen"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestVerifyEmailHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthSvc := mocks.NewMockGrpcAuthService(ctrl)
+	handler := authh.NewAuthHandler(mockAuthSvc, nil)
+
+	app := fiber.New()
+	app.Get("/auth/verify", handler.VerifyEmail)
+
+	t.Run("missing token", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/auth/verify", nil)
+		res, _ := app.Test(req)
+		assert.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		mockAuthSvc.EXPECT().
+			AuthVerifyEmail(gomock.Any(), &pbgen.VerifyEmailRequest{Token: "bad"}).
+			Return(nil, status.Error(codes.InvalidArgument, "invalid"))
+
+		req := httptest.NewRequest(http.MethodGet, "/auth/verify?token=bad", nil)
+		res, _ := app.Test(req)
+		assert.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		mockAuthSvc.EXPECT().
+			AuthVerifyEmail(gomock.Any(), &pbgen.VerifyEmailRequest{Token: "good"}).
+			Return(&pbgen.VerifyEmailResponse{Status: "Success", Msg: "email verified"}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/auth/verify?token=good", nil)
+		res, _ := app.Test(req)
+		assert.Equal(t, fiber.StatusOK, res.StatusCode)
+	})
+}
+
+func TestRequireVerified(t *testing.T) {
+	handler := authh.NewAuthHandler(nil, nil)
+
+	newApp := func(verified bool) *fiber.App {
+		app := fiber.New()
+		app.Post("/farm/create",
+			func(c *fiber.Ctx) error {
+				c.Locals("user_verified", verified)
+				return c.Next()
+			},
+			handler.RequireVerified,
+			func(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusCreated) },
+		)
+		return app
+	}
+
+	res, _ := newApp(false).Test(httptest.NewRequest(http.MethodPost, "/farm/create", nil))
+	assert.Equal(t, fiber.StatusForbidden, res.StatusCode)
+
+	res, _ = newApp(true).Test(httptest.NewRequest(http.MethodPost, "/farm/create", nil))
+	assert.Equal(t, fiber.StatusCreated, res.StatusCode)
+}
@@ -0,0 +1,287 @@
+package unit_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/handlers/farmh"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/models"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/pbgen"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/problem"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func withSubject(c *fiber.Ctx) error {
+	c.Locals("user_subject", "farmer-1")
+	return c.Next()
+}
+
+func TestListFarmsQueryString(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFarmSvc := mocks.NewMockGrpcFarmService(ctrl)
+	handler := farmh.NewFarmHandler(mockFarmSvc)
+
+	app := fiber.New()
+	app.Get("/v1/farms", withSubject, handler.ListFarms)
+
+	t.Run("Negative Limit", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/v1/farms?limit=-1", nil)
+		res, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		mockFarmSvc.EXPECT().
+			GetFarms(gomock.Any(), "farmer-1", models.GetFarmsRequest{
+				SearchName: "rice",
+				SortOrder:  models.SorOrderDesc,
+				Limit:      10,
+				Offset:     20,
+			}).
+			Return(models.GetFarmsResponse{Total: 21}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/farms?search_name=rice&sort_order=desc&limit=10&offset=20", nil)
+		res, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, res.StatusCode)
+
+		body, _ := io.ReadAll(res.Body)
+		assert.Contains(t, string(body), `"total":21`)
+	})
+
+	t.Run("Page Token", func(t *testing.T) {
+		mockFarmSvc.EXPECT().
+			GetFarms(gomock.Any(), "farmer-1", models.GetFarmsRequest{
+				SortOrder:    models.SortOrderAsc,
+				Limit:        10,
+				PageToken:    "tok1",
+				IncludeTotal: true,
+			}).
+			Return(models.GetFarmsResponse{Total: 21, NextPageToken: "tok2"}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/farms?sort_order=asc&limit=10&page_token=tok1&include_total=true", nil)
+		res, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, res.StatusCode)
+
+		body, _ := io.ReadAll(res.Body)
+		assert.Contains(t, string(body), `"next_page_token":"tok2"`)
+	})
+
+	t.Run("Filters", func(t *testing.T) {
+		minSize := 1.5
+		createdFrom := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		mockFarmSvc.EXPECT().
+			GetFarms(gomock.Any(), "farmer-1", models.GetFarmsRequest{
+				SortOrder: models.SorOrderDesc,
+				SortBy:    models.FarmSortSize,
+				Filter: models.FarmListFilter{
+					FarmTypes:   []string{"CROPLAND", "ORCHARD"},
+					City:        "Bandung",
+					MinFarmSize: &minSize,
+					CreatedFrom: &createdFrom,
+				},
+			}).
+			Return(models.GetFarmsResponse{}, nil)
+
+		req := httptest.NewRequest(http.MethodGet,
+			"/v1/farms?sort_order=desc&sort_by=size&farm_type=cropland,orchard&city=Bandung&min_farm_size=1.5&created_from=2026-01-01T00:00:00Z", nil)
+		res, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, res.StatusCode)
+	})
+
+	t.Run("Unknown Sort Field", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/v1/farms?sort_by=color", nil)
+		res, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+	})
+
+	t.Run("Invalid Date", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/v1/farms?updated_to=yesterday", nil)
+		res, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+	})
+}
+
+func TestGetFarmNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFarmSvc := mocks.NewMockGrpcFarmService(ctrl)
+	mockFarmSvc.EXPECT().
+		GetFarmByID(gomock.Any(), "farm-1", "farmer-1").
+		Return(models.Farm{}, status.Error(codes.NotFound, "farm is not found"))
+
+	handler := farmh.NewFarmHandler(mockFarmSvc)
+
+	app := fiber.New()
+	app.Get("/v1/farms/:id", withSubject, handler.GetFarm)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/farms/farm-1", nil)
+	res, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusNotFound, res.StatusCode)
+}
+
+func TestPostFarmCreated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFarmSvc := mocks.NewMockGrpcFarmService(ctrl)
+	mockFarmSvc.EXPECT().
+		CreateFarm(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ any, data []models.CreateFarm) ([]*pbgen.CreateFarmResponse, error) {
+			assert.Len(t, data, 1)
+			assert.Equal(t, "farmer-1", data[0].FarmerID)
+			return []*pbgen.CreateFarmResponse{{FarmId: "farm-1", Status: "Success"}}, nil
+		})
+
+	handler := farmh.NewFarmHandler(mockFarmSvc)
+
+	app := fiber.New()
+	app.Post("/v1/farms", withSubject, handler.PostFarm)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/farms", bytes.NewBufferString(`{"farm_name":"north field"}`))
+	req.Header.Set("Content-Type", "application/json")
+	res, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusCreated, res.StatusCode)
+	assert.Equal(t, "/v1/farms/farm-1", res.Header.Get("Location"))
+}
+
+func TestPatchFarmResolvesAddressID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFarmSvc := mocks.NewMockGrpcFarmService(ctrl)
+	mockFarmSvc.EXPECT().
+		GetFarmByID(gomock.Any(), "farm-1", "farmer-1").
+		Return(models.Farm{ID: "farm-1", Addresses: models.FarmAddress{ID: "addr-1"}}, nil)
+
+	mockFarmSvc.EXPECT().
+		UpdateFarmOrAddress(gomock.Any(), "farmer-1", gomock.Any()).
+		DoAndReturn(func(_ any, _ string, data []models.UpdateFarmWithAddr) ([]*pbgen.UpdateFarmsResponse, error) {
+			assert.Len(t, data, 1)
+			assert.Equal(t, "farm-1", data[0].Farm.ID)
+			assert.Equal(t, "addr-1", data[0].Address.ID)
+			return []*pbgen.UpdateFarmsResponse{{Status: "Success"}}, nil
+		})
+
+	handler := farmh.NewFarmHandler(mockFarmSvc)
+
+	app := fiber.New()
+	app.Patch("/v1/farms/:id", withSubject, handler.PatchFarm)
+
+	body := `{"farm":{"farm_name":"south field"},"address":{"city":"Bandung"}}`
+	req := httptest.NewRequest(http.MethodPatch, "/v1/farms/farm-1", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	res, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, res.StatusCode)
+}
+
+func TestGetFarmByIDProblem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	st, err := status.New(codes.NotFound, "farm is not exist").WithDetails(
+		&errdetails.ErrorInfo{Reason: "FARM_NOT_FOUND", Domain: "farm"},
+	)
+	assert.NoError(t, err)
+
+	mockFarmSvc := mocks.NewMockGrpcFarmService(ctrl)
+	mockFarmSvc.EXPECT().
+		GetFarmByID(gomock.Any(), "farm-1", "farmer-1").
+		Return(models.Farm{}, st.Err())
+
+	handler := farmh.NewFarmHandler(mockFarmSvc)
+
+	app := fiber.New()
+	app.Post("/farm", withSubject, handler.GetFarmByID)
+
+	req := httptest.NewRequest(http.MethodPost, "/farm", bytes.NewBufferString(`{"id":"farm-1"}`))
+	req.Header.Set("Content-Type", "application/json")
+	res, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusNotFound, res.StatusCode)
+	assert.Equal(t, problem.ContentType, res.Header.Get(fiber.HeaderContentType))
+
+	var body problem.Problem
+	assert.NoError(t, json.NewDecoder(res.Body).Decode(&body))
+	assert.Equal(t, "farm is not exist", body.Detail)
+	assert.Equal(t, "FARM_NOT_FOUND", body.Reason)
+	assert.Equal(t, "farm", body.Domain)
+}
+
+func TestSearchFarmsQueryString(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFarmSvc := mocks.NewMockGrpcFarmService(ctrl)
+	handler := farmh.NewFarmHandler(mockFarmSvc)
+
+	app := fiber.New()
+	app.Get("/v1/farms/search", withSubject, handler.SearchFarms)
+
+	t.Run("Negative Offset", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/v1/farms/search?q=rice&offset=-1", nil)
+		res, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		mockFarmSvc.EXPECT().
+			SearchFarms(gomock.Any(), "farmer-1", models.SearchFarmsRequest{
+				Query:     "rice fild",
+				FarmTypes: []string{"CROPLAND"},
+				Provinces: []string{"Jawa Barat", "Bali"},
+				Limit:     5,
+			}).
+			Return(models.SearchFarmsResponse{
+				Data:  []models.Farm{{ID: "farm-1", FarmName: "rice field"}},
+				Total: 1,
+				Facets: models.FarmFacets{
+					FarmTypes: []models.FacetCount{{Value: "CROPLAND", Count: 1}},
+				},
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet,
+			"/v1/farms/search?q=rice+fild&farm_type=cropland&province=Jawa+Barat,Bali&limit=5", nil)
+		res, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, res.StatusCode)
+
+		body, _ := io.ReadAll(res.Body)
+		assert.Contains(t, string(body), `"total":1`)
+		assert.Contains(t, string(body), `"farm_types":[{"value":"CROPLAND","count":1}]`)
+	})
+
+	t.Run("Service Error", func(t *testing.T) {
+		mockFarmSvc.EXPECT().
+			SearchFarms(gomock.Any(), "farmer-1", gomock.Any()).
+			Return(models.SearchFarmsResponse{}, status.Error(codes.InvalidArgument, "limit must be at most 100"))
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/farms/search?limit=500", nil)
+		res, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+	})
+}
@@ -0,0 +1,123 @@
+package unit_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/handlers/farmh"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/models"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/problem"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func farmListMessages(total int, ids ...string) []models.FarmListMessage {
+	msgs := []models.FarmListMessage{{Total: &total}}
+	for _, id := range ids {
+		msgs = append(msgs, models.FarmListMessage{Farm: &models.Farm{ID: id}})
+	}
+	return msgs
+}
+
+func expectStream(stream *mocks.MockFarmListStream, msgs []models.FarmListMessage, last error) {
+	calls := make([]*gomock.Call, 0, len(msgs)+1)
+	for _, msg := range msgs {
+		calls = append(calls, stream.EXPECT().Recv().Return(msg, nil))
+	}
+	calls = append(calls, stream.EXPECT().Recv().Return(models.FarmListMessage{}, last))
+	gomock.InOrder(calls...)
+}
+
+func TestListFarmsStream(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFarmSvc := mocks.NewMockGrpcFarmService(ctrl)
+	handler := farmh.NewFarmHandler(mockFarmSvc)
+
+	app := fiber.New()
+	app.Get("/v1/farms", withSubject, handler.ListFarms)
+
+	t.Run("Unknown Format", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/v1/farms?stream=xml", nil)
+		res, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+	})
+
+	t.Run("NDJSON", func(t *testing.T) {
+		stream := mocks.NewMockFarmListStream(ctrl)
+		expectStream(stream, farmListMessages(2, "farm-1", "farm-2"), io.EOF)
+		mockFarmSvc.EXPECT().
+			StreamFarms(gomock.Any(), "farmer-1", models.GetFarmsRequest{Limit: 2}).
+			Return(stream, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/farms?stream=ndjson&limit=2", nil)
+		res, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, res.StatusCode)
+		assert.Equal(t, "application/x-ndjson", res.Header.Get(fiber.HeaderContentType))
+
+		var lines []models.FarmListMessage
+		scanner := bufio.NewScanner(res.Body)
+		for scanner.Scan() {
+			var msg models.FarmListMessage
+			assert.NoError(t, json.Unmarshal(scanner.Bytes(), &msg))
+			lines = append(lines, msg)
+		}
+
+		if assert.Len(t, lines, 3) {
+			assert.Equal(t, 2, *lines[0].Total)
+			assert.Equal(t, "farm-1", lines[1].Farm.ID)
+			assert.Equal(t, "farm-2", lines[2].Farm.ID)
+		}
+	})
+
+	t.Run("SSE Ends With Error Event", func(t *testing.T) {
+		stream := mocks.NewMockFarmListStream(ctrl)
+		expectStream(stream, farmListMessages(2, "farm-1"), status.Error(codes.Unavailable, "farm service is gone"))
+		mockFarmSvc.EXPECT().
+			StreamFarms(gomock.Any(), "farmer-1", models.GetFarmsRequest{}).
+			Return(stream, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/farms?stream=sse", nil)
+		res, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusOK, res.StatusCode)
+		assert.Equal(t, "text/event-stream", res.Header.Get(fiber.HeaderContentType))
+
+		body, _ := io.ReadAll(res.Body)
+		events := strings.Split(strings.TrimSpace(string(body)), "\n\n")
+		if assert.Len(t, events, 3) {
+			assert.True(t, strings.HasPrefix(events[0], "event: total\n"))
+			assert.True(t, strings.HasPrefix(events[1], "event: farm\n"))
+			assert.True(t, strings.HasPrefix(events[2], "event: error\n"))
+			assert.Contains(t, events[2], "farm service is gone")
+		}
+	})
+
+	t.Run("Rejected Before The First Farm", func(t *testing.T) {
+		stream := mocks.NewMockFarmListStream(ctrl)
+		stream.EXPECT().
+			Recv().
+			Return(models.FarmListMessage{}, status.Error(codes.InvalidArgument, "limit must be greater than or equal to 0"))
+		mockFarmSvc.EXPECT().
+			StreamFarms(gomock.Any(), "farmer-1", gomock.Any()).
+			Return(stream, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/farms?stream=ndjson", nil)
+		res, err := app.Test(req)
+		assert.NoError(t, err)
+		assert.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+		assert.Equal(t, problem.ContentType, res.Header.Get(fiber.HeaderContentType))
+	})
+}
@@ -0,0 +1,110 @@
+package unit_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/health"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/problem"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// healthClient answers every Check with the same status or error.
+type healthClient struct {
+	grpc_health_v1.HealthClient
+	status grpc_health_v1.HealthCheckResponse_ServingStatus
+	err    error
+}
+
+func (hc healthClient) Check(
+	context.Context,
+	*grpc_health_v1.HealthCheckRequest,
+	...grpc.CallOption,
+) (*grpc_health_v1.HealthCheckResponse, error) {
+	if hc.err != nil {
+		return nil, hc.err
+	}
+	return &grpc_health_v1.HealthCheckResponse{Status: hc.status}, nil
+}
+
+var serving = healthClient{status: grpc_health_v1.HealthCheckResponse_SERVING}
+
+func buildApp(downstreams ...health.Downstream) *fiber.App {
+	r := health.NewReadiness(0, downstreams...)
+
+	app := fiber.New()
+	app.Get("/healthz", health.Live)
+	app.Get("/readyz", r.Ready)
+
+	return app
+}
+
+func get(t *testing.T, app *fiber.App, path string) *http.Response {
+	res, err := app.Test(httptest.NewRequest(http.MethodGet, path, nil))
+	assert.NoError(t, err)
+	return res
+}
+
+func TestLive(t *testing.T) {
+	res := get(t, buildApp(), "/healthz")
+	assert.Equal(t, fiber.StatusOK, res.StatusCode)
+}
+
+func TestReady(t *testing.T) {
+	t.Run("Every Downstream Serving", func(t *testing.T) {
+		app := buildApp(
+			health.Downstream{Name: "auth", Client: serving},
+			health.Downstream{Name: "farm", Client: serving},
+		)
+
+		res := get(t, app, "/readyz")
+		assert.Equal(t, fiber.StatusOK, res.StatusCode)
+
+		var body struct {
+			Status   string            `json:"status"`
+			Services map[string]string `json:"services"`
+		}
+		assert.NoError(t, json.NewDecoder(res.Body).Decode(&body))
+		assert.Equal(t, "ready", body.Status)
+		assert.Equal(t, map[string]string{"auth": "SERVING", "farm": "SERVING"}, body.Services)
+	})
+
+	t.Run("Downstream Not Serving", func(t *testing.T) {
+		app := buildApp(
+			health.Downstream{Name: "auth", Client: serving},
+			health.Downstream{Name: "farm", Client: healthClient{status: grpc_health_v1.HealthCheckResponse_NOT_SERVING}},
+		)
+
+		res := get(t, app, "/readyz")
+		assert.Equal(t, fiber.StatusServiceUnavailable, res.StatusCode)
+		assert.Equal(t, problem.ContentType, res.Header.Get(fiber.HeaderContentType))
+
+		var p problem.Problem
+		assert.NoError(t, json.NewDecoder(res.Body).Decode(&p))
+		assert.Equal(t, "not serving: farm", p.Detail)
+		assert.Equal(t, map[string]string{"auth": "SERVING", "farm": "NOT_SERVING"}, p.Metadata)
+	})
+
+	t.Run("Downstream Unreachable", func(t *testing.T) {
+		app := buildApp(
+			health.Downstream{Name: "auth", Client: healthClient{err: status.Error(codes.Unavailable, "connection refused")}},
+			health.Downstream{Name: "farmer", Client: serving},
+		)
+
+		res := get(t, app, "/readyz")
+		assert.Equal(t, fiber.StatusServiceUnavailable, res.StatusCode)
+
+		var p problem.Problem
+		assert.NoError(t, json.NewDecoder(res.Body).Decode(&p))
+		assert.Equal(t, "not serving: auth", p.Detail)
+		assert.Equal(t, map[string]string{"auth": "Unavailable", "farmer": "SERVING"}, p.Metadata)
+	})
+}
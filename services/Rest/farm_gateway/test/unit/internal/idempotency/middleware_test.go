@@ -0,0 +1,231 @@
+package unit_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/idempotency"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/test/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	lockTTL = time.Minute
+	ttl     = time.Hour
+)
+
+func buildApp(store idempotency.Store, status int, calls *int) *fiber.App {
+	app := fiber.New()
+	app.Post("/farms",
+		func(c *fiber.Ctx) error {
+			c.Locals("user_subject", "user1")
+			return c.Next()
+		},
+		idempotency.Middleware(store, lockTTL, ttl),
+		func(c *fiber.Ctx) error {
+			*calls++
+			return c.Status(status).JSON(fiber.Map{"msg": "created"})
+		},
+	)
+
+	return app
+}
+
+func post(t *testing.T, app *fiber.App, key string) *http.Response {
+	return postTo(t, app, "/farms", key)
+}
+
+func postTo(t *testing.T, app *fiber.App, target string, key string) *http.Response {
+	req := httptest.NewRequest(http.MethodPost, target, strings.NewReader(`{"farm_name":"a"}`))
+	req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	if key != "" {
+		req.Header.Set(idempotency.Header, key)
+	}
+
+	res, err := app.Test(req)
+	assert.NoError(t, err)
+	return res
+}
+
+func TestMiddleware(t *testing.T) {
+	t.Run("Without Key", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		calls := 0
+		res := post(t, buildApp(mocks.NewMockStore(ctrl), fiber.StatusCreated, &calls), "")
+		assert.Equal(t, fiber.StatusCreated, res.StatusCode)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Key Too Long", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		calls := 0
+		res := post(t, buildApp(mocks.NewMockStore(ctrl), fiber.StatusCreated, &calls), strings.Repeat("k", 256))
+		assert.Equal(t, fiber.StatusBadRequest, res.StatusCode)
+		assert.Equal(t, 0, calls)
+	})
+
+	t.Run("First Request Is Stored", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mocks.NewMockStore(ctrl)
+		store.EXPECT().
+			Reserve(gomock.Any(), "user1:k1", gomock.Any(), lockTTL).
+			Return(idempotency.Record{}, true, nil)
+		store.EXPECT().
+			Complete(gomock.Any(), "user1:k1", gomock.Any(), ttl).
+			DoAndReturn(func(_ any, _ string, rec idempotency.Record, _ time.Duration) error {
+				assert.True(t, rec.Done)
+				assert.Equal(t, fiber.StatusCreated, rec.Status)
+				assert.JSONEq(t, `{"msg":"created"}`, string(rec.Body))
+				return nil
+			})
+
+		calls := 0
+		res := post(t, buildApp(store, fiber.StatusCreated, &calls), "k1")
+		assert.Equal(t, fiber.StatusCreated, res.StatusCode)
+		assert.Empty(t, res.Header.Get(idempotency.ReplayedHeader))
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Retry Is Replayed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mocks.NewMockStore(ctrl)
+		var first idempotency.Record
+		store.EXPECT().
+			Reserve(gomock.Any(), "user1:k1", gomock.Any(), lockTTL).
+			DoAndReturn(func(_ any, _ string, rec idempotency.Record, _ time.Duration) (idempotency.Record, bool, error) {
+				first = rec
+				return idempotency.Record{}, true, nil
+			})
+		store.EXPECT().Complete(gomock.Any(), "user1:k1", gomock.Any(), ttl).Return(nil)
+
+		calls := 0
+		app := buildApp(store, fiber.StatusCreated, &calls)
+		post(t, app, "k1")
+
+		store.EXPECT().
+			Reserve(gomock.Any(), "user1:k1", gomock.Any(), lockTTL).
+			Return(idempotency.Record{
+				Hash:        first.Hash,
+				Done:        true,
+				Status:      fiber.StatusCreated,
+				ContentType: fiber.MIMEApplicationJSON,
+				Body:        []byte(`{"msg":"stored"}`),
+			}, false, nil)
+
+		res := post(t, app, "k1")
+		assert.Equal(t, fiber.StatusCreated, res.StatusCode)
+		assert.Equal(t, "true", res.Header.Get(idempotency.ReplayedHeader))
+		body, _ := io.ReadAll(res.Body)
+		assert.JSONEq(t, `{"msg":"stored"}`, string(body))
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Different Request With Same Key", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mocks.NewMockStore(ctrl)
+		store.EXPECT().
+			Reserve(gomock.Any(), "user1:k1", gomock.Any(), lockTTL).
+			Return(idempotency.Record{Hash: "other", Done: true}, false, nil)
+
+		calls := 0
+		res := post(t, buildApp(store, fiber.StatusCreated, &calls), "k1")
+		assert.Equal(t, fiber.StatusUnprocessableEntity, res.StatusCode)
+		assert.Equal(t, 0, calls)
+	})
+
+	t.Run("Query String Is Part Of The Request", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mocks.NewMockStore(ctrl)
+		var hashes []string
+		store.EXPECT().
+			Reserve(gomock.Any(), "user1:k1", gomock.Any(), lockTTL).
+			DoAndReturn(func(_ any, _ string, rec idempotency.Record, _ time.Duration) (idempotency.Record, bool, error) {
+				hashes = append(hashes, rec.Hash)
+				return idempotency.Record{}, true, nil
+			}).
+			Times(3)
+		store.EXPECT().Complete(gomock.Any(), "user1:k1", gomock.Any(), ttl).Return(nil).Times(3)
+
+		calls := 0
+		app := buildApp(store, fiber.StatusCreated, &calls)
+		postTo(t, app, "/farms?dry_run=true", "k1")
+		postTo(t, app, "/farms?dry_run=false", "k1")
+		postTo(t, app, "/farms?dry_run=true", "k1")
+
+		assert.NotEqual(t, hashes[0], hashes[1])
+		assert.Equal(t, hashes[0], hashes[2])
+	})
+
+	t.Run("Request Still Pending", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mocks.NewMockStore(ctrl)
+		var hash string
+		store.EXPECT().
+			Reserve(gomock.Any(), "user1:k1", gomock.Any(), lockTTL).
+			DoAndReturn(func(_ any, _ string, rec idempotency.Record, _ time.Duration) (idempotency.Record, bool, error) {
+				hash = rec.Hash
+				return idempotency.Record{Hash: hash}, false, nil
+			})
+
+		calls := 0
+		res := post(t, buildApp(store, fiber.StatusCreated, &calls), "k1")
+		assert.Equal(t, fiber.StatusConflict, res.StatusCode)
+		assert.NotEmpty(t, hash)
+		assert.Equal(t, 0, calls)
+	})
+
+	t.Run("Server Error Releases Key", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mocks.NewMockStore(ctrl)
+		store.EXPECT().
+			Reserve(gomock.Any(), "user1:k1", gomock.Any(), lockTTL).
+			Return(idempotency.Record{}, true, nil)
+		store.EXPECT().Release(gomock.Any(), "user1:k1").Return(nil)
+
+		calls := 0
+		res := post(t, buildApp(store, fiber.StatusBadGateway, &calls), "k1")
+		assert.Equal(t, fiber.StatusBadGateway, res.StatusCode)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Store Unavailable", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mocks.NewMockStore(ctrl)
+		store.EXPECT().
+			Reserve(gomock.Any(), "user1:k1", gomock.Any(), lockTTL).
+			Return(idempotency.Record{}, false, errors.New("dial tcp 10.0.3.7:6379: connection refused"))
+
+		calls := 0
+		res := post(t, buildApp(store, fiber.StatusCreated, &calls), "k1")
+		assert.Equal(t, fiber.StatusServiceUnavailable, res.StatusCode)
+		assert.Equal(t, 0, calls)
+
+		body, _ := io.ReadAll(res.Body)
+		assert.NotContains(t, string(body), "6379")
+	})
+}
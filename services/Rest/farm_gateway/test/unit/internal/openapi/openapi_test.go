@@ -0,0 +1,142 @@
+package unit_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/health"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/openapi"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/ratelimit"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/routes"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/test/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildApp(t *testing.T) *fiber.App {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	app := fiber.New()
+	r := routes.NewRoutes(
+		app,
+		mocks.NewMockGrpcAuthService(ctrl),
+		nil,
+		mocks.NewMockGrpcFarmService(ctrl),
+		mocks.NewMockVerifier(ctrl),
+		nil,
+		ratelimit.NewLimiter(mocks.NewMockRateLimitStore(ctrl), nil),
+		health.NewReadiness(0),
+		routes.PolicyFromEnv(),
+	)
+	r.Build()
+
+	return app
+}
+
+func fetchSpec(t *testing.T, app *fiber.App) openapi.Document {
+	req := httptest.NewRequest(http.MethodGet, "/openapi.json", nil)
+	res, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, res.StatusCode)
+
+	var doc openapi.Document
+	assert.NoError(t, json.NewDecoder(res.Body).Decode(&doc))
+	return doc
+}
+
+func TestSpecCoversRegisteredRoutes(t *testing.T) {
+	app := buildApp(t)
+	doc := fetchSpec(t, app)
+
+	assert.Equal(t, "3.1.0", doc.OpenAPI)
+	assert.Contains(t, doc.Components.SecuritySchemes, openapi.BearerAuth)
+
+	for _, route := range app.GetRoutes(true) {
+		// fiber registers HEAD next to every GET
+		if route.Method == http.MethodHead {
+			continue
+		}
+
+		path := openapi.Path(route.Path)
+		item, ok := doc.Paths[path]
+		if !assert.Truef(t, ok, "%s is missing from the spec", path) {
+			continue
+		}
+
+		_, ok = item[strings.ToLower(route.Method)]
+		assert.Truef(t, ok, "%s %s is missing from the spec", route.Method, path)
+	}
+}
+
+func TestSpecOperations(t *testing.T) {
+	doc := fetchSpec(t, buildApp(t))
+
+	t.Run("Secured Route", func(t *testing.T) {
+		op := doc.Paths["/v1/farms/{id}"]["get"]
+		if assert.NotNil(t, op) {
+			assert.Equal(t, []map[string][]string{{openapi.BearerAuth: {}}}, op.Security)
+			assert.Contains(t, op.Responses, "401")
+			assert.Equal(t, "id", op.Parameters[0].Name)
+			assert.Equal(t, "path", op.Parameters[0].In)
+		}
+	})
+
+	t.Run("Deprecated Route", func(t *testing.T) {
+		legacy := doc.Paths["/auth/signin"]["post"]
+		if assert.NotNil(t, legacy) {
+			assert.True(t, legacy.Deprecated)
+			assert.Contains(t, legacy.Responses["200"].Headers, "Sunset")
+		}
+
+		current := doc.Paths["/v1/auth/signin"]["post"]
+		if assert.NotNil(t, current) {
+			assert.False(t, current.Deprecated)
+			assert.NotContains(t, current.Security, map[string][]string{openapi.BearerAuth: {}})
+		}
+	})
+
+	t.Run("Idempotent Route", func(t *testing.T) {
+		op := doc.Paths["/v1/farms"]["post"]
+		if assert.NotNil(t, op) {
+			assert.Contains(t, op.Parameters, openapi.Parameter{
+				Name:        "Idempotency-Key",
+				In:          "header",
+				Description: "retries with the same key replay the first response instead of repeating the request",
+				Schema:      &openapi.Schema{Type: "string"},
+			})
+			assert.Contains(t, op.Responses, "409")
+			assert.Contains(t, op.Responses, "422")
+		}
+	})
+
+	t.Run("Rate Limited Route", func(t *testing.T) {
+		op := doc.Paths["/v1/auth/signin"]["post"]
+		if assert.NotNil(t, op) {
+			assert.Contains(t, op.Responses, "429")
+		}
+
+		spec := doc.Paths["/openapi.json"]["get"]
+		if assert.NotNil(t, spec) {
+			assert.NotContains(t, spec.Responses, "429")
+		}
+	})
+}
+
+func TestExplorer(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/docs", nil)
+	res, err := buildApp(t).Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, res.StatusCode)
+	assert.Contains(t, res.Header.Get(fiber.HeaderContentType), fiber.MIMETextHTML)
+
+	body, _ := io.ReadAll(res.Body)
+	assert.Contains(t, string(body), "/openapi.json")
+	assert.NotContains(t, string(body), "swagger-ui-dist@5/")
+	assert.Contains(t, string(body), "swagger-ui-dist@5.17.14/")
+}
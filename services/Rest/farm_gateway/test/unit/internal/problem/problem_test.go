@@ -0,0 +1,86 @@
+package unit_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/problem"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestHTTPStatus(t *testing.T) {
+	cases := map[codes.Code]int{
+		codes.NotFound:          fiber.StatusNotFound,
+		codes.InvalidArgument:   fiber.StatusBadRequest,
+		codes.PermissionDenied:  fiber.StatusForbidden,
+		codes.Unauthenticated:   fiber.StatusUnauthorized,
+		codes.ResourceExhausted: fiber.StatusTooManyRequests,
+		codes.Unavailable:       fiber.StatusServiceUnavailable,
+		codes.AlreadyExists:     fiber.StatusConflict,
+		codes.Unknown:           fiber.StatusInternalServerError,
+		codes.Internal:          fiber.StatusInternalServerError,
+	}
+
+	for code, want := range cases {
+		assert.Equal(t, want, problem.HTTPStatus(code), code.String())
+	}
+}
+
+func TestFromStatusDetails(t *testing.T) {
+	st, err := status.New(codes.InvalidArgument, "email must be a valid email address").WithDetails(
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "email", Description: "must be a valid email address"},
+			},
+		},
+		&errdetails.ErrorInfo{Reason: "VALIDATION_FAILED", Domain: "auth"},
+	)
+	assert.NoError(t, err)
+
+	p := problem.FromStatus(st)
+	assert.Equal(t, fiber.StatusBadRequest, p.Status)
+	assert.Equal(t, "InvalidArgument", p.Code)
+	assert.Equal(t, "VALIDATION_FAILED", p.Reason)
+	assert.Equal(t, "auth", p.Domain)
+	assert.Equal(t, []problem.InvalidParam{{Name: "email", Reason: "must be a valid email address"}}, p.InvalidParams)
+	assert.Equal(t, p.Detail, p.Error)
+}
+
+func TestGRPCResponse(t *testing.T) {
+	app := fiber.New()
+	app.Get("/throttled", func(c *fiber.Ctx) error {
+		st, _ := status.New(codes.ResourceExhausted, "too many sign in attempts").WithDetails(
+			&errdetails.RetryInfo{RetryDelay: durationpb.New(90 * time.Second)},
+		)
+		return problem.GRPC(c, st.Err())
+	})
+
+	res, err := app.Test(httptest.NewRequest(http.MethodGet, "/throttled", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusTooManyRequests, res.StatusCode)
+	assert.Equal(t, problem.ContentType, res.Header.Get(fiber.HeaderContentType))
+	assert.Equal(t, "90", res.Header.Get(fiber.HeaderRetryAfter))
+
+	var body problem.Problem
+	assert.NoError(t, json.NewDecoder(res.Body).Decode(&body))
+	assert.Equal(t, "Too Many Requests", body.Title)
+	assert.Equal(t, "/throttled", body.Instance)
+	assert.Equal(t, "too many sign in attempts", body.Detail)
+}
+
+func TestErrorHandler(t *testing.T) {
+	app := fiber.New(fiber.Config{ErrorHandler: problem.ErrorHandler})
+
+	res, err := app.Test(httptest.NewRequest(http.MethodGet, "/missing", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusNotFound, res.StatusCode)
+	assert.Equal(t, problem.ContentType, res.Header.Get(fiber.HeaderContentType))
+}
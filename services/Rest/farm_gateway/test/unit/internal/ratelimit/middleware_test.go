@@ -0,0 +1,153 @@
+package unit_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/ratelimit"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/test/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+var limit = ratelimit.Limit{Requests: 10, Window: time.Minute}
+
+type recorder struct {
+	outcomes []string
+}
+
+func (r *recorder) RecordRateLimit(_ context.Context, group string, outcome string) {
+	r.outcomes = append(r.outcomes, group+":"+outcome)
+}
+
+func buildApp(store ratelimit.Store, rec ratelimit.Recorder, subject string) *fiber.App {
+	return buildAppFailOpen(store, rec, subject, true)
+}
+
+func buildAppFailOpen(store ratelimit.Store, rec ratelimit.Recorder, subject string, failOpen bool) *fiber.App {
+	l := ratelimit.NewLimiter(store, rec)
+
+	app := fiber.New()
+	app.Get("/farms",
+		func(c *fiber.Ctx) error {
+			if subject != "" {
+				c.Locals("user_subject", subject)
+			}
+			return c.Next()
+		},
+		l.Middleware("read", limit, failOpen),
+		func(c *fiber.Ctx) error {
+			return c.SendStatus(fiber.StatusOK)
+		},
+	)
+
+	return app
+}
+
+func get(t *testing.T, app *fiber.App) *http.Response {
+	res, err := app.Test(httptest.NewRequest(http.MethodGet, "/farms", nil))
+	assert.NoError(t, err)
+	return res
+}
+
+func TestMiddleware(t *testing.T) {
+	t.Run("Allowed By Subject", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mocks.NewMockRateLimitStore(ctrl)
+		store.EXPECT().
+			Take(gomock.Any(), "read:sub:user1", limit).
+			Return(ratelimit.Result{Allowed: true, Remaining: 9, Reset: 5500 * time.Millisecond}, nil)
+
+		rec := &recorder{}
+		res := get(t, buildApp(store, rec, "user1"))
+		assert.Equal(t, fiber.StatusOK, res.StatusCode)
+		assert.Equal(t, "10", res.Header.Get(ratelimit.HeaderLimit))
+		assert.Equal(t, "9", res.Header.Get(ratelimit.HeaderRemaining))
+		assert.Equal(t, "6", res.Header.Get(ratelimit.HeaderReset))
+		assert.Equal(t, "10;w=60", res.Header.Get(ratelimit.HeaderPolicy))
+		assert.Equal(t, []string{"read:allowed"}, rec.outcomes)
+	})
+
+	t.Run("Anonymous By IP", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mocks.NewMockRateLimitStore(ctrl)
+		store.EXPECT().
+			Take(gomock.Any(), "read:ip:0.0.0.0", limit).
+			Return(ratelimit.Result{Allowed: true, Remaining: 9}, nil)
+
+		res := get(t, buildApp(store, nil, ""))
+		assert.Equal(t, fiber.StatusOK, res.StatusCode)
+	})
+
+	t.Run("Limited", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mocks.NewMockRateLimitStore(ctrl)
+		store.EXPECT().
+			Take(gomock.Any(), "read:sub:user1", limit).
+			Return(ratelimit.Result{Reset: time.Minute, RetryAfter: 5900 * time.Millisecond}, nil)
+
+		rec := &recorder{}
+		res := get(t, buildApp(store, rec, "user1"))
+		assert.Equal(t, fiber.StatusTooManyRequests, res.StatusCode)
+		assert.Equal(t, "6", res.Header.Get(fiber.HeaderRetryAfter))
+		assert.Equal(t, "0", res.Header.Get(ratelimit.HeaderRemaining))
+		assert.Equal(t, []string{"read:limited"}, rec.outcomes)
+	})
+
+	t.Run("Store Unavailable", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mocks.NewMockRateLimitStore(ctrl)
+		store.EXPECT().
+			Take(gomock.Any(), gomock.Any(), limit).
+			Return(ratelimit.Result{}, errors.New("connection refused"))
+
+		rec := &recorder{}
+		res := get(t, buildApp(store, rec, "user1"))
+		assert.Equal(t, fiber.StatusOK, res.StatusCode)
+		assert.Empty(t, res.Header.Get(ratelimit.HeaderLimit))
+		assert.Equal(t, []string{"read:error"}, rec.outcomes)
+	})
+
+	t.Run("Store Unavailable Fails Closed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mocks.NewMockRateLimitStore(ctrl)
+		store.EXPECT().
+			Take(gomock.Any(), gomock.Any(), limit).
+			Return(ratelimit.Result{}, errors.New("connection refused"))
+
+		rec := &recorder{}
+		res := get(t, buildAppFailOpen(store, rec, "user1", false))
+		assert.Equal(t, fiber.StatusServiceUnavailable, res.StatusCode)
+		assert.Equal(t, []string{"read:error"}, rec.outcomes)
+	})
+}
+
+func TestParseLimit(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		l, err := ratelimit.ParseLimit("5/30s")
+		assert.NoError(t, err)
+		assert.Equal(t, ratelimit.Limit{Requests: 5, Window: 30 * time.Second}, l)
+	})
+
+	for _, val := range []string{"5", "0/1m", "x/1m", "5/soon", "5/-1m"} {
+		t.Run("Invalid "+val, func(t *testing.T) {
+			_, err := ratelimit.ParseLimit(val)
+			assert.Error(t, err)
+		})
+	}
+}
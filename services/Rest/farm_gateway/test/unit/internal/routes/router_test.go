@@ -0,0 +1,87 @@
+package uni_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/routes"
+	"github.com/stretchr/testify/assert"
+)
+
+// step records its name and hands the request to the next handler.
+func step(steps *[]string, name string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		*steps = append(*steps, name)
+		return c.Next()
+	}
+}
+
+func TestRouter_Builder(t *testing.T) {
+	var steps []string
+	handler := func(c *fiber.Ctx) error {
+		steps = append(steps, "handler")
+		return c.SendStatus(fiber.StatusOK)
+	}
+	limit := func(method string) fiber.Handler {
+		return step(&steps, "limit:"+method)
+	}
+
+	build := func(router routes.Router) *fiber.App {
+		app := fiber.New()
+		app.Route("/v1", router.Builder)
+		return app
+	}
+
+	secured := routes.NewRouterHandlers("/farms", http.MethodGet, handler).WithAuth(step(&steps, "auth"))
+	public := routes.NewRouterHandlers("/signup", http.MethodPost, handler)
+
+	tests := []struct {
+		name   string
+		router routes.Router
+		method string
+		path   string
+		want   []string
+	}{
+		{
+			name:   "Secured Route Limits The IP Before The Token",
+			router: routes.NewRouter(secured).RateLimit(limit).RateLimitIP(step(&steps, "limit:ip")),
+			method: http.MethodGet,
+			path:   "/v1/farms",
+			want:   []string{"limit:ip", "auth", "limit:GET", "handler"},
+		},
+		{
+			name:   "Public Route Skips The IP Limiter",
+			router: routes.NewRouter(public).RateLimit(limit).RateLimitIP(step(&steps, "limit:ip")),
+			method: http.MethodPost,
+			path:   "/v1/signup",
+			want:   []string{"limit:POST", "handler"},
+		},
+		{
+			name:   "Secured Route Without Limiter",
+			router: routes.NewRouter(secured),
+			method: http.MethodGet,
+			path:   "/v1/farms",
+			want:   []string{"auth", "handler"},
+		},
+		{
+			name:   "Middleware Runs First",
+			router: routes.NewRouter(secured).Use(step(&steps, "middleware")).RateLimit(limit),
+			method: http.MethodGet,
+			path:   "/v1/farms",
+			want:   []string{"middleware", "auth", "limit:GET", "handler"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			steps = nil
+
+			res, err := build(tt.router).Test(httptest.NewRequest(tt.method, tt.path, nil))
+			assert.NoError(t, err)
+			assert.Equal(t, fiber.StatusOK, res.StatusCode)
+			assert.Equal(t, tt.want, steps)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package uni_test
 
 import (
 	"bytes"
+	"context"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -10,6 +11,7 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/golang/mock/gomock"
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/pbgen"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/ratelimit"
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/routes"
 	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/test/mocks"
 	"github.com/stretchr/testify/assert"
@@ -22,14 +24,15 @@ func TestRoutes_AuthSignup(t *testing.T) {
 	// Mock GrpcAuthService
 	mockGrpcSvc := mocks.NewMockGrpcAuthService(ctrl)
 	mockGrpcSvc.EXPECT().
-		AuthUserRegister(gomock.Any()).
+		AuthUserRegister(gomock.Any(), gomock.Any()).
 		Return(&pbgen.RegisterUserResponse{
 			Status: "Success",
 			Msg:    "User registered",
+			UserId: "u-1",
 		}, nil)
 
 	app := fiber.New()
-	routes := routes.NewRoutes(app, mockGrpcSvc)
+	routes := routes.NewRoutes(app, mockGrpcSvc, nil, nil, nil, nil, nil, nil, routes.Policy{})
 	routes.Build()
 
 	// Request body
@@ -40,14 +43,62 @@ func TestRoutes_AuthSignup(t *testing.T) {
         "Password": "Secret@1"
     }`
 
-	req := httptest.NewRequest(http.MethodPost, "/auth/signup", bytes.NewReader([]byte(jsonReq)))
+	req := httptest.NewRequest(http.MethodPost, "/v1/auth/signup", bytes.NewReader([]byte(jsonReq)))
 	req.Header.Set("Content-Type", "application/json")
 
 	resp, err := app.Test(req)
 	assert.NoError(t, err)
-	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.Equal(t, fiber.StatusAccepted, resp.StatusCode)
 
 	body, _ := io.ReadAll(resp.Body)
 	assert.Contains(t, string(body), `"status":"Success"`)
 	assert.Contains(t, string(body), `"msg":"User registered"`)
 }
+
+func TestRoutes_AuthRateLimitGroups(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// every bucket is empty, the group of the route is the prefix of the key
+	var keys []string
+	store := mocks.NewMockRateLimitStore(ctrl)
+	store.EXPECT().
+		Take(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, key string, _ ratelimit.Limit) (ratelimit.Result, error) {
+			keys = append(keys, key)
+			return ratelimit.Result{Allowed: false}, nil
+		}).
+		AnyTimes()
+
+	app := fiber.New()
+	routes.NewRoutes(app, nil, nil, nil, nil, nil, ratelimit.NewLimiter(store, nil), nil, routes.PolicyFromEnv()).Build()
+
+	tests := []struct {
+		method string
+		path   string
+		want   string
+	}{
+		{http.MethodPost, "/v1/auth/signup", "auth:ip:0.0.0.0"},
+		{http.MethodPost, "/v1/auth/signin", "auth:ip:0.0.0.0"},
+		{http.MethodPost, "/v1/auth/password/forgot", "auth:ip:0.0.0.0"},
+		{http.MethodPost, "/v1/auth/password/reset", "auth:ip:0.0.0.0"},
+		{http.MethodPost, "/v1/auth/2fa/verify", "auth:ip:0.0.0.0"},
+		{http.MethodGet, "/v1/auth/signup/u-1", "read:ip:0.0.0.0"},
+		{http.MethodPost, "/v1/auth/refresh", "write:ip:0.0.0.0"},
+		{http.MethodGet, "/v1/auth/verify", "read:ip:0.0.0.0"},
+		// a secured route is limited by IP before its token is validated
+		{http.MethodPost, "/v1/auth/logout", "ip:ip:0.0.0.0"},
+		{http.MethodPost, "/v1/auth/2fa/enroll", "ip:ip:0.0.0.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			keys = nil
+
+			resp, err := app.Test(httptest.NewRequest(tt.method, tt.path, nil))
+			assert.NoError(t, err)
+			assert.Equal(t, fiber.StatusTooManyRequests, resp.StatusCode)
+			assert.Equal(t, []string{tt.want}, keys)
+		})
+	}
+}
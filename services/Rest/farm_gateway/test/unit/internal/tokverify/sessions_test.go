@@ -0,0 +1,113 @@
+package unit_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/o1egl/paseto"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/tokverify"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/test/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func sessionToken(jti string) paseto.JSONToken {
+	return paseto.JSONToken{
+		Jti:        jti,
+		Subject:    "user1",
+		Expiration: time.Now().Add(time.Hour),
+	}
+}
+
+func TestWithSessionCheck(t *testing.T) {
+	t.Run("Active Session", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockVerifier := mocks.NewMockVerifier(ctrl)
+		mockRevoked := mocks.NewMockRevocationList(ctrl)
+
+		mockVerifier.EXPECT().Verify(gomock.Any(), "tok").Return(sessionToken("s1"), nil)
+		mockRevoked.EXPECT().IsRevoked(gomock.Any(), "s1").Return(false, nil)
+
+		verifier := tokverify.WithSessionCheck(mockVerifier, mockRevoked)
+
+		tok, err := verifier.Verify(context.Background(), "tok")
+		assert.NoError(t, err)
+		assert.Equal(t, "s1", tok.Jti)
+	})
+
+	t.Run("Revoked Session", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockVerifier := mocks.NewMockVerifier(ctrl)
+		mockRevoked := mocks.NewMockRevocationList(ctrl)
+
+		mockVerifier.EXPECT().Verify(gomock.Any(), "tok").Return(sessionToken("s1"), nil)
+		mockRevoked.EXPECT().IsRevoked(gomock.Any(), "s1").Return(true, nil)
+
+		verifier := tokverify.WithSessionCheck(mockVerifier, mockRevoked)
+
+		_, err := verifier.Verify(context.Background(), "tok")
+		assert.ErrorIs(t, err, tokverify.ErrSessionRevoked)
+	})
+
+	t.Run("Session Revoked After Its First Request", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockVerifier := mocks.NewMockVerifier(ctrl)
+		mockRevoked := mocks.NewMockRevocationList(ctrl)
+
+		mockVerifier.EXPECT().Verify(gomock.Any(), "tok").Return(sessionToken("s1"), nil).Times(2)
+		gomock.InOrder(
+			mockRevoked.EXPECT().IsRevoked(gomock.Any(), "s1").Return(false, nil),
+			mockRevoked.EXPECT().IsRevoked(gomock.Any(), "s1").Return(true, nil),
+		)
+
+		verifier := tokverify.WithSessionCheck(mockVerifier, mockRevoked)
+
+		_, err := verifier.Verify(context.Background(), "tok")
+		assert.NoError(t, err)
+
+		// no cached answer keeps the revoked session working
+		_, err = verifier.Verify(context.Background(), "tok")
+		assert.ErrorIs(t, err, tokverify.ErrSessionRevoked)
+	})
+
+	t.Run("Token Invalid Skips Session Check", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockVerifier := mocks.NewMockVerifier(ctrl)
+		mockRevoked := mocks.NewMockRevocationList(ctrl)
+
+		mockVerifier.EXPECT().Verify(gomock.Any(), "tok").Return(paseto.JSONToken{}, tokverify.ErrTokenInvalid)
+
+		verifier := tokverify.WithSessionCheck(mockVerifier, mockRevoked)
+
+		_, err := verifier.Verify(context.Background(), "tok")
+		assert.ErrorIs(t, err, tokverify.ErrTokenInvalid)
+	})
+
+	t.Run("Revocation List Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockVerifier := mocks.NewMockVerifier(ctrl)
+		mockRevoked := mocks.NewMockRevocationList(ctrl)
+
+		errRedis := errors.New("redis: connection pool timeout")
+		mockVerifier.EXPECT().Verify(gomock.Any(), "tok").Return(sessionToken("s1"), nil)
+		mockRevoked.EXPECT().IsRevoked(gomock.Any(), "s1").Return(false, errRedis)
+
+		verifier := tokverify.WithSessionCheck(mockVerifier, mockRevoked)
+
+		_, err := verifier.Verify(context.Background(), "tok")
+		assert.ErrorIs(t, err, errRedis)
+		assert.NotErrorIs(t, err, tokverify.ErrSessionRevoked)
+	})
+}
@@ -0,0 +1,191 @@
+package unit_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/o1egl/paseto"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/pbgen"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/tokverify"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/test/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+type footer struct {
+	KeyID string `json:"kid"`
+}
+
+func signToken(t *testing.T, kid string, priv ed25519.PrivateKey, exp time.Time) string {
+	tok, err := paseto.NewV2().Sign(priv, paseto.JSONToken{
+		Subject:    "user1",
+		Issuer:     "auth",
+		Expiration: exp,
+	}, footer{KeyID: kid})
+	assert.NoError(t, err)
+
+	return tok
+}
+
+func keysResponse(keys map[string]ed25519.PublicKey) *pbgen.GetVerificationKeysResponse {
+	res := &pbgen.GetVerificationKeysResponse{}
+	for kid, pub := range keys {
+		res.Keys = append(res.Keys, &pbgen.VerificationKey{
+			Kid:       kid,
+			Version:   "v2.public",
+			PublicKey: pub,
+		})
+	}
+
+	return res
+}
+
+func TestVerify(t *testing.T) {
+	pub1, priv1, _ := ed25519.GenerateKey(nil)
+	pub2, priv2, _ := ed25519.GenerateKey(nil)
+
+	t.Run("Verify Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockAuthSvc := mocks.NewMockGrpcAuthService(ctrl)
+		mockAuthSvc.EXPECT().
+			AuthVerificationKeys(gomock.Any(), gomock.Any()).
+			Return(keysResponse(map[string]ed25519.PublicKey{"k1": pub1}), nil)
+
+		verifier := tokverify.NewVerifier(mockAuthSvc)
+		assert.NoError(t, verifier.Refresh(context.Background()))
+
+		tok, err := verifier.Verify(context.Background(), signToken(t, "k1", priv1, time.Now().Add(time.Hour)))
+		assert.NoError(t, err)
+		assert.Equal(t, "user1", tok.Subject)
+	})
+
+	t.Run("Verify Accepts Keys During Rotation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockAuthSvc := mocks.NewMockGrpcAuthService(ctrl)
+		mockAuthSvc.EXPECT().
+			AuthVerificationKeys(gomock.Any(), gomock.Any()).
+			Return(keysResponse(map[string]ed25519.PublicKey{"k1": pub1, "k2": pub2}), nil)
+
+		verifier := tokverify.NewVerifier(mockAuthSvc)
+		assert.NoError(t, verifier.Refresh(context.Background()))
+
+		_, err := verifier.Verify(context.Background(), signToken(t, "k1", priv1, time.Now().Add(time.Hour)))
+		assert.NoError(t, err)
+
+		_, err = verifier.Verify(context.Background(), signToken(t, "k2", priv2, time.Now().Add(time.Hour)))
+		assert.NoError(t, err)
+	})
+
+	t.Run("Verify Refresh On Unknown Key ID", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockAuthSvc := mocks.NewMockGrpcAuthService(ctrl)
+		mockAuthSvc.EXPECT().
+			AuthVerificationKeys(gomock.Any(), gomock.Any()).
+			Return(keysResponse(map[string]ed25519.PublicKey{"k2": pub2}), nil)
+
+		verifier := tokverify.NewVerifier(mockAuthSvc)
+
+		_, err := verifier.Verify(context.Background(), signToken(t, "k2", priv2, time.Now().Add(time.Hour)))
+		assert.NoError(t, err)
+
+		_, err = verifier.Verify(context.Background(), signToken(t, "k9", priv2, time.Now().Add(time.Hour)))
+		assert.ErrorIs(t, err, tokverify.ErrUnknownKeyID)
+	})
+
+	t.Run("Verify Error Refresh", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockAuthSvc := mocks.NewMockGrpcAuthService(ctrl)
+		mockAuthSvc.EXPECT().
+			AuthVerificationKeys(gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("grpc fail"))
+
+		verifier := tokverify.NewVerifier(mockAuthSvc)
+
+		_, err := verifier.Verify(context.Background(), signToken(t, "k1", priv1, time.Now().Add(time.Hour)))
+		assert.ErrorIs(t, err, tokverify.ErrUnknownKeyID)
+
+		// the failed refresh counts against the refresh gap
+		_, err = verifier.Verify(context.Background(), signToken(t, "k1", priv1, time.Now().Add(time.Hour)))
+		assert.ErrorIs(t, err, tokverify.ErrUnknownKeyID)
+	})
+
+	t.Run("Verify Unknown Key IDs Share One Refresh", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		release := make(chan struct{})
+		mockAuthSvc := mocks.NewMockGrpcAuthService(ctrl)
+		mockAuthSvc.EXPECT().
+			AuthVerificationKeys(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(context.Context, *pbgen.GetVerificationKeysRequest) (*pbgen.GetVerificationKeysResponse, error) {
+				<-release
+				return keysResponse(map[string]ed25519.PublicKey{"k2": pub2}), nil
+			})
+
+		verifier := tokverify.NewVerifier(mockAuthSvc)
+
+		var wg sync.WaitGroup
+		errs := make(chan error, 10)
+		for range 10 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := verifier.Verify(context.Background(), signToken(t, "k2", priv2, time.Now().Add(time.Hour)))
+				errs <- err
+			}()
+		}
+
+		time.Sleep(20 * time.Millisecond)
+		close(release)
+		wg.Wait()
+		close(errs)
+
+		for err := range errs {
+			assert.NoError(t, err)
+		}
+	})
+
+	t.Run("Verify Error Wrong Signature", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockAuthSvc := mocks.NewMockGrpcAuthService(ctrl)
+		mockAuthSvc.EXPECT().
+			AuthVerificationKeys(gomock.Any(), gomock.Any()).
+			Return(keysResponse(map[string]ed25519.PublicKey{"k1": pub1}), nil)
+
+		verifier := tokverify.NewVerifier(mockAuthSvc)
+		assert.NoError(t, verifier.Refresh(context.Background()))
+
+		_, err := verifier.Verify(context.Background(), signToken(t, "k1", priv2, time.Now().Add(time.Hour)))
+		assert.ErrorIs(t, err, tokverify.ErrTokenInvalid)
+	})
+
+	t.Run("Verify Error Token Expired", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockAuthSvc := mocks.NewMockGrpcAuthService(ctrl)
+		mockAuthSvc.EXPECT().
+			AuthVerificationKeys(gomock.Any(), gomock.Any()).
+			Return(keysResponse(map[string]ed25519.PublicKey{"k1": pub1}), nil)
+
+		verifier := tokverify.NewVerifier(mockAuthSvc)
+		assert.NoError(t, verifier.Refresh(context.Background()))
+
+		_, err := verifier.Verify(context.Background(), signToken(t, "k1", priv1, time.Now().Add(-time.Hour)))
+		assert.ErrorIs(t, err, tokverify.ErrTokenExperied)
+	})
+}
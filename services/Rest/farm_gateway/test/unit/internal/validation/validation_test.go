@@ -0,0 +1,123 @@
+package unit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/pbgen"
+	"github.com/sony-nurdianto/farm/services/Rest/farm_gateway/farm_gateway/internal/validation"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const farmerID = "4f9d2c1e-8f5b-4c1a-9e1d-3b2a1c0d9e8f"
+
+func validCreateFarm() *pbgen.CreateFarmRequest {
+	return &pbgen.CreateFarmRequest{
+		Farm: &pbgen.CreateFarm{
+			FarmerId:   farmerID,
+			FarmName:   "North Field",
+			FarmType:   "CROPLAND",
+			FarmSize:   12.5,
+			FarmStatus: "ACTIVE",
+		},
+		Address: &pbgen.CreateFarmAddress{
+			Street:   "Jl. Merdeka 1",
+			City:     "Bandung",
+			Province: "Jawa Barat",
+		},
+	}
+}
+
+func fieldViolations(t *testing.T, err error) map[string]string {
+	t.Helper()
+
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Len(t, st.Details(), 1)
+
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	assert.True(t, ok)
+
+	fields := make(map[string]string)
+	for _, v := range badRequest.GetFieldViolations() {
+		fields[v.GetField()] = v.GetDescription()
+	}
+
+	return fields
+}
+
+func TestItemsValid(t *testing.T) {
+	assert.NoError(t, validation.Items("data", validCreateFarm(), validCreateFarm()))
+}
+
+func TestItemsReportsViolationsPerItem(t *testing.T) {
+	invalid := validCreateFarm()
+	invalid.Farm.FarmName = ""
+	invalid.Farm.FarmType = "FOREST"
+	invalid.Farm.FarmSize = -3
+
+	err := validation.Items("data", validCreateFarm(), invalid)
+	assert.Error(t, err)
+
+	fields := fieldViolations(t, err)
+	assert.Len(t, fields, 3)
+	assert.Contains(t, fields, "data[1].farm.farm_name")
+	assert.Contains(t, fields, "data[1].farm.farm_type")
+	assert.Contains(t, fields, "data[1].farm.farm_size")
+}
+
+func TestItemsMessageRule(t *testing.T) {
+	err := validation.Items("data", &pbgen.UpdateFarmsRequest{})
+	assert.Error(t, err)
+
+	fields := fieldViolations(t, err)
+	assert.Equal(t, "farm or address must be set", fields["data[0]"])
+}
+
+func TestMessage(t *testing.T) {
+	err := validation.Message(&pbgen.RegisterUserRequest{
+		FullName:    "Sony",
+		Email:       "not-an-email",
+		PhoneNumber: "+62851588206",
+		Password:    "Some@P4assword",
+	})
+	assert.Error(t, err)
+
+	fields := fieldViolations(t, err)
+	assert.Len(t, fields, 1)
+	assert.Contains(t, fields, "email")
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	var invoked bool
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		invoked = true
+		return nil
+	}
+
+	err := validation.UnaryClientInterceptor(
+		context.Background(),
+		pbgen.FarmerService_ListLogins_FullMethodName,
+		&pbgen.ListLoginsRequest{Id: farmerID, Limit: -1},
+		&pbgen.ListLoginsResponse{},
+		nil,
+		invoker,
+	)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.False(t, invoked)
+
+	err = validation.UnaryClientInterceptor(
+		context.Background(),
+		pbgen.FarmerService_ListLogins_FullMethodName,
+		&pbgen.ListLoginsRequest{Id: farmerID, Limit: 10},
+		&pbgen.ListLoginsResponse{},
+		nil,
+		invoker,
+	)
+	assert.NoError(t, err)
+	assert.True(t, invoked)
+}
@@ -19,6 +19,11 @@ type PostgresDatabase interface {
 	BeginTx(ctx context.Context, opts *sql.TxOptions) (SQLTx, error)
 
 	Prepare(query string) (Stmt, error)
+	// QueryContext and QueryRowContext run a query that is built per call and
+	// so is not worth preparing.
+	QueryContext(ctx context.Context, query string, args ...any) (Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) Row
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
 	Close() error
 }
 
@@ -55,6 +60,23 @@ func (pdb postgresDatabase) Prepare(query string) (Stmt, error) {
 	return NewStmt(stmt), nil
 }
 
+func (pdb postgresDatabase) QueryContext(ctx context.Context, query string, args ...any) (Rows, error) {
+	return pdb.db.QueryContext(ctx, query, args...)
+}
+
+func (pdb postgresDatabase) QueryRowContext(ctx context.Context, query string, args ...any) Row {
+	return pdb.db.QueryRowContext(ctx, query, args...)
+}
+
+func (pdb postgresDatabase) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	return pdb.db.ExecContext(ctx, query, args...)
+}
+
+// Stats answers the statistics of the connection pool, see Instrument.
+func (pdb postgresDatabase) Stats() sql.DBStats {
+	return pdb.db.Stats()
+}
+
 func (pdb postgresDatabase) Begin() (SQLTx, error) {
 	tx, err := pdb.db.Begin()
 	if err != nil {
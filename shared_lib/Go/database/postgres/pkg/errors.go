@@ -0,0 +1,32 @@
+package pkg
+
+import "errors"
+
+// SQLSTATE codes of the failures a caller can recover from.
+const (
+	CodeUniqueViolation      = "23505"
+	CodeSerializationFailure = "40001"
+)
+
+// SQLState answers the SQLSTATE code of a failed statement, it is empty for
+// an error that does not come from the database.
+func SQLState(err error) string {
+	var dbErr interface{ SQLState() string }
+	if !errors.As(err, &dbErr) {
+		return ""
+	}
+
+	return dbErr.SQLState()
+}
+
+// IsConflict reports whether a concurrent transaction wrote the same row
+// first, as a unique violation or, under serializable isolation, as a
+// serialization failure.
+func IsConflict(err error) bool {
+	switch SQLState(err) {
+	case CodeUniqueViolation, CodeSerializationFailure:
+		return true
+	default:
+		return false
+	}
+}
@@ -7,9 +7,14 @@ import (
 	_ "github.com/lib/pq"
 )
 
-func OpenPostgres(uri string, instance PostgresInstance) (
+func OpenPostgres(uri string, instance PostgresInstance, opts ...Option) (
 	pg PostgresDatabase, _ error,
 ) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	db, err := instance.Open("postgres", uri)
 	if err != nil {
 		return pg, err
@@ -26,5 +31,14 @@ func OpenPostgres(uri string, instance PostgresInstance) (
 		return pg, err
 	}
 
+	if o.telemetry {
+		traced, err := Instrument(db, o.name)
+		if err != nil {
+			db.Close()
+			return pg, err
+		}
+		return traced, nil
+	}
+
 	return db, nil
 }
@@ -3,6 +3,7 @@ package pkg
 //go:generate mockgen -package=mocks -destination=../test/mocks/mock_pgrows.go -source=rows_def.go
 type Rows interface {
 	Close() error
+	Err() error
 	Next() bool
 	Scan(dest ...any) error
 }
@@ -9,6 +9,7 @@ import (
 type Stmt interface {
 	QueryRowContext(ctx context.Context, args ...any) Row
 	QueryContext(ctx context.Context, args ...any) (Rows, error)
+	ExecContext(ctx context.Context, args ...any) (sql.Result, error)
 	Close() error
 	ToSQLSTMT() *sql.Stmt
 }
@@ -29,6 +30,10 @@ func (s stmt) QueryContext(ctx context.Context, args ...any) (Rows, error) {
 	return s.statement.QueryContext(ctx, args...)
 }
 
+func (s stmt) ExecContext(ctx context.Context, args ...any) (sql.Result, error) {
+	return s.statement.ExecContext(ctx, args...)
+}
+
 func (s stmt) ToSQLSTMT() *sql.Stmt {
 	return s.statement
 }
@@ -0,0 +1,355 @@
+package pkg
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName scopes the spans and the metrics of the package, they
+// go to the providers that observability.Init sets globally.
+const instrumentationName = "github.com/sony-nurdianto/farm/shared_lib/Go/database/postgres"
+
+type options struct {
+	telemetry bool
+	name      string
+}
+
+type Option func(*options)
+
+// WithTelemetry makes OpenPostgres answer a database that traces every
+// statement and records the metrics of its connection pool, name is the
+// db.name of both, e.g. farm.
+func WithTelemetry(name string) Option {
+	return func(o *options) {
+		o.telemetry = true
+		o.name = name
+	}
+}
+
+// poolStats is implemented by the database NewPostgresDb answers.
+type poolStats interface {
+	Stats() sql.DBStats
+}
+
+type telemetry struct {
+	tracer trace.Tracer
+	name   string
+	attrs  []attribute.KeyValue
+}
+
+// Instrument decorates db with a span per QueryContext, QueryRowContext and
+// ExecContext, of the statements it prepares too, and with the metrics of
+// its connection pool when db exposes them. The span of a QueryContext ends
+// when its rows are closed.
+func Instrument(db PostgresDatabase, name string) (PostgresDatabase, error) {
+	tel := telemetry{
+		tracer: otel.Tracer(instrumentationName),
+		name:   name,
+		attrs: []attribute.KeyValue{
+			attribute.String("db.system", "postgresql"),
+			attribute.String("db.name", name),
+		},
+	}
+
+	tdb := tracedDatabase{PostgresDatabase: db, tel: tel}
+
+	if ps, ok := db.(poolStats); ok {
+		reg, err := registerPoolMetrics(otel.Meter(instrumentationName), tel.attrs, ps.Stats)
+		if err != nil {
+			return nil, err
+		}
+		tdb.poolMetrics = reg
+	}
+
+	return tdb, nil
+}
+
+// operation answers the SQL command of query, the first word of it.
+func operation(query string) string {
+	fields := strings.Fields(strings.TrimLeft(query, " \t\n("))
+	if len(fields) == 0 {
+		return ""
+	}
+
+	return strings.ToUpper(fields[0])
+}
+
+// start starts the span of a statement, named like SELECT farm.
+func (tel telemetry) start(ctx context.Context, query string) (context.Context, trace.Span) {
+	op := operation(query)
+
+	attrs := append([]attribute.KeyValue{
+		attribute.String("db.operation", op),
+		attribute.String("db.statement", strings.Join(strings.Fields(query), " ")),
+	}, tel.attrs...)
+
+	name := op
+	if tel.name != "" {
+		name = op + " " + tel.name
+	}
+
+	return tel.tracer.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+}
+
+// end records err and ends span. A row that does not exist is an answer of
+// the database, not a failure of it.
+func end(span trace.Span, err error) {
+	switch {
+	case err == nil:
+		span.SetStatus(codes.Ok, "")
+	case errors.Is(err, sql.ErrNoRows):
+		span.SetAttributes(attribute.Bool("db.no_rows", true))
+	default:
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+func endExec(span trace.Span, res sql.Result, err error) {
+	if err == nil {
+		if n, rerr := res.RowsAffected(); rerr == nil {
+			span.SetAttributes(attribute.Int64("db.rows_affected", n))
+		}
+	}
+
+	end(span, err)
+}
+
+// tracedRows ends the span of its query on Close, the span covers the
+// iteration of the rows and records the error that stopped it.
+type tracedRows struct {
+	Rows
+	span    trace.Span
+	fetched time.Time
+	count   int64
+	once    sync.Once
+}
+
+func newTracedRows(rows Rows, span trace.Span) *tracedRows {
+	return &tracedRows{Rows: rows, span: span, fetched: time.Now()}
+}
+
+func (tr *tracedRows) Next() bool {
+	if !tr.Rows.Next() {
+		return false
+	}
+
+	tr.count++
+	return true
+}
+
+func (tr *tracedRows) Close() error {
+	iterErr := tr.Rows.Err()
+	err := tr.Rows.Close()
+
+	tr.once.Do(func() {
+		tr.span.SetAttributes(
+			attribute.Int64("db.rows_returned", tr.count),
+			attribute.Float64("db.rows_iteration_seconds", time.Since(tr.fetched).Seconds()),
+		)
+		end(tr.span, errors.Join(iterErr, err))
+	})
+
+	return err
+}
+
+type tracedDatabase struct {
+	PostgresDatabase
+	tel         telemetry
+	poolMetrics metric.Registration
+}
+
+func (tdb tracedDatabase) Prepare(query string) (Stmt, error) {
+	st, err := tdb.PostgresDatabase.Prepare(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return tracedStmt{Stmt: st, query: query, tel: tdb.tel}, nil
+}
+
+func (tdb tracedDatabase) QueryContext(ctx context.Context, query string, args ...any) (Rows, error) {
+	ctx, span := tdb.tel.start(ctx, query)
+
+	rows, err := tdb.PostgresDatabase.QueryContext(ctx, query, args...)
+	if err != nil {
+		end(span, err)
+		return nil, err
+	}
+
+	return newTracedRows(rows, span), nil
+}
+
+func (tdb tracedDatabase) QueryRowContext(ctx context.Context, query string, args ...any) Row {
+	ctx, span := tdb.tel.start(ctx, query)
+
+	row := tdb.PostgresDatabase.QueryRowContext(ctx, query, args...)
+	end(span, row.Err())
+
+	return row
+}
+
+func (tdb tracedDatabase) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	ctx, span := tdb.tel.start(ctx, query)
+
+	res, err := tdb.PostgresDatabase.ExecContext(ctx, query, args...)
+	endExec(span, res, err)
+
+	return res, err
+}
+
+func (tdb tracedDatabase) Begin() (SQLTx, error) {
+	tx, err := tdb.PostgresDatabase.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	return tracedTx{SQLTx: tx, tel: tdb.tel}, nil
+}
+
+func (tdb tracedDatabase) BeginTx(ctx context.Context, opts *sql.TxOptions) (SQLTx, error) {
+	tx, err := tdb.PostgresDatabase.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return tracedTx{SQLTx: tx, tel: tdb.tel}, nil
+}
+
+func (tdb tracedDatabase) Close() error {
+	var err error
+	if tdb.poolMetrics != nil {
+		err = tdb.poolMetrics.Unregister()
+	}
+
+	return errors.Join(err, tdb.PostgresDatabase.Close())
+}
+
+type tracedTx struct {
+	SQLTx
+	tel telemetry
+}
+
+func (ttx tracedTx) Prepare(query string) (Stmt, error) {
+	st, err := ttx.SQLTx.Prepare(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return tracedStmt{Stmt: st, query: query, tel: ttx.tel}, nil
+}
+
+// Stmt keeps the query of a statement the database prepared, the span of
+// the statement in the transaction is named after it.
+func (ttx tracedTx) Stmt(st Stmt) Stmt {
+	var query string
+	if ts, ok := st.(tracedStmt); ok {
+		query = ts.query
+	}
+
+	return tracedStmt{Stmt: ttx.SQLTx.Stmt(st), query: query, tel: ttx.tel}
+}
+
+type tracedStmt struct {
+	Stmt
+	query string
+	tel   telemetry
+}
+
+func (ts tracedStmt) QueryRowContext(ctx context.Context, args ...any) Row {
+	ctx, span := ts.tel.start(ctx, ts.query)
+
+	row := ts.Stmt.QueryRowContext(ctx, args...)
+	end(span, row.Err())
+
+	return row
+}
+
+func (ts tracedStmt) QueryContext(ctx context.Context, args ...any) (Rows, error) {
+	ctx, span := ts.tel.start(ctx, ts.query)
+
+	rows, err := ts.Stmt.QueryContext(ctx, args...)
+	if err != nil {
+		end(span, err)
+		return nil, err
+	}
+
+	return newTracedRows(rows, span), nil
+}
+
+func (ts tracedStmt) ExecContext(ctx context.Context, args ...any) (sql.Result, error) {
+	ctx, span := ts.tel.start(ctx, ts.query)
+
+	res, err := ts.Stmt.ExecContext(ctx, args...)
+	endExec(span, res, err)
+
+	return res, err
+}
+
+// registerPoolMetrics observes the statistics of the connection pool on
+// every collection of meter.
+func registerPoolMetrics(
+	meter metric.Meter,
+	attrs []attribute.KeyValue,
+	stats func() sql.DBStats,
+) (metric.Registration, error) {
+	open, oerr := meter.Int64ObservableGauge(
+		"db_client_connections_open",
+		metric.WithDescription("Open connections of the pool, in use and idle"),
+	)
+	inUse, ierr := meter.Int64ObservableGauge(
+		"db_client_connections_in_use",
+		metric.WithDescription("Connections of the pool in use by a statement or a transaction"),
+	)
+	idle, derr := meter.Int64ObservableGauge(
+		"db_client_connections_idle",
+		metric.WithDescription("Idle connections of the pool"),
+	)
+	maxOpen, merr := meter.Int64ObservableGauge(
+		"db_client_connections_max",
+		metric.WithDescription("Maximum number of open connections of the pool"),
+	)
+	waitCount, cerr := meter.Int64ObservableCounter(
+		"db_client_connections_wait_count_total",
+		metric.WithDescription("Total number of connections waited for"),
+	)
+	waitDuration, werr := meter.Float64ObservableCounter(
+		"db_client_connections_wait_duration_seconds_total",
+		metric.WithDescription("Total time blocked waiting for a connection"),
+		metric.WithUnit("s"),
+	)
+	if err := errors.Join(oerr, ierr, derr, merr, cerr, werr); err != nil {
+		return nil, err
+	}
+
+	set := metric.WithAttributes(attrs...)
+
+	return meter.RegisterCallback(
+		func(_ context.Context, o metric.Observer) error {
+			s := stats()
+			o.ObserveInt64(open, int64(s.OpenConnections), set)
+			o.ObserveInt64(inUse, int64(s.InUse), set)
+			o.ObserveInt64(idle, int64(s.Idle), set)
+			o.ObserveInt64(maxOpen, int64(s.MaxOpenConnections), set)
+			o.ObserveInt64(waitCount, s.WaitCount, set)
+			o.ObserveFloat64(waitDuration, s.WaitDuration.Seconds(), set)
+			return nil
+		},
+		open, inUse, idle, maxOpen, waitCount, waitDuration,
+	)
+}
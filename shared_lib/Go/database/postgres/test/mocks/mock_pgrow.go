@@ -33,6 +33,20 @@ func (m *MockRow) EXPECT() *MockRowMockRecorder {
 	return m.recorder
 }
 
+// Err mocks base method.
+func (m *MockRow) Err() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Err")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Err indicates an expected call of Err.
+func (mr *MockRowMockRecorder) Err() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Err", reflect.TypeOf((*MockRow)(nil).Err))
+}
+
 // Scan mocks base method.
 func (m *MockRow) Scan(dest ...any) error {
 	m.ctrl.T.Helper()
@@ -6,6 +6,7 @@ package mocks
 
 import (
 	context "context"
+	sql "database/sql"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -35,6 +36,40 @@ func (m *MockStmt) EXPECT() *MockStmtMockRecorder {
 	return m.recorder
 }
 
+// Close mocks base method.
+func (m *MockStmt) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockStmtMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockStmt)(nil).Close))
+}
+
+// ExecContext mocks base method.
+func (m *MockStmt) ExecContext(ctx context.Context, args ...any) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExecContext", varargs...)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecContext indicates an expected call of ExecContext.
+func (mr *MockStmtMockRecorder) ExecContext(ctx interface{}, args ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecContext", reflect.TypeOf((*MockStmt)(nil).ExecContext), varargs...)
+}
+
 // QueryContext mocks base method.
 func (m *MockStmt) QueryContext(ctx context.Context, args ...any) (pkg.Rows, error) {
 	m.ctrl.T.Helper()
@@ -73,3 +108,17 @@ func (mr *MockStmtMockRecorder) QueryRowContext(ctx interface{}, args ...interfa
 	varargs := append([]interface{}{ctx}, args...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryRowContext", reflect.TypeOf((*MockStmt)(nil).QueryRowContext), varargs...)
 }
+
+// ToSQLSTMT mocks base method.
+func (m *MockStmt) ToSQLSTMT() *sql.Stmt {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToSQLSTMT")
+	ret0, _ := ret[0].(*sql.Stmt)
+	return ret0
+}
+
+// ToSQLSTMT indicates an expected call of ToSQLSTMT.
+func (mr *MockStmtMockRecorder) ToSQLSTMT() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToSQLSTMT", reflect.TypeOf((*MockStmt)(nil).ToSQLSTMT))
+}
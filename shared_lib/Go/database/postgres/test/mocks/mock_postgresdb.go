@@ -6,6 +6,7 @@ package mocks
 
 import (
 	context "context"
+	sql "database/sql"
 	reflect "reflect"
 	time "time"
 
@@ -36,6 +37,36 @@ func (m *MockPostgresDatabase) EXPECT() *MockPostgresDatabaseMockRecorder {
 	return m.recorder
 }
 
+// Begin mocks base method.
+func (m *MockPostgresDatabase) Begin() (pkg.SQLTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Begin")
+	ret0, _ := ret[0].(pkg.SQLTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Begin indicates an expected call of Begin.
+func (mr *MockPostgresDatabaseMockRecorder) Begin() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Begin", reflect.TypeOf((*MockPostgresDatabase)(nil).Begin))
+}
+
+// BeginTx mocks base method.
+func (m *MockPostgresDatabase) BeginTx(ctx context.Context, opts *sql.TxOptions) (pkg.SQLTx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginTx", ctx, opts)
+	ret0, _ := ret[0].(pkg.SQLTx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeginTx indicates an expected call of BeginTx.
+func (mr *MockPostgresDatabaseMockRecorder) BeginTx(ctx, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginTx", reflect.TypeOf((*MockPostgresDatabase)(nil).BeginTx), ctx, opts)
+}
+
 // Close mocks base method.
 func (m *MockPostgresDatabase) Close() error {
 	m.ctrl.T.Helper()
@@ -50,6 +81,26 @@ func (mr *MockPostgresDatabaseMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockPostgresDatabase)(nil).Close))
 }
 
+// ExecContext mocks base method.
+func (m *MockPostgresDatabase) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, query}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExecContext", varargs...)
+	ret0, _ := ret[0].(sql.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecContext indicates an expected call of ExecContext.
+func (mr *MockPostgresDatabaseMockRecorder) ExecContext(ctx, query interface{}, args ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, query}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecContext", reflect.TypeOf((*MockPostgresDatabase)(nil).ExecContext), varargs...)
+}
+
 // PingContext mocks base method.
 func (m *MockPostgresDatabase) PingContext(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -79,6 +130,45 @@ func (mr *MockPostgresDatabaseMockRecorder) Prepare(query interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Prepare", reflect.TypeOf((*MockPostgresDatabase)(nil).Prepare), query)
 }
 
+// QueryContext mocks base method.
+func (m *MockPostgresDatabase) QueryContext(ctx context.Context, query string, args ...any) (pkg.Rows, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, query}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "QueryContext", varargs...)
+	ret0, _ := ret[0].(pkg.Rows)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryContext indicates an expected call of QueryContext.
+func (mr *MockPostgresDatabaseMockRecorder) QueryContext(ctx, query interface{}, args ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, query}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryContext", reflect.TypeOf((*MockPostgresDatabase)(nil).QueryContext), varargs...)
+}
+
+// QueryRowContext mocks base method.
+func (m *MockPostgresDatabase) QueryRowContext(ctx context.Context, query string, args ...any) pkg.Row {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, query}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "QueryRowContext", varargs...)
+	ret0, _ := ret[0].(pkg.Row)
+	return ret0
+}
+
+// QueryRowContext indicates an expected call of QueryRowContext.
+func (mr *MockPostgresDatabaseMockRecorder) QueryRowContext(ctx, query interface{}, args ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, query}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryRowContext", reflect.TypeOf((*MockPostgresDatabase)(nil).QueryRowContext), varargs...)
+}
+
 // SetConnMaxLifetime mocks base method.
 func (m *MockPostgresDatabase) SetConnMaxLifetime(d time.Duration) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,38 @@
+package unit_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/database/postgres/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSQLState(t *testing.T) {
+	err := fmt.Errorf("insert farm: %w", &pq.Error{Code: pkg.CodeUniqueViolation})
+	assert.Equal(t, pkg.CodeUniqueViolation, pkg.SQLState(err))
+
+	assert.Empty(t, pkg.SQLState(errors.New("connection reset")))
+	assert.Empty(t, pkg.SQLState(nil))
+}
+
+func TestIsConflict(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "Unique Violation", err: &pq.Error{Code: pkg.CodeUniqueViolation}, want: true},
+		{name: "Serialization Failure", err: &pq.Error{Code: pkg.CodeSerializationFailure}, want: true},
+		{name: "Foreign Key Violation", err: &pq.Error{Code: "23503"}, want: false},
+		{name: "Not From The Database", err: errors.New("connection reset"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, pkg.IsConflict(tt.err))
+		})
+	}
+}
@@ -0,0 +1,312 @@
+package unit_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/database/postgres/pkg"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/database/postgres/test/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+const selectFarm = `
+	SELECT id, farm_name
+	FROM farms
+	WHERE id = $1`
+
+func setupTelemetry(t *testing.T) (*tracetest.SpanRecorder, *sdkmetric.ManualReader) {
+	prevTP, prevMP := otel.GetTracerProvider(), otel.GetMeterProvider()
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevTP)
+		otel.SetMeterProvider(prevMP)
+	})
+
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	reader := sdkmetric.NewManualReader()
+	otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+
+	return recorder, reader
+}
+
+func attrs(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	out := make(map[attribute.Key]attribute.Value)
+	for _, kv := range span.Attributes() {
+		out[kv.Key] = kv.Value
+	}
+	return out
+}
+
+func lastSpan(t *testing.T, recorder *tracetest.SpanRecorder) sdktrace.ReadOnlySpan {
+	ended := recorder.Ended()
+	require.NotEmpty(t, ended)
+	return ended[len(ended)-1]
+}
+
+// poolDB exposes the stats of a pool like the database of NewPostgresDb.
+type poolDB struct {
+	*mocks.MockPostgresDatabase
+	stats sql.DBStats
+}
+
+func (p poolDB) Stats() sql.DBStats {
+	return p.stats
+}
+
+// txStmt answers the same statement for every statement of the database.
+type txStmt struct {
+	pkg.SQLTx
+	stmt pkg.Stmt
+}
+
+func (tx txStmt) Stmt(pkg.Stmt) pkg.Stmt {
+	return tx.stmt
+}
+
+func TestInstrument_Stmt(t *testing.T) {
+	recorder, _ := setupTelemetry(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockPostgresDatabase(ctrl)
+	mockStmt := mocks.NewMockStmt(ctrl)
+	mockRow := mocks.NewMockRow(ctrl)
+
+	db, err := pkg.Instrument(mockDB, "farm")
+	require.NoError(t, err)
+
+	mockDB.EXPECT().Prepare(selectFarm).Return(mockStmt, nil)
+	stmt, err := db.Prepare(selectFarm)
+	require.NoError(t, err)
+
+	t.Run("QueryRowContext", func(t *testing.T) {
+		mockStmt.EXPECT().QueryRowContext(gomock.Any(), "f-1").Return(mockRow)
+		mockRow.EXPECT().Err().Return(nil)
+
+		stmt.QueryRowContext(context.Background(), "f-1")
+
+		span := lastSpan(t, recorder)
+		assert.Equal(t, "SELECT farm", span.Name())
+		assert.Equal(t, codes.Ok, span.Status().Code)
+
+		got := attrs(span)
+		assert.Equal(t, "postgresql", got["db.system"].AsString())
+		assert.Equal(t, "farm", got["db.name"].AsString())
+		assert.Equal(t, "SELECT", got["db.operation"].AsString())
+		assert.Equal(t, "SELECT id, farm_name FROM farms WHERE id = $1", got["db.statement"].AsString())
+	})
+
+	t.Run("QueryRowContext No Rows", func(t *testing.T) {
+		mockStmt.EXPECT().QueryRowContext(gomock.Any(), "f-2").Return(mockRow)
+		mockRow.EXPECT().Err().Return(sql.ErrNoRows)
+
+		stmt.QueryRowContext(context.Background(), "f-2")
+
+		span := lastSpan(t, recorder)
+		assert.Equal(t, codes.Unset, span.Status().Code)
+		assert.True(t, attrs(span)["db.no_rows"].AsBool())
+	})
+
+	t.Run("QueryContext Error", func(t *testing.T) {
+		mockStmt.EXPECT().QueryContext(gomock.Any(), "f-3").Return(nil, errors.New("connection reset"))
+
+		_, err := stmt.QueryContext(context.Background(), "f-3")
+		assert.EqualError(t, err, "connection reset")
+
+		span := lastSpan(t, recorder)
+		assert.Equal(t, codes.Error, span.Status().Code)
+		assert.Equal(t, "connection reset", span.Status().Description)
+		require.Len(t, span.Events(), 1)
+		assert.Equal(t, "exception", span.Events()[0].Name)
+	})
+
+	t.Run("QueryContext Ends On Close", func(t *testing.T) {
+		mockRows := mocks.NewMockRows(ctrl)
+		mockStmt.EXPECT().QueryContext(gomock.Any(), "f-5").Return(mockRows, nil)
+		gomock.InOrder(
+			mockRows.EXPECT().Next().Return(true),
+			mockRows.EXPECT().Next().Return(true),
+			mockRows.EXPECT().Next().Return(false),
+		)
+		mockRows.EXPECT().Err().Return(nil)
+		mockRows.EXPECT().Close().Return(nil)
+
+		ended := len(recorder.Ended())
+
+		rows, err := stmt.QueryContext(context.Background(), "f-5")
+		require.NoError(t, err)
+
+		for rows.Next() {
+		}
+		assert.Len(t, recorder.Ended(), ended, "span ended before the rows were closed")
+
+		require.NoError(t, rows.Close())
+		require.Len(t, recorder.Ended(), ended+1)
+
+		span := lastSpan(t, recorder)
+		assert.Equal(t, codes.Ok, span.Status().Code)
+		assert.Equal(t, int64(2), attrs(span)["db.rows_returned"].AsInt64())
+		assert.Contains(t, attrs(span), attribute.Key("db.rows_iteration_seconds"))
+	})
+
+	t.Run("QueryContext Iteration Error", func(t *testing.T) {
+		mockRows := mocks.NewMockRows(ctrl)
+		mockStmt.EXPECT().QueryContext(gomock.Any(), "f-6").Return(mockRows, nil)
+		mockRows.EXPECT().Next().Return(false)
+		mockRows.EXPECT().Err().Return(errors.New("conn closed")).Times(2)
+		mockRows.EXPECT().Close().Return(nil).Times(2)
+
+		rows, err := stmt.QueryContext(context.Background(), "f-6")
+		require.NoError(t, err)
+
+		assert.False(t, rows.Next())
+		require.NoError(t, rows.Close())
+
+		ended := len(recorder.Ended())
+		require.NoError(t, rows.Close())
+		assert.Len(t, recorder.Ended(), ended, "span ended twice")
+
+		span := lastSpan(t, recorder)
+		assert.Equal(t, codes.Error, span.Status().Code)
+		assert.Equal(t, "conn closed", span.Status().Description)
+	})
+
+	t.Run("ExecContext", func(t *testing.T) {
+		mockStmt.EXPECT().ExecContext(gomock.Any(), "f-4").Return(driver.RowsAffected(3), nil)
+
+		_, err := stmt.ExecContext(context.Background(), "f-4")
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(3), attrs(lastSpan(t, recorder))["db.rows_affected"].AsInt64())
+	})
+}
+
+func TestInstrument_Tx(t *testing.T) {
+	recorder, _ := setupTelemetry(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockPostgresDatabase(ctrl)
+	mockStmt := mocks.NewMockStmt(ctrl)
+	mockTxStmt := mocks.NewMockStmt(ctrl)
+
+	db, err := pkg.Instrument(mockDB, "farm")
+	require.NoError(t, err)
+
+	mockDB.EXPECT().Prepare(gomock.Any()).Return(mockStmt, nil)
+	stmt, err := db.Prepare("UPDATE farms SET farm_name = $1 WHERE id = $2")
+	require.NoError(t, err)
+
+	mockDB.EXPECT().BeginTx(gomock.Any(), nil).Return(txStmt{stmt: mockTxStmt}, nil)
+	tx, err := db.BeginTx(context.Background(), nil)
+	require.NoError(t, err)
+
+	mockTxStmt.EXPECT().ExecContext(gomock.Any(), "name", "f-1").Return(driver.RowsAffected(1), nil)
+
+	_, err = tx.Stmt(stmt).ExecContext(context.Background(), "name", "f-1")
+	require.NoError(t, err)
+
+	span := lastSpan(t, recorder)
+	assert.Equal(t, "UPDATE farm", span.Name())
+	assert.Equal(t, int64(1), attrs(span)["db.rows_affected"].AsInt64())
+}
+
+func TestInstrument_PoolMetrics(t *testing.T) {
+	_, reader := setupTelemetry(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockPostgresDatabase(ctrl)
+	db, err := pkg.Instrument(poolDB{
+		MockPostgresDatabase: mockDB,
+		stats: sql.DBStats{
+			MaxOpenConnections: 30,
+			OpenConnections:    7,
+			InUse:              5,
+			Idle:               2,
+			WaitCount:          4,
+			WaitDuration:       1500 * time.Millisecond,
+		},
+	}, "farm")
+	require.NoError(t, err)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+
+	got := make(map[string]float64)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			switch data := m.Data.(type) {
+			case metricdata.Gauge[int64]:
+				got[m.Name] = float64(data.DataPoints[0].Value)
+			case metricdata.Sum[int64]:
+				got[m.Name] = float64(data.DataPoints[0].Value)
+			case metricdata.Sum[float64]:
+				got[m.Name] = data.DataPoints[0].Value
+			}
+		}
+	}
+
+	assert.Equal(t, map[string]float64{
+		"db_client_connections_open":                        7,
+		"db_client_connections_in_use":                      5,
+		"db_client_connections_idle":                        2,
+		"db_client_connections_max":                         30,
+		"db_client_connections_wait_count_total":            4,
+		"db_client_connections_wait_duration_seconds_total": 1.5,
+	}, got)
+
+	// closing the database stops observing its pool
+	mockDB.EXPECT().Close().Return(nil)
+	require.NoError(t, db.Close())
+
+	rm = metricdata.ResourceMetrics{}
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	for _, sm := range rm.ScopeMetrics {
+		assert.Empty(t, sm.Metrics)
+	}
+}
+
+func TestPostgres_OpenPostgres_WithTelemetry(t *testing.T) {
+	recorder, _ := setupTelemetry(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPgi := mocks.NewMockPostgresInstance(ctrl)
+	mockPgDB := mocks.NewMockPostgresDatabase(ctrl)
+
+	mockPgi.EXPECT().Open(gomock.Any(), gomock.Any()).Return(mockPgDB, nil)
+	mockPgDB.EXPECT().SetMaxOpenConns(gomock.Any())
+	mockPgDB.EXPECT().SetMaxIdleConns(gomock.Any())
+	mockPgDB.EXPECT().SetConnMaxLifetime(gomock.Any())
+	mockPgDB.EXPECT().PingContext(gomock.Any()).Return(nil)
+
+	db, err := pkg.OpenPostgres("someaddress", mockPgi, pkg.WithTelemetry("farmer"))
+	require.NoError(t, err)
+
+	mockPgDB.EXPECT().ExecContext(gomock.Any(), "DELETE FROM users WHERE id = $1", "u-1").
+		Return(driver.RowsAffected(1), nil)
+
+	_, err = db.ExecContext(context.Background(), "DELETE FROM users WHERE id = $1", "u-1")
+	require.NoError(t, err)
+
+	assert.Equal(t, "DELETE farmer", lastSpan(t, recorder).Name())
+}
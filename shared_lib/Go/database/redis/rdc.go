@@ -9,10 +9,14 @@ type RedisClient interface {
 	HSet(ctx context.Context, key string, values ...any) *IntCmd
 	HGet(ctx context.Context, key string, field string) *StringCmd
 	HGetAll(ctx context.Context, key string) *MapStringStringCmd
+	Get(ctx context.Context, key string) *StringCmd
+	SetNX(ctx context.Context, key string, value any, expiration time.Duration) *BoolCmd
+	SetXX(ctx context.Context, key string, value any, expiration time.Duration) *BoolCmd
 	Expire(ctx context.Context, key string, expiration time.Duration) *BoolCmd
 	TxPipeline() Pipeliner
 	Del(ctx context.Context, keys ...string) *IntCmd
 	Ping(ctx context.Context) StatusCmd
+	Do(ctx context.Context, args ...any) *RedisCmd
 	Close() error
 }
 
@@ -42,6 +46,18 @@ func (c *rdc) HGetAll(ctx context.Context, key string) *MapStringStringCmd {
 	return c.client.HGetAll(ctx, key)
 }
 
+func (c *rdc) Get(ctx context.Context, key string) *StringCmd {
+	return c.client.Get(ctx, key)
+}
+
+func (c *rdc) SetNX(ctx context.Context, key string, value any, expiration time.Duration) *BoolCmd {
+	return c.client.SetNX(ctx, key, value, expiration)
+}
+
+func (c *rdc) SetXX(ctx context.Context, key string, value any, expiration time.Duration) *BoolCmd {
+	return c.client.SetXX(ctx, key, value, expiration)
+}
+
 func (c *rdc) Del(ctx context.Context, keys ...string) *IntCmd {
 	return c.client.Del(ctx, keys...)
 }
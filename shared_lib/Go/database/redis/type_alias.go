@@ -13,4 +13,5 @@ type (
 	BoolCmd            = redis.BoolCmd
 	Pipeliner          = redis.Pipeliner
 	RedisCmd           = redis.Cmd
+	Z                  = redis.Z
 )
@@ -0,0 +1,27 @@
+package grpcstatus
+
+import (
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// Domain is the ErrorInfo domain of the errors a service raises, e.g. farm.
+type Domain string
+
+// WithReason attaches an errdetails.ErrorInfo of the domain to the status,
+// callers branch on its reason instead of parsing msg.
+func (d Domain) WithReason(code codes.Code, reason, msg string) error {
+	return WithDetails(code, msg, &errdetails.ErrorInfo{Reason: reason, Domain: string(d)})
+}
+
+// WithDetails keeps the plain status when the details fail to marshal.
+func WithDetails(code codes.Code, msg string, details ...protoadapt.MessageV1) error {
+	st, err := status.New(code, msg).WithDetails(details...)
+	if err != nil {
+		return status.Error(code, msg)
+	}
+
+	return st.Err()
+}
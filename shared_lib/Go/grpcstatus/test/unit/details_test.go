@@ -0,0 +1,25 @@
+package unit_test
+
+import (
+	"testing"
+
+	"github.com/sony-nurdianto/farm/shared_lib/Go/grpcstatus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDomain_WithReason(t *testing.T) {
+	st := status.Convert(grpcstatus.Domain("farm").WithReason(codes.NotFound, "FARM_NOT_FOUND", "farm is not exist"))
+
+	assert.Equal(t, codes.NotFound, st.Code())
+	assert.Equal(t, "farm is not exist", st.Message())
+	require.Len(t, st.Details(), 1)
+
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	assert.Equal(t, "FARM_NOT_FOUND", info.GetReason())
+	assert.Equal(t, "farm", info.GetDomain())
+}
@@ -0,0 +1,82 @@
+package unit_test
+
+import (
+	"errors"
+	"testing"
+
+	"buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	"buf.build/go/protovalidate"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/grpcstatus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func violation(message string, fields ...string) *protovalidate.Violation {
+	path := &validate.FieldPath{}
+	for _, field := range fields {
+		path.Elements = append(path.Elements, &validate.FieldPathElement{FieldName: proto.String(field)})
+	}
+
+	return &protovalidate.Violation{
+		Proto: &validate.Violation{Field: path, Message: proto.String(message)},
+	}
+}
+
+func validationError() error {
+	return &protovalidate.ValidationError{Violations: []*protovalidate.Violation{
+		violation("value must be greater than 0", "farm", "farm_size"),
+		violation("value is required", "address", "city"),
+	}}
+}
+
+func TestViolations(t *testing.T) {
+	t.Run("Prefixed Field Paths", func(t *testing.T) {
+		badRequest, ok := grpcstatus.Violations("[2]", validationError())
+		require.True(t, ok)
+
+		assert.Equal(t, []*errdetails.BadRequest_FieldViolation{
+			{Field: "[2].farm.farm_size", Description: "value must be greater than 0"},
+			{Field: "[2].address.city", Description: "value is required"},
+		}, badRequest.GetFieldViolations())
+	})
+
+	t.Run("Not A Validation Error", func(t *testing.T) {
+		_, ok := grpcstatus.Violations("", errors.New("compile rules"))
+		assert.False(t, ok)
+	})
+}
+
+func TestValidationStatus(t *testing.T) {
+	t.Run("Nil", func(t *testing.T) {
+		assert.NoError(t, grpcstatus.ValidationStatus("", nil))
+	})
+
+	t.Run("Invalid Argument", func(t *testing.T) {
+		st := status.Convert(grpcstatus.ValidationStatus("", validationError()))
+
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, "invalid request: farm.farm_size value must be greater than 0, address.city value is required", st.Message())
+		require.Len(t, st.Details(), 1)
+
+		badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+		require.True(t, ok)
+		assert.Equal(t, "farm.farm_size", badRequest.GetFieldViolations()[0].GetField())
+	})
+
+	t.Run("Rules Not Evaluated", func(t *testing.T) {
+		st := status.Convert(grpcstatus.ValidationStatus("", errors.New("compile rules")))
+
+		assert.Equal(t, codes.Internal, st.Code())
+		assert.Equal(t, "compile rules", st.Message())
+	})
+}
+
+func TestFieldPath(t *testing.T) {
+	assert.Equal(t, "farm.farm_size", grpcstatus.FieldPath("", "farm.farm_size"))
+	assert.Equal(t, "[1]", grpcstatus.FieldPath("[1]", ""))
+	assert.Equal(t, "data[1].farm", grpcstatus.FieldPath("data[1]", "farm"))
+}
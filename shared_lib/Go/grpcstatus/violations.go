@@ -0,0 +1,75 @@
+// Package grpcstatus builds the gRPC statuses the services answer with, so
+// every service reports the same details for the same failure.
+package grpcstatus
+
+import (
+	"errors"
+	"strings"
+
+	"buf.build/go/protovalidate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Violations reports the violations of a protovalidate.ValidationError as
+// errdetails.BadRequest, with the field paths prefixed by prefix, e.g.
+// "[2]" gives "[2].farm.farm_size". ok is false for any other error.
+func Violations(prefix string, err error) (_ *errdetails.BadRequest, ok bool) {
+	var valErr *protovalidate.ValidationError
+	if !errors.As(err, &valErr) {
+		return nil, false
+	}
+
+	badRequest := &errdetails.BadRequest{}
+	for _, violation := range valErr.Violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       FieldPath(prefix, protovalidate.FieldPathString(violation.Proto.GetField())),
+			Description: violation.Proto.GetMessage(),
+		})
+	}
+
+	return badRequest, true
+}
+
+// Summary joins the violations of badRequest into one line, e.g.
+// "email value must be a valid email address, password value is required".
+func Summary(badRequest *errdetails.BadRequest) string {
+	fields := make([]string, 0, len(badRequest.GetFieldViolations()))
+	for _, violation := range badRequest.GetFieldViolations() {
+		fields = append(fields, strings.TrimSpace(violation.GetField()+" "+violation.GetDescription()))
+	}
+
+	return strings.Join(fields, ", ")
+}
+
+// InvalidArgument is the InvalidArgument status of badRequest, its message
+// is the Summary of the violations.
+func InvalidArgument(badRequest *errdetails.BadRequest) error {
+	return WithDetails(codes.InvalidArgument, "invalid request: "+Summary(badRequest), badRequest)
+}
+
+// ValidationStatus turns the error of protovalidate.Validate into the status
+// of the request, InvalidArgument for broken rules and Internal when the
+// rules could not be evaluated. It is nil when err is nil.
+func ValidationStatus(prefix string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	badRequest, ok := Violations(prefix, err)
+	if !ok {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return InvalidArgument(badRequest)
+}
+
+// FieldPath joins the path of a field to the prefix of its message.
+func FieldPath(prefix, field string) string {
+	if prefix == "" || field == "" {
+		return prefix + field
+	}
+
+	return prefix + "." + field
+}
@@ -12,6 +12,7 @@ type KevProducer interface {
 	Events() chan kafka.Event
 	Produce(msg *kafka.Message, deliveryChan chan kafka.Event) error
 	Flush(timeoutMs int) int
+	GetMetadata(topic *string, allTopics bool, timeoutMs int) (*kafka.Metadata, error)
 
 	// transactions
 	InitTransactions(ctx context.Context) error
@@ -48,6 +49,10 @@ func (p producer) Flush(timeoutMs int) int {
 	return p.kprod.Flush(timeoutMs)
 }
 
+func (p producer) GetMetadata(topic *string, allTopics bool, timeoutMs int) (*kafka.Metadata, error) {
+	return p.kprod.GetMetadata(topic, allTopics, timeoutMs)
+}
+
 func (p producer) InitTransactions(ctx context.Context) error {
 	return p.kprod.InitTransactions(ctx)
 }
@@ -0,0 +1,126 @@
+package kev
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// MessageCarrier reads and writes the trace context of a record through its
+// headers, so the W3C traceparent and baggage travel with the record. It
+// works with the propagator that observability.Init sets globally.
+type MessageCarrier struct {
+	msg *kafka.Message
+}
+
+func NewMessageCarrier(msg *kafka.Message) MessageCarrier {
+	return MessageCarrier{msg: msg}
+}
+
+func (mc MessageCarrier) Get(key string) string {
+	for _, h := range mc.msg.Headers {
+		if strings.EqualFold(h.Key, key) {
+			return string(h.Value)
+		}
+	}
+
+	return ""
+}
+
+// Set replaces the header of key, a record produced again keeps a single
+// traceparent.
+func (mc MessageCarrier) Set(key string, value string) {
+	for i, h := range mc.msg.Headers {
+		if strings.EqualFold(h.Key, key) {
+			mc.msg.Headers[i].Value = []byte(value)
+			return
+		}
+	}
+
+	mc.msg.Headers = append(mc.msg.Headers, kafka.Header{Key: key, Value: []byte(value)})
+}
+
+func (mc MessageCarrier) Keys() []string {
+	keys := make([]string, 0, len(mc.msg.Headers))
+	for _, h := range mc.msg.Headers {
+		keys = append(keys, h.Key)
+	}
+
+	return keys
+}
+
+// TraceHeaders answers the headers that carry the trace context of ctx, to
+// be set on a MessageKafka before it is produced.
+func TraceHeaders(ctx context.Context) []KafkaHeader {
+	var msg kafka.Message
+	otel.GetTextMapPropagator().Inject(ctx, NewMessageCarrier(&msg))
+
+	headers := make([]KafkaHeader, 0, len(msg.Headers))
+	for _, h := range msg.Headers {
+		headers = append(headers, KafkaHeader{Key: h.Key, Value: h.Value})
+	}
+
+	return headers
+}
+
+// InjectTraceContext sets the trace context of ctx on the headers of msg.
+func InjectTraceContext(ctx context.Context, msg *kafka.Message) {
+	otel.GetTextMapPropagator().Inject(ctx, NewMessageCarrier(msg))
+}
+
+// ExtractTraceContext answers ctx with the trace context and the baggage
+// carried by msg. Records without one, like the change events Debezium
+// produces from the rows a sink connector wrote, answer ctx as it is.
+func ExtractTraceContext(ctx context.Context, msg *kafka.Message) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, NewMessageCarrier(msg))
+}
+
+func messageAttributes(msg *kafka.Message) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{
+		attribute.String("messaging.system", "kafka"),
+		attribute.String("messaging.kafka.partition", strconv.Itoa(int(msg.TopicPartition.Partition))),
+		attribute.String("messaging.kafka.offset", msg.TopicPartition.Offset.String()),
+	}
+	if msg.TopicPartition.Topic != nil {
+		attrs = append(attrs, attribute.String("messaging.destination.name", *msg.TopicPartition.Topic))
+	}
+
+	return attrs
+}
+
+// StartConsumerSpan starts the span that processes msg. The span continues
+// the trace of the producer when msg carries one and links to the span of
+// ctx, the loop that consumed it. A record without a trace context is
+// processed within the span of ctx as before.
+func StartConsumerSpan(
+	ctx context.Context,
+	tracer trace.Tracer,
+	name string,
+	msg *kafka.Message,
+	opts ...trace.SpanStartOption,
+) (context.Context, trace.Span) {
+	opts = append(opts,
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(messageAttributes(msg)...),
+	)
+
+	msgCtx := ExtractTraceContext(ctx, msg)
+	remote := trace.SpanContextFromContext(msgCtx)
+	if !remote.IsValid() || !remote.IsRemote() {
+		return tracer.Start(ctx, name, opts...)
+	}
+
+	if loop := trace.SpanContextFromContext(ctx); loop.IsValid() {
+		opts = append(opts, trace.WithLinks(trace.Link{
+			SpanContext: loop,
+			Attributes:  []attribute.KeyValue{attribute.String("link.type", "consumer_loop")},
+		}))
+	}
+
+	return tracer.Start(msgCtx, name, opts...)
+}
@@ -35,6 +35,21 @@ func (m *MockKafka) EXPECT() *MockKafkaMockRecorder {
 	return m.recorder
 }
 
+// NewConsumer mocks base method.
+func (m *MockKafka) NewConsumer(conf *kafka.ConfigMap) (kev.KevConsumer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewConsumer", conf)
+	ret0, _ := ret[0].(kev.KevConsumer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewConsumer indicates an expected call of NewConsumer.
+func (mr *MockKafkaMockRecorder) NewConsumer(conf interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewConsumer", reflect.TypeOf((*MockKafka)(nil).NewConsumer), conf)
+}
+
 // NewProducer mocks base method.
 func (m *MockKafka) NewProducer(conf *kafka.ConfigMap) (kev.KevProducer, error) {
 	m.ctrl.T.Helper()
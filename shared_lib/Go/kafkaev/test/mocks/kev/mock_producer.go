@@ -117,6 +117,21 @@ func (mr *MockKevProducerMockRecorder) Flush(timeoutMs interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Flush", reflect.TypeOf((*MockKevProducer)(nil).Flush), timeoutMs)
 }
 
+// GetMetadata mocks base method.
+func (m *MockKevProducer) GetMetadata(topic *string, allTopics bool, timeoutMs int) (*kafka.Metadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMetadata", topic, allTopics, timeoutMs)
+	ret0, _ := ret[0].(*kafka.Metadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMetadata indicates an expected call of GetMetadata.
+func (mr *MockKevProducerMockRecorder) GetMetadata(topic, allTopics, timeoutMs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetadata", reflect.TypeOf((*MockKevProducer)(nil).GetMetadata), topic, allTopics, timeoutMs)
+}
+
 // InitTransactions mocks base method.
 func (m *MockKevProducer) InitTransactions(ctx context.Context) error {
 	m.ctrl.T.Helper()
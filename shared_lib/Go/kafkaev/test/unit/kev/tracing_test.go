@@ -0,0 +1,138 @@
+package unit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/sony-nurdianto/farm/shared_lib/Go/kafkaev/kev"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func setupTracing(t *testing.T) (*tracetest.SpanRecorder, trace.Tracer) {
+	prev := otel.GetTextMapPropagator()
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+	t.Cleanup(func() { otel.SetTextMapPropagator(prev) })
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	return recorder, tp.Tracer("kev-test")
+}
+
+// produced answers a record carrying the trace context of a producer span.
+func produced(t *testing.T, tracer trace.Tracer) (*kafka.Message, trace.SpanContext) {
+	member, err := baggage.NewMember("user.id", "u-1")
+	require.NoError(t, err)
+	bag, err := baggage.New(member)
+	require.NoError(t, err)
+
+	ctx, span := tracer.Start(baggage.ContextWithBaggage(context.Background(), bag), "publish")
+	defer span.End()
+
+	topic := "user-login"
+	msg := kev.MessageKafka{
+		TopicPartition: kev.KafkaTopicPartition{
+			Topic:     &topic,
+			Partition: kev.KafkaPartitionAny,
+		},
+		Headers: kev.TraceHeaders(ctx),
+	}.Factory()
+
+	return &msg, span.SpanContext()
+}
+
+func TestTraceHeaders(t *testing.T) {
+	_, tracer := setupTracing(t)
+
+	msg, producer := produced(t, tracer)
+
+	carrier := kev.NewMessageCarrier(msg)
+	assert.Contains(t, carrier.Get("traceparent"), producer.TraceID().String())
+	assert.Equal(t, "user.id=u-1", carrier.Get("baggage"))
+
+	t.Run("Without Span", func(t *testing.T) {
+		assert.Empty(t, kev.TraceHeaders(context.Background()))
+	})
+}
+
+func TestInjectTraceContext(t *testing.T) {
+	_, tracer := setupTracing(t)
+
+	msg, _ := produced(t, tracer)
+
+	ctx, span := tracer.Start(context.Background(), "republish")
+	defer span.End()
+
+	kev.InjectTraceContext(ctx, msg)
+
+	var traceparents int
+	for _, h := range msg.Headers {
+		if h.Key == "traceparent" {
+			traceparents++
+		}
+	}
+	assert.Equal(t, 1, traceparents)
+	assert.Contains(t, kev.NewMessageCarrier(msg).Get("traceparent"), span.SpanContext().TraceID().String())
+}
+
+func TestExtractTraceContext(t *testing.T) {
+	_, tracer := setupTracing(t)
+
+	msg, producer := produced(t, tracer)
+
+	ctx := kev.ExtractTraceContext(context.Background(), msg)
+
+	sc := trace.SpanContextFromContext(ctx)
+	assert.True(t, sc.IsRemote())
+	assert.Equal(t, producer.TraceID(), sc.TraceID())
+	assert.Equal(t, producer.SpanID(), sc.SpanID())
+	assert.Equal(t, "u-1", baggage.FromContext(ctx).Member("user.id").Value())
+}
+
+func TestStartConsumerSpan(t *testing.T) {
+	recorder, tracer := setupTracing(t)
+
+	loopCtx, loop := tracer.Start(context.Background(), "sync_user_cache")
+	defer loop.End()
+
+	t.Run("Traced Record", func(t *testing.T) {
+		msg, producer := produced(t, tracer)
+
+		_, span := kev.StartConsumerSpan(loopCtx, tracer, "process_kafka_message", msg)
+		span.End()
+
+		ended := recorder.Ended()
+		got := ended[len(ended)-1]
+		assert.Equal(t, trace.SpanKindConsumer, got.SpanKind())
+		assert.Equal(t, producer.TraceID(), got.Parent().TraceID())
+		assert.Equal(t, producer.SpanID(), got.Parent().SpanID())
+		require.Len(t, got.Links(), 1)
+		assert.Equal(t, loop.SpanContext().SpanID(), got.Links()[0].SpanContext.SpanID())
+	})
+
+	t.Run("Untraced Record", func(t *testing.T) {
+		topic := "farmer-db.public.users_all_partitions"
+		msg := &kafka.Message{
+			TopicPartition: kafka.TopicPartition{Topic: &topic},
+			Headers:        []kafka.Header{{Key: "__op", Value: []byte("c")}},
+		}
+
+		_, span := kev.StartConsumerSpan(loopCtx, tracer, "process_kafka_message", msg)
+		span.End()
+
+		ended := recorder.Ended()
+		got := ended[len(ended)-1]
+		assert.Equal(t, loop.SpanContext().SpanID(), got.Parent().SpanID())
+		assert.Empty(t, got.Links())
+	})
+}
@@ -0,0 +1,86 @@
+package trace
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RepoSpans starts and ends the spans of the calls of a repo, named like
+// Repo:GetFarmByID. They go to the provider that observability.Init sets
+// globally.
+type RepoSpans struct {
+	tracerName string
+	outcomes   []error
+}
+
+// NewRepoSpans answers the spans of a repo traced by tracerName. outcomes
+// are the errors the repo answers with rather than fails with, e.g. a farm
+// that does not exist, they are recorded as repo.outcome and leave the
+// status of the span unset.
+func NewRepoSpans(tracerName string, outcomes ...error) RepoSpans {
+	return RepoSpans{tracerName: tracerName, outcomes: outcomes}
+}
+
+// Start starts the internal span of a repo call, the statements it runs on
+// an instrumented database get a client span each.
+func (rs RepoSpans) Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return rs.start(ctx, name, trace.SpanKindInternal, attrs)
+}
+
+// StartClient starts the client span of a call to a store whose client is
+// not instrumented, e.g. a redis command.
+func (rs RepoSpans) StartClient(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return rs.start(ctx, name, trace.SpanKindClient, attrs)
+}
+
+func (rs RepoSpans) start(
+	ctx context.Context,
+	name string,
+	kind trace.SpanKind,
+	attrs []attribute.KeyValue,
+) (context.Context, trace.Span) {
+	return otel.Tracer(rs.tracerName).Start(
+		ctx,
+		"Repo:"+name,
+		trace.WithSpanKind(kind),
+		trace.WithAttributes(attrs...),
+	)
+}
+
+// End records err and ends span.
+func (rs RepoSpans) End(span trace.Span, err error) {
+	switch {
+	case err == nil:
+		span.SetStatus(codes.Ok, "")
+	case rs.isOutcome(err):
+		span.SetAttributes(attribute.String("repo.outcome", err.Error()))
+	default:
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+func (rs RepoSpans) isOutcome(err error) bool {
+	for _, outcome := range rs.outcomes {
+		if errors.Is(err, outcome) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CacheAttrs describes a redis command.
+func CacheAttrs(command string) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("db.system", "redis"),
+		attribute.String("db.operation", command),
+	}
+}
@@ -0,0 +1,78 @@
+package runtime
+
+import (
+	"errors"
+	"fmt"
+)
+
+// The exit codes of a service run by Main.
+const (
+	// ExitOK is a clean shutdown.
+	ExitOK = 0
+	// ExitFailure is a hook that failed to start or failed while running,
+	// the same code log.Fatal exits with.
+	ExitFailure = 1
+	// ExitUncleanShutdown is a shutdown that missed its deadline or whose
+	// hooks failed to stop.
+	ExitUncleanShutdown = 2
+)
+
+// StartError is a hook that failed to start.
+type StartError struct {
+	Hook string
+	Err  error
+}
+
+func (e *StartError) Error() string {
+	return fmt.Sprintf("%s failed to start: %v", e.Hook, e.Err)
+}
+
+func (e *StartError) Unwrap() error {
+	return e.Err
+}
+
+// RunError is a hook whose Run failed before it was stopped.
+type RunError struct {
+	Hook string
+	Err  error
+}
+
+func (e *RunError) Error() string {
+	return fmt.Sprintf("%s failed: %v", e.Hook, e.Err)
+}
+
+func (e *RunError) Unwrap() error {
+	return e.Err
+}
+
+// ShutdownError lists the hooks that did not stop cleanly.
+type ShutdownError struct {
+	Err error
+}
+
+func (e *ShutdownError) Error() string {
+	return fmt.Sprintf("unclean shutdown: %v", e.Err)
+}
+
+func (e *ShutdownError) Unwrap() error {
+	return e.Err
+}
+
+// ExitCode is the exit code of the outcome of Runner.Run, a failure takes
+// precedence over the unclean shutdown that may follow it.
+func ExitCode(err error) int {
+	var startErr *StartError
+	var runErr *RunError
+	var shutdownErr *ShutdownError
+
+	switch {
+	case err == nil:
+		return ExitOK
+	case errors.As(err, &startErr), errors.As(err, &runErr):
+		return ExitFailure
+	case errors.As(err, &shutdownErr):
+		return ExitUncleanShutdown
+	default:
+		return ExitFailure
+	}
+}
@@ -0,0 +1,175 @@
+// Package health serves the grpc.health.v1 status of a gRPC server from
+// periodic probes of the dependencies its services cannot work without.
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/sony-nurdianto/farm/shared_lib/Go/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+const (
+	DefaultInterval = 10 * time.Second
+	DefaultTimeout  = 2 * time.Second
+)
+
+// ReflectionEnv enables server reflection when set to true, it is off by
+// default so production servers do not describe themselves.
+const ReflectionEnv = "GRPC_REFLECTION"
+
+// Probe checks one dependency, e.g. a Postgres or Redis ping.
+type Probe struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+type Checker struct {
+	server   *health.Server
+	probes   []Probe
+	services []string
+	interval time.Duration
+	timeout  time.Duration
+
+	mu      sync.Mutex
+	failing map[string]bool
+}
+
+type Option func(*Checker)
+
+// WithInterval sets how often the probes run.
+func WithInterval(d time.Duration) Option {
+	return func(c *Checker) {
+		if d > 0 {
+			c.interval = d
+		}
+	}
+}
+
+// WithTimeout bounds every probe.
+func WithTimeout(d time.Duration) Option {
+	return func(c *Checker) {
+		if d > 0 {
+			c.timeout = d
+		}
+	}
+}
+
+func NewChecker(probes []Probe, opts ...Option) *Checker {
+	c := &Checker{
+		server:   health.NewServer(),
+		probes:   probes,
+		interval: DefaultInterval,
+		timeout:  DefaultTimeout,
+		failing:  make(map[string]bool, len(probes)),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// Register serves the health service on srv, call it once the services of
+// srv are registered. Every service and the server as a whole, the empty
+// service name, are not serving until the first Check. Server reflection is
+// registered too when ReflectionEnv is true.
+func (c *Checker) Register(srv *grpc.Server) {
+	for name := range srv.GetServiceInfo() {
+		c.services = append(c.services, name)
+	}
+
+	c.setStatus(grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	grpc_health_v1.RegisterHealthServer(srv, c.server)
+
+	if enabled, _ := strconv.ParseBool(os.Getenv(ReflectionEnv)); enabled {
+		reflection.Register(srv)
+	}
+}
+
+// Check runs every probe and reports the services as serving only when all
+// of them pass.
+func (c *Checker) Check(ctx context.Context) error {
+	var errs []error
+	for _, p := range c.probes {
+		probeCtx, cancel := context.WithTimeout(ctx, c.timeout)
+		err := p.Check(probeCtx)
+		cancel()
+
+		c.record(p.Name, err)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", p.Name, err))
+		}
+	}
+
+	status := grpc_health_v1.HealthCheckResponse_SERVING
+	if len(errs) > 0 {
+		status = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+	c.setStatus(status)
+
+	return errors.Join(errs...)
+}
+
+// record logs when a probe starts or stops failing, not on every run.
+func (c *Checker) record(name string, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch {
+	case err != nil && !c.failing[name]:
+		log.Printf("health: %s is failing: %v", name, err)
+	case err == nil && c.failing[name]:
+		log.Printf("health: %s recovered", name)
+	}
+	c.failing[name] = err != nil
+}
+
+func (c *Checker) setStatus(status grpc_health_v1.HealthCheckResponse_ServingStatus) {
+	c.server.SetServingStatus("", status)
+	for _, name := range c.services {
+		c.server.SetServingStatus(name, status)
+	}
+}
+
+// Hook checks the probes once the server it depends on is started and
+// every interval after. It stops before that server, so the server is
+// reported as not serving while it drains.
+func (c *Checker) Hook(dependsOn ...string) runtime.Hook {
+	return runtime.Hook{
+		Name:      "health",
+		DependsOn: dependsOn,
+		Start: func(ctx context.Context) error {
+			// a failing dependency is reported, it does not fail the start
+			c.Check(ctx)
+			return nil
+		},
+		Run: func(ctx context.Context) error {
+			ticker := time.NewTicker(c.interval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-ticker.C:
+					c.Check(ctx)
+				}
+			}
+		},
+		Stop: func(context.Context) error {
+			c.server.Shutdown()
+			return nil
+		},
+	}
+}
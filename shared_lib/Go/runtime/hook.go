@@ -0,0 +1,93 @@
+package runtime
+
+import (
+	"context"
+	"net"
+)
+
+// Hook is a component of a service the Runner starts and stops, e.g. a
+// repository, a server or a consumer loop. Every func is optional.
+type Hook struct {
+	Name string
+	// DependsOn names the hooks that must be started, and so ready, before
+	// this one starts. They stop after it.
+	DependsOn []string
+	// Start returns once the component is ready to be used by the hooks
+	// depending on it, long running work belongs in Run.
+	Start func(ctx context.Context) error
+	// Run blocks for the life of the component. Its context is cancelled
+	// when the component stops, an error before that fails the service.
+	Run func(ctx context.Context) error
+	// Stop releases the component. It is called after the Run context is
+	// cancelled, ctx is done once the shutdown deadline passes.
+	Stop func(ctx context.Context) error
+}
+
+// GracefulServer is a server that drains its in-flight requests on
+// GracefulStop, like *grpc.Server.
+type GracefulServer interface {
+	Serve(lis net.Listener) error
+	GracefulStop()
+	Stop()
+}
+
+// ServerHook listens on addr when it starts and serves srv until it stops.
+// The in-flight requests are drained until the shutdown deadline, the
+// connections left are closed then.
+func ServerHook(name string, addr string, srv GracefulServer, dependsOn ...string) Hook {
+	var lis net.Listener
+
+	return Hook{
+		Name:      name,
+		DependsOn: dependsOn,
+		Start: func(context.Context) (err error) {
+			lis, err = net.Listen("tcp", addr)
+			return err
+		},
+		Run: func(context.Context) error {
+			return srv.Serve(lis)
+		},
+		Stop: func(ctx context.Context) error {
+			drained := make(chan struct{})
+			go func() {
+				srv.GracefulStop()
+				close(drained)
+			}()
+
+			select {
+			case <-drained:
+				return nil
+			case <-ctx.Done():
+				srv.Stop()
+				return ctx.Err()
+			}
+		},
+	}
+}
+
+// HTTPServer is an HTTP server that drains its in-flight requests on
+// shutdown, like *fiber.App.
+type HTTPServer interface {
+	Listener(ln net.Listener) error
+	ShutdownWithContext(ctx context.Context) error
+}
+
+// HTTPHook listens on addr when it starts and serves app until it stops.
+func HTTPHook(name string, addr string, app HTTPServer, dependsOn ...string) Hook {
+	var lis net.Listener
+
+	return Hook{
+		Name:      name,
+		DependsOn: dependsOn,
+		Start: func(context.Context) (err error) {
+			lis, err = net.Listen("tcp", addr)
+			return err
+		},
+		Run: func(context.Context) error {
+			return app.Listener(lis)
+		},
+		Stop: func(ctx context.Context) error {
+			return app.ShutdownWithContext(ctx)
+		},
+	}
+}
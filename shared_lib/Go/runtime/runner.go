@@ -0,0 +1,235 @@
+// Package runtime runs the components of a service for its lifetime. The
+// components are started in the order of their dependencies, run until the
+// service is signalled or one of them fails and are then stopped in the
+// reverse order within a shutdown deadline.
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+// DefaultShutdownTimeout bounds the shutdown of a Runner unless
+// WithShutdownTimeout says otherwise.
+const DefaultShutdownTimeout = 30 * time.Second
+
+type Runner struct {
+	hooks           []Hook
+	shutdownTimeout time.Duration
+}
+
+type Option func(*Runner)
+
+// WithShutdownTimeout bounds how long the hooks may take to stop.
+func WithShutdownTimeout(d time.Duration) Option {
+	return func(r *Runner) {
+		if d > 0 {
+			r.shutdownTimeout = d
+		}
+	}
+}
+
+func NewRunner(opts ...Option) *Runner {
+	r := &Runner{shutdownTimeout: DefaultShutdownTimeout}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// Register adds hooks, they are started in the order they are registered
+// unless a dependency asks for another.
+func (r *Runner) Register(hooks ...Hook) {
+	r.hooks = append(r.hooks, hooks...)
+}
+
+// SignalContext is done on SIGINT or SIGTERM, a service builds its
+// components with it and hands it to Main.
+func SignalContext() (context.Context, context.CancelFunc) {
+	return signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+}
+
+// Main runs the hooks until ctx is done and exits the process with the
+// ExitCode of the outcome. The deferred calls of the caller do not run,
+// what has to be released belongs in a Stop.
+func (r *Runner) Main(ctx context.Context) {
+	err := r.Run(ctx)
+	if err != nil {
+		log.Println(err)
+	}
+
+	os.Exit(ExitCode(err))
+}
+
+type running struct {
+	hook   Hook
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// Run starts the hooks and blocks until ctx is done or a hook fails, the
+// hooks that started are then stopped. It returns nil after a clean
+// shutdown.
+func (r *Runner) Run(ctx context.Context) error {
+	order, err := r.order()
+	if err != nil {
+		return &StartError{Hook: "runtime", Err: err}
+	}
+
+	// the hooks stop one after the other, not all at once when ctx is done
+	runCtx := context.WithoutCancel(ctx)
+	failed := make(chan error, len(order))
+
+	var started []*running
+	var startErr error
+	for _, h := range order {
+		if ctx.Err() != nil {
+			break
+		}
+
+		if h.Start != nil {
+			if err := h.Start(ctx); err != nil {
+				startErr = &StartError{Hook: h.Name, Err: err}
+				break
+			}
+		}
+
+		rn := &running{hook: h, done: make(chan struct{})}
+		rn.ctx, rn.cancel = context.WithCancel(runCtx)
+		started = append(started, rn)
+		go rn.run(failed)
+	}
+
+	var runErr error
+	if startErr == nil {
+		log.Printf("%d components running", len(started))
+
+		select {
+		case <-ctx.Done():
+			log.Println("shutdown signalled, stopping")
+		case runErr = <-failed:
+			log.Println(runErr)
+		}
+	}
+
+	stopErr := r.shutdown(started)
+
+	return errors.Join(startErr, runErr, stopErr)
+}
+
+func (rn *running) run(failed chan<- error) {
+	defer close(rn.done)
+
+	if rn.hook.Run == nil {
+		return
+	}
+
+	err := rn.hook.Run(rn.ctx)
+	if err == nil {
+		return
+	}
+
+	// a cancelled Run is how a stop looks from the inside
+	if rn.ctx.Err() != nil && errors.Is(err, context.Canceled) {
+		return
+	}
+
+	if rn.ctx.Err() != nil {
+		log.Printf("%s stopped with: %v", rn.hook.Name, err)
+		return
+	}
+
+	failed <- &RunError{Hook: rn.hook.Name, Err: err}
+}
+
+// shutdown stops the hooks in the reverse order they started, every hook
+// is stopped even when the deadline has passed so it can release what it
+// holds.
+func (r *Runner) shutdown(started []*running) error {
+	ctx, cancel := context.WithTimeout(context.Background(), r.shutdownTimeout)
+	defer cancel()
+
+	var errs []error
+	for i := len(started) - 1; i >= 0; i-- {
+		rn := started[i]
+		rn.cancel()
+
+		if rn.hook.Stop != nil {
+			if err := rn.hook.Stop(ctx); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", rn.hook.Name, err))
+			}
+		}
+
+		select {
+		case <-rn.done:
+		case <-ctx.Done():
+			errs = append(errs, fmt.Errorf("%s did not stop: %w", rn.hook.Name, ctx.Err()))
+		}
+	}
+
+	if len(errs) > 0 {
+		return &ShutdownError{Err: errors.Join(errs...)}
+	}
+
+	return nil
+}
+
+// order sorts the hooks so every hook follows its dependencies, hooks keep
+// their registration order otherwise.
+func (r *Runner) order() ([]Hook, error) {
+	byName := make(map[string]Hook, len(r.hooks))
+	for _, h := range r.hooks {
+		if _, ok := byName[h.Name]; ok {
+			return nil, fmt.Errorf("hook %q is registered twice", h.Name)
+		}
+		byName[h.Name] = h
+	}
+
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := make(map[string]int, len(r.hooks))
+	order := make([]Hook, 0, len(r.hooks))
+
+	var visit func(h Hook) error
+	visit = func(h Hook) error {
+		switch state[h.Name] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("hook %q depends on itself", h.Name)
+		}
+
+		state[h.Name] = visiting
+		for _, dep := range h.DependsOn {
+			d, ok := byName[dep]
+			if !ok {
+				return fmt.Errorf("hook %q depends on unknown hook %q", h.Name, dep)
+			}
+			if err := visit(d); err != nil {
+				return err
+			}
+		}
+		state[h.Name] = visited
+
+		order = append(order, h)
+		return nil
+	}
+
+	for _, h := range r.hooks {
+		if err := visit(h); err != nil {
+			return nil, err
+		}
+	}
+
+	return order, nil
+}
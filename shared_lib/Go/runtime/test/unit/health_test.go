@@ -0,0 +1,143 @@
+package unit_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync/atomic"
+	"testing"
+
+	"github.com/sony-nurdianto/farm/shared_lib/Go/runtime/health"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type echoServer interface{}
+
+var echoDesc = grpc.ServiceDesc{
+	ServiceName: "test.v1.EchoService",
+	HandlerType: (*echoServer)(nil),
+}
+
+// serveChecker serves c on an in-memory listener with one registered
+// service and returns a client connection to it.
+func serveChecker(t *testing.T, c *health.Checker) *grpc.ClientConn {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	srv.RegisterService(&echoDesc, struct{}{})
+	c.Register(srv)
+
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return conn
+}
+
+func servingStatus(t *testing.T, conn *grpc.ClientConn, service string) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	t.Helper()
+
+	res, err := grpc_health_v1.NewHealthClient(conn).Check(
+		context.Background(),
+		&grpc_health_v1.HealthCheckRequest{Service: service},
+	)
+	assert.NoError(t, err)
+
+	return res.GetStatus()
+}
+
+func TestChecker(t *testing.T) {
+	t.Run("Not Serving Before The First Check", func(t *testing.T) {
+		c := health.NewChecker(nil)
+		conn := serveChecker(t, c)
+
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, servingStatus(t, conn, ""))
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, servingStatus(t, conn, echoDesc.ServiceName))
+	})
+
+	t.Run("Follows The Probes", func(t *testing.T) {
+		var down atomic.Bool
+		c := health.NewChecker([]health.Probe{
+			{Name: "cache", Check: func(context.Context) error { return nil }},
+			{Name: "database", Check: func(context.Context) error {
+				if down.Load() {
+					return errors.New("connection refused")
+				}
+				return nil
+			}},
+		})
+		conn := serveChecker(t, c)
+
+		assert.NoError(t, c.Check(context.Background()))
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, servingStatus(t, conn, ""))
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, servingStatus(t, conn, echoDesc.ServiceName))
+
+		down.Store(true)
+		err := c.Check(context.Background())
+		assert.ErrorContains(t, err, "database: connection refused")
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, servingStatus(t, conn, ""))
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, servingStatus(t, conn, echoDesc.ServiceName))
+
+		down.Store(false)
+		assert.NoError(t, c.Check(context.Background()))
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, servingStatus(t, conn, ""))
+	})
+
+	t.Run("Not Serving Once Stopped", func(t *testing.T) {
+		c := health.NewChecker(nil)
+		conn := serveChecker(t, c)
+		hook := c.Hook()
+
+		assert.NoError(t, hook.Start(context.Background()))
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, servingStatus(t, conn, ""))
+
+		assert.NoError(t, hook.Stop(context.Background()))
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, servingStatus(t, conn, ""))
+	})
+}
+
+func TestCheckerReflection(t *testing.T) {
+	listServices := func(t *testing.T, conn *grpc.ClientConn) error {
+		stream, err := grpc_reflection_v1.NewServerReflectionClient(conn).ServerReflectionInfo(context.Background())
+		if err != nil {
+			return err
+		}
+		err = stream.Send(&grpc_reflection_v1.ServerReflectionRequest{
+			MessageRequest: &grpc_reflection_v1.ServerReflectionRequest_ListServices{},
+		})
+		if err != nil {
+			return err
+		}
+		_, err = stream.Recv()
+		return err
+	}
+
+	t.Run("Disabled By Default", func(t *testing.T) {
+		t.Setenv(health.ReflectionEnv, "")
+		conn := serveChecker(t, health.NewChecker(nil))
+
+		assert.Error(t, listServices(t, conn))
+	})
+
+	t.Run("Enabled By The Env", func(t *testing.T) {
+		t.Setenv(health.ReflectionEnv, "true")
+		conn := serveChecker(t, health.NewChecker(nil))
+
+		assert.NoError(t, listServices(t, conn))
+	})
+}
@@ -0,0 +1,238 @@
+package unit_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sony-nurdianto/farm/shared_lib/Go/runtime"
+	"github.com/stretchr/testify/assert"
+)
+
+// journal records the lifecycle events of the hooks in order.
+type journal struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (j *journal) add(event string) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.events = append(j.events, event)
+}
+
+func (j *journal) list() []string {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return append([]string(nil), j.events...)
+}
+
+func hook(j *journal, name string, dependsOn ...string) runtime.Hook {
+	return runtime.Hook{
+		Name:      name,
+		DependsOn: dependsOn,
+		Start: func(context.Context) error {
+			j.add("start " + name)
+			return nil
+		},
+		Run: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		},
+		Stop: func(context.Context) error {
+			j.add("stop " + name)
+			return nil
+		},
+	}
+}
+
+func TestRunnerOrder(t *testing.T) {
+	j := &journal{}
+	r := runtime.NewRunner()
+	r.Register(
+		hook(j, "server", "repo"),
+		hook(j, "repo", "observability"),
+		hook(j, "observability"),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		for len(j.list()) < 3 {
+			time.Sleep(time.Millisecond)
+		}
+		cancel()
+	}()
+
+	err := r.Run(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, runtime.ExitOK, runtime.ExitCode(err))
+	assert.Equal(t, []string{
+		"start observability",
+		"start repo",
+		"start server",
+		"stop server",
+		"stop repo",
+		"stop observability",
+	}, j.list())
+}
+
+func TestRunnerStartFailure(t *testing.T) {
+	j := &journal{}
+	broken := hook(j, "repo")
+	broken.Start = func(context.Context) error {
+		return errors.New("connection refused")
+	}
+
+	r := runtime.NewRunner()
+	r.Register(hook(j, "observability"), broken, hook(j, "server", "repo"))
+
+	err := r.Run(context.Background())
+	var startErr *runtime.StartError
+	if assert.ErrorAs(t, err, &startErr) {
+		assert.Equal(t, "repo", startErr.Hook)
+	}
+	assert.Equal(t, runtime.ExitFailure, runtime.ExitCode(err))
+	assert.Equal(t, []string{"start observability", "stop observability"}, j.list())
+}
+
+func TestRunnerRunFailure(t *testing.T) {
+	j := &journal{}
+	consumer := hook(j, "consumer")
+	consumer.Run = func(context.Context) error {
+		return errors.New("broker is gone")
+	}
+
+	r := runtime.NewRunner()
+	r.Register(hook(j, "repo"), consumer)
+
+	err := r.Run(context.Background())
+	var runErr *runtime.RunError
+	if assert.ErrorAs(t, err, &runErr) {
+		assert.Equal(t, "consumer", runErr.Hook)
+	}
+	assert.Equal(t, runtime.ExitFailure, runtime.ExitCode(err))
+	assert.Equal(t, []string{"start repo", "start consumer", "stop consumer", "stop repo"}, j.list())
+}
+
+func TestRunnerShutdownTimeout(t *testing.T) {
+	j := &journal{}
+	stuck := hook(j, "stuck")
+	stuck.Run = func(context.Context) error {
+		select {}
+	}
+
+	r := runtime.NewRunner(runtime.WithShutdownTimeout(20 * time.Millisecond))
+	r.Register(hook(j, "repo"), stuck)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// a signal before the start starts nothing
+	assert.NoError(t, r.Run(ctx))
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := r.Run(ctx)
+	var shutdownErr *runtime.ShutdownError
+	assert.ErrorAs(t, err, &shutdownErr)
+	assert.Equal(t, runtime.ExitUncleanShutdown, runtime.ExitCode(err))
+	// the hooks after the stuck one still stop
+	assert.Contains(t, j.list(), "stop repo")
+}
+
+func TestRunnerDependencies(t *testing.T) {
+	j := &journal{}
+
+	t.Run("Unknown", func(t *testing.T) {
+		r := runtime.NewRunner()
+		r.Register(hook(j, "server", "repo"))
+		assert.ErrorContains(t, r.Run(context.Background()), `unknown hook "repo"`)
+	})
+
+	t.Run("Cycle", func(t *testing.T) {
+		r := runtime.NewRunner()
+		r.Register(hook(j, "a", "b"), hook(j, "b", "a"))
+		assert.ErrorContains(t, r.Run(context.Background()), "depends on itself")
+	})
+
+	assert.Empty(t, j.list())
+}
+
+// server is a GracefulServer whose GracefulStop waits for the in-flight
+// requests.
+type server struct {
+	mu       sync.Mutex
+	lis      net.Listener
+	inFlight sync.WaitGroup
+	drained  bool
+}
+
+func (s *server) Serve(lis net.Listener) error {
+	s.mu.Lock()
+	s.lis = lis
+	s.mu.Unlock()
+
+	for {
+		if _, err := lis.Accept(); err != nil {
+			return nil
+		}
+	}
+}
+
+func (s *server) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.lis != nil {
+		s.lis.Close()
+	}
+}
+
+func (s *server) GracefulStop() {
+	s.close()
+	s.inFlight.Wait()
+	s.drained = true
+}
+
+func (s *server) Stop() {
+	s.close()
+}
+
+func TestServerHook(t *testing.T) {
+	t.Run("Drains", func(t *testing.T) {
+		srv := &server{}
+		srv.inFlight.Add(1)
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			srv.inFlight.Done()
+		}()
+
+		r := runtime.NewRunner()
+		r.Register(runtime.ServerHook("grpc", "127.0.0.1:0", srv))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		assert.NoError(t, r.Run(ctx))
+		assert.True(t, srv.drained)
+	})
+
+	t.Run("Deadline", func(t *testing.T) {
+		srv := &server{}
+		srv.inFlight.Add(1)
+		defer srv.inFlight.Done()
+
+		r := runtime.NewRunner(runtime.WithShutdownTimeout(10 * time.Millisecond))
+		r.Register(runtime.ServerHook("grpc", "127.0.0.1:0", srv))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		err := r.Run(ctx)
+		assert.Equal(t, runtime.ExitUncleanShutdown, runtime.ExitCode(err))
+		assert.False(t, srv.drained)
+	})
+}